@@ -27,6 +27,11 @@ const (
 	HeaderAuthStatus  = "X-Auth-Status"
 	HeaderSessionUUID = "X-Session-Uuid"
 
+	// Роли и права пользователя через запятую; заголовок передаётся всегда,
+	// чтобы значение от клиента с тем же именем было перезаписано
+	HeaderUserRoles       = "X-User-Roles"
+	HeaderUserPermissions = "X-User-Permissions"
+
 	HeaderCookie        = "cookie"
 	HeaderAuthorization = "authorization"
 
@@ -185,6 +190,18 @@ func (a *api) allowRequest(whoamiResp *iamauth.WhoamiResponse, sessionUUID strin
 				Value: whoamiResp.User.Info.Login,
 			},
 		},
+		{
+			Header: &corev3.HeaderValue{
+				Key:   HeaderUserRoles,
+				Value: strings.Join(whoamiResp.User.Roles, ","),
+			},
+		},
+		{
+			Header: &corev3.HeaderValue{
+				Key:   HeaderUserPermissions,
+				Value: strings.Join(whoamiResp.User.Permissions, ","),
+			},
+		},
 	}
 
 	if sessionUUID != "" {
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+const (
+	HeaderUserAgent    = "user-agent"
+	HeaderForwardedFor = "x-forwarded-for"
+	HeaderRealIP       = "x-real-ip"
+)
+
+// clientInfoFromContext определяет клиента по metadata: при вызове через envoy
+// адрес берётся из X-Forwarded-For/X-Real-IP, иначе — адрес gRPC peer
+func clientInfoFromContext(ctx context.Context) model.ClientInfo {
+	var info model.ClientInfo
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(HeaderUserAgent); len(values) > 0 {
+		info.UserAgent = values[0]
+	}
+
+	// Последний адрес X-Forwarded-For дописывает envoy (use_remote_address):
+	// предыдущие присылает сам клиент, и по ним легко обойти ограничения по IP
+	if values := md.Get(HeaderForwardedFor); len(values) > 0 {
+		addrs := strings.Split(values[len(values)-1], ",")
+		info.IP = strings.TrimSpace(addrs[len(addrs)-1])
+	}
+	if info.IP == "" {
+		if values := md.Get(HeaderRealIP); len(values) > 0 {
+			info.IP = strings.TrimSpace(values[0])
+		}
+	}
+	if info.IP == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			info.IP = p.Addr.String()
+			if host, _, err := net.SplitHostPort(info.IP); err == nil {
+				info.IP = host
+			}
+		}
+	}
+
+	return info
+}
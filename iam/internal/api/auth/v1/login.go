@@ -2,10 +2,14 @@ package v1
 
 import (
 	"context"
+	"errors"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
 	iamauth "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/auth/v1"
 )
 
@@ -24,8 +28,12 @@ func (a *api) Login(ctx context.Context, req *iamauth.LoginRequest) (*iamauth.Lo
 		return nil, status.Error(codes.InvalidArgument, "password cannot be empty")
 	}
 
-	sessionUUID, err := a.authService.Login(ctx, login, password)
+	sessionUUID, err := a.authService.Login(ctx, login, password, clientInfoFromContext(ctx))
 	if err != nil {
+		var lockedErr *model.LoginLockedError
+		if errors.As(err, &lockedErr) {
+			return nil, loginLockedStatus(lockedErr)
+		}
 		return nil, status.Error(codes.Unauthenticated, "authentication failed")
 	}
 
@@ -33,3 +41,16 @@ func (a *api) Login(ctx context.Context, req *iamauth.LoginRequest) (*iamauth.Lo
 		SessionUuid: sessionUUID,
 	}, nil
 }
+
+// loginLockedStatus возвращает ResourceExhausted с errdetails.RetryInfo, чтобы клиент знал, когда повторить вход
+func loginLockedStatus(lockedErr *model.LoginLockedError) error {
+	st := status.New(codes.ResourceExhausted, "too many failed login attempts, try again later")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(lockedErr.RetryAfter),
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
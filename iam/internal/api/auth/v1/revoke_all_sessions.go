@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	iamauth "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/auth/v1"
+)
+
+func (a *api) RevokeAllSessions(ctx context.Context, req *iamauth.RevokeAllSessionsRequest) (*iamauth.RevokeAllSessionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	sessionUUID := req.GetSessionUuid()
+	if sessionUUID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_uuid cannot be empty")
+	}
+
+	revoked, err := a.authService.RevokeAllSessions(ctx, sessionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "session not found or expired")
+		}
+		return nil, status.Error(codes.Internal, "failed to revoke sessions")
+	}
+
+	return &iamauth.RevokeAllSessionsResponse{
+		RevokedCount: int32(revoked), //nolint:gosec // число сессий пользователя невелико
+	}, nil
+}
@@ -2,16 +2,26 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 	userV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/user/v1"
 )
 
+// registerFieldPaths сопоставляет поля модели с путями полей RegisterRequest
+var registerFieldPaths = map[string]string{
+	model.FieldLogin:    "info.info.login",
+	model.FieldEmail:    "info.info.email",
+	model.FieldPassword: "info.password",
+}
+
 func (a *api) Register(ctx context.Context, req *userV1.RegisterRequest) (*userV1.RegisterResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
@@ -27,23 +37,21 @@ func (a *api) Register(ctx context.Context, req *userV1.RegisterRequest) (*userV
 		return nil, status.Error(codes.InvalidArgument, "info.info cannot be nil")
 	}
 
+	// Поля проверяет сервис: так клиент получает ошибки по всем полям сразу
 	login := userInfo.GetLogin()
-	if login == "" {
-		return nil, status.Error(codes.InvalidArgument, "login cannot be empty")
-	}
-
 	email := userInfo.GetEmail()
-	if email == "" {
-		return nil, status.Error(codes.InvalidArgument, "email cannot be empty")
-	}
-
 	password := info.GetPassword()
-	if password == "" {
-		return nil, status.Error(codes.InvalidArgument, "password cannot be empty")
-	}
 
 	userUUID, err := a.userService.Register(ctx, login, email, password)
 	if err != nil {
+		var validationErr *model.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, validationStatus(validationErr)
+		}
+		if errors.Is(err, model.ErrUserAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "user with this login or email already exists")
+		}
+
 		logger.Error(ctx,
 			"failed to register user",
 			zap.String("login", login),
@@ -57,3 +65,24 @@ func (a *api) Register(ctx context.Context, req *userV1.RegisterRequest) (*userV
 		UserUuid: userUUID,
 	}, nil
 }
+
+// validationStatus возвращает InvalidArgument с errdetails.BadRequest по каждому полю
+func validationStatus(validationErr *model.ValidationError) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range validationErr.Violations {
+		field, ok := registerFieldPaths[violation.Field]
+		if !ok {
+			field = violation.Field
+		}
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: violation.Description,
+		})
+	}
+
+	st, err := status.New(codes.InvalidArgument, "invalid registration data").WithDetails(badRequest)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, validationErr.Error())
+	}
+	return st.Err()
+}
@@ -12,9 +12,11 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/nkolesnikov999/micro2-OK/iam/internal/config"
+	iamMetrics "github.com/nkolesnikov999/micro2-OK/iam/internal/metrics"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/grpc/health"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/metrics"
 	authV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/auth/v1"
 	userV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/user/v1"
 )
@@ -45,6 +47,7 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initMetrics,
 		a.initListener,
 		a.initGRPCServer,
 	}
@@ -80,6 +83,22 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initMetrics(ctx context.Context) error {
+	err := metrics.InitProvider(ctx, config.AppConfig().MetricCollector)
+	if err != nil {
+		return err
+	}
+
+	err = iamMetrics.InitMetrics(config.AppConfig().MetricCollector.ServiceName())
+	if err != nil {
+		return err
+	}
+
+	closer.AddNamed("metrics provider", metrics.Shutdown)
+
+	return nil
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().GRPC.Address())
 	if err != nil {
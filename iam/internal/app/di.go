@@ -14,6 +14,7 @@ import (
 	userV1API "github.com/nkolesnikov999/micro2-OK/iam/internal/api/user/v1"
 	"github.com/nkolesnikov999/micro2-OK/iam/internal/config"
 	"github.com/nkolesnikov999/micro2-OK/iam/internal/repository"
+	loginAttemptRepository "github.com/nkolesnikov999/micro2-OK/iam/internal/repository/login_attempt"
 	sessionRepository "github.com/nkolesnikov999/micro2-OK/iam/internal/repository/session"
 	userRepository "github.com/nkolesnikov999/micro2-OK/iam/internal/repository/user"
 	"github.com/nkolesnikov999/micro2-OK/iam/internal/service"
@@ -35,8 +36,9 @@ type diContainer struct {
 	authService service.AuthService
 	userService service.UserService
 
-	sessionRepository repository.SessionRepository
-	userRepository    repository.UserRepository
+	sessionRepository      repository.SessionRepository
+	userRepository         repository.UserRepository
+	loginAttemptRepository repository.LoginAttemptRepository
 
 	postgresDB  *pgx.Conn
 	redisClient cache.RedisClient
@@ -75,6 +77,9 @@ func (d *diContainer) AuthService(ctx context.Context) service.AuthService {
 		d.authService = authService.NewService(
 			d.SessionRepository(ctx),
 			d.UserRepository(ctx),
+			d.LoginAttemptRepository(ctx),
+			config.AppConfig().Session.Policy(),
+			config.AppConfig().LoginThrottle.Policy(),
 		)
 	}
 
@@ -85,6 +90,7 @@ func (d *diContainer) UserService(ctx context.Context) service.UserService {
 	if d.userService == nil {
 		d.userService = userService.NewService(
 			d.UserRepository(ctx),
+			config.AppConfig().Password.Policy(),
 		)
 	}
 
@@ -107,6 +113,14 @@ func (d *diContainer) UserRepository(ctx context.Context) repository.UserReposit
 	return d.userRepository
 }
 
+func (d *diContainer) LoginAttemptRepository(ctx context.Context) repository.LoginAttemptRepository {
+	if d.loginAttemptRepository == nil {
+		d.loginAttemptRepository = loginAttemptRepository.NewRepository(d.RedisClient(ctx))
+	}
+
+	return d.loginAttemptRepository
+}
+
 func (d *diContainer) PostgresDB(ctx context.Context) *pgx.Conn {
 	if d.postgresDB == nil {
 		conn, err := pgx.Connect(ctx, config.AppConfig().Postgres.URI())
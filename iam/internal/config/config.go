@@ -11,11 +11,14 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger   LoggerConfig
-	GRPC     GRPCConfig
-	Postgres PostgresConfig
-	Redis    RedisConfig
-	Session  SessionConfig
+	Logger          LoggerConfig
+	GRPC            GRPCConfig
+	Postgres        PostgresConfig
+	Redis           RedisConfig
+	Session         SessionConfig
+	Password        PasswordConfig
+	LoginThrottle   LoginThrottleConfig
+	MetricCollector MetricCollectorConfig
 }
 
 func Load(path ...string) error {
@@ -49,12 +52,30 @@ func Load(path ...string) error {
 		return err
 	}
 
+	passwordCfg, err := env.NewPasswordConfig()
+	if err != nil {
+		return err
+	}
+
+	loginThrottleCfg, err := env.NewLoginThrottleConfig()
+	if err != nil {
+		return err
+	}
+
+	metricCollectorCfg, err := env.NewMetricCollectorConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:   loggerCfg,
-		GRPC:     grpcCfg,
-		Postgres: postgresCfg,
-		Redis:    redisCfg,
-		Session:  sessionCfg,
+		Logger:          loggerCfg,
+		GRPC:            grpcCfg,
+		Postgres:        postgresCfg,
+		Redis:           redisCfg,
+		Session:         sessionCfg,
+		Password:        passwordCfg,
+		LoginThrottle:   loginThrottleCfg,
+		MetricCollector: metricCollectorCfg,
 	}
 
 	return nil
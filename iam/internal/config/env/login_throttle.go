@@ -0,0 +1,57 @@
+package env
+
+import (
+	"errors"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+type loginThrottleEnvConfig struct {
+	MaxLoginFailures int64         `env:"LOGIN_THROTTLE_MAX_LOGIN_FAILURES,required"`
+	MaxIPFailures    int64         `env:"LOGIN_THROTTLE_MAX_IP_FAILURES,required"`
+	FailureWindow    time.Duration `env:"LOGIN_THROTTLE_FAILURE_WINDOW,required"`
+	BaseLockout      time.Duration `env:"LOGIN_THROTTLE_BASE_LOCKOUT,required"`
+	MaxLockout       time.Duration `env:"LOGIN_THROTTLE_MAX_LOCKOUT,required"`
+}
+
+type loginThrottleConfig struct {
+	raw loginThrottleEnvConfig
+}
+
+func NewLoginThrottleConfig() (*loginThrottleConfig, error) {
+	var raw loginThrottleEnvConfig
+	err := env.Parse(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw.MaxLoginFailures < 0 || raw.MaxIPFailures < 0 {
+		return nil, errors.New("LOGIN_THROTTLE_MAX_*_FAILURES must not be negative")
+	}
+	if raw.FailureWindow < time.Second {
+		return nil, errors.New("LOGIN_THROTTLE_FAILURE_WINDOW must be at least 1s")
+	}
+	// TTL ключей Redis задаётся в секундах
+	if raw.BaseLockout < time.Second {
+		return nil, errors.New("LOGIN_THROTTLE_BASE_LOCKOUT must be at least 1s")
+	}
+	if raw.MaxLockout < raw.BaseLockout {
+		return nil, errors.New("LOGIN_THROTTLE_MAX_LOCKOUT must not be less than LOGIN_THROTTLE_BASE_LOCKOUT")
+	}
+
+	return &loginThrottleConfig{raw: raw}, nil
+}
+
+// Policy возвращает правила блокировки входа
+func (cfg *loginThrottleConfig) Policy() model.LoginThrottlePolicy {
+	return model.LoginThrottlePolicy{
+		MaxLoginFailures: cfg.raw.MaxLoginFailures,
+		MaxIPFailures:    cfg.raw.MaxIPFailures,
+		FailureWindow:    cfg.raw.FailureWindow,
+		BaseLockout:      cfg.raw.BaseLockout,
+		MaxLockout:       cfg.raw.MaxLockout,
+	}
+}
@@ -0,0 +1,94 @@
+package env
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+// bcryptMaxLength — bcrypt учитывает только первые 72 байта пароля
+const bcryptMaxLength = 72
+
+type passwordEnvConfig struct {
+	MinLength      int    `env:"PASSWORD_MIN_LENGTH,required"`
+	MaxLength      int    `env:"PASSWORD_MAX_LENGTH,required"`
+	RequireUpper   bool   `env:"PASSWORD_REQUIRE_UPPER,required"`
+	RequireLower   bool   `env:"PASSWORD_REQUIRE_LOWER,required"`
+	RequireDigit   bool   `env:"PASSWORD_REQUIRE_DIGIT,required"`
+	RequireSpecial bool   `env:"PASSWORD_REQUIRE_SPECIAL,required"`
+	DenylistPath   string `env:"PASSWORD_DENYLIST_PATH"`
+}
+
+type passwordConfig struct {
+	raw      passwordEnvConfig
+	denylist map[string]struct{}
+}
+
+func NewPasswordConfig() (*passwordConfig, error) {
+	var raw passwordEnvConfig
+	err := env.Parse(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw.MinLength <= 0 || raw.MinLength > raw.MaxLength {
+		return nil, errors.New("PASSWORD_MIN_LENGTH must be positive and not greater than PASSWORD_MAX_LENGTH")
+	}
+	if raw.MaxLength > bcryptMaxLength {
+		return nil, fmt.Errorf("PASSWORD_MAX_LENGTH must not exceed %d", bcryptMaxLength)
+	}
+
+	denylist, err := loadDenylist(raw.DenylistPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &passwordConfig{raw: raw, denylist: denylist}, nil
+}
+
+// Policy возвращает парольную политику вместе со списком утёкших паролей
+func (cfg *passwordConfig) Policy() model.PasswordPolicy {
+	return model.PasswordPolicy{
+		MinLength:      cfg.raw.MinLength,
+		MaxLength:      cfg.raw.MaxLength,
+		RequireUpper:   cfg.raw.RequireUpper,
+		RequireLower:   cfg.raw.RequireLower,
+		RequireDigit:   cfg.raw.RequireDigit,
+		RequireSpecial: cfg.raw.RequireSpecial,
+		Denylist:       cfg.denylist,
+	}
+}
+
+// loadDenylist читает файл с паролями по одному на строку; пустой путь — без списка
+func loadDenylist(path string) (map[string]struct{}, error) {
+	denylist := make(map[string]struct{})
+	if path == "" {
+		return denylist, nil
+	}
+
+	file, err := os.Open(path) //nolint:gosec // путь задаётся конфигурацией сервиса
+	if err != nil {
+		return nil, fmt.Errorf("failed to open password denylist: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		denylist[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read password denylist: %w", err)
+	}
+
+	return denylist, nil
+}
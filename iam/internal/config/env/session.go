@@ -1,13 +1,19 @@
 package env
 
 import (
+	"errors"
 	"time"
 
 	"github.com/caarlos0/env/v11"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
 )
 
 type sessionEnvConfig struct {
-	TTL time.Duration `env:"SESSION_TTL,required"`
+	TTL             time.Duration `env:"SESSION_TTL,required"`
+	Sliding         bool          `env:"SESSION_SLIDING,required"`
+	RefreshInterval time.Duration `env:"SESSION_REFRESH_INTERVAL,required"`
+	MaxLifetime     time.Duration `env:"SESSION_MAX_LIFETIME,required"`
 }
 
 type sessionConfig struct {
@@ -21,9 +27,29 @@ func NewSessionConfig() (*sessionConfig, error) {
 		return nil, err
 	}
 
+	if raw.TTL <= 0 {
+		return nil, errors.New("SESSION_TTL must be positive")
+	}
+	if raw.RefreshInterval <= 0 {
+		return nil, errors.New("SESSION_REFRESH_INTERVAL must be positive")
+	}
+	if raw.MaxLifetime < raw.TTL {
+		return nil, errors.New("SESSION_MAX_LIFETIME must not be less than SESSION_TTL")
+	}
+
 	return &sessionConfig{raw: raw}, nil
 }
 
 func (cfg *sessionConfig) TTL() time.Duration {
 	return cfg.raw.TTL
 }
+
+// Policy возвращает правила продления сессий
+func (cfg *sessionConfig) Policy() model.SessionPolicy {
+	return model.SessionPolicy{
+		TTL:             cfg.raw.TTL,
+		Sliding:         cfg.raw.Sliding,
+		RefreshInterval: cfg.raw.RefreshInterval,
+		MaxLifetime:     cfg.raw.MaxLifetime,
+	}
+}
@@ -1,6 +1,10 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
 
 type LoggerConfig interface {
 	Level() string
@@ -27,6 +31,21 @@ type RedisConfig interface {
 	IdleTimeout() time.Duration
 }
 
+type PasswordConfig interface {
+	Policy() model.PasswordPolicy
+}
+
 type SessionConfig interface {
 	TTL() time.Duration
+	Policy() model.SessionPolicy
+}
+
+type LoginThrottleConfig interface {
+	Policy() model.LoginThrottlePolicy
+}
+
+type MetricCollectorConfig interface {
+	CollectorEndpoint() string
+	CollectorInterval() time.Duration
+	ServiceName() string
 }
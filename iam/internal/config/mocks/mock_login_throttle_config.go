@@ -0,0 +1,83 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LoginThrottleConfig is an autogenerated mock type for the LoginThrottleConfig type
+type LoginThrottleConfig struct {
+	mock.Mock
+}
+
+type LoginThrottleConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LoginThrottleConfig) EXPECT() *LoginThrottleConfig_Expecter {
+	return &LoginThrottleConfig_Expecter{mock: &_m.Mock}
+}
+
+// Policy provides a mock function with no fields
+func (_m *LoginThrottleConfig) Policy() model.LoginThrottlePolicy {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Policy")
+	}
+
+	var r0 model.LoginThrottlePolicy
+	if rf, ok := ret.Get(0).(func() model.LoginThrottlePolicy); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.LoginThrottlePolicy)
+	}
+
+	return r0
+}
+
+// LoginThrottleConfig_Policy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Policy'
+type LoginThrottleConfig_Policy_Call struct {
+	*mock.Call
+}
+
+// Policy is a helper method to define mock.On call
+func (_e *LoginThrottleConfig_Expecter) Policy() *LoginThrottleConfig_Policy_Call {
+	return &LoginThrottleConfig_Policy_Call{Call: _e.mock.On("Policy")}
+}
+
+func (_c *LoginThrottleConfig_Policy_Call) Run(run func()) *LoginThrottleConfig_Policy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LoginThrottleConfig_Policy_Call) Return(_a0 model.LoginThrottlePolicy) *LoginThrottleConfig_Policy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoginThrottleConfig_Policy_Call) RunAndReturn(run func() model.LoginThrottlePolicy) *LoginThrottleConfig_Policy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLoginThrottleConfig creates a new instance of LoginThrottleConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLoginThrottleConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LoginThrottleConfig {
+	mock := &LoginThrottleConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PasswordConfig is an autogenerated mock type for the PasswordConfig type
+type PasswordConfig struct {
+	mock.Mock
+}
+
+type PasswordConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PasswordConfig) EXPECT() *PasswordConfig_Expecter {
+	return &PasswordConfig_Expecter{mock: &_m.Mock}
+}
+
+// Policy provides a mock function with no fields
+func (_m *PasswordConfig) Policy() model.PasswordPolicy {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Policy")
+	}
+
+	var r0 model.PasswordPolicy
+	if rf, ok := ret.Get(0).(func() model.PasswordPolicy); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.PasswordPolicy)
+	}
+
+	return r0
+}
+
+// PasswordConfig_Policy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Policy'
+type PasswordConfig_Policy_Call struct {
+	*mock.Call
+}
+
+// Policy is a helper method to define mock.On call
+func (_e *PasswordConfig_Expecter) Policy() *PasswordConfig_Policy_Call {
+	return &PasswordConfig_Policy_Call{Call: _e.mock.On("Policy")}
+}
+
+func (_c *PasswordConfig_Policy_Call) Run(run func()) *PasswordConfig_Policy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PasswordConfig_Policy_Call) Return(_a0 model.PasswordPolicy) *PasswordConfig_Policy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PasswordConfig_Policy_Call) RunAndReturn(run func() model.PasswordPolicy) *PasswordConfig_Policy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPasswordConfig creates a new instance of PasswordConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPasswordConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PasswordConfig {
+	mock := &PasswordConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
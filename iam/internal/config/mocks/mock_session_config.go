@@ -8,6 +8,7 @@ package mocks
 import (
 	time "time"
 
+	model "github.com/nkolesnikov999/micro2-OK/iam/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -24,6 +25,51 @@ func (_m *SessionConfig) EXPECT() *SessionConfig_Expecter {
 	return &SessionConfig_Expecter{mock: &_m.Mock}
 }
 
+// Policy provides a mock function with no fields
+func (_m *SessionConfig) Policy() model.SessionPolicy {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Policy")
+	}
+
+	var r0 model.SessionPolicy
+	if rf, ok := ret.Get(0).(func() model.SessionPolicy); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.SessionPolicy)
+	}
+
+	return r0
+}
+
+// SessionConfig_Policy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Policy'
+type SessionConfig_Policy_Call struct {
+	*mock.Call
+}
+
+// Policy is a helper method to define mock.On call
+func (_e *SessionConfig_Expecter) Policy() *SessionConfig_Policy_Call {
+	return &SessionConfig_Policy_Call{Call: _e.mock.On("Policy")}
+}
+
+func (_c *SessionConfig_Policy_Call) Run(run func()) *SessionConfig_Policy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SessionConfig_Policy_Call) Return(_a0 model.SessionPolicy) *SessionConfig_Policy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionConfig_Policy_Call) RunAndReturn(run func() model.SessionPolicy) *SessionConfig_Policy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TTL provides a mock function with no fields
 func (_m *SessionConfig) TTL() time.Duration {
 	ret := _m.Called()
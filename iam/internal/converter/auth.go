@@ -4,14 +4,30 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	iamauth "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/auth/v1"
 	commonV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/common/v1"
 )
 
 func ToProtoSession(session model.Session) *commonV1.Session {
 	return &commonV1.Session{
-		Uuid:      session.UUID.String(),
-		CreatedAt: timestamppb.New(session.CreatedAt),
-		UpdatedAt: timestamppb.New(session.UpdatedAt),
-		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		Uuid:       session.UUID.String(),
+		CreatedAt:  timestamppb.New(session.CreatedAt),
+		UpdatedAt:  timestamppb.New(session.UpdatedAt),
+		ExpiresAt:  timestamppb.New(session.ExpiresAt),
+		UserAgent:  session.UserAgent,
+		ClientIp:   session.ClientIP,
+		LastSeenAt: timestamppb.New(session.LastSeenAt),
 	}
 }
+
+// ToProtoActiveSessions отмечает сессию currentSessionUUID как текущую
+func ToProtoActiveSessions(sessions []model.Session, currentSessionUUID string) []*iamauth.ActiveSession {
+	result := make([]*iamauth.ActiveSession, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &iamauth.ActiveSession{
+			Session:   ToProtoSession(session),
+			IsCurrent: session.UUID.String() == currentSessionUUID,
+		})
+	}
+	return result
+}
@@ -20,8 +20,10 @@ func ToProtoUser(user model.User) *commonV1.User {
 			Email:               user.Info.Email,
 			NotificationMethods: protoNotificationMethods,
 		},
-		CreatedAt: timestamppb.New(user.CreatedAt),
-		UpdatedAt: timestamppb.New(user.UpdatedAt),
+		Roles:       user.Roles,
+		Permissions: user.Permissions,
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+		UpdatedAt:   timestamppb.New(user.UpdatedAt),
 	}
 }
 
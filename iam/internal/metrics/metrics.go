@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// LoginFailuresTotal - COUNTER для подсчета неудачных попыток входа
+// Тип: Int64Counter (монотонно возрастающий)
+// Использование: всплеск неудач — признак подбора паролей
+var LoginFailuresTotal metric.Int64Counter
+
+// LoginLockoutsTotal - COUNTER для подсчета блокировок входа
+// Тип: Int64Counter (монотонно возрастающий)
+// Лейблы: scope (login, ip)
+var LoginLockoutsTotal metric.Int64Counter
+
+// LoginLockedAttemptsTotal - COUNTER для подсчета попыток входа, отклонённых из-за блокировки
+// Тип: Int64Counter (монотонно возрастающий)
+// Лейблы: scope (login, ip)
+var LoginLockedAttemptsTotal metric.Int64Counter
+
+// InitMetrics инициализирует все метрики iam сервиса
+// Должна быть вызвана один раз при старте приложения после инициализации OpenTelemetry провайдера
+func InitMetrics(serviceName string) error {
+	meter := otel.Meter(serviceName)
+	var err error
+
+	LoginFailuresTotal, err = meter.Int64Counter(
+		serviceName+"_login_failures_total",
+		metric.WithDescription("Total number of failed login attempts"),
+	)
+	if err != nil {
+		return err
+	}
+
+	LoginLockoutsTotal, err = meter.Int64Counter(
+		serviceName+"_login_lockouts_total",
+		metric.WithDescription("Total number of login lockouts"),
+	)
+	if err != nil {
+		return err
+	}
+
+	LoginLockedAttemptsTotal, err = meter.Int64Counter(
+		serviceName+"_login_locked_attempts_total",
+		metric.WithDescription("Total number of login attempts rejected by lockout"),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
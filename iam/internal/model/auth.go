@@ -7,9 +7,39 @@ import (
 )
 
 type Session struct {
-	UUID      uuid.UUID
-	UserUUID  uuid.UUID
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	ExpiresAt time.Time
+	UUID       uuid.UUID
+	UserUUID   uuid.UUID
+	UserAgent  string
+	ClientIP   string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ExpiresAt  time.Time
+	LastSeenAt time.Time
+}
+
+// SessionPolicy задаёт срок жизни сессий.
+// При Sliding активность в сессии (Whoami) продлевает её на TTL, но не чаще
+// раза в RefreshInterval и не дальше CreatedAt + MaxLifetime
+type SessionPolicy struct {
+	TTL             time.Duration
+	Sliding         bool
+	RefreshInterval time.Duration
+	MaxLifetime     time.Duration
+}
+
+// ExpiresAt возвращает срок действия сессии, созданной в createdAt, при активности в now
+func (p SessionPolicy) ExpiresAt(createdAt, now time.Time) time.Time {
+	expiresAt := now.Add(p.TTL)
+	if p.MaxLifetime > 0 {
+		if limit := createdAt.Add(p.MaxLifetime); expiresAt.After(limit) {
+			expiresAt = limit
+		}
+	}
+	return expiresAt
+}
+
+// ClientInfo описывает клиента, с которого выполняется вход
+type ClientInfo struct {
+	UserAgent string
+	IP        string
 }
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPolicyExpiresAt(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		policy SessionPolicy
+		now    time.Time
+		want   time.Time
+	}{
+		{
+			name:   "new session",
+			policy: SessionPolicy{TTL: time.Hour, MaxLifetime: 24 * time.Hour},
+			now:    createdAt,
+			want:   createdAt.Add(time.Hour),
+		},
+		{
+			name:   "extended by activity",
+			policy: SessionPolicy{TTL: time.Hour, MaxLifetime: 24 * time.Hour},
+			now:    createdAt.Add(5 * time.Hour),
+			want:   createdAt.Add(6 * time.Hour),
+		},
+		{
+			name:   "capped by max lifetime",
+			policy: SessionPolicy{TTL: time.Hour, MaxLifetime: 24 * time.Hour},
+			now:    createdAt.Add(23*time.Hour + 30*time.Minute),
+			want:   createdAt.Add(24 * time.Hour),
+		},
+		{
+			name:   "max lifetime shorter than ttl",
+			policy: SessionPolicy{TTL: time.Hour, MaxLifetime: 30 * time.Minute},
+			now:    createdAt,
+			want:   createdAt.Add(30 * time.Minute),
+		},
+		{
+			name:   "no max lifetime",
+			policy: SessionPolicy{TTL: time.Hour},
+			now:    createdAt.Add(100 * time.Hour),
+			want:   createdAt.Add(101 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.policy.ExpiresAt(createdAt, tt.now))
+		})
+	}
+}
@@ -3,13 +3,15 @@ package model
 import "errors"
 
 var (
-	ErrSessionNotFound    = errors.New("session not found")
-	ErrUserNotFound       = errors.New("user not found")
-	ErrUserAlreadyExists  = errors.New("user already exists")
-	ErrUserGetFailed      = errors.New("user get failed")
-	ErrUserCreateFailed   = errors.New("user create failed")
-	ErrInvalidLogin       = errors.New("invalid login")
-	ErrInvalidEmail       = errors.New("invalid email")
-	ErrInvalidPassword    = errors.New("invalid password")
+	ErrSessionNotFound   = errors.New("session not found")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUserGetFailed     = errors.New("user get failed")
+	ErrUserCreateFailed  = errors.New("user create failed")
+	ErrInvalidLogin      = errors.New("invalid login")
+	ErrInvalidEmail      = errors.New("invalid email")
+	ErrInvalidPassword   = errors.New("invalid password")
+	// ErrInvalidCredentials не различает неизвестного пользователя и неверный пароль
+	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrPasswordHashFailed = errors.New("password hash failed")
 )
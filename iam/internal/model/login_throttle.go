@@ -0,0 +1,58 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoginThrottleScope — по какому признаку считаются неудачные попытки входа
+type LoginThrottleScope string
+
+const (
+	LoginThrottleScopeLogin LoginThrottleScope = "login"
+	LoginThrottleScopeIP    LoginThrottleScope = "ip"
+)
+
+// LoginThrottlePolicy задаёт защиту входа от подбора пароля
+type LoginThrottlePolicy struct {
+	// MaxLoginFailures и MaxIPFailures — число неудач, после которого вход блокируется (0 — признак не учитывается)
+	MaxLoginFailures int64
+	MaxIPFailures    int64
+	// FailureWindow — сколько живёт счётчик неудач после последней блокировки или первой неудачи
+	FailureWindow time.Duration
+	// BaseLockout удваивается с каждой неудачей сверх лимита, но не превышает MaxLockout
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+}
+
+// Limit возвращает лимит неудач для признака
+func (p LoginThrottlePolicy) Limit(scope LoginThrottleScope) int64 {
+	if scope == LoginThrottleScopeIP {
+		return p.MaxIPFailures
+	}
+	return p.MaxLoginFailures
+}
+
+// Lockout возвращает длительность блокировки после failures неудач; 0 — блокировать не нужно
+func (p LoginThrottlePolicy) Lockout(scope LoginThrottleScope, failures int64) time.Duration {
+	limit := p.Limit(scope)
+	if limit <= 0 || failures < limit {
+		return 0
+	}
+
+	lockout := p.BaseLockout
+	for i := limit; i < failures && lockout < p.MaxLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, p.MaxLockout)
+}
+
+// LoginLockedError — вход временно заблокирован из-за множества неудачных попыток
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry after %s", e.RetryAfter)
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginThrottlePolicyLockout(t *testing.T) {
+	policy := LoginThrottlePolicy{
+		MaxLoginFailures: 3,
+		MaxIPFailures:    0,
+		BaseLockout:      time.Minute,
+		MaxLockout:       10 * time.Minute,
+	}
+
+	tests := []struct {
+		name     string
+		scope    LoginThrottleScope
+		failures int64
+		want     time.Duration
+	}{
+		{name: "below limit", scope: LoginThrottleScopeLogin, failures: 2, want: 0},
+		{name: "at limit", scope: LoginThrottleScopeLogin, failures: 3, want: time.Minute},
+		{name: "doubles", scope: LoginThrottleScopeLogin, failures: 4, want: 2 * time.Minute},
+		{name: "doubles again", scope: LoginThrottleScopeLogin, failures: 6, want: 8 * time.Minute},
+		{name: "capped", scope: LoginThrottleScopeLogin, failures: 7, want: 10 * time.Minute},
+		{name: "capped far above limit", scope: LoginThrottleScopeLogin, failures: 1000, want: 10 * time.Minute},
+		{name: "disabled scope", scope: LoginThrottleScopeIP, failures: 1000, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, policy.Lockout(tt.scope, tt.failures))
+		})
+	}
+}
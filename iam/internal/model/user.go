@@ -6,11 +6,19 @@ import (
 	"github.com/google/uuid"
 )
 
+// Роли пользователей; права ролей хранятся в таблице role_permissions
+const (
+	RoleCustomer = "customer"
+	RoleAdmin    = "admin"
+)
+
 type User struct {
-	UUID      uuid.UUID
-	Info      UserInfo
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	UUID        uuid.UUID
+	Info        UserInfo
+	Roles       []string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 type UserInfo struct {
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"strings"
+)
+
+// PasswordPolicy задаёт требования к паролю при регистрации
+type PasswordPolicy struct {
+	MinLength      int
+	MaxLength      int
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSpecial bool
+	// Denylist — утёкшие пароли в нижнем регистре
+	Denylist map[string]struct{}
+}
+
+// FieldViolation описывает ошибку в одном поле запроса
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError содержит все ошибки входных данных, чтобы клиент показал их разом
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Field+": "+v.Description)
+	}
+	return "invalid input: " + strings.Join(parts, "; ")
+}
+
+// Поля регистрации в FieldViolation
+const (
+	FieldLogin    = "login"
+	FieldEmail    = "email"
+	FieldPassword = "password"
+)
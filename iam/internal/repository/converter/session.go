@@ -18,11 +18,14 @@ func ToRepoSession(session model.Session) repoModel.SessionRedisView {
 	}
 
 	return repoModel.SessionRedisView{
-		UUID:        session.UUID.String(),
-		UserUUID:    session.UserUUID.String(),
-		CreatedAtNs: session.CreatedAt.UnixNano(),
-		UpdatedAtNs: updatedAtNs,
-		ExpiresAtNs: session.ExpiresAt.UnixNano(),
+		UUID:         session.UUID.String(),
+		UserUUID:     session.UserUUID.String(),
+		UserAgent:    session.UserAgent,
+		ClientIP:     session.ClientIP,
+		CreatedAtNs:  session.CreatedAt.UnixNano(),
+		UpdatedAtNs:  updatedAtNs,
+		ExpiresAtNs:  session.ExpiresAt.UnixNano(),
+		LastSeenAtNs: session.LastSeenAt.UnixNano(),
 	}
 }
 
@@ -49,11 +52,20 @@ func ToModelSession(redisView repoModel.SessionRedisView) (model.Session, error)
 
 	expiresAt := time.Unix(0, redisView.ExpiresAtNs)
 
+	// Сессии, созданные до учёта активности, считаем активными с момента входа
+	lastSeenAt := createdAt
+	if redisView.LastSeenAtNs != 0 {
+		lastSeenAt = time.Unix(0, redisView.LastSeenAtNs)
+	}
+
 	return model.Session{
-		UUID:      uuidVal,
-		UserUUID:  userUUID,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
-		ExpiresAt: expiresAt,
+		UUID:       uuidVal,
+		UserUUID:   userUUID,
+		UserAgent:  redisView.UserAgent,
+		ClientIP:   redisView.ClientIP,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+		ExpiresAt:  expiresAt,
+		LastSeenAt: lastSeenAt,
 	}, nil
 }
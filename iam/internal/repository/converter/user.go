@@ -20,6 +20,7 @@ func ToRepoUser(user model.User) (repoModel.User, error) {
 		Email:               user.Info.Email,
 		PasswordHash:        "", // PasswordHash устанавливается отдельно при создании/обновлении
 		NotificationMethods: notificationMethodsJSON,
+		Roles:               user.Roles,
 		CreatedAt:           user.CreatedAt,
 		UpdatedAt:           user.UpdatedAt,
 	}, nil
@@ -43,9 +44,11 @@ func ToModelUser(repoUser repoModel.User) (model.User, error) {
 	}
 
 	return model.User{
-		UUID:      repoUser.UUID,
-		CreatedAt: repoUser.CreatedAt,
-		UpdatedAt: repoUser.UpdatedAt,
+		UUID:        repoUser.UUID,
+		Roles:       repoUser.Roles,
+		Permissions: repoUser.Permissions,
+		CreatedAt:   repoUser.CreatedAt,
+		UpdatedAt:   repoUser.UpdatedAt,
 		Info: model.UserInfo{
 			Login:               repoUser.Login,
 			Email:               repoUser.Email,
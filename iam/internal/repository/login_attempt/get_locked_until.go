@@ -0,0 +1,30 @@
+package login_attempt
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+// GetLockedUntil возвращает время снятия блокировки; нулевое время — блокировки нет
+func (r *repository) GetLockedUntil(ctx context.Context, scope model.LoginThrottleScope, key string) (time.Time, error) {
+	value, err := r.cache.Get(ctx, r.getLockKey(scope, key))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+
+	lockedUntilNs, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, lockedUntilNs), nil
+}
@@ -0,0 +1,12 @@
+package login_attempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+func (r *repository) IncrementFailures(ctx context.Context, scope model.LoginThrottleScope, key string, window time.Duration) (int64, error) {
+	return r.cache.IncrWithTTL(ctx, r.getFailuresKey(scope, key), window)
+}
@@ -0,0 +1,22 @@
+package login_attempt
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+// Lock блокирует вход на lockout. Счётчик неудач продлевается на время блокировки
+// и ещё window после неё: следующая неудача даст блокировку вдвое длиннее
+func (r *repository) Lock(ctx context.Context, scope model.LoginThrottleScope, key string, lockout, window time.Duration) error {
+	lockedUntil := time.Now().Add(lockout)
+
+	err := r.cache.SetWithTTL(ctx, r.getLockKey(scope, key), strconv.FormatInt(lockedUntil.UnixNano(), 10), lockout)
+	if err != nil {
+		return err
+	}
+
+	return r.cache.Expire(ctx, r.getFailuresKey(scope, key), lockout+window)
+}
@@ -0,0 +1,34 @@
+package login_attempt
+
+import (
+	"fmt"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/iam/internal/repository"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/cache"
+)
+
+const (
+	failuresKey = "iam:login:failures:%s:%s"
+	lockKey     = "iam:login:lock:%s:%s"
+)
+
+var _ def.LoginAttemptRepository = (*repository)(nil)
+
+type repository struct {
+	cache cache.RedisClient
+}
+
+func NewRepository(cache cache.RedisClient) *repository {
+	return &repository{
+		cache: cache,
+	}
+}
+
+func (r *repository) getFailuresKey(scope model.LoginThrottleScope, key string) string {
+	return fmt.Sprintf(failuresKey, scope, key)
+}
+
+func (r *repository) getLockKey(scope model.LoginThrottleScope, key string) string {
+	return fmt.Sprintf(lockKey, scope, key)
+}
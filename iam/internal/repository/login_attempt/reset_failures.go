@@ -0,0 +1,11 @@
+package login_attempt
+
+import (
+	"context"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+func (r *repository) ResetFailures(ctx context.Context, scope model.LoginThrottleScope, key string) error {
+	return r.cache.Del(ctx, r.getFailuresKey(scope, key))
+}
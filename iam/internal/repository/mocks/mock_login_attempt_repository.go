@@ -0,0 +1,257 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LoginAttemptRepository is an autogenerated mock type for the LoginAttemptRepository type
+type LoginAttemptRepository struct {
+	mock.Mock
+}
+
+type LoginAttemptRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LoginAttemptRepository) EXPECT() *LoginAttemptRepository_Expecter {
+	return &LoginAttemptRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetLockedUntil provides a mock function with given fields: ctx, scope, key
+func (_m *LoginAttemptRepository) GetLockedUntil(ctx context.Context, scope model.LoginThrottleScope, key string) (time.Time, error) {
+	ret := _m.Called(ctx, scope, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLockedUntil")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.LoginThrottleScope, string) (time.Time, error)); ok {
+		return rf(ctx, scope, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.LoginThrottleScope, string) time.Time); ok {
+		r0 = rf(ctx, scope, key)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.LoginThrottleScope, string) error); ok {
+		r1 = rf(ctx, scope, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoginAttemptRepository_GetLockedUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLockedUntil'
+type LoginAttemptRepository_GetLockedUntil_Call struct {
+	*mock.Call
+}
+
+// GetLockedUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope model.LoginThrottleScope
+//   - key string
+func (_e *LoginAttemptRepository_Expecter) GetLockedUntil(ctx interface{}, scope interface{}, key interface{}) *LoginAttemptRepository_GetLockedUntil_Call {
+	return &LoginAttemptRepository_GetLockedUntil_Call{Call: _e.mock.On("GetLockedUntil", ctx, scope, key)}
+}
+
+func (_c *LoginAttemptRepository_GetLockedUntil_Call) Run(run func(ctx context.Context, scope model.LoginThrottleScope, key string)) *LoginAttemptRepository_GetLockedUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.LoginThrottleScope), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_GetLockedUntil_Call) Return(_a0 time.Time, _a1 error) *LoginAttemptRepository_GetLockedUntil_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_GetLockedUntil_Call) RunAndReturn(run func(context.Context, model.LoginThrottleScope, string) (time.Time, error)) *LoginAttemptRepository_GetLockedUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementFailures provides a mock function with given fields: ctx, scope, key, window
+func (_m *LoginAttemptRepository) IncrementFailures(ctx context.Context, scope model.LoginThrottleScope, key string, window time.Duration) (int64, error) {
+	ret := _m.Called(ctx, scope, key, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementFailures")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.LoginThrottleScope, string, time.Duration) (int64, error)); ok {
+		return rf(ctx, scope, key, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.LoginThrottleScope, string, time.Duration) int64); ok {
+		r0 = rf(ctx, scope, key, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.LoginThrottleScope, string, time.Duration) error); ok {
+		r1 = rf(ctx, scope, key, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoginAttemptRepository_IncrementFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementFailures'
+type LoginAttemptRepository_IncrementFailures_Call struct {
+	*mock.Call
+}
+
+// IncrementFailures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope model.LoginThrottleScope
+//   - key string
+//   - window time.Duration
+func (_e *LoginAttemptRepository_Expecter) IncrementFailures(ctx interface{}, scope interface{}, key interface{}, window interface{}) *LoginAttemptRepository_IncrementFailures_Call {
+	return &LoginAttemptRepository_IncrementFailures_Call{Call: _e.mock.On("IncrementFailures", ctx, scope, key, window)}
+}
+
+func (_c *LoginAttemptRepository_IncrementFailures_Call) Run(run func(ctx context.Context, scope model.LoginThrottleScope, key string, window time.Duration)) *LoginAttemptRepository_IncrementFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.LoginThrottleScope), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_IncrementFailures_Call) Return(_a0 int64, _a1 error) *LoginAttemptRepository_IncrementFailures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_IncrementFailures_Call) RunAndReturn(run func(context.Context, model.LoginThrottleScope, string, time.Duration) (int64, error)) *LoginAttemptRepository_IncrementFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function with given fields: ctx, scope, key, lockout, window
+func (_m *LoginAttemptRepository) Lock(ctx context.Context, scope model.LoginThrottleScope, key string, lockout time.Duration, window time.Duration) error {
+	ret := _m.Called(ctx, scope, key, lockout, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.LoginThrottleScope, string, time.Duration, time.Duration) error); ok {
+		r0 = rf(ctx, scope, key, lockout, window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoginAttemptRepository_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type LoginAttemptRepository_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope model.LoginThrottleScope
+//   - key string
+//   - lockout time.Duration
+//   - window time.Duration
+func (_e *LoginAttemptRepository_Expecter) Lock(ctx interface{}, scope interface{}, key interface{}, lockout interface{}, window interface{}) *LoginAttemptRepository_Lock_Call {
+	return &LoginAttemptRepository_Lock_Call{Call: _e.mock.On("Lock", ctx, scope, key, lockout, window)}
+}
+
+func (_c *LoginAttemptRepository_Lock_Call) Run(run func(ctx context.Context, scope model.LoginThrottleScope, key string, lockout time.Duration, window time.Duration)) *LoginAttemptRepository_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.LoginThrottleScope), args[2].(string), args[3].(time.Duration), args[4].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_Lock_Call) Return(_a0 error) *LoginAttemptRepository_Lock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_Lock_Call) RunAndReturn(run func(context.Context, model.LoginThrottleScope, string, time.Duration, time.Duration) error) *LoginAttemptRepository_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetFailures provides a mock function with given fields: ctx, scope, key
+func (_m *LoginAttemptRepository) ResetFailures(ctx context.Context, scope model.LoginThrottleScope, key string) error {
+	ret := _m.Called(ctx, scope, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetFailures")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.LoginThrottleScope, string) error); ok {
+		r0 = rf(ctx, scope, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoginAttemptRepository_ResetFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetFailures'
+type LoginAttemptRepository_ResetFailures_Call struct {
+	*mock.Call
+}
+
+// ResetFailures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scope model.LoginThrottleScope
+//   - key string
+func (_e *LoginAttemptRepository_Expecter) ResetFailures(ctx interface{}, scope interface{}, key interface{}) *LoginAttemptRepository_ResetFailures_Call {
+	return &LoginAttemptRepository_ResetFailures_Call{Call: _e.mock.On("ResetFailures", ctx, scope, key)}
+}
+
+func (_c *LoginAttemptRepository_ResetFailures_Call) Run(run func(ctx context.Context, scope model.LoginThrottleScope, key string)) *LoginAttemptRepository_ResetFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.LoginThrottleScope), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LoginAttemptRepository_ResetFailures_Call) Return(_a0 error) *LoginAttemptRepository_ResetFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoginAttemptRepository_ResetFailures_Call) RunAndReturn(run func(context.Context, model.LoginThrottleScope, string) error) *LoginAttemptRepository_ResetFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLoginAttemptRepository creates a new instance of LoginAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLoginAttemptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LoginAttemptRepository {
+	mock := &LoginAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -123,6 +123,53 @@ func (_c *SessionRepository_CreateSession_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DeleteSession provides a mock function with given fields: ctx, sessionUUID
+func (_m *SessionRepository) DeleteSession(ctx context.Context, sessionUUID string) error {
+	ret := _m.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, sessionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_DeleteSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSession'
+type SessionRepository_DeleteSession_Call struct {
+	*mock.Call
+}
+
+// DeleteSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID string
+func (_e *SessionRepository_Expecter) DeleteSession(ctx interface{}, sessionUUID interface{}) *SessionRepository_DeleteSession_Call {
+	return &SessionRepository_DeleteSession_Call{Call: _e.mock.On("DeleteSession", ctx, sessionUUID)}
+}
+
+func (_c *SessionRepository_DeleteSession_Call) Run(run func(ctx context.Context, sessionUUID string)) *SessionRepository_DeleteSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_DeleteSession_Call) Return(_a0 error) *SessionRepository_DeleteSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_DeleteSession_Call) RunAndReturn(run func(context.Context, string) error) *SessionRepository_DeleteSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSession provides a mock function with given fields: ctx, sessionUUID
 func (_m *SessionRepository) GetSession(ctx context.Context, sessionUUID string) (model.Session, error) {
 	ret := _m.Called(ctx, sessionUUID)
@@ -180,6 +227,209 @@ func (_c *SessionRepository_GetSession_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetUserSessions provides a mock function with given fields: ctx, userUUID
+func (_m *SessionRepository) GetUserSessions(ctx context.Context, userUUID string) ([]model.Session, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSessions")
+	}
+
+	var r0 []model.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Session, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Session); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepository_GetUserSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSessions'
+type SessionRepository_GetUserSessions_Call struct {
+	*mock.Call
+}
+
+// GetUserSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *SessionRepository_Expecter) GetUserSessions(ctx interface{}, userUUID interface{}) *SessionRepository_GetUserSessions_Call {
+	return &SessionRepository_GetUserSessions_Call{Call: _e.mock.On("GetUserSessions", ctx, userUUID)}
+}
+
+func (_c *SessionRepository_GetUserSessions_Call) Run(run func(ctx context.Context, userUUID string)) *SessionRepository_GetUserSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_GetUserSessions_Call) Return(_a0 []model.Session, _a1 error) *SessionRepository_GetUserSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepository_GetUserSessions_Call) RunAndReturn(run func(context.Context, string) ([]model.Session, error)) *SessionRepository_GetUserSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSessionFromUserSet provides a mock function with given fields: ctx, userUUID, sessionUUID
+func (_m *SessionRepository) RemoveSessionFromUserSet(ctx context.Context, userUUID string, sessionUUID string) error {
+	ret := _m.Called(ctx, userUUID, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSessionFromUserSet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userUUID, sessionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_RemoveSessionFromUserSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSessionFromUserSet'
+type SessionRepository_RemoveSessionFromUserSet_Call struct {
+	*mock.Call
+}
+
+// RemoveSessionFromUserSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - sessionUUID string
+func (_e *SessionRepository_Expecter) RemoveSessionFromUserSet(ctx interface{}, userUUID interface{}, sessionUUID interface{}) *SessionRepository_RemoveSessionFromUserSet_Call {
+	return &SessionRepository_RemoveSessionFromUserSet_Call{Call: _e.mock.On("RemoveSessionFromUserSet", ctx, userUUID, sessionUUID)}
+}
+
+func (_c *SessionRepository_RemoveSessionFromUserSet_Call) Run(run func(ctx context.Context, userUUID string, sessionUUID string)) *SessionRepository_RemoveSessionFromUserSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_RemoveSessionFromUserSet_Call) Return(_a0 error) *SessionRepository_RemoveSessionFromUserSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_RemoveSessionFromUserSet_Call) RunAndReturn(run func(context.Context, string, string) error) *SessionRepository_RemoveSessionFromUserSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveStaleSessions provides a mock function with given fields: ctx, userUUID
+func (_m *SessionRepository) RemoveStaleSessions(ctx context.Context, userUUID string) error {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveStaleSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_RemoveStaleSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveStaleSessions'
+type SessionRepository_RemoveStaleSessions_Call struct {
+	*mock.Call
+}
+
+// RemoveStaleSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *SessionRepository_Expecter) RemoveStaleSessions(ctx interface{}, userUUID interface{}) *SessionRepository_RemoveStaleSessions_Call {
+	return &SessionRepository_RemoveStaleSessions_Call{Call: _e.mock.On("RemoveStaleSessions", ctx, userUUID)}
+}
+
+func (_c *SessionRepository_RemoveStaleSessions_Call) Run(run func(ctx context.Context, userUUID string)) *SessionRepository_RemoveStaleSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_RemoveStaleSessions_Call) Return(_a0 error) *SessionRepository_RemoveStaleSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_RemoveStaleSessions_Call) RunAndReturn(run func(context.Context, string) error) *SessionRepository_RemoveStaleSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TouchSession provides a mock function with given fields: ctx, sessionUUID, lastSeenAt, expiresAt
+func (_m *SessionRepository) TouchSession(ctx context.Context, sessionUUID string, lastSeenAt time.Time, expiresAt time.Time) error {
+	ret := _m.Called(ctx, sessionUUID, lastSeenAt, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, sessionUUID, lastSeenAt, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepository_TouchSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchSession'
+type SessionRepository_TouchSession_Call struct {
+	*mock.Call
+}
+
+// TouchSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID string
+//   - lastSeenAt time.Time
+//   - expiresAt time.Time
+func (_e *SessionRepository_Expecter) TouchSession(ctx interface{}, sessionUUID interface{}, lastSeenAt interface{}, expiresAt interface{}) *SessionRepository_TouchSession_Call {
+	return &SessionRepository_TouchSession_Call{Call: _e.mock.On("TouchSession", ctx, sessionUUID, lastSeenAt, expiresAt)}
+}
+
+func (_c *SessionRepository_TouchSession_Call) Run(run func(ctx context.Context, sessionUUID string, lastSeenAt time.Time, expiresAt time.Time)) *SessionRepository_TouchSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SessionRepository_TouchSession_Call) Return(_a0 error) *SessionRepository_TouchSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepository_TouchSession_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) error) *SessionRepository_TouchSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSessionRepository creates a new instance of SessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSessionRepository(t interface {
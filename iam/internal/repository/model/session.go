@@ -1,9 +1,18 @@
 package model
 
 type SessionRedisView struct {
-	UUID        string `redis:"uuid"`
-	UserUUID    string `redis:"user_uuid"`
-	CreatedAtNs int64  `redis:"created_at"`
-	UpdatedAtNs *int64 `redis:"updated_at,omitempty"`
-	ExpiresAtNs int64  `redis:"expires_at"`
+	UUID         string `redis:"uuid"`
+	UserUUID     string `redis:"user_uuid"`
+	UserAgent    string `redis:"user_agent"`
+	ClientIP     string `redis:"client_ip"`
+	CreatedAtNs  int64  `redis:"created_at"`
+	UpdatedAtNs  *int64 `redis:"updated_at,omitempty"`
+	ExpiresAtNs  int64  `redis:"expires_at"`
+	LastSeenAtNs int64  `redis:"last_seen_at"`
+}
+
+// SessionActivityRedisView поля сессии, обновляемые при активности пользователя
+type SessionActivityRedisView struct {
+	ExpiresAtNs  int64 `redis:"expires_at"`
+	LastSeenAtNs int64 `redis:"last_seen_at"`
 }
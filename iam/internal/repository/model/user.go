@@ -12,6 +12,8 @@ type User struct {
 	Email               string    `db:"email"`
 	PasswordHash        string    `db:"password_hash"`
 	NotificationMethods []byte    `db:"notification_methods"`
+	Roles               []string  `db:"roles"`
+	Permissions         []string  `db:"permissions"`
 	CreatedAt           time.Time `db:"created_at"`
 	UpdatedAt           time.Time `db:"updated_at"`
 }
@@ -10,7 +10,12 @@ import (
 type SessionRepository interface {
 	CreateSession(ctx context.Context, session model.Session, ttl time.Duration) error
 	GetSession(ctx context.Context, sessionUUID string) (model.Session, error)
+	TouchSession(ctx context.Context, sessionUUID string, lastSeenAt, expiresAt time.Time) error
+	DeleteSession(ctx context.Context, sessionUUID string) error
 	AddSessionToUserSet(ctx context.Context, userUUID, sessionUUID string) error
+	RemoveSessionFromUserSet(ctx context.Context, userUUID, sessionUUID string) error
+	GetUserSessions(ctx context.Context, userUUID string) ([]model.Session, error)
+	RemoveStaleSessions(ctx context.Context, userUUID string) error
 }
 
 type UserRepository interface {
@@ -18,3 +23,12 @@ type UserRepository interface {
 	GetUser(ctx context.Context, userUUID string) (model.User, error)
 	GetUserByLoginOrEmail(ctx context.Context, loginOrEmail string) (model.User, string, error)
 }
+
+// LoginAttemptRepository хранит счётчики неудачных входов и блокировки по логину и IP
+type LoginAttemptRepository interface {
+	// IncrementFailures учитывает неудачную попытку и возвращает число неудач в окне
+	IncrementFailures(ctx context.Context, scope model.LoginThrottleScope, key string, window time.Duration) (int64, error)
+	ResetFailures(ctx context.Context, scope model.LoginThrottleScope, key string) error
+	Lock(ctx context.Context, scope model.LoginThrottleScope, key string, lockout, window time.Duration) error
+	GetLockedUntil(ctx context.Context, scope model.LoginThrottleScope, key string) (time.Time, error)
+}
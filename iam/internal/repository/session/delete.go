@@ -0,0 +1,9 @@
+package session
+
+import (
+	"context"
+)
+
+func (r *repository) DeleteSession(ctx context.Context, sessionUUID string) error {
+	return r.cache.Del(ctx, r.getCacheKey(sessionUUID))
+}
@@ -0,0 +1,63 @@
+package session
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+// GetUserSessions возвращает активные сессии пользователя.
+// Сессии, чей hash уже истёк, удаляются из множества пользователя
+func (r *repository) GetUserSessions(ctx context.Context, userUUID string) ([]model.Session, error) {
+	setKey := r.getUserSessionsKey(userUUID)
+
+	sessionUUIDs, err := r.cache.SMembers(ctx, setKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]model.Session, 0, len(sessionUUIDs))
+	for _, sessionUUID := range sessionUUIDs {
+		session, err := r.GetSession(ctx, sessionUUID)
+		if errors.Is(err, model.ErrSessionNotFound) {
+			if err := r.cache.SRem(ctx, setKey, sessionUUID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// RemoveStaleSessions удаляет из множества пользователя сессии с истёкшим hash
+func (r *repository) RemoveStaleSessions(ctx context.Context, userUUID string) error {
+	setKey := r.getUserSessionsKey(userUUID)
+
+	sessionUUIDs, err := r.cache.SMembers(ctx, setKey)
+	if err != nil {
+		return err
+	}
+
+	for _, sessionUUID := range sessionUUIDs {
+		exists, err := r.cache.Exists(ctx, r.getCacheKey(sessionUUID))
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		if err := r.cache.SRem(ctx, setKey, sessionUUID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package session
+
+import (
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+// createSessions сохраняет сессии пользователя и добавляет в его множество сессию без hash
+func (s *RepositorySuite) createSessions(userUUID uuid.UUID) (live []model.Session, stale string) {
+	now := time.Now().Truncate(time.Second)
+	for range 2 {
+		session := model.Session{
+			UUID:       uuid.New(),
+			UserUUID:   userUUID,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			LastSeenAt: now,
+			ExpiresAt:  now.Add(time.Hour),
+		}
+		s.Require().NoError(s.repository.CreateSession(s.ctx, session, time.Hour))
+		s.Require().NoError(s.repository.AddSessionToUserSet(s.ctx, userUUID.String(), session.UUID.String()))
+		live = append(live, session)
+	}
+
+	// Hash истёк, а ссылка в множестве осталась
+	stale = uuid.New().String()
+	s.Require().NoError(s.repository.AddSessionToUserSet(s.ctx, userUUID.String(), stale))
+
+	return live, stale
+}
+
+func (s *RepositorySuite) members(userUUID uuid.UUID) []string {
+	conn := s.pool.Get()
+	defer func() { _ = conn.Close() }()
+
+	members, err := redigo.Strings(conn.Do("SMEMBERS", s.repository.getUserSessionsKey(userUUID.String())))
+	s.Require().NoError(err)
+	return members
+}
+
+func (s *RepositorySuite) TestGetUserSessionsDropsStaleMembers() {
+	userUUID := uuid.New()
+	live, stale := s.createSessions(userUUID)
+
+	sessions, err := s.repository.GetUserSessions(s.ctx, userUUID.String())
+	s.Require().NoError(err)
+	s.Require().Len(sessions, 2)
+	s.Require().ElementsMatch([]uuid.UUID{live[0].UUID, live[1].UUID}, []uuid.UUID{sessions[0].UUID, sessions[1].UUID})
+
+	s.Require().NotContains(s.members(userUUID), stale)
+	s.Require().Len(s.members(userUUID), 2)
+}
+
+func (s *RepositorySuite) TestRemoveStaleSessions() {
+	userUUID := uuid.New()
+	live, stale := s.createSessions(userUUID)
+
+	s.Require().NoError(s.repository.RemoveStaleSessions(s.ctx, userUUID.String()))
+	s.Require().ElementsMatch([]string{live[0].UUID.String(), live[1].UUID.String()}, s.members(userUUID))
+	s.Require().NotContains(s.members(userUUID), stale)
+}
+
+func (s *RepositorySuite) TestGetUserSessionsEmpty() {
+	sessions, err := s.repository.GetUserSessions(s.ctx, uuid.New().String())
+	s.Require().NoError(err)
+	s.Require().Empty(sessions)
+}
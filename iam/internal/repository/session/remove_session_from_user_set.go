@@ -0,0 +1,9 @@
+package session
+
+import (
+	"context"
+)
+
+func (r *repository) RemoveSessionFromUserSet(ctx context.Context, userUUID, sessionUUID string) error {
+	return r.cache.SRem(ctx, r.getUserSessionsKey(userUUID), sessionUUID)
+}
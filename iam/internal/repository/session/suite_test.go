@@ -0,0 +1,81 @@
+package session
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/cache/redis"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// testRedisDB — отдельная база Redis, которую тесты очищают целиком
+const testRedisDB = 15
+
+type RepositorySuite struct {
+	suite.Suite
+
+	ctx        context.Context
+	pool       *redigo.Pool
+	repository *repository
+}
+
+func (s *RepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	// Получаем адрес из переменной окружения или используем значение по умолчанию
+	redisAddr := os.Getenv("REDIS_ADDR")
+	if redisAddr == "" {
+		redisAddr = "localhost:6379"
+	}
+
+	s.pool = &redigo.Pool{
+		MaxIdle:     2,
+		IdleTimeout: time.Minute,
+		Dial: func() (redigo.Conn, error) {
+			return redigo.Dial("tcp", redisAddr, redigo.DialDatabase(testRedisDB))
+		},
+	}
+
+	conn := s.pool.Get()
+	defer func() { _ = conn.Close() }()
+	if _, err := conn.Do("PING"); err != nil {
+		s.T().Fatalf("Redis ping failed: %v", err)
+	}
+}
+
+func (s *RepositorySuite) TearDownSuite() {
+	if s.pool != nil {
+		s.flush()
+		_ = s.pool.Close()
+	}
+}
+
+func (s *RepositorySuite) SetupTest() {
+	s.flush()
+	s.repository = NewRepository(redis.NewClient(s.pool, &logger.NoopLogger{}, time.Second))
+}
+
+func (s *RepositorySuite) flush() {
+	conn := s.pool.Get()
+	defer func() { _ = conn.Close() }()
+	_, _ = conn.Do("FLUSHDB")
+}
+
+// ttl возвращает оставшийся срок жизни ключа
+func (s *RepositorySuite) ttl(key string) time.Duration {
+	conn := s.pool.Get()
+	defer func() { _ = conn.Close() }()
+
+	ms, err := redigo.Int64(conn.Do("PTTL", key))
+	s.Require().NoError(err)
+	return time.Duration(ms) * time.Millisecond
+}
+
+func TestRepositoryIntegration(t *testing.T) {
+	suite.Run(t, new(RepositorySuite))
+}
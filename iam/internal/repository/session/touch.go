@@ -0,0 +1,28 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/iam/internal/repository/model"
+)
+
+// TouchSession обновляет время активности и срок жизни сессии.
+// Запись условная: если сессию уже удалили (Logout, RevokeAll) или она истекла,
+// hash не создаётся заново и возвращается ErrSessionNotFound
+func (r *repository) TouchSession(ctx context.Context, sessionUUID string, lastSeenAt, expiresAt time.Time) error {
+	updated, err := r.cache.HashSetIfExists(ctx, r.getCacheKey(sessionUUID), repoModel.SessionActivityRedisView{
+		ExpiresAtNs:  expiresAt.UnixNano(),
+		LastSeenAtNs: lastSeenAt.UnixNano(),
+	}, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return model.ErrSessionNotFound
+	}
+
+	return nil
+}
@@ -16,12 +16,23 @@ func (r *repository) CreateUser(ctx context.Context, user model.User, passwordHa
 		                  notification_methods, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)`
 
+	insertRoleQuery := `
+		INSERT INTO user_roles (user_uuid, role)
+		VALUES ($1, $2)`
+
 	repoUser, err := repoConverter.ToRepoUserWithPasswordHash(user, passwordHash)
 	if err != nil {
 		return err
 	}
 
-	_, err = r.connDB.Exec(ctx, insertQuery,
+	// Пользователь и его роли сохраняются вместе
+	tx, err := r.connDB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, insertQuery,
 		repoUser.UUID,
 		repoUser.Login,
 		repoUser.Email,
@@ -39,5 +50,12 @@ func (r *repository) CreateUser(ctx context.Context, user model.User, passwordHa
 		return err
 	}
 
-	return nil
+	for _, role := range repoUser.Roles {
+		_, err = tx.Exec(ctx, insertRoleQuery, repoUser.UUID, role)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
 }
@@ -19,8 +19,9 @@ func (r *repository) GetUser(ctx context.Context, userUUID string) (model.User,
 	}
 
 	query := `
-		SELECT uuid, login, email, password_hash, 
-		       notification_methods, created_at, updated_at
+		SELECT uuid, login, email, password_hash,
+		       notification_methods, created_at, updated_at,
+		       ` + rolesColumns + `
 		FROM users 
 		WHERE uuid = $1`
 
@@ -17,8 +17,9 @@ func (r *repository) GetUserByLoginOrEmail(ctx context.Context, loginOrEmail str
 	}
 
 	query := `
-		SELECT uuid, login, email, password_hash, 
-		       notification_methods, created_at, updated_at
+		SELECT uuid, login, email, password_hash,
+		       notification_methods, created_at, updated_at,
+		       ` + rolesColumns + `
 		FROM users 
 		WHERE login = $1 OR email = $1`
 
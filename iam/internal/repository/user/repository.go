@@ -8,6 +8,14 @@ import (
 
 var _ def.UserRepository = (*repository)(nil)
 
+// rolesColumns выбирает роли пользователя и права этих ролей
+const rolesColumns = `
+		       array(select ur.role from user_roles ur
+		             where ur.user_uuid = users.uuid order by ur.role)::text[] as roles,
+		       array(select distinct rp.permission from user_roles ur
+		             join role_permissions rp on rp.role = ur.role
+		             where ur.user_uuid = users.uuid order by rp.permission)::text[] as permissions`
+
 type repository struct {
 	connDB *pgx.Conn
 }
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ListSessions(ctx context.Context, sessionUUID string) ([]model.Session, error) {
+	if sessionUUID == "" {
+		logger.Error(ctx, "empty session_uuid provided")
+		return nil, model.ErrSessionNotFound
+	}
+
+	session, err := s.sessionRepository.GetSession(ctx, sessionUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get session",
+			zap.String("sessionUUID", sessionUUID),
+			zap.Error(err),
+		)
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return nil, model.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	sessions, err := s.sessionRepository.GetUserSessions(ctx, session.UserUUID.String())
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get user sessions",
+			zap.String("userUUID", session.UserUUID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Последние активные сессии первыми
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
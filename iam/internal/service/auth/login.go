@@ -13,7 +13,7 @@ import (
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 )
 
-func (s *service) Login(ctx context.Context, login, password string) (string, error) {
+func (s *service) Login(ctx context.Context, login, password string, client model.ClientInfo) (string, error) {
 	// Валидация входных данных
 	if login == "" {
 		logger.Error(ctx, "empty login provided")
@@ -24,42 +24,66 @@ func (s *service) Login(ctx context.Context, login, password string) (string, er
 		return "", model.ErrInvalidPassword
 	}
 
+	// Заблокированный вход отклоняем до обращения к БД и bcrypt
+	targets := s.throttleTargets(login, client)
+	err := s.checkLoginLock(ctx, targets)
+	if err != nil {
+		logger.Warn(ctx,
+			"login rejected",
+			zap.String("login", login),
+			zap.String("clientIP", client.IP),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
 	// Получаем пользователя по login или email вместе с password hash
 	user, passwordHash, err := s.userRepository.GetUserByLoginOrEmail(ctx, login)
-	if err != nil {
+	if err != nil && !errors.Is(err, model.ErrUserNotFound) {
 		logger.Error(ctx,
 			"failed to get user by login or email",
 			zap.String("login", login),
 			zap.Error(err),
 		)
-		if errors.Is(err, model.ErrUserNotFound) {
-			return "", model.ErrUserNotFound
-		}
 		return "", err
 	}
 
+	// Для неизвестного пользователя сравниваем с фиктивным хешем, чтобы по времени ответа
+	// нельзя было отличить его от неверного пароля
+	userFound := err == nil
+	if !userFound {
+		passwordHash = string(dummyPasswordHash)
+	}
+
 	// Проверяем пароль
 	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
-	if err != nil {
-		logger.Error(ctx,
-			"invalid password",
+	if err != nil || !userFound {
+		logger.Warn(ctx,
+			"invalid credentials",
 			zap.String("login", login),
-			zap.Error(err),
+			zap.String("clientIP", client.IP),
+			zap.Bool("userFound", userFound),
 		)
-		return "", model.ErrInvalidPassword
+		s.registerLoginFailure(ctx, targets)
+		return "", model.ErrInvalidCredentials
 	}
 
+	s.resetLoginFailures(ctx, targets)
+
 	// Создаем сессию
 	sessionUUID := uuid.New()
 	now := time.Now()
-	expiresAt := now.Add(24 * time.Hour) // Сессия действительна 24 часа
+	expiresAt := s.sessionPolicy.ExpiresAt(now, now)
 
 	session := model.Session{
-		UUID:      sessionUUID,
-		UserUUID:  user.UUID,
-		CreatedAt: now,
-		UpdatedAt: now,
-		ExpiresAt: expiresAt,
+		UUID:       sessionUUID,
+		UserUUID:   user.UUID,
+		UserAgent:  client.UserAgent,
+		ClientIP:   client.IP,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		ExpiresAt:  expiresAt,
+		LastSeenAt: now,
 	}
 
 	// Сохраняем сессию в Redis
@@ -86,6 +110,16 @@ func (s *service) Login(ctx context.Context, login, password string) (string, er
 		return "", err
 	}
 
+	// Заодно чистим множество от истёкших сессий, чтобы оно не росло бесконечно
+	err = s.sessionRepository.RemoveStaleSessions(ctx, user.UUID.String())
+	if err != nil {
+		logger.Warn(ctx,
+			"failed to remove stale sessions",
+			zap.String("userUUID", user.UUID.String()),
+			zap.Error(err),
+		)
+	}
+
 	logger.Debug(ctx,
 		"user logged in successfully",
 		zap.String("userUUID", user.UUID.String()),
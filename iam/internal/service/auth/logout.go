@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) Logout(ctx context.Context, sessionUUID string) error {
+	if sessionUUID == "" {
+		logger.Error(ctx, "empty session_uuid provided")
+		return model.ErrSessionNotFound
+	}
+
+	// Сессия нужна, чтобы знать, из множества какого пользователя её удалить
+	session, err := s.sessionRepository.GetSession(ctx, sessionUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get session",
+			zap.String("sessionUUID", sessionUUID),
+			zap.Error(err),
+		)
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return model.ErrSessionNotFound
+		}
+		return err
+	}
+
+	err = s.revokeSession(ctx, session.UserUUID.String(), sessionUUID)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug(ctx,
+		"user logged out successfully",
+		zap.String("userUUID", session.UserUUID.String()),
+		zap.String("sessionUUID", sessionUUID),
+	)
+
+	return nil
+}
+
+// revokeSession удаляет сессию и её связь с пользователем
+func (s *service) revokeSession(ctx context.Context, userUUID, sessionUUID string) error {
+	err := s.sessionRepository.DeleteSession(ctx, sessionUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to delete session",
+			zap.String("sessionUUID", sessionUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	err = s.sessionRepository.RemoveSessionFromUserSet(ctx, userUUID, sessionUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to remove session from user set",
+			zap.String("userUUID", userUUID),
+			zap.String("sessionUUID", sessionUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+func (s *ServiceSuite) TestLogout() {
+	session := newTestSession(time.Now(), time.Now(), time.Now().Add(time.Hour))
+	s.sessionRepository.On("GetSession", mock.Anything, session.UUID.String()).Return(session, nil)
+	s.sessionRepository.On("DeleteSession", mock.Anything, session.UUID.String()).Return(nil).Once()
+	s.sessionRepository.On("RemoveSessionFromUserSet", mock.Anything, session.UserUUID.String(), session.UUID.String()).Return(nil).Once()
+
+	err := s.service.Logout(s.ctx, session.UUID.String())
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestLogoutUnknownSession() {
+	sessionUUID := uuid.New().String()
+	s.sessionRepository.On("GetSession", mock.Anything, sessionUUID).Return(model.Session{}, model.ErrSessionNotFound)
+
+	err := s.service.Logout(s.ctx, sessionUUID)
+	s.Require().ErrorIs(err, model.ErrSessionNotFound)
+	s.sessionRepository.AssertNotCalled(s.T(), "DeleteSession", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestLogoutEmptySession() {
+	err := s.service.Logout(s.ctx, "")
+	s.Require().ErrorIs(err, model.ErrSessionNotFound)
+}
+
+func (s *ServiceSuite) TestRevokeAllSessions() {
+	now := time.Now()
+	current := newTestSession(now, now, now.Add(time.Hour))
+	other := newTestSession(now, now, now.Add(time.Hour))
+	other.UserUUID = current.UserUUID
+	userUUID := current.UserUUID.String()
+
+	s.sessionRepository.On("GetSession", mock.Anything, current.UUID.String()).Return(current, nil)
+	// Репозиторий возвращает только живые сессии, истёкшие он убирает из множества сам
+	s.sessionRepository.On("GetUserSessions", mock.Anything, userUUID).Return([]model.Session{current, other}, nil)
+	for _, session := range []model.Session{current, other} {
+		s.sessionRepository.On("DeleteSession", mock.Anything, session.UUID.String()).Return(nil).Once()
+		s.sessionRepository.On("RemoveSessionFromUserSet", mock.Anything, userUUID, session.UUID.String()).Return(nil).Once()
+	}
+
+	revoked, err := s.service.RevokeAllSessions(s.ctx, current.UUID.String())
+	s.Require().NoError(err)
+	s.Require().Equal(2, revoked)
+}
+
+func (s *ServiceSuite) TestRevokeAllSessionsUnknownSession() {
+	sessionUUID := uuid.New().String()
+	s.sessionRepository.On("GetSession", mock.Anything, sessionUUID).Return(model.Session{}, model.ErrSessionNotFound)
+
+	revoked, err := s.service.RevokeAllSessions(s.ctx, sessionUUID)
+	s.Require().ErrorIs(err, model.ErrSessionNotFound)
+	s.Require().Zero(revoked)
+	s.sessionRepository.AssertNotCalled(s.T(), "GetUserSessions", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestRevokeAllSessionsDeleteFailed() {
+	now := time.Now()
+	current := newTestSession(now, now, now.Add(time.Hour))
+	deleteErr := errors.New("redis unavailable")
+
+	s.sessionRepository.On("GetSession", mock.Anything, current.UUID.String()).Return(current, nil)
+	s.sessionRepository.On("GetUserSessions", mock.Anything, current.UserUUID.String()).Return([]model.Session{current}, nil)
+	s.sessionRepository.On("DeleteSession", mock.Anything, current.UUID.String()).Return(deleteErr)
+
+	revoked, err := s.service.RevokeAllSessions(s.ctx, current.UUID.String())
+	s.Require().ErrorIs(err, deleteErr)
+	s.Require().Zero(revoked)
+}
+
+func (s *ServiceSuite) TestLoginRemovesStaleSessions() {
+	user := s.expectUser()
+	s.expectNotLocked()
+	s.loginAttemptRepository.On("ResetFailures", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.sessionRepository.On("CreateSession", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.sessionRepository.On("AddSessionToUserSet", mock.Anything, user.UUID.String(), mock.Anything).Return(nil)
+	// Ошибка очистки не мешает входу
+	s.sessionRepository.On("RemoveStaleSessions", mock.Anything, user.UUID.String()).
+		Return(errors.New("redis unavailable")).Once()
+
+	sessionUUID, err := s.service.Login(s.ctx, testLogin, testPassword, testClient)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(sessionUUID)
+}
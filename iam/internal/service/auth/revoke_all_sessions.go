@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) RevokeAllSessions(ctx context.Context, sessionUUID string) (int, error) {
+	if sessionUUID == "" {
+		logger.Error(ctx, "empty session_uuid provided")
+		return 0, model.ErrSessionNotFound
+	}
+
+	// Завершить все сессии может только владелец действующей сессии
+	session, err := s.sessionRepository.GetSession(ctx, sessionUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get session",
+			zap.String("sessionUUID", sessionUUID),
+			zap.Error(err),
+		)
+		if errors.Is(err, model.ErrSessionNotFound) {
+			return 0, model.ErrSessionNotFound
+		}
+		return 0, err
+	}
+
+	userUUID := session.UserUUID.String()
+
+	// Истёкшие сессии отбрасываются репозиторием и не попадают в счётчик
+	sessions, err := s.sessionRepository.GetUserSessions(ctx, userUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get user sessions",
+			zap.String("userUUID", userUUID),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	for _, userSession := range sessions {
+		err = s.revokeSession(ctx, userUUID, userSession.UUID.String())
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	logger.Debug(ctx,
+		"all user sessions revoked",
+		zap.String("userUUID", userUUID),
+		zap.Int("revokedCount", len(sessions)),
+	)
+
+	return len(sessions), nil
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/iam/internal/repository"
 	def "github.com/nkolesnikov999/micro2-OK/iam/internal/service"
 )
@@ -8,16 +9,25 @@ import (
 var _ def.AuthService = (*service)(nil)
 
 type service struct {
-	sessionRepository repository.SessionRepository
-	userRepository    repository.UserRepository
+	sessionRepository      repository.SessionRepository
+	userRepository         repository.UserRepository
+	loginAttemptRepository repository.LoginAttemptRepository
+	sessionPolicy          model.SessionPolicy
+	throttlePolicy         model.LoginThrottlePolicy
 }
 
 func NewService(
 	sessionRepository repository.SessionRepository,
 	userRepository repository.UserRepository,
+	loginAttemptRepository repository.LoginAttemptRepository,
+	sessionPolicy model.SessionPolicy,
+	throttlePolicy model.LoginThrottlePolicy,
 ) *service {
 	return &service{
-		sessionRepository: sessionRepository,
-		userRepository:    userRepository,
+		sessionRepository:      sessionRepository,
+		userRepository:         userRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		sessionPolicy:          sessionPolicy,
+		throttlePolicy:         throttlePolicy,
 	}
 }
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/repository/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	sessionRepository      *mocks.SessionRepository
+	userRepository         *mocks.UserRepository
+	loginAttemptRepository *mocks.LoginAttemptRepository
+
+	sessionPolicy  model.SessionPolicy
+	throttlePolicy model.LoginThrottlePolicy
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+
+	// Глобальный MeterProvider по умолчанию no-op, счётчики нужны только не-nil
+	_ = metrics.InitMetrics("iam-service-test")
+
+	s.ctx = context.Background()
+
+	s.sessionRepository = mocks.NewSessionRepository(s.T())
+	s.userRepository = mocks.NewUserRepository(s.T())
+	s.loginAttemptRepository = mocks.NewLoginAttemptRepository(s.T())
+
+	s.sessionPolicy = model.SessionPolicy{
+		TTL:             time.Hour,
+		Sliding:         true,
+		RefreshInterval: time.Minute,
+		MaxLifetime:     24 * time.Hour,
+	}
+	s.throttlePolicy = model.LoginThrottlePolicy{
+		MaxLoginFailures: 3,
+		MaxIPFailures:    10,
+		FailureWindow:    15 * time.Minute,
+		BaseLockout:      time.Minute,
+		MaxLockout:       15 * time.Minute,
+	}
+
+	s.newService()
+}
+
+// newService пересобирает сервис после изменения политик в тесте
+func (s *ServiceSuite) newService() {
+	s.service = NewService(
+		s.sessionRepository,
+		s.userRepository,
+		s.loginAttemptRepository,
+		s.sessionPolicy,
+		s.throttlePolicy,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
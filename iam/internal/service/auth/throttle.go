@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// dummyPasswordHash сравнивается с паролем, когда пользователь не найден:
+// ответ занимает столько же времени, сколько проверка настоящего пароля
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy-password-for-timing"), bcrypt.DefaultCost)
+
+// throttleTarget — признак, по которому считаются неудачные попытки входа
+type throttleTarget struct {
+	scope model.LoginThrottleScope
+	key   string
+}
+
+// throttleTargets возвращает признаки попытки входа; IP учитывается, только если известен
+func (s *service) throttleTargets(login string, client model.ClientInfo) []throttleTarget {
+	targets := make([]throttleTarget, 0, 2)
+	if s.throttlePolicy.Limit(model.LoginThrottleScopeLogin) > 0 {
+		targets = append(targets, throttleTarget{
+			scope: model.LoginThrottleScopeLogin,
+			key:   strings.ToLower(strings.TrimSpace(login)),
+		})
+	}
+	if client.IP != "" && s.throttlePolicy.Limit(model.LoginThrottleScopeIP) > 0 {
+		targets = append(targets, throttleTarget{
+			scope: model.LoginThrottleScopeIP,
+			key:   client.IP,
+		})
+	}
+
+	return targets
+}
+
+// checkLoginLock возвращает LoginLockedError, если вход заблокирован по любому из признаков
+func (s *service) checkLoginLock(ctx context.Context, targets []throttleTarget) error {
+	var retryAfter time.Duration
+	var lockedScope model.LoginThrottleScope
+
+	for _, target := range targets {
+		lockedUntil, err := s.loginAttemptRepository.GetLockedUntil(ctx, target.scope, target.key)
+		if err != nil {
+			return err
+		}
+
+		if wait := time.Until(lockedUntil); wait > retryAfter {
+			retryAfter = wait
+			lockedScope = target.scope
+		}
+	}
+
+	if retryAfter <= 0 {
+		return nil
+	}
+
+	metrics.LoginLockedAttemptsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("scope", string(lockedScope)),
+	))
+
+	return &model.LoginLockedError{RetryAfter: retryAfter.Round(time.Second)}
+}
+
+// registerLoginFailure учитывает неудачу и блокирует вход по признакам, превысившим лимит.
+// Ошибки Redis только логируются: клиент всё равно получает ErrInvalidCredentials
+func (s *service) registerLoginFailure(ctx context.Context, targets []throttleTarget) {
+	metrics.LoginFailuresTotal.Add(ctx, 1)
+
+	for _, target := range targets {
+		failures, err := s.loginAttemptRepository.IncrementFailures(ctx, target.scope, target.key, s.throttlePolicy.FailureWindow)
+		if err != nil {
+			logger.Warn(ctx,
+				"failed to count login failure",
+				zap.String("scope", string(target.scope)),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		lockout := s.throttlePolicy.Lockout(target.scope, failures)
+		if lockout == 0 {
+			continue
+		}
+
+		err = s.loginAttemptRepository.Lock(ctx, target.scope, target.key, lockout, s.throttlePolicy.FailureWindow)
+		if err != nil {
+			logger.Warn(ctx,
+				"failed to lock login",
+				zap.String("scope", string(target.scope)),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		metrics.LoginLockoutsTotal.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("scope", string(target.scope)),
+		))
+
+		logger.Warn(ctx,
+			"login locked after repeated failures",
+			zap.String("scope", string(target.scope)),
+			zap.String("key", target.key),
+			zap.Int64("failures", failures),
+			zap.Duration("lockout", lockout),
+		)
+	}
+}
+
+// resetLoginFailures сбрасывает счётчик логина после успешного входа.
+// Счётчик IP не сбрасывается: иначе один свой аккаунт позволил бы подбирать чужие без ограничений
+func (s *service) resetLoginFailures(ctx context.Context, targets []throttleTarget) {
+	for _, target := range targets {
+		if target.scope != model.LoginThrottleScopeLogin {
+			continue
+		}
+
+		err := s.loginAttemptRepository.ResetFailures(ctx, target.scope, target.key)
+		if err != nil {
+			logger.Warn(ctx,
+				"failed to reset login failures",
+				zap.String("scope", string(target.scope)),
+				zap.Error(err),
+			)
+		}
+	}
+}
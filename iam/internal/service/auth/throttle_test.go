@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+const (
+	testLogin    = "ivan.petrov"
+	testPassword = "Str0ng-Passw0rd"
+	testIP       = "203.0.113.7"
+)
+
+var testClient = model.ClientInfo{UserAgent: "test-agent", IP: testIP}
+
+// expectUser настраивает поиск пользователя с паролем testPassword
+func (s *ServiceSuite) expectUser() model.User {
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	s.Require().NoError(err)
+
+	user := model.User{UUID: uuid.New(), Info: model.UserInfo{Login: testLogin}}
+	s.userRepository.On("GetUserByLoginOrEmail", mock.Anything, testLogin).Return(user, string(hash), nil)
+	return user
+}
+
+// expectNotLocked настраивает отсутствие блокировки по логину и IP
+func (s *ServiceSuite) expectNotLocked() {
+	s.loginAttemptRepository.On("GetLockedUntil", mock.Anything, model.LoginThrottleScopeLogin, testLogin).Return(time.Time{}, nil)
+	s.loginAttemptRepository.On("GetLockedUntil", mock.Anything, model.LoginThrottleScopeIP, testIP).Return(time.Time{}, nil)
+}
+
+func (s *ServiceSuite) TestLoginLocksAfterMaxFailures() {
+	s.expectUser()
+	s.expectNotLocked()
+	s.loginAttemptRepository.On("IncrementFailures", mock.Anything, model.LoginThrottleScopeLogin, testLogin, s.throttlePolicy.FailureWindow).
+		Return(s.throttlePolicy.MaxLoginFailures, nil)
+	s.loginAttemptRepository.On("IncrementFailures", mock.Anything, model.LoginThrottleScopeIP, testIP, s.throttlePolicy.FailureWindow).
+		Return(int64(1), nil)
+	// Блокируется только логин: лимит IP ещё не достигнут
+	s.loginAttemptRepository.On("Lock", mock.Anything, model.LoginThrottleScopeLogin, testLogin, s.throttlePolicy.BaseLockout, s.throttlePolicy.FailureWindow).
+		Return(nil).Once()
+
+	_, err := s.service.Login(s.ctx, testLogin, "wrong-password", testClient)
+	s.Require().ErrorIs(err, model.ErrInvalidCredentials)
+	s.loginAttemptRepository.AssertNotCalled(s.T(), "Lock", mock.Anything, model.LoginThrottleScopeIP, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestLoginBelowLimitDoesNotLock() {
+	s.expectUser()
+	s.expectNotLocked()
+	s.loginAttemptRepository.On("IncrementFailures", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+
+	_, err := s.service.Login(s.ctx, testLogin, "wrong-password", testClient)
+	s.Require().ErrorIs(err, model.ErrInvalidCredentials)
+	s.loginAttemptRepository.AssertNotCalled(s.T(), "Lock", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestLoginLockoutDoublesUpToMax() {
+	tests := []struct {
+		failures int64
+		lockout  time.Duration
+	}{
+		{failures: 4, lockout: 2 * time.Minute},
+		{failures: 5, lockout: 4 * time.Minute},
+		{failures: 7, lockout: 15 * time.Minute},
+		{failures: 50, lockout: 15 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		s.SetupTest()
+		s.expectUser()
+		s.expectNotLocked()
+		s.loginAttemptRepository.On("IncrementFailures", mock.Anything, model.LoginThrottleScopeLogin, testLogin, mock.Anything).Return(tt.failures, nil)
+		s.loginAttemptRepository.On("IncrementFailures", mock.Anything, model.LoginThrottleScopeIP, testIP, mock.Anything).Return(int64(1), nil)
+		s.loginAttemptRepository.On("Lock", mock.Anything, model.LoginThrottleScopeLogin, testLogin, tt.lockout, s.throttlePolicy.FailureWindow).
+			Return(nil).Once()
+
+		_, err := s.service.Login(s.ctx, testLogin, "wrong-password", testClient)
+		s.Require().ErrorIs(err, model.ErrInvalidCredentials)
+	}
+}
+
+func (s *ServiceSuite) TestLoginRejectedWhileLocked() {
+	// Блокировка по IP действует и для другого логина; БД и bcrypt не вызываются
+	s.loginAttemptRepository.On("GetLockedUntil", mock.Anything, model.LoginThrottleScopeLogin, testLogin).Return(time.Time{}, nil)
+	s.loginAttemptRepository.On("GetLockedUntil", mock.Anything, model.LoginThrottleScopeIP, testIP).
+		Return(time.Now().Add(90*time.Second), nil)
+
+	_, err := s.service.Login(s.ctx, testLogin, testPassword, testClient)
+
+	var locked *model.LoginLockedError
+	s.Require().ErrorAs(err, &locked)
+	s.Require().InDelta(90*time.Second, locked.RetryAfter, float64(2*time.Second))
+	s.userRepository.AssertNotCalled(s.T(), "GetUserByLoginOrEmail", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestLoginUnknownUserInvalidCredentials() {
+	s.expectNotLocked()
+	s.userRepository.On("GetUserByLoginOrEmail", mock.Anything, testLogin).Return(model.User{}, "", model.ErrUserNotFound)
+	// Неизвестный пользователь считается неудачей так же, как неверный пароль
+	s.loginAttemptRepository.On("IncrementFailures", mock.Anything, model.LoginThrottleScopeLogin, testLogin, mock.Anything).Return(int64(1), nil).Once()
+	s.loginAttemptRepository.On("IncrementFailures", mock.Anything, model.LoginThrottleScopeIP, testIP, mock.Anything).Return(int64(1), nil).Once()
+
+	// Даже пароль от фиктивного хеша не проходит: пользователь не найден
+	_, err := s.service.Login(s.ctx, testLogin, "dummy-password-for-timing", testClient)
+	s.Require().ErrorIs(err, model.ErrInvalidCredentials)
+}
+
+func (s *ServiceSuite) TestLoginNormalizesThrottleKey() {
+	s.loginAttemptRepository.On("GetLockedUntil", mock.Anything, model.LoginThrottleScopeLogin, testLogin).
+		Return(time.Now().Add(time.Minute), nil)
+
+	_, err := s.service.Login(s.ctx, "  Ivan.Petrov ", testPassword, model.ClientInfo{})
+
+	var locked *model.LoginLockedError
+	s.Require().ErrorAs(err, &locked)
+}
+
+func (s *ServiceSuite) TestLoginSuccessResetsOnlyLoginCounter() {
+	user := s.expectUser()
+	s.expectNotLocked()
+	s.loginAttemptRepository.On("ResetFailures", mock.Anything, model.LoginThrottleScopeLogin, testLogin).Return(nil).Once()
+	s.sessionRepository.On("CreateSession", mock.Anything, mock.Anything, s.sessionPolicy.TTL).Return(nil)
+	s.sessionRepository.On("AddSessionToUserSet", mock.Anything, user.UUID.String(), mock.Anything).Return(nil)
+	s.sessionRepository.On("RemoveStaleSessions", mock.Anything, user.UUID.String()).Return(nil)
+
+	sessionUUID, err := s.service.Login(s.ctx, testLogin, testPassword, testClient)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(sessionUUID)
+
+	// Счётчик IP не сбрасывается: свой аккаунт не должен открывать подбор чужих
+	s.loginAttemptRepository.AssertNotCalled(s.T(), "ResetFailures", mock.Anything, model.LoginThrottleScopeIP, mock.Anything)
+	s.loginAttemptRepository.AssertNotCalled(s.T(), "IncrementFailures", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -46,6 +47,8 @@ func (s *service) Whoami(ctx context.Context, sessionUUID string) (model.Session
 		return model.Session{}, model.User{}, err
 	}
 
+	s.touchSession(ctx, &session)
+
 	logger.Debug(ctx,
 		"whoami retrieved successfully",
 		zap.String("sessionUUID", sessionUUID),
@@ -54,3 +57,33 @@ func (s *service) Whoami(ctx context.Context, sessionUUID string) (model.Session
 
 	return session, user, nil
 }
+
+// touchSession отмечает активность в сессии и в скользящем режиме продлевает её.
+// Whoami вызывается на каждый запрос через envoy, поэтому запись в Redis
+// происходит не чаще раза в RefreshInterval; ошибка записи не мешает ответу
+func (s *service) touchSession(ctx context.Context, session *model.Session) {
+	now := time.Now()
+	if now.Sub(session.LastSeenAt) < s.sessionPolicy.RefreshInterval {
+		return
+	}
+
+	expiresAt := session.ExpiresAt
+	if s.sessionPolicy.Sliding {
+		if extended := s.sessionPolicy.ExpiresAt(session.CreatedAt, now); extended.After(expiresAt) {
+			expiresAt = extended
+		}
+	}
+
+	err := s.sessionRepository.TouchSession(ctx, session.UUID.String(), now, expiresAt)
+	if err != nil {
+		logger.Warn(ctx,
+			"failed to update session activity",
+			zap.String("sessionUUID", session.UUID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	session.LastSeenAt = now
+	session.ExpiresAt = expiresAt
+}
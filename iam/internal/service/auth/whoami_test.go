@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+// expectSession настраивает сессию и её пользователя для Whoami
+func (s *ServiceSuite) expectSession(session model.Session) {
+	s.sessionRepository.On("GetSession", mock.Anything, session.UUID.String()).Return(session, nil)
+	s.userRepository.On("GetUser", mock.Anything, session.UserUUID.String()).
+		Return(model.User{UUID: session.UserUUID}, nil)
+}
+
+func newTestSession(createdAt, lastSeenAt, expiresAt time.Time) model.Session {
+	return model.Session{
+		UUID:       uuid.New(),
+		UserUUID:   uuid.New(),
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+		LastSeenAt: lastSeenAt,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+func (s *ServiceSuite) TestWhoamiWithinRefreshIntervalSkipsTouch() {
+	now := time.Now()
+	session := newTestSession(now.Add(-time.Hour), now.Add(-10*time.Second), now.Add(50*time.Minute))
+	s.expectSession(session)
+
+	got, _, err := s.service.Whoami(s.ctx, session.UUID.String())
+	s.Require().NoError(err)
+	s.Require().Equal(session.ExpiresAt, got.ExpiresAt)
+	s.sessionRepository.AssertNotCalled(s.T(), "TouchSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestWhoamiSlidingExtendsSession() {
+	now := time.Now()
+	session := newTestSession(now.Add(-2*time.Hour), now.Add(-5*time.Minute), now.Add(55*time.Minute))
+	s.expectSession(session)
+
+	// Новый срок — около now+TTL
+	s.sessionRepository.On("TouchSession", mock.Anything, session.UUID.String(), mock.Anything,
+		mock.MatchedBy(func(expiresAt time.Time) bool {
+			return expiresAt.After(now.Add(s.sessionPolicy.TTL).Add(-time.Second)) &&
+				!expiresAt.After(time.Now().Add(s.sessionPolicy.TTL))
+		})).Return(nil).Once()
+
+	got, _, err := s.service.Whoami(s.ctx, session.UUID.String())
+	s.Require().NoError(err)
+	s.Require().True(got.ExpiresAt.After(session.ExpiresAt))
+	s.Require().True(got.LastSeenAt.After(session.LastSeenAt))
+}
+
+func (s *ServiceSuite) TestWhoamiSlidingCappedByMaxLifetime() {
+	now := time.Now()
+	createdAt := now.Add(-s.sessionPolicy.MaxLifetime + 30*time.Minute)
+	session := newTestSession(createdAt, now.Add(-5*time.Minute), now.Add(20*time.Minute))
+	s.expectSession(session)
+
+	limit := createdAt.Add(s.sessionPolicy.MaxLifetime)
+	s.sessionRepository.On("TouchSession", mock.Anything, session.UUID.String(), mock.Anything, limit).Return(nil).Once()
+
+	got, _, err := s.service.Whoami(s.ctx, session.UUID.String())
+	s.Require().NoError(err)
+	s.Require().Equal(limit, got.ExpiresAt)
+}
+
+func (s *ServiceSuite) TestWhoamiNonSlidingKeepsExpiresAt() {
+	s.sessionPolicy.Sliding = false
+	s.newService()
+
+	now := time.Now()
+	session := newTestSession(now.Add(-time.Hour), now.Add(-5*time.Minute), now.Add(10*time.Minute))
+	s.expectSession(session)
+
+	// Отмечается только активность, срок не продлевается
+	s.sessionRepository.On("TouchSession", mock.Anything, session.UUID.String(), mock.Anything, session.ExpiresAt).Return(nil).Once()
+
+	got, _, err := s.service.Whoami(s.ctx, session.UUID.String())
+	s.Require().NoError(err)
+	s.Require().Equal(session.ExpiresAt, got.ExpiresAt)
+}
+
+func (s *ServiceSuite) TestWhoamiTouchFailureDoesNotFail() {
+	now := time.Now()
+	session := newTestSession(now.Add(-time.Hour), now.Add(-5*time.Minute), now.Add(55*time.Minute))
+	s.expectSession(session)
+
+	// Сессию отозвали между GetSession и TouchSession: ответ по прочитанной сессии
+	s.sessionRepository.On("TouchSession", mock.Anything, session.UUID.String(), mock.Anything, mock.Anything).
+		Return(model.ErrSessionNotFound).Once()
+
+	got, _, err := s.service.Whoami(s.ctx, session.UUID.String())
+	s.Require().NoError(err)
+	s.Require().Equal(session.ExpiresAt, got.ExpiresAt)
+	s.Require().Equal(session.LastSeenAt, got.LastSeenAt)
+}
+
+func (s *ServiceSuite) TestWhoamiUnknownSession() {
+	sessionUUID := uuid.New().String()
+	s.sessionRepository.On("GetSession", mock.Anything, sessionUUID).Return(model.Session{}, model.ErrSessionNotFound)
+
+	_, _, err := s.service.Whoami(s.ctx, sessionUUID)
+	s.Require().ErrorIs(err, model.ErrSessionNotFound)
+}
@@ -25,9 +25,68 @@ func (_m *AuthService) EXPECT() *AuthService_Expecter {
 	return &AuthService_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function with given fields: ctx, login, password
-func (_m *AuthService) Login(ctx context.Context, login string, password string) (string, error) {
-	ret := _m.Called(ctx, login, password)
+// ListSessions provides a mock function with given fields: ctx, sessionUUID
+func (_m *AuthService) ListSessions(ctx context.Context, sessionUUID string) ([]model.Session, error) {
+	ret := _m.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 []model.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Session, error)); ok {
+		return rf(ctx, sessionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Session); ok {
+		r0 = rf(ctx, sessionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AuthService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID string
+func (_e *AuthService_Expecter) ListSessions(ctx interface{}, sessionUUID interface{}) *AuthService_ListSessions_Call {
+	return &AuthService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, sessionUUID)}
+}
+
+func (_c *AuthService_ListSessions_Call) Run(run func(ctx context.Context, sessionUUID string)) *AuthService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthService_ListSessions_Call) Return(_a0 []model.Session, _a1 error) *AuthService_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_ListSessions_Call) RunAndReturn(run func(context.Context, string) ([]model.Session, error)) *AuthService_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, login, password, client
+func (_m *AuthService) Login(ctx context.Context, login string, password string, client model.ClientInfo) (string, error) {
+	ret := _m.Called(ctx, login, password, client)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
@@ -35,17 +94,17 @@ func (_m *AuthService) Login(ctx context.Context, login string, password string)
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
-		return rf(ctx, login, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ClientInfo) (string, error)); ok {
+		return rf(ctx, login, password, client)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = rf(ctx, login, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ClientInfo) string); ok {
+		r0 = rf(ctx, login, password, client)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, login, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.ClientInfo) error); ok {
+		r1 = rf(ctx, login, password, client)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +121,14 @@ type AuthService_Login_Call struct {
 //   - ctx context.Context
 //   - login string
 //   - password string
-func (_e *AuthService_Expecter) Login(ctx interface{}, login interface{}, password interface{}) *AuthService_Login_Call {
-	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, login, password)}
+//   - client model.ClientInfo
+func (_e *AuthService_Expecter) Login(ctx interface{}, login interface{}, password interface{}, client interface{}) *AuthService_Login_Call {
+	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, login, password, client)}
 }
 
-func (_c *AuthService_Login_Call) Run(run func(ctx context.Context, login string, password string)) *AuthService_Login_Call {
+func (_c *AuthService_Login_Call) Run(run func(ctx context.Context, login string, password string, client model.ClientInfo)) *AuthService_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(model.ClientInfo))
 	})
 	return _c
 }
@@ -78,7 +138,111 @@ func (_c *AuthService_Login_Call) Return(_a0 string, _a1 error) *AuthService_Log
 	return _c
 }
 
-func (_c *AuthService_Login_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *AuthService_Login_Call {
+func (_c *AuthService_Login_Call) RunAndReturn(run func(context.Context, string, string, model.ClientInfo) (string, error)) *AuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, sessionUUID
+func (_m *AuthService) Logout(ctx context.Context, sessionUUID string) error {
+	ret := _m.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, sessionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type AuthService_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID string
+func (_e *AuthService_Expecter) Logout(ctx interface{}, sessionUUID interface{}) *AuthService_Logout_Call {
+	return &AuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, sessionUUID)}
+}
+
+func (_c *AuthService_Logout_Call) Run(run func(ctx context.Context, sessionUUID string)) *AuthService_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthService_Logout_Call) Return(_a0 error) *AuthService_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthService_Logout_Call) RunAndReturn(run func(context.Context, string) error) *AuthService_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAllSessions provides a mock function with given fields: ctx, sessionUUID
+func (_m *AuthService) RevokeAllSessions(ctx context.Context, sessionUUID string) (int, error) {
+	ret := _m.Called(ctx, sessionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAllSessions")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, sessionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, sessionUUID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_RevokeAllSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllSessions'
+type AuthService_RevokeAllSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeAllSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionUUID string
+func (_e *AuthService_Expecter) RevokeAllSessions(ctx interface{}, sessionUUID interface{}) *AuthService_RevokeAllSessions_Call {
+	return &AuthService_RevokeAllSessions_Call{Call: _e.mock.On("RevokeAllSessions", ctx, sessionUUID)}
+}
+
+func (_c *AuthService_RevokeAllSessions_Call) Run(run func(ctx context.Context, sessionUUID string)) *AuthService_RevokeAllSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeAllSessions_Call) Return(_a0 int, _a1 error) *AuthService_RevokeAllSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthService_RevokeAllSessions_Call) RunAndReturn(run func(context.Context, string) (int, error)) *AuthService_RevokeAllSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
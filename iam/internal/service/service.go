@@ -7,8 +7,11 @@ import (
 )
 
 type AuthService interface {
-	Login(ctx context.Context, login, password string) (string, error)
+	Login(ctx context.Context, login, password string, client model.ClientInfo) (string, error)
 	Whoami(ctx context.Context, sessionUUID string) (model.Session, model.User, error)
+	Logout(ctx context.Context, sessionUUID string) error
+	ListSessions(ctx context.Context, sessionUUID string) ([]model.Session, error)
+	RevokeAllSessions(ctx context.Context, sessionUUID string) (int, error)
 }
 
 type UserService interface {
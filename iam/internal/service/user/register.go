@@ -15,17 +15,13 @@ import (
 
 func (s *service) Register(ctx context.Context, login, email, password string) (string, error) {
 	// Валидация входных данных
-	if login == "" {
-		logger.Error(ctx, "empty login provided")
-		return "", model.ErrInvalidLogin
-	}
-	if email == "" {
-		logger.Error(ctx, "empty email provided")
-		return "", model.ErrInvalidEmail
-	}
-	if password == "" {
-		logger.Error(ctx, "empty password provided")
-		return "", model.ErrInvalidPassword
+	if err := s.validateRegistration(login, email, password); err != nil {
+		logger.Warn(ctx,
+			"invalid registration data",
+			zap.String("login", login),
+			zap.Error(err),
+		)
+		return "", err
 	}
 
 	// Хешируем пароль
@@ -46,6 +42,7 @@ func (s *service) Register(ctx context.Context, login, email, password string) (
 	// Создаем модель пользователя
 	user := model.User{
 		UUID:      userUUID,
+		Roles:     []string{model.RoleCustomer},
 		CreatedAt: now,
 		UpdatedAt: now,
 		Info: model.UserInfo{
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/iam/internal/repository"
 	def "github.com/nkolesnikov999/micro2-OK/iam/internal/service"
 )
@@ -9,12 +10,15 @@ var _ def.UserService = (*service)(nil)
 
 type service struct {
 	userRepository repository.UserRepository
+	passwordPolicy model.PasswordPolicy
 }
 
 func NewService(
 	userRepository repository.UserRepository,
+	passwordPolicy model.PasswordPolicy,
 ) *service {
 	return &service{
 		userRepository: userRepository,
+		passwordPolicy: passwordPolicy,
 	}
 }
@@ -0,0 +1,138 @@
+package user
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+const (
+	loginMinLength = 3
+	loginMaxLength = 64
+	// Ограничения длины адреса из RFC 5321
+	emailMaxLength      = 254
+	emailLocalMaxLength = 64
+)
+
+// loginPattern запрещает @: вход принимает логин или email, и логин
+// не должен совпадать с чужим адресом
+var loginPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._+-]*$`)
+
+// validateRegistration проверяет все поля и возвращает *model.ValidationError со всеми нарушениями
+func (s *service) validateRegistration(login, email, password string) error {
+	var violations []model.FieldViolation
+	add := func(field, description string) {
+		violations = append(violations, model.FieldViolation{Field: field, Description: description})
+	}
+
+	if description := validateLogin(login); description != "" {
+		add(model.FieldLogin, description)
+	}
+	if description := validateEmail(email); description != "" {
+		add(model.FieldEmail, description)
+	}
+	for _, description := range s.validatePassword(password, login, email) {
+		add(model.FieldPassword, description)
+	}
+
+	if len(violations) > 0 {
+		return &model.ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+func validateLogin(login string) string {
+	switch {
+	case login == "":
+		return "login cannot be empty"
+	case len(login) < loginMinLength || len(login) > loginMaxLength:
+		return fmt.Sprintf("login must be from %d to %d characters long", loginMinLength, loginMaxLength)
+	case !loginPattern.MatchString(login):
+		return "login may contain only latin letters, digits and . _ + -, and must start with a letter or digit"
+	}
+	return ""
+}
+
+func validateEmail(email string) string {
+	if email == "" {
+		return "email cannot be empty"
+	}
+	if len(email) > emailMaxLength {
+		return fmt.Sprintf("email must be at most %d characters long", emailMaxLength)
+	}
+
+	// ParseAddress принимает и "Имя <addr>", поэтому адрес должен совпасть с вводом целиком
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "email is not a valid address"
+	}
+
+	at := strings.LastIndex(email, "@")
+	local, domain := email[:at], email[at+1:]
+	if len(local) > emailLocalMaxLength {
+		return fmt.Sprintf("email local part must be at most %d characters long", emailLocalMaxLength)
+	}
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, "[") {
+		return "email domain must be a fully qualified domain name"
+	}
+
+	return ""
+}
+
+// validatePassword возвращает все нарушения парольной политики
+func (s *service) validatePassword(password, login, email string) []string {
+	policy := s.passwordPolicy
+	if password == "" {
+		return []string{"password cannot be empty"}
+	}
+
+	var violations []string
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		violations = append(violations, fmt.Sprintf("password must be at least %d characters long", policy.MinLength))
+	}
+	// bcrypt учитывает только первые байты пароля, поэтому предел — в байтах
+	if len(password) > policy.MaxLength {
+		violations = append(violations, fmt.Sprintf("password must be at most %d bytes long", policy.MaxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSpecial = true
+		}
+	}
+	if policy.RequireUpper && !hasUpper {
+		violations = append(violations, "password must contain an uppercase letter")
+	}
+	if policy.RequireLower && !hasLower {
+		violations = append(violations, "password must contain a lowercase letter")
+	}
+	if policy.RequireDigit && !hasDigit {
+		violations = append(violations, "password must contain a digit")
+	}
+	if policy.RequireSpecial && !hasSpecial {
+		violations = append(violations, "password must contain a special character")
+	}
+
+	lower := strings.ToLower(password)
+	if lower == strings.ToLower(login) || lower == strings.ToLower(email) {
+		violations = append(violations, "password must not match login or email")
+	}
+	if _, breached := policy.Denylist[lower]; breached {
+		violations = append(violations, "password is too common and appears in known data breaches")
+	}
+
+	return violations
+}
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nkolesnikov999/micro2-OK/iam/internal/model"
+)
+
+const (
+	validLogin    = "ivan.petrov"
+	validEmail    = "ivan@example.com"
+	validPassword = "Str0ng-Passw0rd"
+)
+
+func newTestService() *service {
+	return NewService(nil, model.PasswordPolicy{
+		MinLength:      8,
+		MaxLength:      72,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSpecial: true,
+		Denylist:       map[string]struct{}{"passw0rd!x": {}},
+	})
+}
+
+// violatedFields возвращает поля из *model.ValidationError в порядке нарушений
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *model.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	fields := make([]string, 0, len(validationErr.Violations))
+	for _, violation := range validationErr.Violations {
+		fields = append(fields, violation.Field)
+	}
+	return fields
+}
+
+func TestValidateRegistration(t *testing.T) {
+	tests := []struct {
+		name       string
+		login      string
+		email      string
+		password   string
+		wantFields []string
+	}{
+		{name: "valid", login: validLogin, email: validEmail, password: validPassword},
+		{name: "login with plus and dash", login: "ivan+shop-1", email: validEmail, password: validPassword},
+		{name: "empty login", login: "", email: validEmail, password: validPassword, wantFields: []string{model.FieldLogin}},
+		{name: "short login", login: "iv", email: validEmail, password: validPassword, wantFields: []string{model.FieldLogin}},
+		{name: "long login", login: strings.Repeat("a", loginMaxLength+1), email: validEmail, password: validPassword, wantFields: []string{model.FieldLogin}},
+		{name: "login with @", login: "other@example.com", email: validEmail, password: validPassword, wantFields: []string{model.FieldLogin}},
+		{name: "login starts with dot", login: ".ivan", email: validEmail, password: validPassword, wantFields: []string{model.FieldLogin}},
+		{name: "login with cyrillic", login: "иван", email: validEmail, password: validPassword, wantFields: []string{model.FieldLogin}},
+		{name: "empty email", login: validLogin, email: "", password: validPassword, wantFields: []string{model.FieldEmail}},
+		{name: "email with display name", login: validLogin, email: "Ivan <ivan@example.com>", password: validPassword, wantFields: []string{model.FieldEmail}},
+		{name: "email without dot in domain", login: validLogin, email: "ivan@localhost", password: validPassword, wantFields: []string{model.FieldEmail}},
+		{name: "email long local part", login: validLogin, email: strings.Repeat("a", emailLocalMaxLength+1) + "@example.com", password: validPassword, wantFields: []string{model.FieldEmail}},
+		{name: "empty password", login: validLogin, email: validEmail, password: "", wantFields: []string{model.FieldPassword}},
+		{name: "password matches login", login: "Ivan.Petrov-1", email: validEmail, password: "ivan.petrov-1", wantFields: []string{model.FieldPassword, model.FieldPassword}},
+		{name: "denylisted password", login: validLogin, email: validEmail, password: "Passw0rd!X", wantFields: []string{model.FieldPassword}},
+		{
+			name:       "all fields invalid",
+			login:      "a@b",
+			email:      "not-an-email",
+			password:   "short",
+			wantFields: []string{model.FieldLogin, model.FieldEmail, model.FieldPassword, model.FieldPassword, model.FieldPassword, model.FieldPassword},
+		},
+	}
+
+	s := newTestService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.validateRegistration(tt.login, tt.email, tt.password)
+			require.Equal(t, tt.wantFields, violatedFields(t, err))
+		})
+	}
+}
+
+func TestValidatePasswordReportsAllViolations(t *testing.T) {
+	violations := newTestService().validatePassword("abc", validLogin, validEmail)
+
+	require.Equal(t, []string{
+		"password must be at least 8 characters long",
+		"password must contain an uppercase letter",
+		"password must contain a digit",
+		"password must contain a special character",
+	}, violations)
+}
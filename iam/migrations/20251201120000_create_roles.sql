@@ -0,0 +1,52 @@
+-- +goose Up
+
+-- создаем справочник ролей
+create table if not exists roles
+(
+    name        varchar(64) primary key,
+    description text not null default ''
+);
+
+-- создаем таблицу прав ролей
+create table if not exists role_permissions
+(
+    role       varchar(64)  not null references roles (name) on delete cascade,
+    permission varchar(128) not null,
+    primary key (role, permission)
+);
+
+-- создаем таблицу ролей пользователей
+create table if not exists user_roles
+(
+    user_uuid uuid        not null references users (uuid) on delete cascade,
+    role      varchar(64) not null references roles (name),
+    primary key (user_uuid, role)
+);
+
+insert into roles (name, description)
+values ('customer', 'Покупатель: каталог и собственные заказы'),
+       ('admin', 'Администратор: управление каталогом, складом и пользователями')
+on conflict (name) do nothing;
+
+insert into role_permissions (role, permission)
+values ('customer', 'catalog:read'),
+       ('customer', 'orders:read'),
+       ('customer', 'orders:write'),
+       ('admin', 'catalog:read'),
+       ('admin', 'catalog:write'),
+       ('admin', 'inventory:write'),
+       ('admin', 'orders:read'),
+       ('admin', 'orders:write'),
+       ('admin', 'users:admin')
+on conflict do nothing;
+
+-- существующие пользователи становятся покупателями
+insert into user_roles (user_uuid, role)
+select uuid, 'customer'
+from users
+on conflict do nothing;
+
+-- +goose Down
+drop table if exists user_roles;
+drop table if exists role_permissions;
+drop table if exists roles;
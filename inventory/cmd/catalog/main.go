@@ -0,0 +1,268 @@
+// Команда catalog загружает каталог деталей из файла и выгружает его обратно.
+//
+//	go run ./inventory/cmd/catalog import -format csv -file parts.csv -dry-run
+//	go run ./inventory/cmd/catalog import -format ndjson -file parts.ndjson -report report.csv
+//	go run ./inventory/cmd/catalog export -format csv -file backup.csv
+//
+// Импорт создаёт или обновляет детали по uuid. stock_quantity применяется только
+// к новым деталям и проводится приходом через журнал движений остатков на первый
+// склад из WAREHOUSE_PRIORITY.
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/app"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/config"
+	catalogConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/converter/catalog"
+	inventoryMetrics "github.com/nkolesnikov999/micro2-OK/inventory/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const (
+	defaultConfigPath = "./deploy/compose/inventory/.env"
+
+	exitOK     = 0
+	exitFailed = 1
+	exitUsage  = 2
+)
+
+var errRowsFailed = errors.New("some rows failed to import")
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	if len(args) == 0 {
+		printUsage()
+		return exitUsage
+	}
+
+	command := args[0]
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	formatFlag := flags.String("format", string(catalogConverter.FormatCSV), "формат файла: csv или ndjson")
+	fileFlag := flags.String("file", "", "путь к файлу каталога")
+	configFlag := flags.String("config", defaultConfigPath, "путь к .env с настройками inventory")
+	dryRunFlag := flags.Bool("dry-run", false, "только проверить файл, ничего не сохраняя (для import)")
+	reportFlag := flags.String("report", "", "путь к CSV-отчёту по каждой строке (для import)")
+
+	if command != "import" && command != "export" {
+		printUsage()
+		return exitUsage
+	}
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+
+	format, err := catalogConverter.ParseFormat(*formatFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	if *fileFlag == "" {
+		fmt.Fprintln(os.Stderr, "флаг -file обязателен")
+		return exitUsage
+	}
+
+	// Каталог загружается из файла, тестовые детали при подключении к пустой базе не нужны
+	if err := os.Setenv("MONGO_SEED_PARTS_COUNT", "0"); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailed
+	}
+
+	if err := config.Load(*configFlag); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		return exitFailed
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	defer gracefulShutdown()
+
+	if err := logger.Init(
+		ctx,
+		config.AppConfig().Logger.Level(),
+		config.AppConfig().Logger.AsJson(),
+		config.AppConfig().Logger.EnableOTLP(),
+		config.AppConfig().Logger.OTLPEndpoint(),
+		config.AppConfig().Logger.ServiceName(),
+	); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to init logger: %v\n", err)
+		return exitFailed
+	}
+	closer.SetLogger(logger.Logger())
+
+	// Метрики кэша в коллектор не отправляются: провайдер не настроен, счетчики пишут в no-op
+	if err := inventoryMetrics.InitMetrics(config.AppConfig().MetricCollector.ServiceName()); err != nil {
+		logger.Error(ctx, "❌ Ошибка инициализации метрик", zap.Error(err))
+		return exitFailed
+	}
+
+	diContainer := app.NewDiContainer()
+
+	// Приход без явного склада идёт на первый склад из списка приоритетов
+	if err := diContainer.WarehouseService(ctx).InitWarehouses(ctx); err != nil {
+		logger.Error(ctx, "❌ Ошибка инициализации складов", zap.Error(err))
+		return exitFailed
+	}
+
+	// Изменение цены при импорте не должно затереть прежнюю цену детали без истории
+	if err := diContainer.PriceService(ctx).InitPriceHistory(ctx); err != nil {
+		logger.Error(ctx, "❌ Ошибка инициализации истории цен", zap.Error(err))
+		return exitFailed
+	}
+
+	catalogService := diContainer.CatalogService(ctx)
+
+	switch command {
+	case "import":
+		err = importCatalog(ctx, catalogService, format, *fileFlag, *reportFlag, *dryRunFlag)
+	case "export":
+		err = exportCatalog(ctx, catalogService, format, *fileFlag)
+	}
+
+	if err != nil {
+		if !errors.Is(err, errRowsFailed) {
+			logger.Error(ctx, "❌ Ошибка при работе с каталогом", zap.String("command", command), zap.Error(err))
+		}
+		return exitFailed
+	}
+
+	return exitOK
+}
+
+func importCatalog(ctx context.Context, catalogService service.CatalogService, format catalogConverter.Format, path, reportPath string, dryRun bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	rows, err := catalogConverter.Decode(file, format)
+	if err != nil {
+		return err
+	}
+
+	report, err := catalogService.ImportParts(ctx, rows, dryRun)
+	if err != nil {
+		return err
+	}
+
+	if reportPath != "" {
+		if err := writeReportFile(reportPath, report); err != nil {
+			return err
+		}
+	}
+
+	printReport(os.Stdout, report)
+
+	if report.Failed > 0 {
+		return errRowsFailed
+	}
+
+	return nil
+}
+
+func exportCatalog(ctx context.Context, catalogService service.CatalogService, format catalogConverter.Format, path string) error {
+	parts, err := catalogService.ExportParts(ctx)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := catalogConverter.Encode(file, format, parts); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stdout, "exported %d parts to %s\n", len(parts), path)
+	return nil
+}
+
+// printReport выводит только строки с ошибками и итог, полный отчёт пишется в -report.
+func printReport(w io.Writer, report model.ImportReport) {
+	for _, row := range report.Rows {
+		if row.Action == model.ImportActionFailed {
+			fmt.Fprintf(w, "row %d (uuid %q): %s\n", row.Row, row.Uuid, row.Error)
+		}
+	}
+
+	mode := "import"
+	if report.DryRun {
+		mode = "dry run"
+	}
+
+	fmt.Fprintf(w, "%s: created %d, updated %d, unchanged %d, failed %d\n",
+		mode, report.Created, report.Updated, report.Unchanged, report.Failed)
+}
+
+func writeReportFile(path string, report model.ImportReport) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(file)
+	_ = writer.Write([]string{"row", "uuid", "action", "error"})
+	for _, row := range report.Rows {
+		_ = writer.Write([]string{strconv.Itoa(row.Row), row.Uuid, string(row.Action), row.Error})
+	}
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func printUsage() {
+	fmt.Fprintln(os.Stderr, `usage:
+  catalog import -file <path> [-format csv|ndjson] [-dry-run] [-report <path>] [-config <path>]
+  catalog export -file <path> [-format csv|ndjson] [-config <path>]`)
+}
+
+func gracefulShutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := closer.CloseAll(ctx); err != nil {
+		logger.Error(ctx, "❌ Ошибка при завершении работы", zap.Error(err))
+	}
+
+	if err := logger.Sync(); err != nil {
+		fmt.Fprintf(os.Stderr, "logger sync error: %v\n", err)
+	}
+	if err := logger.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "logger close error: %v\n", err)
+	}
+}
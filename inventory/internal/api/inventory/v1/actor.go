@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+)
+
+const (
+	// userUUIDMetadataKey заголовок, который Envoy добавляет после ext_authz
+	userUUIDMetadataKey = "x-user-uuid"
+	// systemActor используется, когда вызов пришёл без пользователя
+	systemActor = "system"
+)
+
+// actorFromContext возвращает UUID пользователя, выполняющего запрос
+func actorFromContext(ctx context.Context) string {
+	if user, ok := grpcAuth.GetUserFromContext(ctx); ok && user.GetUuid() != "" {
+		return user.GetUuid()
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(userUUIDMetadataKey); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	return systemActor
+}
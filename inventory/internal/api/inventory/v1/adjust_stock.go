@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) AdjustStock(ctx context.Context, req *inventoryV1.AdjustStockRequest) (*inventoryV1.AdjustStockResponse, error) {
+	if _, err := uuid.Parse(req.GetPartUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part_uuid format: %v", err)
+	}
+
+	if req.GetReferenceId() != "" {
+		if _, err := uuid.Parse(req.GetReferenceId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reference_id format: %v", err)
+		}
+	}
+
+	if req.GetWarehouseCode() != "" && !model.ValidWarehouseCode(req.GetWarehouseCode()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid warehouse_code format")
+	}
+
+	movement, err := a.stockService.AdjustStock(ctx, model.StockMovement{
+		PartUuid:      req.GetPartUuid(),
+		Delta:         req.GetDelta(),
+		Reason:        converter.ToModelStockMovementReason(req.GetReason()),
+		ReferenceID:   req.GetReferenceId(),
+		Actor:         actorFromContext(ctx),
+		WarehouseCode: req.GetWarehouseCode(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, "part not found")
+		case errors.Is(err, model.ErrWarehouseNotFound):
+			return nil, status.Error(codes.NotFound, "warehouse not found")
+		case errors.Is(err, model.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient stock")
+		case errors.Is(err, model.ErrInvalidStockDelta), errors.Is(err, model.ErrInvalidStockReason):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.AdjustStockResponse{Movement: converter.ToProtoStockMovement(movement)}, nil
+}
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestAdjustStockSuccess() {
+	var (
+		partUUID  = gofakeit.UUID()
+		orderUUID = gofakeit.UUID()
+		userUUID  = gofakeit.UUID()
+		ctx       = metadata.NewIncomingContext(s.ctx, metadata.Pairs(userUUIDMetadataKey, userUUID))
+		req       = &inventoryV1.AdjustStockRequest{
+			PartUuid:    partUUID,
+			Delta:       -2,
+			Reason:      inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_RESERVATION,
+			ReferenceId: orderUUID,
+		}
+		movement = model.StockMovement{
+			Uuid:        gofakeit.UUID(),
+			PartUuid:    partUUID,
+			Delta:       -2,
+			Reason:      model.StockMovementReasonReservation,
+			ReferenceID: orderUUID,
+			Actor:       userUUID,
+			StockAfter:  8,
+			CreatedAt:   time.Now(),
+		}
+	)
+
+	s.stockService.On("AdjustStock", ctx, model.StockMovement{
+		PartUuid:    partUUID,
+		Delta:       -2,
+		Reason:      model.StockMovementReasonReservation,
+		ReferenceID: orderUUID,
+		Actor:       userUUID,
+	}).Return(movement, nil)
+
+	res, err := s.api.AdjustStock(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(movement.Uuid, res.Movement.Uuid)
+	s.Require().Equal(int64(8), res.Movement.StockAfter)
+	s.Require().Equal(userUUID, res.Movement.Actor)
+	s.Require().Equal(inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_RESERVATION, res.Movement.Reason)
+}
+
+func (s *APISuite) TestAdjustStockWithoutUserUsesSystemActor() {
+	partUUID := gofakeit.UUID()
+
+	s.stockService.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.Actor == systemActor
+	})).Return(model.StockMovement{PartUuid: partUUID, Actor: systemActor}, nil)
+
+	res, err := s.api.AdjustStock(s.ctx, &inventoryV1.AdjustStockRequest{
+		PartUuid: partUUID,
+		Delta:    5,
+		Reason:   inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_RESTOCK,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(systemActor, res.Movement.Actor)
+}
+
+func (s *APISuite) TestAdjustStockInvalidPartUUID() {
+	res, err := s.api.AdjustStock(s.ctx, &inventoryV1.AdjustStockRequest{PartUuid: "invalid", Delta: 1})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestAdjustStockInvalidReferenceID() {
+	res, err := s.api.AdjustStock(s.ctx, &inventoryV1.AdjustStockRequest{
+		PartUuid:    gofakeit.UUID(),
+		Delta:       1,
+		ReferenceId: "order-1",
+	})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestAdjustStockErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: model.ErrPartNotFound, code: codes.NotFound},
+		{err: model.ErrInsufficientStock, code: codes.FailedPrecondition},
+		{err: model.ErrInvalidStockDelta, code: codes.InvalidArgument},
+		{err: model.ErrInvalidStockReason, code: codes.InvalidArgument},
+		{err: gofakeit.Error(), code: codes.Internal},
+	}
+
+	for _, tc := range cases {
+		partUUID := gofakeit.UUID()
+
+		s.stockService.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+			return m.PartUuid == partUUID
+		})).Return(model.StockMovement{}, tc.err).Once()
+
+		res, err := s.api.AdjustStock(s.ctx, &inventoryV1.AdjustStockRequest{
+			PartUuid: partUUID,
+			Delta:    -1,
+			Reason:   inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_SALE,
+		})
+		s.Require().Nil(res)
+		s.Require().Equal(tc.code, status.Code(err))
+	}
+}
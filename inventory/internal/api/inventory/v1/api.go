@@ -9,10 +9,28 @@ type api struct {
 	inventoryV1.UnimplementedInventoryServiceServer
 
 	inventoryService service.PartService
+	stockService     service.StockService
+
+	compatibilityService service.CompatibilityService
+	warehouseService     service.WarehouseService
+	priceService         service.PartPriceService
+	kitService           service.KitService
 }
 
-func NewAPI(inventoryService service.PartService) *api {
+func NewAPI(
+	inventoryService service.PartService,
+	stockService service.StockService,
+	compatibilityService service.CompatibilityService,
+	warehouseService service.WarehouseService,
+	priceService service.PartPriceService,
+	kitService service.KitService,
+) *api {
 	return &api{
-		inventoryService: inventoryService,
+		inventoryService:     inventoryService,
+		stockService:         stockService,
+		compatibilityService: compatibilityService,
+		warehouseService:     warehouseService,
+		priceService:         priceService,
+		kitService:           kitService,
 	}
 }
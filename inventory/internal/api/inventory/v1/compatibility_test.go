@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestCreateCompatibilityRuleSuccess() {
+	info := &inventoryV1.CompatibilityRuleInfo{
+		Type:        inventoryV1.CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_REQUIRES,
+		Subject:     &inventoryV1.PartSelector{Category: inventoryV1.Category_CATEGORY_ENGINE},
+		Target:      &inventoryV1.PartSelector{Category: inventoryV1.Category_CATEGORY_FUEL},
+		Description: "engine needs fuel",
+	}
+	created := model.CompatibilityRule{
+		Uuid:        gofakeit.UUID(),
+		Type:        model.CompatibilityRuleTypeRequires,
+		Subject:     model.PartSelector{Category: model.CategoryEngine},
+		Target:      model.PartSelector{Category: model.CategoryFuel},
+		Description: info.GetDescription(),
+		CreatedAt:   time.Now(),
+	}
+
+	s.compatibilityService.On("CreateRule", s.ctx, mock.MatchedBy(func(r model.CompatibilityRule) bool {
+		return r.Type == created.Type && r.Subject == created.Subject && r.Target == created.Target
+	})).Return(created, nil)
+
+	res, err := s.api.CreateCompatibilityRule(s.ctx, &inventoryV1.CreateCompatibilityRuleRequest{Info: info})
+	s.Require().NoError(err)
+	s.Require().Equal(created.Uuid, res.GetRule().GetUuid())
+	s.Require().Equal(info.GetType(), res.GetRule().GetInfo().GetType())
+	s.Require().Equal(inventoryV1.Category_CATEGORY_FUEL, res.GetRule().GetInfo().GetTarget().GetCategory())
+}
+
+func (s *APISuite) TestCreateCompatibilityRuleInvalid() {
+	s.compatibilityService.On("CreateRule", s.ctx, mock.Anything).
+		Return(model.CompatibilityRule{}, model.ErrInvalidCompatibilityRule)
+
+	res, err := s.api.CreateCompatibilityRule(s.ctx, &inventoryV1.CreateCompatibilityRuleRequest{
+		Info: &inventoryV1.CompatibilityRuleInfo{},
+	})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestCreateCompatibilityRuleMissingInfo() {
+	res, err := s.api.CreateCompatibilityRule(s.ctx, &inventoryV1.CreateCompatibilityRuleRequest{})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestListCompatibilityRules() {
+	s.compatibilityService.On("ListRules", s.ctx).Return([]model.CompatibilityRule{
+		{Uuid: gofakeit.UUID(), Type: model.CompatibilityRuleTypeMaxPerOrder, MaxQuantity: 2},
+	}, nil)
+
+	res, err := s.api.ListCompatibilityRules(s.ctx, &inventoryV1.ListCompatibilityRulesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.GetRules(), 1)
+	s.Require().Equal(int64(2), res.GetRules()[0].GetInfo().GetMaxQuantity())
+	s.Require().Nil(res.GetRules()[0].GetInfo().GetTarget())
+}
+
+func (s *APISuite) TestDeleteCompatibilityRuleNotFound() {
+	ruleUUID := gofakeit.UUID()
+	s.compatibilityService.On("DeleteRule", s.ctx, ruleUUID).Return(model.ErrCompatibilityRuleNotFound)
+
+	res, err := s.api.DeleteCompatibilityRule(s.ctx, &inventoryV1.DeleteCompatibilityRuleRequest{Uuid: ruleUUID})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *APISuite) TestDeleteCompatibilityRuleInvalidUUID() {
+	res, err := s.api.DeleteCompatibilityRule(s.ctx, &inventoryV1.DeleteCompatibilityRuleRequest{Uuid: "bad"})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestValidateConfigurationValid() {
+	partUUIDs := []string{gofakeit.UUID(), gofakeit.UUID()}
+	s.compatibilityService.On("ValidateConfiguration", s.ctx, partUUIDs).Return(nil, nil)
+
+	res, err := s.api.ValidateConfiguration(s.ctx, &inventoryV1.ValidateConfigurationRequest{PartUuids: partUUIDs})
+	s.Require().NoError(err)
+	s.Require().True(res.GetValid())
+	s.Require().Empty(res.GetViolations())
+}
+
+func (s *APISuite) TestValidateConfigurationViolations() {
+	partUUIDs := []string{gofakeit.UUID()}
+	violation := model.RuleViolation{
+		RuleUuid:  gofakeit.UUID(),
+		Type:      model.CompatibilityRuleTypeRequires,
+		Message:   gofakeit.Sentence(),
+		PartUuids: partUUIDs,
+	}
+	s.compatibilityService.On("ValidateConfiguration", s.ctx, partUUIDs).Return([]model.RuleViolation{violation}, nil)
+
+	res, err := s.api.ValidateConfiguration(s.ctx, &inventoryV1.ValidateConfigurationRequest{PartUuids: partUUIDs})
+	s.Require().NoError(err)
+	s.Require().False(res.GetValid())
+	s.Require().Len(res.GetViolations(), 1)
+	s.Require().Equal(violation.RuleUuid, res.GetViolations()[0].GetRuleUuid())
+	s.Require().Equal(inventoryV1.CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_REQUIRES, res.GetViolations()[0].GetType())
+	s.Require().Equal(violation.Message, res.GetViolations()[0].GetMessage())
+}
+
+func (s *APISuite) TestValidateConfigurationPartNotFound() {
+	partUUIDs := []string{gofakeit.UUID()}
+	s.compatibilityService.On("ValidateConfiguration", s.ctx, partUUIDs).Return(nil, model.ErrPartNotFound)
+
+	res, err := s.api.ValidateConfiguration(s.ctx, &inventoryV1.ValidateConfigurationRequest{PartUuids: partUUIDs})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *APISuite) TestValidateConfigurationInvalidUUID() {
+	res, err := s.api.ValidateConfiguration(s.ctx, &inventoryV1.ValidateConfigurationRequest{PartUuids: []string{"bad"}})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateCompatibilityRule(ctx context.Context, req *inventoryV1.CreateCompatibilityRuleRequest) (*inventoryV1.CreateCompatibilityRuleResponse, error) {
+	if req.GetInfo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "info is required")
+	}
+
+	rule, err := a.compatibilityService.CreateRule(ctx, converter.ToModelCompatibilityRuleInfo(req.GetInfo()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidCompatibilityRule) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.CreateCompatibilityRuleResponse{Rule: converter.ToProtoCompatibilityRule(rule)}, nil
+}
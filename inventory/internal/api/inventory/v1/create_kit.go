@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateKit(ctx context.Context, req *inventoryV1.CreateKitRequest) (*inventoryV1.CreateKitResponse, error) {
+	if err := validateKitInfo(req.GetInfo()); err != nil {
+		return nil, err
+	}
+
+	kit, err := a.kitService.CreateKit(ctx, converter.ToModelKitInfo(req.GetInfo()))
+	if err != nil {
+		return nil, kitError(err)
+	}
+
+	return &inventoryV1.CreateKitResponse{Kit: converter.ToProtoKit(kit)}, nil
+}
+
+func validateKitInfo(info *inventoryV1.KitInfo) error {
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "info is required")
+	}
+
+	for _, component := range info.GetComponents() {
+		if _, err := uuid.Parse(component.GetPartUuid()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid part_uuid format: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func kitError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrKitNotFound):
+		return status.Error(codes.NotFound, "kit not found")
+	case errors.Is(err, model.ErrPartNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrInvalidKit):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, "internal error")
+}
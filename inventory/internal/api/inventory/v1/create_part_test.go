@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestCreatePartSuccess() {
+	req := &inventoryV1.CreatePartRequest{
+		Info: &inventoryV1.PartInfo{
+			Name:     gofakeit.Name(),
+			Price:    150,
+			Category: inventoryV1.Category_CATEGORY_ENGINE,
+			Tags:     []string{"main"},
+		},
+	}
+	created := model.Part{
+		Uuid:     gofakeit.UUID(),
+		Name:     req.GetInfo().GetName(),
+		Price:    150,
+		Category: model.CategoryEngine,
+		Tags:     []string{"main"},
+	}
+
+	s.inventoryService.On("CreatePart", s.ctx, mock.MatchedBy(func(p model.Part) bool {
+		return p.Uuid == "" && p.Name == created.Name && p.Price == created.Price && p.Category == model.CategoryEngine
+	})).Return(created, nil)
+
+	res, err := s.api.CreatePart(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(created.Uuid, res.GetPart().GetUuid())
+	s.Require().Equal(inventoryV1.Category_CATEGORY_ENGINE, res.GetPart().GetCategory())
+}
+
+func (s *APISuite) TestCreatePartMissingInfo() {
+	res, err := s.api.CreatePart(s.ctx, &inventoryV1.CreatePartRequest{})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestCreatePartInvalid() {
+	s.inventoryService.On("CreatePart", s.ctx, mock.Anything).
+		Return(model.Part{}, fmt.Errorf("%w: name is required", model.ErrInvalidPart))
+
+	res, err := s.api.CreatePart(s.ctx, &inventoryV1.CreatePartRequest{Info: &inventoryV1.PartInfo{}})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.InvalidArgument, st.Code())
+	s.Require().Contains(st.Message(), "name is required")
+}
+
+func (s *APISuite) TestCreatePartServiceError() {
+	s.inventoryService.On("CreatePart", s.ctx, mock.Anything).Return(model.Part{}, gofakeit.Error())
+
+	res, err := s.api.CreatePart(s.ctx, &inventoryV1.CreatePartRequest{Info: &inventoryV1.PartInfo{Name: "Name"}})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.Internal, status.Code(err))
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateWarehouse(ctx context.Context, req *inventoryV1.CreateWarehouseRequest) (*inventoryV1.CreateWarehouseResponse, error) {
+	if req.GetInfo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "info is required")
+	}
+
+	warehouse, err := a.warehouseService.CreateWarehouse(ctx, converter.ToModelWarehouseInfo(req.GetInfo()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidWarehouse):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrWarehouseAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, "warehouse already exists")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.CreateWarehouseResponse{Warehouse: converter.ToProtoWarehouse(warehouse)}, nil
+}
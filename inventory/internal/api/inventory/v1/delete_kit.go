@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeleteKit(ctx context.Context, req *inventoryV1.DeleteKitRequest) (*inventoryV1.DeleteKitResponse, error) {
+	if _, err := uuid.Parse(req.GetUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+
+	if err := a.kitService.DeleteKit(ctx, req.GetUuid()); err != nil {
+		return nil, kitError(err)
+	}
+
+	return &inventoryV1.DeleteKitResponse{}, nil
+}
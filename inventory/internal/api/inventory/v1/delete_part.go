@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeletePart(ctx context.Context, req *inventoryV1.DeletePartRequest) (*inventoryV1.DeletePartResponse, error) {
+	if _, err := uuid.Parse(req.GetUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+
+	if err := a.inventoryService.DeletePart(ctx, req.GetUuid()); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Error(codes.NotFound, "part not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.DeletePartResponse{}, nil
+}
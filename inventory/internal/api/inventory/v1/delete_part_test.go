@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestDeletePartSuccess() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryService.On("DeletePart", s.ctx, uuid).Return(nil)
+
+	res, err := s.api.DeletePart(s.ctx, &inventoryV1.DeletePartRequest{Uuid: uuid})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+}
+
+func (s *APISuite) TestDeletePartInvalidUUID() {
+	res, err := s.api.DeletePart(s.ctx, &inventoryV1.DeletePartRequest{Uuid: "bad"})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestDeletePartNotFound() {
+	uuid := gofakeit.UUID()
+
+	s.inventoryService.On("DeletePart", s.ctx, uuid).Return(model.ErrPartNotFound)
+
+	res, err := s.api.DeletePart(s.ctx, &inventoryV1.DeletePartRequest{Uuid: uuid})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetPartFacets(ctx context.Context, req *inventoryV1.GetPartFacetsRequest) (*inventoryV1.GetPartFacetsResponse, error) {
+	if req.GetTopTagsLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "top_tags_limit must not be negative")
+	}
+
+	modelFilter := converter.ToModelPartsFilter(req.GetFilter())
+
+	facets, err := a.inventoryService.GetPartFacets(ctx, modelFilter, int(req.GetTopTagsLimit()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return converter.ToProtoPartFacets(facets), nil
+}
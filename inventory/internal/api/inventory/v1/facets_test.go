@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestGetPartFacetsSuccess() {
+	var (
+		req = &inventoryV1.GetPartFacetsRequest{
+			Filter: &inventoryV1.PartsFilter{
+				Categories: []inventoryV1.Category{inventoryV1.Category_CATEGORY_WING},
+			},
+			TopTagsLimit: 3,
+		}
+		expectedFilter = model.PartsFilter{
+			Uuids:                 []string{},
+			Names:                 []string{},
+			Categories:            []model.Category{model.CategoryWing},
+			ManufacturerCountries: []string{},
+			Tags:                  []string{},
+		}
+		facets = model.PartFacets{
+			Categories:            []model.CategoryFacet{{Category: model.CategoryWing, Count: 2}},
+			ManufacturerCountries: []model.FacetCount{{Value: "France", Count: 2}},
+			ManufacturerNames:     []model.FacetCount{{Value: "Airbus", Count: 2}},
+			Tags:                  []model.FacetCount{{Value: "wing", Count: 2}, {Value: "light", Count: 1}},
+			PriceRange:            model.PriceRange{Min: 150.5, Max: 700},
+		}
+	)
+
+	s.inventoryService.On("GetPartFacets", s.ctx, expectedFilter, 3).Return(facets, nil)
+
+	res, err := s.api.GetPartFacets(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Len(res.Categories, 1)
+	s.Require().Equal(inventoryV1.Category_CATEGORY_WING, res.Categories[0].Category)
+	s.Require().Equal(int64(2), res.Categories[0].Count)
+	s.Require().Equal("France", res.ManufacturerCountries[0].Value)
+	s.Require().Equal("Airbus", res.ManufacturerNames[0].Value)
+	s.Require().Len(res.Tags, 2)
+	s.Require().Equal("wing", res.Tags[0].Value)
+	s.Require().Equal(150.5, res.PriceRange.Min)
+	s.Require().Equal(700.0, res.PriceRange.Max)
+}
+
+func (s *APISuite) TestGetPartFacetsNegativeTopTagsLimit() {
+	req := &inventoryV1.GetPartFacetsRequest{TopTagsLimit: -1}
+
+	res, err := s.api.GetPartFacets(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.InvalidArgument, st.Code())
+}
+
+func (s *APISuite) TestGetPartFacetsServiceError() {
+	req := &inventoryV1.GetPartFacetsRequest{}
+
+	s.inventoryService.On("GetPartFacets", s.ctx, model.PartsFilter{
+		Uuids:                 []string{},
+		Names:                 []string{},
+		Categories:            []model.Category{},
+		ManufacturerCountries: []string{},
+		Tags:                  []string{},
+	}, 0).Return(model.PartFacets{}, gofakeit.Error())
+
+	res, err := s.api.GetPartFacets(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.Internal, st.Code())
+}
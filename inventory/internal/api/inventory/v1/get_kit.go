@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetKit(ctx context.Context, req *inventoryV1.GetKitRequest) (*inventoryV1.GetKitResponse, error) {
+	if _, err := uuid.Parse(req.GetUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+
+	kit, err := a.kitService.GetKit(ctx, req.GetUuid())
+	if err != nil {
+		return nil, kitError(err)
+	}
+
+	return &inventoryV1.GetKitResponse{Kit: converter.ToProtoKit(kit)}, nil
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetPartPriceAt(ctx context.Context, req *inventoryV1.GetPartPriceAtRequest) (*inventoryV1.GetPartPriceAtResponse, error) {
+	if _, err := uuid.Parse(req.GetPartUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part_uuid format: %v", err)
+	}
+
+	at := time.Now()
+	if req.GetAt() != nil {
+		if !req.GetAt().IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "invalid at timestamp")
+		}
+		at = req.GetAt().AsTime()
+	}
+
+	price, err := a.priceService.GetPartPriceAt(ctx, req.GetPartUuid(), at)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, "part not found")
+		case errors.Is(err, model.ErrPartPriceNotFound):
+			return nil, status.Error(codes.NotFound, "part had no price at the requested time")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.GetPartPriceAtResponse{Price: converter.ToProtoPartPrice(price)}, nil
+}
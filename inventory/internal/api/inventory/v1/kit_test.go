@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestCreateKitSuccess() {
+	partUuid := gofakeit.UUID()
+	override := 50.0
+	info := &inventoryV1.KitInfo{
+		Name:          "Starter kit",
+		Components:    []*inventoryV1.KitComponent{{PartUuid: partUuid, Quantity: 2}},
+		PriceOverride: &override,
+	}
+
+	s.kitService.On("CreateKit", s.ctx, mock.MatchedBy(func(k model.Kit) bool {
+		return k.Name == info.Name && len(k.Components) == 1 &&
+			k.Components[0] == model.KitComponent{PartUuid: partUuid, Quantity: 2} &&
+			k.PriceOverride != nil && *k.PriceOverride == override
+	})).Return(model.Kit{
+		Uuid:              gofakeit.UUID(),
+		Name:              info.Name,
+		Components:        []model.KitComponent{{PartUuid: partUuid, Quantity: 2}},
+		PriceOverride:     &override,
+		Price:             override,
+		AvailableQuantity: 3,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}, nil)
+
+	res, err := s.api.CreateKit(s.ctx, &inventoryV1.CreateKitRequest{Info: info})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.GetKit().GetUuid())
+	s.Require().Equal(override, res.GetKit().GetPrice())
+	s.Require().Equal(override, res.GetKit().GetPriceOverride())
+	s.Require().Equal(int64(3), res.GetKit().GetAvailableQuantity())
+	s.Require().Len(res.GetKit().GetComponents(), 1)
+}
+
+func (s *APISuite) TestCreateKitInvalidRequest() {
+	cases := []*inventoryV1.CreateKitRequest{
+		{},
+		{Info: &inventoryV1.KitInfo{Name: "kit", Components: []*inventoryV1.KitComponent{{PartUuid: "invalid", Quantity: 1}}}},
+	}
+
+	for _, req := range cases {
+		_, err := s.api.CreateKit(s.ctx, req)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+	s.kitService.AssertNotCalled(s.T(), "CreateKit", mock.Anything, mock.Anything)
+}
+
+func (s *APISuite) TestCreateKitErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: model.ErrInvalidKit, code: codes.InvalidArgument},
+		{err: model.ErrPartNotFound, code: codes.NotFound},
+		{err: gofakeit.Error(), code: codes.Internal},
+	}
+
+	for _, tc := range cases {
+		s.kitService.On("CreateKit", s.ctx, mock.Anything).Return(model.Kit{}, tc.err).Once()
+
+		_, err := s.api.CreateKit(s.ctx, &inventoryV1.CreateKitRequest{Info: &inventoryV1.KitInfo{Name: "kit"}})
+		s.Require().Equal(tc.code, status.Code(err))
+	}
+}
+
+func (s *APISuite) TestGetKit() {
+	kitUuid := gofakeit.UUID()
+	s.kitService.On("GetKit", s.ctx, kitUuid).Return(model.Kit{Uuid: kitUuid, Price: 120}, nil)
+
+	res, err := s.api.GetKit(s.ctx, &inventoryV1.GetKitRequest{Uuid: kitUuid})
+	s.Require().NoError(err)
+	s.Require().Equal(kitUuid, res.GetKit().GetUuid())
+	s.Require().Equal(120.0, res.GetKit().GetPrice())
+	s.Require().Nil(res.GetKit().PriceOverride)
+}
+
+func (s *APISuite) TestGetKitNotFound() {
+	kitUuid := gofakeit.UUID()
+	s.kitService.On("GetKit", s.ctx, kitUuid).Return(model.Kit{}, model.ErrKitNotFound)
+
+	_, err := s.api.GetKit(s.ctx, &inventoryV1.GetKitRequest{Uuid: kitUuid})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *APISuite) TestListKits() {
+	uuids := []string{gofakeit.UUID(), gofakeit.UUID()}
+	s.kitService.On("ListKits", s.ctx, uuids).Return([]model.Kit{{Uuid: uuids[0]}, {Uuid: uuids[1]}}, nil)
+
+	res, err := s.api.ListKits(s.ctx, &inventoryV1.ListKitsRequest{Uuids: uuids})
+	s.Require().NoError(err)
+	s.Require().Len(res.GetKits(), 2)
+}
+
+func (s *APISuite) TestListKitsInvalidUuid() {
+	_, err := s.api.ListKits(s.ctx, &inventoryV1.ListKitsRequest{Uuids: []string{"invalid"}})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestUpdateKit() {
+	kitUuid := gofakeit.UUID()
+	partUuid := gofakeit.UUID()
+
+	s.kitService.On("UpdateKit", s.ctx, mock.MatchedBy(func(k model.Kit) bool {
+		return k.Uuid == kitUuid && k.PriceOverride == nil
+	})).Return(model.Kit{Uuid: kitUuid, Name: "Renamed"}, nil)
+
+	res, err := s.api.UpdateKit(s.ctx, &inventoryV1.UpdateKitRequest{
+		Uuid: kitUuid,
+		Info: &inventoryV1.KitInfo{
+			Name:       "Renamed",
+			Components: []*inventoryV1.KitComponent{{PartUuid: partUuid, Quantity: 1}},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("Renamed", res.GetKit().GetName())
+}
+
+func (s *APISuite) TestDeleteKit() {
+	kitUuid := gofakeit.UUID()
+	s.kitService.On("DeleteKit", s.ctx, kitUuid).Return(nil)
+
+	_, err := s.api.DeleteKit(s.ctx, &inventoryV1.DeleteKitRequest{Uuid: kitUuid})
+	s.Require().NoError(err)
+
+	_, err = s.api.DeleteKit(s.ctx, &inventoryV1.DeleteKitRequest{Uuid: "invalid"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListCompatibilityRules(ctx context.Context, _ *inventoryV1.ListCompatibilityRulesRequest) (*inventoryV1.ListCompatibilityRulesResponse, error) {
+	rules, err := a.compatibilityService.ListRules(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.ListCompatibilityRulesResponse{Rules: converter.ToProtoCompatibilityRules(rules)}, nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListKits(ctx context.Context, req *inventoryV1.ListKitsRequest) (*inventoryV1.ListKitsResponse, error) {
+	for _, kitUuid := range req.GetUuids() {
+		if _, err := uuid.Parse(kitUuid); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+		}
+	}
+
+	kits, err := a.kitService.ListKits(ctx, req.GetUuids())
+	if err != nil {
+		return nil, kitError(err)
+	}
+
+	return &inventoryV1.ListKitsResponse{Kits: converter.ToProtoKits(kits)}, nil
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListPartPrices(ctx context.Context, req *inventoryV1.ListPartPricesRequest) (*inventoryV1.ListPartPricesResponse, error) {
+	if _, err := uuid.Parse(req.GetPartUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part_uuid format: %v", err)
+	}
+
+	prices, err := a.priceService.ListPartPrices(ctx, req.GetPartUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Error(codes.NotFound, "part not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.ListPartPricesResponse{Prices: converter.ToProtoPartPrices(prices)}, nil
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListStockMovements(ctx context.Context, req *inventoryV1.ListStockMovementsRequest) (*inventoryV1.ListStockMovementsResponse, error) {
+	if _, err := uuid.Parse(req.GetPartUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part_uuid format: %v", err)
+	}
+
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	if req.GetWarehouseCode() != "" && !model.ValidWarehouseCode(req.GetWarehouseCode()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid warehouse_code format")
+	}
+
+	movements, err := a.stockService.ListStockMovements(ctx, model.StockMovementsFilter{
+		PartUuid:      req.GetPartUuid(),
+		ReferenceID:   req.GetReferenceId(),
+		WarehouseCode: req.GetWarehouseCode(),
+		Limit:         int(req.GetLimit()),
+		Offset:        int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.ListStockMovementsResponse{Movements: converter.ToProtoStockMovements(movements)}, nil
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestListStockMovementsSuccess() {
+	var (
+		partUUID  = gofakeit.UUID()
+		movements = []model.StockMovement{
+			{
+				Uuid:       gofakeit.UUID(),
+				PartUuid:   partUUID,
+				Delta:      10,
+				Reason:     model.StockMovementReasonRestock,
+				Actor:      gofakeit.UUID(),
+				StockAfter: 10,
+				CreatedAt:  time.Now(),
+			},
+		}
+	)
+
+	s.stockService.On("ListStockMovements", s.ctx, model.StockMovementsFilter{
+		PartUuid: partUUID,
+		Limit:    20,
+		Offset:   40,
+	}).Return(movements, nil)
+
+	res, err := s.api.ListStockMovements(s.ctx, &inventoryV1.ListStockMovementsRequest{
+		PartUuid: partUUID,
+		Limit:    20,
+		Offset:   40,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Movements, 1)
+	s.Require().Equal(movements[0].Uuid, res.Movements[0].Uuid)
+	s.Require().Equal(inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_RESTOCK, res.Movements[0].Reason)
+}
+
+func (s *APISuite) TestListStockMovementsInvalidRequest() {
+	res, err := s.api.ListStockMovements(s.ctx, &inventoryV1.ListStockMovementsRequest{PartUuid: "invalid"})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	res, err = s.api.ListStockMovements(s.ctx, &inventoryV1.ListStockMovementsRequest{PartUuid: gofakeit.UUID(), Limit: -1})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestListStockMovementsServiceError() {
+	partUUID := gofakeit.UUID()
+
+	s.stockService.On("ListStockMovements", s.ctx, model.StockMovementsFilter{PartUuid: partUUID}).
+		Return(nil, gofakeit.Error())
+
+	res, err := s.api.ListStockMovements(s.ctx, &inventoryV1.ListStockMovementsRequest{PartUuid: partUUID})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.Internal, status.Code(err))
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestSchedulePartPriceSuccess() {
+	partUuid := gofakeit.UUID()
+	effectiveFrom := time.Now().Add(24 * time.Hour).UTC()
+
+	s.priceService.On("SchedulePartPrice", s.ctx, model.PartPrice{
+		PartUuid:      partUuid,
+		Price:         150,
+		EffectiveFrom: effectiveFrom,
+	}).Return(model.PartPrice{PartUuid: partUuid, Price: 150, EffectiveFrom: effectiveFrom, CreatedAt: time.Now()}, nil)
+
+	res, err := s.api.SchedulePartPrice(s.ctx, &inventoryV1.SchedulePartPriceRequest{
+		PartUuid:      partUuid,
+		Price:         150,
+		EffectiveFrom: timestamppb.New(effectiveFrom),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(150.0, res.GetPrice().GetPrice())
+	s.Require().True(res.GetPrice().GetEffectiveFrom().AsTime().Equal(effectiveFrom))
+}
+
+func (s *APISuite) TestSchedulePartPriceInvalidRequest() {
+	cases := []*inventoryV1.SchedulePartPriceRequest{
+		{PartUuid: "invalid", Price: 1, EffectiveFrom: timestamppb.Now()},
+		{PartUuid: gofakeit.UUID(), Price: 1},
+	}
+
+	for _, req := range cases {
+		_, err := s.api.SchedulePartPrice(s.ctx, req)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+	s.priceService.AssertNotCalled(s.T(), "SchedulePartPrice", mock.Anything, mock.Anything)
+}
+
+func (s *APISuite) TestSchedulePartPriceErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: model.ErrInvalidPartPrice, code: codes.InvalidArgument},
+		{err: model.ErrPartNotFound, code: codes.NotFound},
+		{err: gofakeit.Error(), code: codes.Internal},
+	}
+
+	for _, tc := range cases {
+		partUuid := gofakeit.UUID()
+		s.priceService.On("SchedulePartPrice", s.ctx, mock.MatchedBy(func(p model.PartPrice) bool {
+			return p.PartUuid == partUuid
+		})).Return(model.PartPrice{}, tc.err).Once()
+
+		_, err := s.api.SchedulePartPrice(s.ctx, &inventoryV1.SchedulePartPriceRequest{
+			PartUuid:      partUuid,
+			Price:         1,
+			EffectiveFrom: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		s.Require().Equal(tc.code, status.Code(err))
+	}
+}
+
+func (s *APISuite) TestListPartPrices() {
+	partUuid := gofakeit.UUID()
+	prices := []model.PartPrice{
+		{PartUuid: partUuid, Price: 120, EffectiveFrom: time.Now().Add(time.Hour)},
+		{PartUuid: partUuid, Price: 100, EffectiveFrom: time.Now().Add(-time.Hour)},
+	}
+
+	s.priceService.On("ListPartPrices", s.ctx, partUuid).Return(prices, nil)
+
+	res, err := s.api.ListPartPrices(s.ctx, &inventoryV1.ListPartPricesRequest{PartUuid: partUuid})
+	s.Require().NoError(err)
+	s.Require().Len(res.GetPrices(), 2)
+	s.Require().Equal(120.0, res.GetPrices()[0].GetPrice())
+}
+
+func (s *APISuite) TestListPartPricesNotFound() {
+	partUuid := gofakeit.UUID()
+
+	s.priceService.On("ListPartPrices", s.ctx, partUuid).Return(nil, model.ErrPartNotFound)
+
+	_, err := s.api.ListPartPrices(s.ctx, &inventoryV1.ListPartPricesRequest{PartUuid: partUuid})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *APISuite) TestGetPartPriceAt() {
+	partUuid := gofakeit.UUID()
+	at := time.Now().Add(-72 * time.Hour).UTC()
+	price := model.PartPrice{PartUuid: partUuid, Price: 88, EffectiveFrom: at.Add(-time.Hour)}
+
+	s.priceService.On("GetPartPriceAt", s.ctx, partUuid, at).Return(price, nil)
+
+	res, err := s.api.GetPartPriceAt(s.ctx, &inventoryV1.GetPartPriceAtRequest{PartUuid: partUuid, At: timestamppb.New(at)})
+	s.Require().NoError(err)
+	s.Require().Equal(88.0, res.GetPrice().GetPrice())
+}
+
+func (s *APISuite) TestGetPartPriceAtDefaultsToNow() {
+	partUuid := gofakeit.UUID()
+	before := time.Now()
+
+	s.priceService.On("GetPartPriceAt", s.ctx, partUuid, mock.MatchedBy(func(at time.Time) bool {
+		return !at.Before(before) && !at.After(time.Now())
+	})).Return(model.PartPrice{PartUuid: partUuid, Price: 10}, nil)
+
+	_, err := s.api.GetPartPriceAt(s.ctx, &inventoryV1.GetPartPriceAtRequest{PartUuid: partUuid})
+	s.Require().NoError(err)
+}
+
+func (s *APISuite) TestGetPartPriceAtErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: model.ErrPartNotFound, code: codes.NotFound},
+		{err: model.ErrPartPriceNotFound, code: codes.NotFound},
+		{err: gofakeit.Error(), code: codes.Internal},
+	}
+
+	for _, tc := range cases {
+		partUuid := gofakeit.UUID()
+		s.priceService.On("GetPartPriceAt", s.ctx, partUuid, mock.Anything).Return(model.PartPrice{}, tc.err).Once()
+
+		_, err := s.api.GetPartPriceAt(s.ctx, &inventoryV1.GetPartPriceAtRequest{PartUuid: partUuid})
+		s.Require().Equal(tc.code, status.Code(err))
+	}
+
+	_, err := s.api.GetPartPriceAt(s.ctx, &inventoryV1.GetPartPriceAtRequest{PartUuid: "invalid"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
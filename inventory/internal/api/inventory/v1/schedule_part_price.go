@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) SchedulePartPrice(ctx context.Context, req *inventoryV1.SchedulePartPriceRequest) (*inventoryV1.SchedulePartPriceResponse, error) {
+	if _, err := uuid.Parse(req.GetPartUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid part_uuid format: %v", err)
+	}
+
+	if !req.GetEffectiveFrom().IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "effective_from is required")
+	}
+
+	price, err := a.priceService.SchedulePartPrice(ctx, model.PartPrice{
+		PartUuid:      req.GetPartUuid(),
+		Price:         req.GetPrice(),
+		EffectiveFrom: req.GetEffectiveFrom().AsTime(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, "part not found")
+		case errors.Is(err, model.ErrInvalidPartPrice):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.SchedulePartPriceResponse{Price: converter.ToProtoPartPrice(price)}, nil
+}
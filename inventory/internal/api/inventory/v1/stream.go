@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+const (
+	defaultStreamChunkSize = 100
+	maxStreamChunkSize     = 1000
+)
+
+func (a *api) StreamParts(req *inventoryV1.StreamPartsRequest, stream inventoryV1.InventoryService_StreamPartsServer) error {
+	chunkSize := int(req.GetChunkSize())
+	if chunkSize < 0 || chunkSize > maxStreamChunkSize {
+		return status.Errorf(codes.InvalidArgument, "chunk_size must be between 1 and %d", maxStreamChunkSize)
+	}
+	if chunkSize == 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+
+	ctx := stream.Context()
+
+	err := a.inventoryService.StreamParts(ctx, converter.ToModelPartsFilter(req.GetFilter()), chunkSize, func(parts []model.Part) error {
+		return stream.Send(&inventoryV1.StreamPartsResponse{Parts: converter.ToProtoParts(parts)})
+	})
+	if err != nil {
+		// Клиент отменил вызов или истёк дедлайн — сообщаем об этом, а не о внутренней ошибке
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return status.FromContextError(ctxErr).Err()
+		}
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	return nil
+}
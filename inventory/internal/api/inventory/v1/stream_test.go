@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+// fakePartsStream собирает отправленные сообщения вместо записи в сеть
+type fakePartsStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	sent    []*inventoryV1.StreamPartsResponse
+	sendErr error
+}
+
+func (f *fakePartsStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakePartsStream) Send(res *inventoryV1.StreamPartsResponse) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	f.sent = append(f.sent, res)
+	return nil
+}
+
+func (s *APISuite) TestStreamPartsSendsChunks() {
+	stream := &fakePartsStream{ctx: s.ctx}
+	chunks := [][]model.Part{
+		{{Uuid: gofakeit.UUID(), Category: model.CategoryWing}, {Uuid: gofakeit.UUID(), Category: model.CategoryWing}},
+		{{Uuid: gofakeit.UUID(), Category: model.CategoryWing}},
+	}
+
+	s.inventoryService.On("StreamParts", s.ctx, mock.MatchedBy(func(f model.PartsFilter) bool {
+		return len(f.Categories) == 1 && f.Categories[0] == model.CategoryWing
+	}), 2, mock.Anything).
+		Return(func(_ context.Context, _ model.PartsFilter, _ int, send func([]model.Part) error) error {
+			for _, chunk := range chunks {
+				if err := send(chunk); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	err := s.api.StreamParts(&inventoryV1.StreamPartsRequest{
+		Filter:    &inventoryV1.PartsFilter{Categories: []inventoryV1.Category{inventoryV1.Category_CATEGORY_WING}},
+		ChunkSize: 2,
+	}, stream)
+	s.Require().NoError(err)
+	s.Require().Len(stream.sent, 2)
+	s.Require().Len(stream.sent[0].GetParts(), 2)
+	s.Require().Equal(chunks[1][0].Uuid, stream.sent[1].GetParts()[0].GetUuid())
+}
+
+func (s *APISuite) TestStreamPartsDefaultChunkSize() {
+	stream := &fakePartsStream{ctx: s.ctx}
+
+	s.inventoryService.On("StreamParts", s.ctx, mock.Anything, defaultStreamChunkSize, mock.Anything).Return(nil)
+
+	s.Require().NoError(s.api.StreamParts(&inventoryV1.StreamPartsRequest{}, stream))
+	s.Require().Empty(stream.sent)
+}
+
+func (s *APISuite) TestStreamPartsInvalidChunkSize() {
+	for _, chunkSize := range []int32{-1, maxStreamChunkSize + 1} {
+		err := s.api.StreamParts(&inventoryV1.StreamPartsRequest{ChunkSize: chunkSize}, &fakePartsStream{ctx: s.ctx})
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (s *APISuite) TestStreamPartsClientCancelled() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+	stream := &fakePartsStream{ctx: ctx}
+
+	s.inventoryService.On("StreamParts", ctx, mock.Anything, mock.Anything, mock.Anything).Return(context.Canceled)
+
+	err := s.api.StreamParts(&inventoryV1.StreamPartsRequest{}, stream)
+	s.Require().Equal(codes.Canceled, status.Code(err))
+}
+
+func (s *APISuite) TestStreamPartsDeadlineExceeded() {
+	ctx, cancel := context.WithTimeout(s.ctx, 0)
+	defer cancel()
+	<-ctx.Done()
+	stream := &fakePartsStream{ctx: ctx}
+
+	s.inventoryService.On("StreamParts", ctx, mock.Anything, mock.Anything, mock.Anything).Return(context.DeadlineExceeded)
+
+	err := s.api.StreamParts(&inventoryV1.StreamPartsRequest{}, stream)
+	s.Require().Equal(codes.DeadlineExceeded, status.Code(err))
+}
+
+func (s *APISuite) TestStreamPartsServiceError() {
+	stream := &fakePartsStream{ctx: s.ctx, sendErr: errors.New("transport is closing")}
+
+	s.inventoryService.On("StreamParts", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(gofakeit.Error())
+
+	err := s.api.StreamParts(&inventoryV1.StreamPartsRequest{}, stream)
+	s.Require().Equal(codes.Internal, status.Code(err))
+}
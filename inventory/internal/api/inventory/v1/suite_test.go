@@ -15,6 +15,12 @@ type APISuite struct {
 	ctx context.Context
 
 	inventoryService *mocks.PartService
+	stockService     *mocks.StockService
+
+	compatibilityService *mocks.CompatibilityService
+	warehouseService     *mocks.WarehouseService
+	priceService         *mocks.PartPriceService
+	kitService           *mocks.KitService
 
 	api *api
 }
@@ -23,9 +29,19 @@ func (s *APISuite) SetupTest() {
 	s.ctx = context.Background()
 
 	s.inventoryService = mocks.NewPartService(s.T())
+	s.stockService = mocks.NewStockService(s.T())
+	s.compatibilityService = mocks.NewCompatibilityService(s.T())
+	s.warehouseService = mocks.NewWarehouseService(s.T())
+	s.priceService = mocks.NewPartPriceService(s.T())
+	s.kitService = mocks.NewKitService(s.T())
 
 	s.api = NewAPI(
 		s.inventoryService,
+		s.stockService,
+		s.compatibilityService,
+		s.warehouseService,
+		s.priceService,
+		s.kitService,
 	)
 }
 
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UpdateKit(ctx context.Context, req *inventoryV1.UpdateKitRequest) (*inventoryV1.UpdateKitResponse, error) {
+	if _, err := uuid.Parse(req.GetUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+
+	if err := validateKitInfo(req.GetInfo()); err != nil {
+		return nil, err
+	}
+
+	kit := converter.ToModelKitInfo(req.GetInfo())
+	kit.Uuid = req.GetUuid()
+
+	kit, err := a.kitService.UpdateKit(ctx, kit)
+	if err != nil {
+		return nil, kitError(err)
+	}
+
+	return &inventoryV1.UpdateKitResponse{Kit: converter.ToProtoKit(kit)}, nil
+}
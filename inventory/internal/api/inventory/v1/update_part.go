@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UpdatePart(ctx context.Context, req *inventoryV1.UpdatePartRequest) (*inventoryV1.UpdatePartResponse, error) {
+	if _, err := uuid.Parse(req.GetUuid()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid uuid format: %v", err)
+	}
+
+	if req.GetInfo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "info is required")
+	}
+
+	part := converter.ToModelPartInfo(req.GetInfo())
+	part.Uuid = req.GetUuid()
+
+	part, err := a.inventoryService.UpdatePart(ctx, part)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, "part not found")
+		case errors.Is(err, model.ErrInvalidPart):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.UpdatePartResponse{Part: converter.ToProtoPart(part)}, nil
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestUpdatePartSuccess() {
+	uuid := gofakeit.UUID()
+	req := &inventoryV1.UpdatePartRequest{
+		Uuid: uuid,
+		Info: &inventoryV1.PartInfo{
+			Name:     "New name",
+			Price:    200,
+			Category: inventoryV1.Category_CATEGORY_WING,
+		},
+	}
+	updated := model.Part{Uuid: uuid, Name: "New name", Price: 200, StockQuantity: 3, Category: model.CategoryWing}
+
+	s.inventoryService.On("UpdatePart", s.ctx, mock.MatchedBy(func(p model.Part) bool {
+		return p.Uuid == uuid && p.Name == "New name" && p.Price == 200 && p.Category == model.CategoryWing
+	})).Return(updated, nil)
+
+	res, err := s.api.UpdatePart(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(uuid, res.GetPart().GetUuid())
+	s.Require().Equal(int64(3), res.GetPart().GetStockQuantity())
+}
+
+func (s *APISuite) TestUpdatePartInvalidUUID() {
+	res, err := s.api.UpdatePart(s.ctx, &inventoryV1.UpdatePartRequest{Uuid: "bad", Info: &inventoryV1.PartInfo{}})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestUpdatePartNotFound() {
+	s.inventoryService.On("UpdatePart", s.ctx, mock.Anything).Return(model.Part{}, model.ErrPartNotFound)
+
+	res, err := s.api.UpdatePart(s.ctx, &inventoryV1.UpdatePartRequest{Uuid: gofakeit.UUID(), Info: &inventoryV1.PartInfo{Name: "Name"}})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
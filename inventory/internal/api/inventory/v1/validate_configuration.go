@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ValidateConfiguration(ctx context.Context, req *inventoryV1.ValidateConfigurationRequest) (*inventoryV1.ValidateConfigurationResponse, error) {
+	for _, partUUID := range req.GetPartUuids() {
+		if _, err := uuid.Parse(partUUID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid part_uuid format: %v", err)
+		}
+	}
+
+	violations, err := a.compatibilityService.ValidateConfiguration(ctx, req.GetPartUuids())
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &inventoryV1.ValidateConfigurationResponse{
+		Valid:      len(violations) == 0,
+		Violations: converter.ToProtoRuleViolations(violations),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (s *APISuite) TestCreateWarehouseSuccess() {
+	info := &inventoryV1.WarehouseInfo{Code: "vostochny", Name: "Восточный", Location: gofakeit.City()}
+	created := model.Warehouse{Code: info.GetCode(), Name: info.GetName(), Location: info.GetLocation(), CreatedAt: time.Now()}
+
+	s.warehouseService.On("CreateWarehouse", s.ctx, model.Warehouse{
+		Code:     info.GetCode(),
+		Name:     info.GetName(),
+		Location: info.GetLocation(),
+	}).Return(created, nil)
+
+	res, err := s.api.CreateWarehouse(s.ctx, &inventoryV1.CreateWarehouseRequest{Info: info})
+	s.Require().NoError(err)
+	s.Require().Equal(info.GetCode(), res.GetWarehouse().GetCode())
+	s.Require().Equal(info.GetLocation(), res.GetWarehouse().GetLocation())
+}
+
+func (s *APISuite) TestCreateWarehouseErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: model.ErrInvalidWarehouse, code: codes.InvalidArgument},
+		{err: model.ErrWarehouseAlreadyExists, code: codes.AlreadyExists},
+		{err: gofakeit.Error(), code: codes.Internal},
+	}
+
+	for _, tc := range cases {
+		s.warehouseService.On("CreateWarehouse", s.ctx, mock.Anything).Return(model.Warehouse{}, tc.err).Once()
+
+		res, err := s.api.CreateWarehouse(s.ctx, &inventoryV1.CreateWarehouseRequest{Info: &inventoryV1.WarehouseInfo{}})
+		s.Require().Nil(res)
+		s.Require().Equal(tc.code, status.Code(err))
+	}
+}
+
+func (s *APISuite) TestListWarehouses() {
+	s.warehouseService.On("ListWarehouses", s.ctx).Return([]model.Warehouse{{Code: "baikonur"}, {Code: "plesetsk"}}, nil)
+
+	res, err := s.api.ListWarehouses(s.ctx, &inventoryV1.ListWarehousesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.GetWarehouses(), 2)
+	s.Require().Equal("plesetsk", res.GetWarehouses()[1].GetCode())
+}
+
+func (s *APISuite) TestAdjustStockTargetsWarehouse() {
+	partUUID := gofakeit.UUID()
+
+	s.stockService.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.PartUuid == partUUID && m.WarehouseCode == "plesetsk"
+	})).Return(model.StockMovement{
+		Uuid:                gofakeit.UUID(),
+		PartUuid:            partUUID,
+		Delta:               5,
+		Reason:              model.StockMovementReasonRestock,
+		StockAfter:          12,
+		WarehouseCode:       "plesetsk",
+		WarehouseStockAfter: 5,
+	}, nil)
+
+	res, err := s.api.AdjustStock(s.ctx, &inventoryV1.AdjustStockRequest{
+		PartUuid:      partUUID,
+		Delta:         5,
+		Reason:        inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_RESTOCK,
+		WarehouseCode: "plesetsk",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("plesetsk", res.GetMovement().GetWarehouseCode())
+	s.Require().Equal(int64(5), res.GetMovement().GetWarehouseStockAfter())
+	s.Require().Equal(int64(12), res.GetMovement().GetStockAfter())
+}
+
+func (s *APISuite) TestAdjustStockWarehouseNotFound() {
+	s.stockService.On("AdjustStock", s.ctx, mock.Anything).Return(model.StockMovement{}, model.ErrWarehouseNotFound)
+
+	res, err := s.api.AdjustStock(s.ctx, &inventoryV1.AdjustStockRequest{
+		PartUuid:      gofakeit.UUID(),
+		Delta:         5,
+		Reason:        inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_RESTOCK,
+		WarehouseCode: "missing",
+	})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *APISuite) TestAdjustStockInvalidWarehouseCode() {
+	res, err := s.api.AdjustStock(s.ctx, &inventoryV1.AdjustStockRequest{
+		PartUuid:      gofakeit.UUID(),
+		Delta:         5,
+		Reason:        inventoryV1.StockMovementReason_STOCK_MOVEMENT_REASON_RESTOCK,
+		WarehouseCode: "bad.code",
+	})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestGetPartReturnsWarehouseStock() {
+	partUUID := gofakeit.UUID()
+	s.inventoryService.On("GetPart", s.ctx, partUUID).Return(model.Part{
+		Uuid:           partUUID,
+		StockQuantity:  7,
+		WarehouseStock: map[string]int64{"baikonur": 4, "plesetsk": 3},
+	}, nil)
+
+	res, err := s.api.GetPart(s.ctx, &inventoryV1.GetPartRequest{Uuid: partUUID})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(7), res.GetPart().GetStockQuantity())
+	s.Require().Equal(map[string]int64{"baikonur": 4, "plesetsk": 3}, res.GetPart().GetWarehouseStock())
+}
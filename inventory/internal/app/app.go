@@ -8,15 +8,40 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/config"
+	inventoryMetrics "github.com/nkolesnikov999/micro2-OK/inventory/internal/metrics"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/grpc/health"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/metrics"
+	grpcMiddleware "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
 	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
 )
 
+// Права, выдаваемые ролями IAM
+const (
+	catalogWritePermission   = "catalog:write"
+	inventoryWritePermission = "inventory:write"
+)
+
+// methodPermissions — права на изменение каталога и склада; чтение доступно всем
+var methodPermissions = grpcMiddleware.MethodPermissions{
+	inventoryV1.InventoryService_CreatePart_FullMethodName:              {catalogWritePermission},
+	inventoryV1.InventoryService_UpdatePart_FullMethodName:              {catalogWritePermission},
+	inventoryV1.InventoryService_DeletePart_FullMethodName:              {catalogWritePermission},
+	inventoryV1.InventoryService_CreateKit_FullMethodName:               {catalogWritePermission},
+	inventoryV1.InventoryService_UpdateKit_FullMethodName:               {catalogWritePermission},
+	inventoryV1.InventoryService_DeleteKit_FullMethodName:               {catalogWritePermission},
+	inventoryV1.InventoryService_CreateCompatibilityRule_FullMethodName: {catalogWritePermission},
+	inventoryV1.InventoryService_DeleteCompatibilityRule_FullMethodName: {catalogWritePermission},
+	inventoryV1.InventoryService_SchedulePartPrice_FullMethodName:       {catalogWritePermission},
+	inventoryV1.InventoryService_AdjustStock_FullMethodName:             {inventoryWritePermission},
+	inventoryV1.InventoryService_CreateWarehouse_FullMethodName:         {inventoryWritePermission},
+}
+
 type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
@@ -43,8 +68,11 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.initMetrics,
 		a.initListener,
 		a.initGRPCServer,
+		a.initWarehouses,
+		a.initPriceHistory,
 	}
 
 	for _, f := range inits {
@@ -78,6 +106,22 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initMetrics(ctx context.Context) error {
+	err := metrics.InitProvider(ctx, config.AppConfig().MetricCollector)
+	if err != nil {
+		return err
+	}
+
+	err = inventoryMetrics.InitMetrics(config.AppConfig().MetricCollector.ServiceName())
+	if err != nil {
+		return err
+	}
+
+	closer.AddNamed("metrics provider", metrics.Shutdown)
+
+	return nil
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().GRPC.Address())
 	if err != nil {
@@ -98,8 +142,20 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
+	// Сессия проверяется через IAM; health check и reflection доступны без неё
+	auth := grpcMiddleware.NewAuthInterceptor(
+		a.diContainer.IAMClient(ctx),
+		"/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/",
+		"/grpc.reflection.v1.ServerReflection/",
+		"/grpc.reflection.v1alpha.ServerReflection/",
+	)
+	// Права берутся из пользователя, которого AuthInterceptor положил в контекст
+	permissions := grpcMiddleware.NewPermissionInterceptor(methodPermissions)
+
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
+		grpc.ChainUnaryInterceptor(auth.Unary(), permissions.Unary()),
+		grpc.ChainStreamInterceptor(auth.Stream(), permissions.Stream()),
 	)
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
@@ -115,6 +171,16 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	return nil
 }
 
+// initWarehouses выполняется после initGRPCServer: к этому моменту тестовые детали
+// уже созданы, и их остаток тоже распределяется на склад по умолчанию
+func (a *App) initWarehouses(ctx context.Context) error {
+	return a.diContainer.WarehouseService(ctx).InitWarehouses(ctx)
+}
+
+func (a *App) initPriceHistory(ctx context.Context) error {
+	return a.diContainer.PriceService(ctx).InitPriceHistory(ctx)
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
 	logger.Info(ctx, fmt.Sprintf("🚀 gRPC InventoryService server listening on %s", config.AppConfig().GRPC.Address()))
 
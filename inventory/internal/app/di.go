@@ -3,30 +3,86 @@ package app
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/IBM/sarama"
+	redigo "github.com/gomodule/redigo/redis"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	grpcConn "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	partV1API "github.com/nkolesnikov999/micro2-OK/inventory/internal/api/inventory/v1"
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/config"
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+	compatibilityRepository "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/compatibility"
+	kitRepository "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/kit"
 	partRepository "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/part"
+	partCacheRepository "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/part_cache"
+	partPriceRepository "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/part_price"
+	stockRepository "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/stock"
+	warehouseRepository "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/warehouse"
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+	catalogService "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/catalog"
+	compatibilityService "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/compatibility"
+	kitService "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/kit"
 	partService "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/part"
+	priceService "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/price"
+	partProducer "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/producer/part_producer"
+	stockProducer "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/producer/stock_producer"
+	stockService "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/stock"
+	warehouseService "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/warehouse"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/cache"
+	redisClient "github.com/nkolesnikov999/micro2-OK/platform/pkg/cache/redis"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
+	wrappedKafka "github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka"
+	wrappedKafkaProducer "github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka/producer"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	grpcMiddleware "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+	authV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/auth/v1"
 	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
 )
 
 type diContainer struct {
 	partV1API inventoryV1.InventoryServiceServer
 
-	partService service.PartService
+	partService    service.PartService
+	stockService   service.StockService
+	catalogService service.CatalogService
 
-	partRepository repository.PartRepository
+	compatibilityService service.CompatibilityService
+	warehouseService     service.WarehouseService
+	priceService         service.PartPriceService
+	kitService           service.KitService
+
+	partProducerService  service.PartProducerService
+	stockProducerService service.StockProducerService
+
+	partRepository      repository.PartRepository
+	partCacheRepository repository.PartCacheRepository
+	stockRepository     repository.StockRepository
+
+	compatibilityRuleRepository repository.CompatibilityRuleRepository
+	warehouseRepository         repository.WarehouseRepository
+	partPriceRepository         repository.PartPriceRepository
+	kitRepository               repository.KitRepository
+
+	iamClient grpcMiddleware.IAMClient
+	iamConn   *grpcConn.ClientConn
 
 	mongoDBClient *mongo.Client
 	mongoDBHandle *mongo.Database
+
+	redisClient cache.RedisClient
+	redisPool   *redigo.Pool
+
+	syncProducer              sarama.SyncProducer
+	partCreatedProducer       wrappedKafka.Producer
+	partUpdatedProducer       wrappedKafka.Producer
+	partDeletedProducer       wrappedKafka.Producer
+	stockLevelChangedProducer wrappedKafka.Producer
+	stockLowProducer          wrappedKafka.Producer
 }
 
 func NewDiContainer() *diContainer {
@@ -35,7 +91,14 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) PartV1API(ctx context.Context) inventoryV1.InventoryServiceServer {
 	if d.partV1API == nil {
-		d.partV1API = partV1API.NewAPI(d.PartService(ctx))
+		d.partV1API = partV1API.NewAPI(
+			d.PartService(ctx),
+			d.StockService(ctx),
+			d.CompatibilityService(ctx),
+			d.WarehouseService(ctx),
+			d.PriceService(ctx),
+			d.KitService(ctx),
+		)
 	}
 
 	return d.partV1API
@@ -43,20 +106,165 @@ func (d *diContainer) PartV1API(ctx context.Context) inventoryV1.InventoryServic
 
 func (d *diContainer) PartService(ctx context.Context) service.PartService {
 	if d.partService == nil {
-		d.partService = partService.NewService(d.PartRepository(ctx))
+		d.partService = partService.NewService(
+			d.PartRepository(ctx),
+			d.PartPriceRepository(ctx),
+			d.PartCacheRepository(ctx),
+			d.PartProducerService(),
+			config.AppConfig().Redis.PartCacheTTL(),
+			config.AppConfig().Redis.ListCacheTTL(),
+		)
 	}
 
 	return d.partService
 }
 
+func (d *diContainer) StockService(ctx context.Context) service.StockService {
+	if d.stockService == nil {
+		d.stockService = stockService.NewService(
+			d.StockRepository(ctx),
+			d.WarehouseRepository(ctx),
+			d.PartCacheRepository(ctx),
+			d.StockProducerService(),
+			config.AppConfig().StockProducer.LowStockThreshold(),
+			config.AppConfig().Warehouse.Priority(),
+		)
+	}
+
+	return d.stockService
+}
+
+func (d *diContainer) CatalogService(ctx context.Context) service.CatalogService {
+	if d.catalogService == nil {
+		d.catalogService = catalogService.NewService(d.PartService(ctx), d.StockService(ctx))
+	}
+
+	return d.catalogService
+}
+
+func (d *diContainer) CompatibilityService(ctx context.Context) service.CompatibilityService {
+	if d.compatibilityService == nil {
+		d.compatibilityService = compatibilityService.NewService(d.CompatibilityRuleRepository(ctx), d.PartService(ctx))
+	}
+
+	return d.compatibilityService
+}
+
+func (d *diContainer) PriceService(ctx context.Context) service.PartPriceService {
+	if d.priceService == nil {
+		d.priceService = priceService.NewService(
+			d.PartPriceRepository(ctx),
+			d.PartRepository(ctx),
+			d.PartCacheRepository(ctx),
+		)
+	}
+
+	return d.priceService
+}
+
+func (d *diContainer) KitService(ctx context.Context) service.KitService {
+	if d.kitService == nil {
+		d.kitService = kitService.NewService(d.KitRepository(ctx), d.PartService(ctx))
+	}
+
+	return d.kitService
+}
+
+func (d *diContainer) WarehouseService(ctx context.Context) service.WarehouseService {
+	if d.warehouseService == nil {
+		d.warehouseService = warehouseService.NewService(
+			d.WarehouseRepository(ctx),
+			d.StockRepository(ctx),
+			config.AppConfig().Warehouse.Priority(),
+		)
+	}
+
+	return d.warehouseService
+}
+
+func (d *diContainer) PartProducerService() service.PartProducerService {
+	if d.partProducerService == nil {
+		d.partProducerService = partProducer.NewService(
+			d.PartCreatedProducer(),
+			d.PartUpdatedProducer(),
+			d.PartDeletedProducer(),
+		)
+	}
+
+	return d.partProducerService
+}
+
+func (d *diContainer) StockProducerService() service.StockProducerService {
+	if d.stockProducerService == nil {
+		d.stockProducerService = stockProducer.NewService(
+			d.StockLevelChangedProducer(),
+			d.StockLowProducer(),
+		)
+	}
+
+	return d.stockProducerService
+}
+
 func (d *diContainer) PartRepository(ctx context.Context) repository.PartRepository {
 	if d.partRepository == nil {
-		d.partRepository = partRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+		d.partRepository = partRepository.NewRepository(
+			ctx,
+			d.MongoDBHandle(ctx),
+			config.AppConfig().Mongo.SeedPartsCount(),
+		)
 	}
 
 	return d.partRepository
 }
 
+func (d *diContainer) PartCacheRepository(ctx context.Context) repository.PartCacheRepository {
+	if d.partCacheRepository == nil {
+		d.partCacheRepository = partCacheRepository.NewRepository(d.RedisClient(ctx))
+	}
+
+	return d.partCacheRepository
+}
+
+func (d *diContainer) StockRepository(ctx context.Context) repository.StockRepository {
+	if d.stockRepository == nil {
+		d.stockRepository = stockRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+	}
+
+	return d.stockRepository
+}
+
+func (d *diContainer) CompatibilityRuleRepository(ctx context.Context) repository.CompatibilityRuleRepository {
+	if d.compatibilityRuleRepository == nil {
+		d.compatibilityRuleRepository = compatibilityRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+	}
+
+	return d.compatibilityRuleRepository
+}
+
+func (d *diContainer) PartPriceRepository(ctx context.Context) repository.PartPriceRepository {
+	if d.partPriceRepository == nil {
+		d.partPriceRepository = partPriceRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+	}
+
+	return d.partPriceRepository
+}
+
+func (d *diContainer) WarehouseRepository(ctx context.Context) repository.WarehouseRepository {
+	if d.warehouseRepository == nil {
+		d.warehouseRepository = warehouseRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+	}
+
+	return d.warehouseRepository
+}
+
+func (d *diContainer) KitRepository(ctx context.Context) repository.KitRepository {
+	if d.kitRepository == nil {
+		d.kitRepository = kitRepository.NewRepository(ctx, d.MongoDBHandle(ctx))
+	}
+
+	return d.kitRepository
+}
+
 func (d *diContainer) MongoDBClient(ctx context.Context) *mongo.Client {
 	if d.mongoDBClient == nil {
 		client, err := mongo.Connect(ctx, options.Client().ApplyURI(config.AppConfig().Mongo.URI()))
@@ -86,3 +294,142 @@ func (d *diContainer) MongoDBHandle(ctx context.Context) *mongo.Database {
 
 	return d.mongoDBHandle
 }
+
+func (d *diContainer) RedisPool() *redigo.Pool {
+	if d.redisPool == nil {
+		redisCfg := config.AppConfig().Redis
+		d.redisPool = &redigo.Pool{
+			MaxIdle:     redisCfg.MaxIdle(),
+			IdleTimeout: redisCfg.IdleTimeout(),
+			Dial: func() (redigo.Conn, error) {
+				return redigo.Dial("tcp", redisCfg.Address())
+			},
+			TestOnBorrow: func(c redigo.Conn, t time.Time) error {
+				_, err := c.Do("PING")
+				return err
+			},
+		}
+
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return d.redisPool.Close()
+		})
+	}
+
+	return d.redisPool
+}
+
+func (d *diContainer) RedisClient(ctx context.Context) cache.RedisClient {
+	if d.redisClient == nil {
+		redisCfg := config.AppConfig().Redis
+		d.redisClient = redisClient.NewClient(
+			d.RedisPool(),
+			logger.Logger(),
+			redisCfg.ConnectionTimeout(),
+		)
+	}
+
+	return d.redisClient
+}
+
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().PartProducer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create sync producer: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.syncProducer = p
+	}
+
+	return d.syncProducer
+}
+
+func (d *diContainer) PartCreatedProducer() wrappedKafka.Producer {
+	if d.partCreatedProducer == nil {
+		d.partCreatedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PartProducer.PartCreatedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.partCreatedProducer
+}
+
+func (d *diContainer) PartUpdatedProducer() wrappedKafka.Producer {
+	if d.partUpdatedProducer == nil {
+		d.partUpdatedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PartProducer.PartUpdatedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.partUpdatedProducer
+}
+
+func (d *diContainer) PartDeletedProducer() wrappedKafka.Producer {
+	if d.partDeletedProducer == nil {
+		d.partDeletedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PartProducer.PartDeletedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.partDeletedProducer
+}
+
+func (d *diContainer) StockLevelChangedProducer() wrappedKafka.Producer {
+	if d.stockLevelChangedProducer == nil {
+		d.stockLevelChangedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().StockProducer.StockLevelChangedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.stockLevelChangedProducer
+}
+
+func (d *diContainer) StockLowProducer() wrappedKafka.Producer {
+	if d.stockLowProducer == nil {
+		d.stockLowProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().StockProducer.StockLowTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.stockLowProducer
+}
+
+func (d *diContainer) IAMConn(ctx context.Context) *grpcConn.ClientConn {
+	if d.iamConn == nil {
+		conn, err := grpcConn.NewClient(
+			config.AppConfig().IAMGRPC.Address(),
+			grpcConn.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			panic(fmt.Errorf("failed to connect to IAM service: %w", err))
+		}
+
+		closer.AddNamed("IAM gRPC connection", func(ctx context.Context) error {
+			return conn.Close()
+		})
+
+		d.iamConn = conn
+	}
+
+	return d.iamConn
+}
+
+// IAMClient проверяет сессии пользователей в AuthInterceptor
+func (d *diContainer) IAMClient(ctx context.Context) grpcMiddleware.IAMClient {
+	if d.iamClient == nil {
+		d.iamClient = authV1.NewAuthServiceClient(d.IAMConn(ctx))
+	}
+
+	return d.iamClient
+}
@@ -11,10 +11,16 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger  LoggerConfig
-	GRPC    GRPCConfig
-	Mongo   MongoConfig
-	IAMGRPC IAMGRPCConfig
+	Logger          LoggerConfig
+	GRPC            GRPCConfig
+	Mongo           MongoConfig
+	IAMGRPC         IAMGRPCConfig
+	Kafka           KafkaConfig
+	PartProducer    PartProducerConfig
+	StockProducer   StockProducerConfig
+	Redis           RedisConfig
+	Warehouse       WarehouseConfig
+	MetricCollector MetricCollectorConfig
 }
 
 func Load(path ...string) error {
@@ -43,11 +49,47 @@ func Load(path ...string) error {
 		return err
 	}
 
+	kafkaCfg, err := env.NewKafkaConfig()
+	if err != nil {
+		return err
+	}
+
+	partProducerCfg, err := env.NewPartProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	stockProducerCfg, err := env.NewStockProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	redisCfg, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
+	warehouseCfg, err := env.NewWarehouseConfig()
+	if err != nil {
+		return err
+	}
+
+	metricCollectorCfg, err := env.NewMetricCollectorConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:  loggerCfg,
-		GRPC:    grpcCfg,
-		Mongo:   mongoCfg,
-		IAMGRPC: iamGRPCCfg,
+		Logger:          loggerCfg,
+		GRPC:            grpcCfg,
+		Mongo:           mongoCfg,
+		IAMGRPC:         iamGRPCCfg,
+		Kafka:           kafkaCfg,
+		PartProducer:    partProducerCfg,
+		StockProducer:   stockProducerCfg,
+		Redis:           redisCfg,
+		Warehouse:       warehouseCfg,
+		MetricCollector: metricCollectorCfg,
 	}
 
 	return nil
@@ -13,6 +13,8 @@ type mongoEnvConfig struct {
 	User     string `env:"MONGO_INITDB_ROOT_USERNAME,required"`
 	Password string `env:"MONGO_INITDB_ROOT_PASSWORD,required"`
 	AuthDB   string `env:"MONGO_AUTH_DB,required"`
+	// Сколько тестовых деталей создать в пустой коллекции. 0 — не заполнять
+	SeedPartsCount int `env:"MONGO_SEED_PARTS_COUNT,required"`
 }
 
 type mongoConfig struct {
@@ -44,3 +46,7 @@ func (cfg *mongoConfig) URI() string {
 func (cfg *mongoConfig) DatabaseName() string {
 	return cfg.raw.Database
 }
+
+func (cfg *mongoConfig) SeedPartsCount() int {
+	return cfg.raw.SeedPartsCount
+}
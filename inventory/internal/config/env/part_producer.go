@@ -0,0 +1,46 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type partProducerEnvConfig struct {
+	PartCreatedTopicName string `env:"PART_CREATED_TOPIC_NAME,required"`
+	PartUpdatedTopicName string `env:"PART_UPDATED_TOPIC_NAME,required"`
+	PartDeletedTopicName string `env:"PART_DELETED_TOPIC_NAME,required"`
+}
+
+type partProducerConfig struct {
+	raw partProducerEnvConfig
+}
+
+func NewPartProducerConfig() (*partProducerConfig, error) {
+	var raw partProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &partProducerConfig{raw: raw}, nil
+}
+
+func (cfg *partProducerConfig) PartCreatedTopic() string {
+	return cfg.raw.PartCreatedTopicName
+}
+
+func (cfg *partProducerConfig) PartUpdatedTopic() string {
+	return cfg.raw.PartUpdatedTopicName
+}
+
+func (cfg *partProducerConfig) PartDeletedTopic() string {
+	return cfg.raw.PartDeletedTopicName
+}
+
+// Config возвращает конфигурацию для sarama producer
+func (cfg *partProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -0,0 +1,37 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type stockProducerEnvConfig struct {
+	StockLevelChangedTopicName string `env:"STOCK_LEVEL_CHANGED_TOPIC_NAME,required"`
+	StockLowTopicName          string `env:"STOCK_LOW_TOPIC_NAME,required"`
+	LowStockThreshold          int64  `env:"LOW_STOCK_THRESHOLD,required"`
+}
+
+type stockProducerConfig struct {
+	raw stockProducerEnvConfig
+}
+
+func NewStockProducerConfig() (*stockProducerConfig, error) {
+	var raw stockProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &stockProducerConfig{raw: raw}, nil
+}
+
+func (cfg *stockProducerConfig) StockLevelChangedTopic() string {
+	return cfg.raw.StockLevelChangedTopicName
+}
+
+func (cfg *stockProducerConfig) StockLowTopic() string {
+	return cfg.raw.StockLowTopicName
+}
+
+// LowStockThreshold возвращает остаток, при достижении которого публикуется StockLow
+func (cfg *stockProducerConfig) LowStockThreshold() int64 {
+	return cfg.raw.LowStockThreshold
+}
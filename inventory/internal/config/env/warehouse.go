@@ -0,0 +1,28 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type warehouseEnvConfig struct {
+	Priority []string `env:"WAREHOUSE_PRIORITY,required"`
+}
+
+type warehouseConfig struct {
+	raw warehouseEnvConfig
+}
+
+func NewWarehouseConfig() (*warehouseConfig, error) {
+	var raw warehouseEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &warehouseConfig{raw: raw}, nil
+}
+
+// Priority возвращает коды складов в порядке выбора: первый склад считается
+// ближайшим и по умолчанию принимает приход
+func (cfg *warehouseConfig) Priority() []string {
+	return cfg.raw.Priority
+}
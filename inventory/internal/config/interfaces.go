@@ -1,5 +1,11 @@
 package config
 
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
@@ -15,8 +21,45 @@ type GRPCConfig interface {
 type MongoConfig interface {
 	URI() string
 	DatabaseName() string
+	SeedPartsCount() int
 }
 
 type IAMGRPCConfig interface {
 	Address() string
 }
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+type PartProducerConfig interface {
+	PartCreatedTopic() string
+	PartUpdatedTopic() string
+	PartDeletedTopic() string
+	Config() *sarama.Config
+}
+
+type StockProducerConfig interface {
+	StockLevelChangedTopic() string
+	StockLowTopic() string
+	LowStockThreshold() int64
+}
+
+type RedisConfig interface {
+	Address() string
+	ConnectionTimeout() time.Duration
+	MaxIdle() int
+	IdleTimeout() time.Duration
+	PartCacheTTL() time.Duration
+	ListCacheTTL() time.Duration
+}
+
+type WarehouseConfig interface {
+	Priority() []string
+}
+
+type MetricCollectorConfig interface {
+	CollectorEndpoint() string
+	CollectorInterval() time.Duration
+	ServiceName() string
+}
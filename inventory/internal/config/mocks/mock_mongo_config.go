@@ -65,6 +65,51 @@ func (_c *MongoConfig_DatabaseName_Call) RunAndReturn(run func() string) *MongoC
 	return _c
 }
 
+// SeedPartsCount provides a mock function with no fields
+func (_m *MongoConfig) SeedPartsCount() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SeedPartsCount")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// MongoConfig_SeedPartsCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeedPartsCount'
+type MongoConfig_SeedPartsCount_Call struct {
+	*mock.Call
+}
+
+// SeedPartsCount is a helper method to define mock.On call
+func (_e *MongoConfig_Expecter) SeedPartsCount() *MongoConfig_SeedPartsCount_Call {
+	return &MongoConfig_SeedPartsCount_Call{Call: _e.mock.On("SeedPartsCount")}
+}
+
+func (_c *MongoConfig_SeedPartsCount_Call) Run(run func()) *MongoConfig_SeedPartsCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MongoConfig_SeedPartsCount_Call) Return(_a0 int) *MongoConfig_SeedPartsCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MongoConfig_SeedPartsCount_Call) RunAndReturn(run func() int) *MongoConfig_SeedPartsCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // URI provides a mock function with no fields
 func (_m *MongoConfig) URI() string {
 	ret := _m.Called()
@@ -0,0 +1,220 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	sarama "github.com/IBM/sarama"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PartProducerConfig is an autogenerated mock type for the PartProducerConfig type
+type PartProducerConfig struct {
+	mock.Mock
+}
+
+type PartProducerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartProducerConfig) EXPECT() *PartProducerConfig_Expecter {
+	return &PartProducerConfig_Expecter{mock: &_m.Mock}
+}
+
+// Config provides a mock function with no fields
+func (_m *PartProducerConfig) Config() *sarama.Config {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 *sarama.Config
+	if rf, ok := ret.Get(0).(func() *sarama.Config); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sarama.Config)
+		}
+	}
+
+	return r0
+}
+
+// PartProducerConfig_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type PartProducerConfig_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *PartProducerConfig_Expecter) Config() *PartProducerConfig_Config_Call {
+	return &PartProducerConfig_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *PartProducerConfig_Config_Call) Run(run func()) *PartProducerConfig_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PartProducerConfig_Config_Call) Return(_a0 *sarama.Config) *PartProducerConfig_Config_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerConfig_Config_Call) RunAndReturn(run func() *sarama.Config) *PartProducerConfig_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PartCreatedTopic provides a mock function with no fields
+func (_m *PartProducerConfig) PartCreatedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PartCreatedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PartProducerConfig_PartCreatedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PartCreatedTopic'
+type PartProducerConfig_PartCreatedTopic_Call struct {
+	*mock.Call
+}
+
+// PartCreatedTopic is a helper method to define mock.On call
+func (_e *PartProducerConfig_Expecter) PartCreatedTopic() *PartProducerConfig_PartCreatedTopic_Call {
+	return &PartProducerConfig_PartCreatedTopic_Call{Call: _e.mock.On("PartCreatedTopic")}
+}
+
+func (_c *PartProducerConfig_PartCreatedTopic_Call) Run(run func()) *PartProducerConfig_PartCreatedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PartProducerConfig_PartCreatedTopic_Call) Return(_a0 string) *PartProducerConfig_PartCreatedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerConfig_PartCreatedTopic_Call) RunAndReturn(run func() string) *PartProducerConfig_PartCreatedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PartDeletedTopic provides a mock function with no fields
+func (_m *PartProducerConfig) PartDeletedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PartDeletedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PartProducerConfig_PartDeletedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PartDeletedTopic'
+type PartProducerConfig_PartDeletedTopic_Call struct {
+	*mock.Call
+}
+
+// PartDeletedTopic is a helper method to define mock.On call
+func (_e *PartProducerConfig_Expecter) PartDeletedTopic() *PartProducerConfig_PartDeletedTopic_Call {
+	return &PartProducerConfig_PartDeletedTopic_Call{Call: _e.mock.On("PartDeletedTopic")}
+}
+
+func (_c *PartProducerConfig_PartDeletedTopic_Call) Run(run func()) *PartProducerConfig_PartDeletedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PartProducerConfig_PartDeletedTopic_Call) Return(_a0 string) *PartProducerConfig_PartDeletedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerConfig_PartDeletedTopic_Call) RunAndReturn(run func() string) *PartProducerConfig_PartDeletedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PartUpdatedTopic provides a mock function with no fields
+func (_m *PartProducerConfig) PartUpdatedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PartUpdatedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PartProducerConfig_PartUpdatedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PartUpdatedTopic'
+type PartProducerConfig_PartUpdatedTopic_Call struct {
+	*mock.Call
+}
+
+// PartUpdatedTopic is a helper method to define mock.On call
+func (_e *PartProducerConfig_Expecter) PartUpdatedTopic() *PartProducerConfig_PartUpdatedTopic_Call {
+	return &PartProducerConfig_PartUpdatedTopic_Call{Call: _e.mock.On("PartUpdatedTopic")}
+}
+
+func (_c *PartProducerConfig_PartUpdatedTopic_Call) Run(run func()) *PartProducerConfig_PartUpdatedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PartProducerConfig_PartUpdatedTopic_Call) Return(_a0 string) *PartProducerConfig_PartUpdatedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerConfig_PartUpdatedTopic_Call) RunAndReturn(run func() string) *PartProducerConfig_PartUpdatedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartProducerConfig creates a new instance of PartProducerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartProducerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartProducerConfig {
+	mock := &PartProducerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
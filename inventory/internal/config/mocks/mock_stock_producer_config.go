@@ -0,0 +1,170 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// StockProducerConfig is an autogenerated mock type for the StockProducerConfig type
+type StockProducerConfig struct {
+	mock.Mock
+}
+
+type StockProducerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockProducerConfig) EXPECT() *StockProducerConfig_Expecter {
+	return &StockProducerConfig_Expecter{mock: &_m.Mock}
+}
+
+// LowStockThreshold provides a mock function with no fields
+func (_m *StockProducerConfig) LowStockThreshold() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LowStockThreshold")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// StockProducerConfig_LowStockThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LowStockThreshold'
+type StockProducerConfig_LowStockThreshold_Call struct {
+	*mock.Call
+}
+
+// LowStockThreshold is a helper method to define mock.On call
+func (_e *StockProducerConfig_Expecter) LowStockThreshold() *StockProducerConfig_LowStockThreshold_Call {
+	return &StockProducerConfig_LowStockThreshold_Call{Call: _e.mock.On("LowStockThreshold")}
+}
+
+func (_c *StockProducerConfig_LowStockThreshold_Call) Run(run func()) *StockProducerConfig_LowStockThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StockProducerConfig_LowStockThreshold_Call) Return(_a0 int64) *StockProducerConfig_LowStockThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockProducerConfig_LowStockThreshold_Call) RunAndReturn(run func() int64) *StockProducerConfig_LowStockThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StockLevelChangedTopic provides a mock function with no fields
+func (_m *StockProducerConfig) StockLevelChangedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StockLevelChangedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// StockProducerConfig_StockLevelChangedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StockLevelChangedTopic'
+type StockProducerConfig_StockLevelChangedTopic_Call struct {
+	*mock.Call
+}
+
+// StockLevelChangedTopic is a helper method to define mock.On call
+func (_e *StockProducerConfig_Expecter) StockLevelChangedTopic() *StockProducerConfig_StockLevelChangedTopic_Call {
+	return &StockProducerConfig_StockLevelChangedTopic_Call{Call: _e.mock.On("StockLevelChangedTopic")}
+}
+
+func (_c *StockProducerConfig_StockLevelChangedTopic_Call) Run(run func()) *StockProducerConfig_StockLevelChangedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StockProducerConfig_StockLevelChangedTopic_Call) Return(_a0 string) *StockProducerConfig_StockLevelChangedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockProducerConfig_StockLevelChangedTopic_Call) RunAndReturn(run func() string) *StockProducerConfig_StockLevelChangedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StockLowTopic provides a mock function with no fields
+func (_m *StockProducerConfig) StockLowTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StockLowTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// StockProducerConfig_StockLowTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StockLowTopic'
+type StockProducerConfig_StockLowTopic_Call struct {
+	*mock.Call
+}
+
+// StockLowTopic is a helper method to define mock.On call
+func (_e *StockProducerConfig_Expecter) StockLowTopic() *StockProducerConfig_StockLowTopic_Call {
+	return &StockProducerConfig_StockLowTopic_Call{Call: _e.mock.On("StockLowTopic")}
+}
+
+func (_c *StockProducerConfig_StockLowTopic_Call) Run(run func()) *StockProducerConfig_StockLowTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StockProducerConfig_StockLowTopic_Call) Return(_a0 string) *StockProducerConfig_StockLowTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockProducerConfig_StockLowTopic_Call) RunAndReturn(run func() string) *StockProducerConfig_StockLowTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockProducerConfig creates a new instance of StockProducerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockProducerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockProducerConfig {
+	mock := &StockProducerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// WarehouseConfig is an autogenerated mock type for the WarehouseConfig type
+type WarehouseConfig struct {
+	mock.Mock
+}
+
+type WarehouseConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WarehouseConfig) EXPECT() *WarehouseConfig_Expecter {
+	return &WarehouseConfig_Expecter{mock: &_m.Mock}
+}
+
+// Priority provides a mock function with no fields
+func (_m *WarehouseConfig) Priority() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Priority")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// WarehouseConfig_Priority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Priority'
+type WarehouseConfig_Priority_Call struct {
+	*mock.Call
+}
+
+// Priority is a helper method to define mock.On call
+func (_e *WarehouseConfig_Expecter) Priority() *WarehouseConfig_Priority_Call {
+	return &WarehouseConfig_Priority_Call{Call: _e.mock.On("Priority")}
+}
+
+func (_c *WarehouseConfig_Priority_Call) Run(run func()) *WarehouseConfig_Priority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WarehouseConfig_Priority_Call) Return(_a0 []string) *WarehouseConfig_Priority_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WarehouseConfig_Priority_Call) RunAndReturn(run func() []string) *WarehouseConfig_Priority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWarehouseConfig creates a new instance of WarehouseConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWarehouseConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WarehouseConfig {
+	mock := &WarehouseConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
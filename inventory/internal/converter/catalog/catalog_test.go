@@ -0,0 +1,130 @@
+package catalog
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func sampleParts() []model.Part {
+	return []model.Part{
+		{
+			Uuid:          "0b4ac5d4-6f1c-4d3a-9b8e-6f1d2c3b4a5e",
+			Name:          "Main engine",
+			Description:   "Ion drive, \"quoted\", with comma",
+			Price:         1500.5,
+			StockQuantity: 3,
+			Category:      model.CategoryEngine,
+			Dimensions:    &model.Dimensions{Length: 120, Width: 80.5, Height: 60, Weight: 250},
+			Manufacturer:  &model.Manufacturer{Name: "ACME", Country: "Germany", Website: "https://acme.example"},
+			Tags:          []string{"engine", "ion"},
+			Metadata: map[string]*model.Value{
+				"serial":    {StringValue: "SN-1"},
+				"revision":  {Int64Value: 7},
+				"tolerance": {DoubleValue: 2},
+				"certified": {BoolValue: true},
+			},
+		},
+		{
+			Uuid:     "1c5bd6e5-7a2d-4e4b-8c9f-7a2e3d4c5b6f",
+			Name:     "Porthole",
+			Price:    0,
+			Category: model.CategoryPorthole,
+		},
+	}
+}
+
+func (s *CatalogSuite) TestRoundTrip() {
+	for _, format := range []Format{FormatCSV, FormatNDJSON} {
+		var buf bytes.Buffer
+		s.Require().NoError(Encode(&buf, format, sampleParts()))
+
+		rows, err := Decode(&buf, format)
+		s.Require().NoError(err, format)
+		s.Require().Len(rows, 2, format)
+
+		for i, row := range rows {
+			s.Require().NoError(row.ParseErr, format)
+			s.Require().Equal(sampleParts()[i], row.Part, format)
+		}
+	}
+}
+
+func (s *CatalogSuite) TestEncodeIsStable() {
+	for _, format := range []Format{FormatCSV, FormatNDJSON} {
+		var first, second bytes.Buffer
+		s.Require().NoError(Encode(&first, format, sampleParts()))
+		s.Require().NoError(Encode(&second, format, sampleParts()))
+		s.Require().Equal(first.String(), second.String(), format)
+	}
+}
+
+func (s *CatalogSuite) TestDecodeCSVRowErrors() {
+	input := strings.Join([]string{
+		"uuid,name,price,category,stock_quantity",
+		"0b4ac5d4-6f1c-4d3a-9b8e-6f1d2c3b4a5e,Engine,10,engine,2",
+		"1c5bd6e5-7a2d-4e4b-8c9f-7a2e3d4c5b6f,Wing,abc,wing,",
+		"2d6ce7f6-8b3e-4f5c-9da0-8b3f4e5d6c7a,Tank,5,rocket,",
+		"3e7df807-9c4f-4a6d-aeb1-9c4a5f6e7d8b,Short",
+	}, "\n")
+
+	rows, err := Decode(strings.NewReader(input), FormatCSV)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 4)
+
+	s.Require().NoError(rows[0].ParseErr)
+	s.Require().Equal(model.CategoryEngine, rows[0].Part.Category)
+	s.Require().Equal(int64(2), rows[0].Part.StockQuantity)
+
+	s.Require().ErrorContains(rows[1].ParseErr, "invalid price")
+	s.Require().ErrorContains(rows[2].ParseErr, "unknown category")
+	s.Require().ErrorContains(rows[3].ParseErr, "expected 5 fields, got 2")
+
+	for i, row := range rows {
+		s.Require().Equal(i+1, row.Row)
+	}
+}
+
+func (s *CatalogSuite) TestDecodeCSVHeaderErrors() {
+	_, err := Decode(strings.NewReader(""), FormatCSV)
+	s.Require().ErrorContains(err, "csv header is missing")
+
+	_, err = Decode(strings.NewReader("uuid,name,price"), FormatCSV)
+	s.Require().ErrorContains(err, `required csv column "category" is missing`)
+
+	_, err = Decode(strings.NewReader("uuid,name,price,category,colour"), FormatCSV)
+	s.Require().ErrorContains(err, `unknown csv column "colour"`)
+}
+
+func (s *CatalogSuite) TestDecodeNDJSONRowErrors() {
+	input := strings.Join([]string{
+		`{"uuid":"0b4ac5d4-6f1c-4d3a-9b8e-6f1d2c3b4a5e","name":"Engine","price":10,"category":"CATEGORY_ENGINE"}`,
+		``,
+		`{"uuid":"1c5bd6e5-7a2d-4e4b-8c9f-7a2e3d4c5b6f","name":"Wing",`,
+		`{"uuid":"2d6ce7f6-8b3e-4f5c-9da0-8b3f4e5d6c7a","name":"Tank","price":5,"category":"fuel","colour":"red"}`,
+		`{"uuid":"3e7df807-9c4f-4a6d-aeb1-9c4a5f6e7d8b","name":"Tank","price":5,"category":"fuel","metadata":{"a":[1]}}`,
+	}, "\n")
+
+	rows, err := Decode(strings.NewReader(input), FormatNDJSON)
+	s.Require().NoError(err)
+	s.Require().Len(rows, 4)
+
+	s.Require().NoError(rows[0].ParseErr)
+	s.Require().Equal(1, rows[0].Row)
+
+	// Пустая строка пропускается, но номера строк совпадают с файлом
+	s.Require().Equal(3, rows[1].Row)
+	s.Require().ErrorContains(rows[1].ParseErr, "invalid json")
+	s.Require().ErrorContains(rows[2].ParseErr, "unknown field")
+	s.Require().ErrorContains(rows[3].ParseErr, "unsupported value")
+}
+
+func (s *CatalogSuite) TestParseFormat() {
+	format, err := ParseFormat("NDJSON")
+	s.Require().NoError(err)
+	s.Require().Equal(FormatNDJSON, format)
+
+	_, err = ParseFormat("xml")
+	s.Require().Error(err)
+}
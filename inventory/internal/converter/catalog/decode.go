@@ -0,0 +1,221 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+// Decode читает каталог из r. Ошибки отдельных строк возвращаются в CatalogRow.ParseErr,
+// ошибка функции означает, что файл нельзя прочитать целиком (нет заголовка CSV, ошибка ввода-вывода).
+func Decode(r io.Reader, format Format) ([]model.CatalogRow, error) {
+	switch format {
+	case FormatCSV:
+		return decodeCSV(r)
+	case FormatNDJSON:
+		return decodeNDJSON(r)
+	}
+
+	return nil, fmt.Errorf("unknown catalog format %q", format)
+}
+
+// decodeNDJSON нумерует строки по номеру строки в файле, пустые строки пропускаются.
+func decodeNDJSON(r io.Reader) ([]model.CatalogRow, error) {
+	reader := bufio.NewReader(r)
+	rows := make([]model.CatalogRow, 0)
+
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 {
+			row := model.CatalogRow{Row: line}
+			row.Part, row.ParseErr = decodeJSONRecord(trimmed)
+			rows = append(rows, row)
+		}
+
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+	}
+}
+
+func decodeJSONRecord(data []byte) (model.Part, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+
+	var rec record
+	if err := decoder.Decode(&rec); err != nil {
+		return model.Part{}, fmt.Errorf("invalid json: %w", err)
+	}
+
+	if decoder.More() {
+		return model.Part{}, errors.New("invalid json: unexpected data after object")
+	}
+
+	return toModelPart(rec)
+}
+
+// decodeCSV нумерует строки по порядку записей, не считая заголовок.
+func decodeCSV(r io.Reader) ([]model.CatalogRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv header is missing")
+		}
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns, err := parseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]model.CatalogRow, 0)
+	for rowNum := 1; ; rowNum++ {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		row := model.CatalogRow{Row: rowNum}
+
+		var parseErr *csv.ParseError
+		switch {
+		case errors.As(err, &parseErr):
+			row.ParseErr = fmt.Errorf("invalid csv: %w", parseErr.Err)
+		case err != nil:
+			return nil, err
+		case len(fields) != len(header):
+			row.ParseErr = fmt.Errorf("expected %d fields, got %d", len(header), len(fields))
+		default:
+			row.Part, row.ParseErr = decodeCSVRecord(columns, fields)
+		}
+
+		rows = append(rows, row)
+	}
+}
+
+func parseHeader(header []string) (map[string]int, error) {
+	known := make(map[string]struct{}, len(csvColumns))
+	for _, column := range csvColumns {
+		known[column] = struct{}{}
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := known[name]; !ok {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("duplicate csv column %q", name)
+		}
+		columns[name] = i
+	}
+
+	for _, name := range requiredCSVColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("required csv column %q is missing", name)
+		}
+	}
+
+	return columns, nil
+}
+
+func decodeCSVRecord(columns map[string]int, fields []string) (model.Part, error) {
+	get := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(fields[i])
+		}
+		return ""
+	}
+
+	rec := record{
+		Uuid:        get(columnUuid),
+		Name:        get(columnName),
+		Description: get(columnDescription),
+		Category:    get(columnCategory),
+	}
+
+	var err error
+	if rec.Price, err = parseFloat(columnPrice, get(columnPrice), true); err != nil {
+		return model.Part{}, err
+	}
+
+	if value := get(columnStockQuantity); value != "" {
+		if rec.StockQuantity, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return model.Part{}, fmt.Errorf("invalid %s: %w", columnStockQuantity, err)
+		}
+	}
+
+	if get(columnLength) != "" || get(columnWidth) != "" || get(columnHeight) != "" || get(columnWeight) != "" {
+		rec.Dimensions = &dimensions{}
+		for name, target := range map[string]*float64{
+			columnLength: &rec.Dimensions.Length,
+			columnWidth:  &rec.Dimensions.Width,
+			columnHeight: &rec.Dimensions.Height,
+			columnWeight: &rec.Dimensions.Weight,
+		} {
+			if *target, err = parseFloat(name, get(name), false); err != nil {
+				return model.Part{}, err
+			}
+		}
+	}
+
+	if get(columnManufacturerName) != "" || get(columnManufacturerCountry) != "" || get(columnManufacturerWebsite) != "" {
+		rec.Manufacturer = &manufacturer{
+			Name:    get(columnManufacturerName),
+			Country: get(columnManufacturerCountry),
+			Website: get(columnManufacturerWebsite),
+		}
+	}
+
+	if value := get(columnTags); value != "" {
+		for _, tag := range strings.Split(value, tagSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				rec.Tags = append(rec.Tags, tag)
+			}
+		}
+	}
+
+	if value := get(columnMetadata); value != "" {
+		decoder := json.NewDecoder(strings.NewReader(value))
+		decoder.UseNumber()
+		if err := decoder.Decode(&rec.Metadata); err != nil {
+			return model.Part{}, fmt.Errorf("invalid %s: %w", columnMetadata, err)
+		}
+	}
+
+	return toModelPart(rec)
+}
+
+func parseFloat(column, value string, required bool) (float64, error) {
+	if value == "" {
+		if required {
+			return 0, fmt.Errorf("%s is required", column)
+		}
+		return 0, nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", column, err)
+	}
+
+	return f, nil
+}
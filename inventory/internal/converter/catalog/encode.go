@@ -0,0 +1,102 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+// Encode пишет каталог в w в том же формате, который принимает Decode.
+func Encode(w io.Writer, format Format, parts []model.Part) error {
+	switch format {
+	case FormatCSV:
+		return encodeCSV(w, parts)
+	case FormatNDJSON:
+		return encodeNDJSON(w, parts)
+	}
+
+	return fmt.Errorf("unknown catalog format %q", format)
+}
+
+func encodeNDJSON(w io.Writer, parts []model.Part) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	for _, part := range parts {
+		if err := encoder.Encode(toRecord(part)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeCSV(w io.Writer, parts []model.Part) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvColumns); err != nil {
+		return err
+	}
+
+	for _, part := range parts {
+		fields, err := encodeCSVRecord(toRecord(part))
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(fields); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func encodeCSVRecord(rec record) ([]string, error) {
+	fields := map[string]string{
+		columnUuid:          rec.Uuid,
+		columnName:          rec.Name,
+		columnDescription:   rec.Description,
+		columnPrice:         formatFloat(rec.Price),
+		columnStockQuantity: strconv.FormatInt(rec.StockQuantity, 10),
+		columnCategory:      rec.Category,
+		columnTags:          strings.Join(rec.Tags, tagSeparator),
+	}
+
+	if rec.Dimensions != nil {
+		fields[columnLength] = formatFloat(rec.Dimensions.Length)
+		fields[columnWidth] = formatFloat(rec.Dimensions.Width)
+		fields[columnHeight] = formatFloat(rec.Dimensions.Height)
+		fields[columnWeight] = formatFloat(rec.Dimensions.Weight)
+	}
+
+	if rec.Manufacturer != nil {
+		fields[columnManufacturerName] = rec.Manufacturer.Name
+		fields[columnManufacturerCountry] = rec.Manufacturer.Country
+		fields[columnManufacturerWebsite] = rec.Manufacturer.Website
+	}
+
+	if len(rec.Metadata) > 0 {
+		metadata, err := json.Marshal(rec.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode metadata of part %s: %w", rec.Uuid, err)
+		}
+		fields[columnMetadata] = string(metadata)
+	}
+
+	result := make([]string, 0, len(csvColumns))
+	for _, column := range csvColumns {
+		result = append(result, fields[column])
+	}
+
+	return result, nil
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
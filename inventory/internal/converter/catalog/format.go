@@ -0,0 +1,66 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Format string
+
+// Колонки CSV. В NDJSON те же поля, но размеры и производитель — вложенные объекты
+const (
+	columnUuid                = "uuid"
+	columnName                = "name"
+	columnDescription         = "description"
+	columnPrice               = "price"
+	columnStockQuantity       = "stock_quantity"
+	columnCategory            = "category"
+	columnLength              = "length"
+	columnWidth               = "width"
+	columnHeight              = "height"
+	columnWeight              = "weight"
+	columnManufacturerName    = "manufacturer_name"
+	columnManufacturerCountry = "manufacturer_country"
+	columnManufacturerWebsite = "manufacturer_website"
+	columnTags                = "tags"
+	columnMetadata            = "metadata"
+
+	// Теги в CSV хранятся в одной колонке через разделитель
+	tagSeparator = "|"
+)
+
+var csvColumns = []string{
+	columnUuid,
+	columnName,
+	columnDescription,
+	columnPrice,
+	columnStockQuantity,
+	columnCategory,
+	columnLength,
+	columnWidth,
+	columnHeight,
+	columnWeight,
+	columnManufacturerName,
+	columnManufacturerCountry,
+	columnManufacturerWebsite,
+	columnTags,
+	columnMetadata,
+}
+
+var requiredCSVColumns = []string{columnUuid, columnName, columnPrice, columnCategory}
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(value)) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatNDJSON:
+		return FormatNDJSON, nil
+	}
+
+	return "", fmt.Errorf("unknown catalog format %q, expected csv or ndjson", value)
+}
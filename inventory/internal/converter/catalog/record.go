@@ -0,0 +1,204 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+const categoryPrefix = "CATEGORY_"
+
+// record — строка каталога в файле. Формат общий для CSV и NDJSON,
+// в CSV вложенные поля разворачиваются в отдельные колонки.
+type record struct {
+	Uuid          string         `json:"uuid"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description,omitempty"`
+	Price         float64        `json:"price"`
+	StockQuantity int64          `json:"stock_quantity"`
+	Category      string         `json:"category"`
+	Dimensions    *dimensions    `json:"dimensions,omitempty"`
+	Manufacturer  *manufacturer  `json:"manufacturer,omitempty"`
+	Tags          []string       `json:"tags,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+}
+
+type dimensions struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Weight float64 `json:"weight"`
+}
+
+type manufacturer struct {
+	Name    string `json:"name"`
+	Country string `json:"country,omitempty"`
+	Website string `json:"website,omitempty"`
+}
+
+func toRecord(part model.Part) record {
+	rec := record{
+		Uuid:          part.Uuid,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      formatCategory(part.Category),
+		Tags:          part.Tags,
+		Metadata:      formatMetadata(part.Metadata),
+	}
+
+	if part.Dimensions != nil {
+		rec.Dimensions = &dimensions{
+			Length: part.Dimensions.Length,
+			Width:  part.Dimensions.Width,
+			Height: part.Dimensions.Height,
+			Weight: part.Dimensions.Weight,
+		}
+	}
+
+	if part.Manufacturer != nil {
+		rec.Manufacturer = &manufacturer{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+			Website: part.Manufacturer.Website,
+		}
+	}
+
+	return rec
+}
+
+func toModelPart(rec record) (model.Part, error) {
+	category, err := parseCategory(rec.Category)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	metadata, err := parseMetadata(rec.Metadata)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	part := model.Part{
+		Uuid:          strings.TrimSpace(rec.Uuid),
+		Name:          rec.Name,
+		Description:   rec.Description,
+		Price:         rec.Price,
+		StockQuantity: rec.StockQuantity,
+		Category:      category,
+		Tags:          rec.Tags,
+		Metadata:      metadata,
+	}
+
+	if rec.Dimensions != nil {
+		part.Dimensions = &model.Dimensions{
+			Length: rec.Dimensions.Length,
+			Width:  rec.Dimensions.Width,
+			Height: rec.Dimensions.Height,
+			Weight: rec.Dimensions.Weight,
+		}
+	}
+
+	if rec.Manufacturer != nil {
+		part.Manufacturer = &model.Manufacturer{
+			Name:    rec.Manufacturer.Name,
+			Country: rec.Manufacturer.Country,
+			Website: rec.Manufacturer.Website,
+		}
+	}
+
+	return part, nil
+}
+
+// formatCategory возвращает имя категории без префикса enum'а: ENGINE, FUEL и т.д.
+func formatCategory(category model.Category) string {
+	return strings.TrimPrefix(converter.ToProtoCategory(category).String(), categoryPrefix)
+}
+
+// parseCategory принимает имя категории в любом регистре, с префиксом CATEGORY_ или без него.
+func parseCategory(value string) (model.Category, error) {
+	name := strings.ToUpper(strings.TrimSpace(value))
+	if !strings.HasPrefix(name, categoryPrefix) {
+		name = categoryPrefix + name
+	}
+
+	category, ok := inventoryV1.Category_value[name]
+	if !ok || category == int32(inventoryV1.Category_CATEGORY_UNSPECIFIED) {
+		return model.CategoryUnspecified, fmt.Errorf("unknown category %q", value)
+	}
+
+	return converter.ToModelCategory(inventoryV1.Category(category)), nil
+}
+
+// formatMetadata выбирает тип значения так же, как converter.ToProtoValue.
+// Дробные числа всегда пишутся с точкой, чтобы при импорте не превратиться в целые.
+func formatMetadata(metadata map[string]*model.Value) map[string]any {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	result := make(map[string]any, len(metadata))
+	for key, value := range metadata {
+		if value == nil {
+			continue
+		}
+
+		switch {
+		case value.StringValue != "":
+			result[key] = value.StringValue
+		case value.Int64Value != 0:
+			result[key] = json.Number(strconv.FormatInt(value.Int64Value, 10))
+		case value.DoubleValue != 0:
+			formatted := strconv.FormatFloat(value.DoubleValue, 'f', -1, 64)
+			if !strings.Contains(formatted, ".") {
+				formatted += ".0"
+			}
+			result[key] = json.Number(formatted)
+		default:
+			result[key] = value.BoolValue
+		}
+	}
+
+	return result
+}
+
+// parseMetadata ожидает значения, декодированные с UseNumber: строки, числа и bool.
+func parseMetadata(metadata map[string]any) (map[string]*model.Value, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]*model.Value, len(metadata))
+	for key, raw := range metadata {
+		switch v := raw.(type) {
+		case string:
+			result[key] = &model.Value{StringValue: v}
+		case bool:
+			result[key] = &model.Value{BoolValue: v}
+		case json.Number:
+			if !strings.ContainsAny(v.String(), ".eE") {
+				i, err := v.Int64()
+				if err != nil {
+					return nil, fmt.Errorf("metadata %q: %w", key, err)
+				}
+				result[key] = &model.Value{Int64Value: i}
+				continue
+			}
+
+			f, err := v.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("metadata %q: %w", key, err)
+			}
+			result[key] = &model.Value{DoubleValue: f}
+		default:
+			return nil, fmt.Errorf("metadata %q: unsupported value %v, expected string, number or bool", key, raw)
+		}
+	}
+
+	return result, nil
+}
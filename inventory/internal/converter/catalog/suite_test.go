@@ -0,0 +1,15 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CatalogSuite struct {
+	suite.Suite
+}
+
+func TestCatalogSuite(t *testing.T) {
+	suite.Run(t, new(CatalogSuite))
+}
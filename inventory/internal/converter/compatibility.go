@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoCompatibilityRule(rule model.CompatibilityRule) *inventoryV1.CompatibilityRule {
+	return &inventoryV1.CompatibilityRule{
+		Uuid: rule.Uuid,
+		Info: &inventoryV1.CompatibilityRuleInfo{
+			Type:             ToProtoCompatibilityRuleType(rule.Type),
+			Subject:          ToProtoPartSelector(rule.Subject),
+			Target:           ToProtoPartSelector(rule.Target),
+			MaxQuantity:      rule.MaxQuantity,
+			MatchMetadataKey: rule.MatchMetadataKey,
+			Description:      rule.Description,
+		},
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+}
+
+func ToProtoCompatibilityRules(rules []model.CompatibilityRule) []*inventoryV1.CompatibilityRule {
+	result := make([]*inventoryV1.CompatibilityRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, ToProtoCompatibilityRule(rule))
+	}
+	return result
+}
+
+func ToModelCompatibilityRuleInfo(info *inventoryV1.CompatibilityRuleInfo) model.CompatibilityRule {
+	return model.CompatibilityRule{
+		Type:             ToModelCompatibilityRuleType(info.GetType()),
+		Subject:          ToModelPartSelector(info.GetSubject()),
+		Target:           ToModelPartSelector(info.GetTarget()),
+		MaxQuantity:      info.GetMaxQuantity(),
+		MatchMetadataKey: info.GetMatchMetadataKey(),
+		Description:      info.GetDescription(),
+	}
+}
+
+func ToProtoPartSelector(selector model.PartSelector) *inventoryV1.PartSelector {
+	if selector.IsEmpty() {
+		return nil
+	}
+	return &inventoryV1.PartSelector{
+		Category:      ToProtoCategory(selector.Category),
+		Tag:           selector.Tag,
+		MetadataKey:   selector.MetadataKey,
+		MetadataValue: selector.MetadataValue,
+	}
+}
+
+func ToModelPartSelector(selector *inventoryV1.PartSelector) model.PartSelector {
+	return model.PartSelector{
+		Category:      ToModelCategory(selector.GetCategory()),
+		Tag:           selector.GetTag(),
+		MetadataKey:   selector.GetMetadataKey(),
+		MetadataValue: selector.GetMetadataValue(),
+	}
+}
+
+func ToProtoRuleViolations(violations []model.RuleViolation) []*inventoryV1.RuleViolation {
+	result := make([]*inventoryV1.RuleViolation, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, &inventoryV1.RuleViolation{
+			RuleUuid:  violation.RuleUuid,
+			Type:      ToProtoCompatibilityRuleType(violation.Type),
+			Message:   violation.Message,
+			PartUuids: violation.PartUuids,
+		})
+	}
+	return result
+}
+
+func ToProtoCompatibilityRuleType(ruleType model.CompatibilityRuleType) inventoryV1.CompatibilityRuleType {
+	return inventoryV1.CompatibilityRuleType(ruleType)
+}
+
+func ToModelCompatibilityRuleType(ruleType inventoryV1.CompatibilityRuleType) model.CompatibilityRuleType {
+	return model.CompatibilityRuleType(ruleType)
+}
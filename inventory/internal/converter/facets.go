@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoPartFacets(facets model.PartFacets) *inventoryV1.GetPartFacetsResponse {
+	categories := make([]*inventoryV1.CategoryFacet, 0, len(facets.Categories))
+	for _, facet := range facets.Categories {
+		categories = append(categories, &inventoryV1.CategoryFacet{
+			Category: ToProtoCategory(facet.Category),
+			Count:    facet.Count,
+		})
+	}
+
+	return &inventoryV1.GetPartFacetsResponse{
+		Categories:            categories,
+		ManufacturerCountries: ToProtoFacetCounts(facets.ManufacturerCountries),
+		ManufacturerNames:     ToProtoFacetCounts(facets.ManufacturerNames),
+		Tags:                  ToProtoFacetCounts(facets.Tags),
+		PriceRange: &inventoryV1.PriceRange{
+			Min: facets.PriceRange.Min,
+			Max: facets.PriceRange.Max,
+		},
+	}
+}
+
+func ToProtoFacetCounts(counts []model.FacetCount) []*inventoryV1.FacetCount {
+	result := make([]*inventoryV1.FacetCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, &inventoryV1.FacetCount{
+			Value: count.Value,
+			Count: count.Count,
+		})
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoKit(kit model.Kit) *inventoryV1.Kit {
+	return &inventoryV1.Kit{
+		Uuid:              kit.Uuid,
+		Name:              kit.Name,
+		Description:       kit.Description,
+		Components:        ToProtoKitComponents(kit.Components),
+		PriceOverride:     kit.PriceOverride,
+		Price:             kit.Price,
+		AvailableQuantity: kit.AvailableQuantity,
+		CreatedAt:         timestamppb.New(kit.CreatedAt),
+		UpdatedAt:         timestamppb.New(kit.UpdatedAt),
+	}
+}
+
+func ToProtoKits(kits []model.Kit) []*inventoryV1.Kit {
+	result := make([]*inventoryV1.Kit, 0, len(kits))
+	for _, kit := range kits {
+		result = append(result, ToProtoKit(kit))
+	}
+	return result
+}
+
+func ToProtoKitComponents(components []model.KitComponent) []*inventoryV1.KitComponent {
+	result := make([]*inventoryV1.KitComponent, 0, len(components))
+	for _, component := range components {
+		result = append(result, &inventoryV1.KitComponent{
+			PartUuid: component.PartUuid,
+			Quantity: component.Quantity,
+		})
+	}
+	return result
+}
+
+func ToModelKitInfo(info *inventoryV1.KitInfo) model.Kit {
+	components := make([]model.KitComponent, 0, len(info.GetComponents()))
+	for _, component := range info.GetComponents() {
+		components = append(components, model.KitComponent{
+			PartUuid: component.GetPartUuid(),
+			Quantity: component.GetQuantity(),
+		})
+	}
+
+	return model.Kit{
+		Name:          info.GetName(),
+		Description:   info.GetDescription(),
+		Components:    components,
+		PriceOverride: info.PriceOverride,
+	}
+}
@@ -9,18 +9,19 @@ import (
 
 func ToProtoPart(part model.Part) *inventoryV1.Part {
 	return &inventoryV1.Part{
-		Uuid:          part.Uuid,
-		Name:          part.Name,
-		Description:   part.Description,
-		Price:         part.Price,
-		StockQuantity: part.StockQuantity,
-		Category:      ToProtoCategory(part.Category),
-		Dimensions:    ToProtoDimensions(part.Dimensions),
-		Manufacturer:  ToProtoManufacturer(part.Manufacturer),
-		Tags:          part.Tags,
-		Metadata:      ToProtoValueMap(part.Metadata),
-		CreatedAt:     timestamppb.New(part.CreatedAt),
-		UpdatedAt:     timestamppb.New(part.UpdatedAt),
+		Uuid:           part.Uuid,
+		Name:           part.Name,
+		Description:    part.Description,
+		Price:          part.Price,
+		StockQuantity:  part.StockQuantity,
+		WarehouseStock: part.WarehouseStock,
+		Category:       ToProtoCategory(part.Category),
+		Dimensions:     ToProtoDimensions(part.Dimensions),
+		Manufacturer:   ToProtoManufacturer(part.Manufacturer),
+		Tags:           part.Tags,
+		Metadata:       ToProtoValueMap(part.Metadata),
+		CreatedAt:      timestamppb.New(part.CreatedAt),
+		UpdatedAt:      timestamppb.New(part.UpdatedAt),
 	}
 }
 
@@ -34,18 +35,19 @@ func ToProtoParts(parts []model.Part) []*inventoryV1.Part {
 
 func ToModelPart(part *inventoryV1.Part) model.Part {
 	return model.Part{
-		Uuid:          part.GetUuid(),
-		Name:          part.GetName(),
-		Description:   part.GetDescription(),
-		Price:         part.GetPrice(),
-		StockQuantity: part.GetStockQuantity(),
-		Category:      ToModelCategory(part.GetCategory()),
-		Dimensions:    ToModelDimensions(part.GetDimensions()),
-		Manufacturer:  ToModelManufacturer(part.GetManufacturer()),
-		Tags:          part.GetTags(),
-		Metadata:      ToModelValueMap(part.GetMetadata()),
-		CreatedAt:     part.GetCreatedAt().AsTime(),
-		UpdatedAt:     part.GetUpdatedAt().AsTime(),
+		Uuid:           part.GetUuid(),
+		Name:           part.GetName(),
+		Description:    part.GetDescription(),
+		Price:          part.GetPrice(),
+		StockQuantity:  part.GetStockQuantity(),
+		WarehouseStock: part.GetWarehouseStock(),
+		Category:       ToModelCategory(part.GetCategory()),
+		Dimensions:     ToModelDimensions(part.GetDimensions()),
+		Manufacturer:   ToModelManufacturer(part.GetManufacturer()),
+		Tags:           part.GetTags(),
+		Metadata:       ToModelValueMap(part.GetMetadata()),
+		CreatedAt:      part.GetCreatedAt().AsTime(),
+		UpdatedAt:      part.GetUpdatedAt().AsTime(),
 	}
 }
 
@@ -214,3 +216,17 @@ func ToModelPartsFilter(filter *inventoryV1.PartsFilter) model.PartsFilter {
 		Tags:                  tags,
 	}
 }
+
+// ToModelPartInfo собирает деталь из редактируемых полей запроса. UUID, остаток и даты заполняет сервис.
+func ToModelPartInfo(info *inventoryV1.PartInfo) model.Part {
+	return model.Part{
+		Name:         info.GetName(),
+		Description:  info.GetDescription(),
+		Price:        info.GetPrice(),
+		Category:     ToModelCategory(info.GetCategory()),
+		Dimensions:   ToModelDimensions(info.GetDimensions()),
+		Manufacturer: ToModelManufacturer(info.GetManufacturer()),
+		Tags:         info.GetTags(),
+		Metadata:     ToModelValueMap(info.GetMetadata()),
+	}
+}
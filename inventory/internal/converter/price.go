@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoPartPrice(price model.PartPrice) *inventoryV1.PartPrice {
+	return &inventoryV1.PartPrice{
+		PartUuid:      price.PartUuid,
+		Price:         price.Price,
+		EffectiveFrom: timestamppb.New(price.EffectiveFrom),
+		CreatedAt:     timestamppb.New(price.CreatedAt),
+	}
+}
+
+func ToProtoPartPrices(prices []model.PartPrice) []*inventoryV1.PartPrice {
+	result := make([]*inventoryV1.PartPrice, 0, len(prices))
+	for _, price := range prices {
+		result = append(result, ToProtoPartPrice(price))
+	}
+	return result
+}
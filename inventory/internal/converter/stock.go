@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoStockMovement(movement model.StockMovement) *inventoryV1.StockMovement {
+	return &inventoryV1.StockMovement{
+		Uuid:                movement.Uuid,
+		PartUuid:            movement.PartUuid,
+		Delta:               movement.Delta,
+		Reason:              ToProtoStockMovementReason(movement.Reason),
+		ReferenceId:         movement.ReferenceID,
+		Actor:               movement.Actor,
+		StockAfter:          movement.StockAfter,
+		CreatedAt:           timestamppb.New(movement.CreatedAt),
+		WarehouseCode:       movement.WarehouseCode,
+		WarehouseStockAfter: movement.WarehouseStockAfter,
+	}
+}
+
+func ToProtoStockMovements(movements []model.StockMovement) []*inventoryV1.StockMovement {
+	result := make([]*inventoryV1.StockMovement, 0, len(movements))
+	for _, movement := range movements {
+		result = append(result, ToProtoStockMovement(movement))
+	}
+	return result
+}
+
+func ToProtoStockMovementReason(reason model.StockMovementReason) inventoryV1.StockMovementReason {
+	return inventoryV1.StockMovementReason(reason)
+}
+
+func ToModelStockMovementReason(reason inventoryV1.StockMovementReason) model.StockMovementReason {
+	return model.StockMovementReason(reason)
+}
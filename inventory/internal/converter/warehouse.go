@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToProtoWarehouse(warehouse model.Warehouse) *inventoryV1.Warehouse {
+	return &inventoryV1.Warehouse{
+		Code:      warehouse.Code,
+		Name:      warehouse.Name,
+		Location:  warehouse.Location,
+		CreatedAt: timestamppb.New(warehouse.CreatedAt),
+	}
+}
+
+func ToProtoWarehouses(warehouses []model.Warehouse) []*inventoryV1.Warehouse {
+	result := make([]*inventoryV1.Warehouse, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		result = append(result, ToProtoWarehouse(warehouse))
+	}
+	return result
+}
+
+func ToModelWarehouseInfo(info *inventoryV1.WarehouseInfo) model.Warehouse {
+	return model.Warehouse{
+		Code:     info.GetCode(),
+		Name:     info.GetName(),
+		Location: info.GetLocation(),
+	}
+}
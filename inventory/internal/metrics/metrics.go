@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Значения лейбла cache
+const (
+	// CachePart - кэш отдельных деталей (GetPart)
+	CachePart = "part"
+	// CacheList - кэш результатов фильтрации (ListParts)
+	CacheList = "list"
+)
+
+var (
+	// CacheHitsTotal - COUNTER для подсчета попаданий в Redis-кэш деталей
+	// Тип: Int64Counter (монотонно возрастающий)
+	// Использование: вместе с CacheMissesTotal дает hit ratio кэша
+	// Лейблы: cache (part, list)
+	CacheHitsTotal metric.Int64Counter
+
+	// CacheMissesTotal - COUNTER для подсчета промахов Redis-кэша деталей
+	// Тип: Int64Counter (монотонно возрастающий)
+	// Использование: каждый промах означает чтение из MongoDB.
+	// Ошибки Redis тоже считаются промахом — запрос уходит в MongoDB
+	// Лейблы: cache (part, list)
+	CacheMissesTotal metric.Int64Counter
+)
+
+// InitMetrics инициализирует все метрики inventory сервиса
+// Должна быть вызвана один раз при старте приложения после инициализации OpenTelemetry провайдера
+func InitMetrics(serviceName string) error {
+	meter := otel.Meter(serviceName)
+	var err error
+
+	// Создаем счетчик попаданий в кэш
+	CacheHitsTotal, err = meter.Int64Counter(
+		serviceName+"_cache_hits_total",
+		metric.WithDescription("Total number of inventory cache hits"),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Создаем счетчик промахов кэша
+	CacheMissesTotal, err = meter.Int64Counter(
+		serviceName+"_cache_misses_total",
+		metric.WithDescription("Total number of inventory cache misses"),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
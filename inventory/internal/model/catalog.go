@@ -0,0 +1,37 @@
+package model
+
+// CatalogRow — деталь, прочитанная из файла импорта.
+// Если строку не удалось разобрать, ParseErr содержит причину, а Part пуст.
+type CatalogRow struct {
+	// Номер строки в файле (с единицы, без учёта заголовка CSV)
+	Row      int
+	Part     Part
+	ParseErr error
+}
+
+type ImportAction string
+
+const (
+	ImportActionCreated   ImportAction = "created"
+	ImportActionUpdated   ImportAction = "updated"
+	ImportActionUnchanged ImportAction = "unchanged"
+	ImportActionFailed    ImportAction = "failed"
+)
+
+type ImportRowResult struct {
+	Row    int
+	Uuid   string
+	Action ImportAction
+	Error  string
+}
+
+type ImportReport struct {
+	// В режиме проверки Action показывает, что произошло бы при импорте
+	DryRun bool
+	Rows   []ImportRowResult
+
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+}
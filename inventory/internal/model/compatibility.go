@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+type CompatibilityRule struct {
+	Uuid string
+	// Тип правила
+	Type CompatibilityRuleType
+	// Детали, к которым применяется правило
+	Subject PartSelector
+	// Связанные детали. Не используется для MAX_PER_ORDER
+	Target PartSelector
+	// Максимальное количество единиц для MAX_PER_ORDER
+	MaxQuantity int64
+	// Для COMPATIBLE_WITH: ключ метаданных, значения которого должны совпадать.
+	// Пусто — у деталей должен быть хотя бы один общий тег
+	MatchMetadataKey string
+	// Описание правила, возвращается в нарушениях
+	Description string
+	// Дата создания
+	CreatedAt time.Time
+}
+
+type CompatibilityRuleType int32
+
+const (
+	CompatibilityRuleTypeUnspecified    CompatibilityRuleType = 0
+	CompatibilityRuleTypeRequires       CompatibilityRuleType = 1
+	CompatibilityRuleTypeExcludes       CompatibilityRuleType = 2
+	CompatibilityRuleTypeMaxPerOrder    CompatibilityRuleType = 3
+	CompatibilityRuleTypeCompatibleWith CompatibilityRuleType = 4
+)
+
+// PartSelector выбирает детали по атрибутам. Заданные поля проверяются все вместе.
+type PartSelector struct {
+	// Категория. CategoryUnspecified — любая
+	Category Category
+	// Тег. Пусто — не проверяем
+	Tag string
+	// Ключ метаданных. Пусто — не проверяем
+	MetadataKey string
+	// Значение метаданных в строковом виде. Пусто — достаточно наличия ключа
+	MetadataValue string
+}
+
+// IsEmpty сообщает, что селектор подходит под любую деталь.
+func (s PartSelector) IsEmpty() bool {
+	return s.Category == CategoryUnspecified && s.Tag == "" && s.MetadataKey == ""
+}
+
+type RuleViolation struct {
+	RuleUuid string
+	// Тип нарушенного правила
+	Type CompatibilityRuleType
+	// Описание нарушения
+	Message string
+	// UUID деталей, из-за которых правило нарушено
+	PartUuids []string
+}
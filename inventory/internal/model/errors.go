@@ -2,4 +2,24 @@ package model
 
 import "errors"
 
-var ErrPartNotFound = errors.New("part not found")
+var (
+	ErrPartNotFound       = errors.New("part not found")
+	ErrInvalidPart        = errors.New("invalid part")
+	ErrInsufficientStock  = errors.New("insufficient stock")
+	ErrInvalidStockDelta  = errors.New("invalid stock delta")
+	ErrInvalidStockReason = errors.New("invalid stock movement reason")
+	ErrCacheMiss          = errors.New("cache miss")
+
+	ErrCompatibilityRuleNotFound = errors.New("compatibility rule not found")
+	ErrInvalidCompatibilityRule  = errors.New("invalid compatibility rule")
+
+	ErrWarehouseNotFound      = errors.New("warehouse not found")
+	ErrWarehouseAlreadyExists = errors.New("warehouse already exists")
+	ErrInvalidWarehouse       = errors.New("invalid warehouse")
+
+	ErrPartPriceNotFound = errors.New("part price not found")
+	ErrInvalidPartPrice  = errors.New("invalid part price")
+
+	ErrKitNotFound = errors.New("kit not found")
+	ErrInvalidKit  = errors.New("invalid kit")
+)
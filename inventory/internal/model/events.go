@@ -0,0 +1,43 @@
+package model
+
+type PartCreatedEvent struct {
+	EventUUID string
+	PartUUID  string
+	Name      string
+	Category  Category
+	Price     float64
+}
+
+type PartUpdatedEvent struct {
+	EventUUID     string
+	PartUUID      string
+	Name          string
+	Category      Category
+	Price         float64
+	PreviousPrice float64
+}
+
+type PartDeletedEvent struct {
+	EventUUID string
+	PartUUID  string
+}
+
+type StockLevelChangedEvent struct {
+	EventUUID     string
+	PartUUID      string
+	MovementUUID  string
+	Delta         int64
+	StockQuantity int64
+	Reason        StockMovementReason
+	ReferenceID   string
+	// Склад и остаток на нём после изменения
+	WarehouseCode  string
+	WarehouseStock int64
+}
+
+type StockLowEvent struct {
+	EventUUID     string
+	PartUUID      string
+	StockQuantity int64
+	Threshold     int64
+}
@@ -0,0 +1,29 @@
+package model
+
+type PartFacets struct {
+	// Количество деталей по категориям
+	Categories []CategoryFacet
+	// Количество деталей по странам производителей
+	ManufacturerCountries []FacetCount
+	// Количество деталей по названиям производителей
+	ManufacturerNames []FacetCount
+	// Самые популярные теги
+	Tags []FacetCount
+	// Диапазон цен
+	PriceRange PriceRange
+}
+
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+type CategoryFacet struct {
+	Category Category
+	Count    int64
+}
+
+type PriceRange struct {
+	Min float64
+	Max float64
+}
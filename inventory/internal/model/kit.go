@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// Kit — комплект из нескольких деталей, который продаётся как один товар
+type Kit struct {
+	Uuid string
+	// Название
+	Name string
+	// Описание
+	Description string
+	// Состав комплекта, каждая деталь указывается один раз
+	Components []KitComponent
+	// Цена, заданная вручную. nil — цена считается по составу
+	PriceOverride *float64
+	// Действующая цена: PriceOverride или сумма цен деталей. Не хранится, считается при чтении
+	Price float64
+	// Сколько комплектов можно собрать из остатков деталей. Не хранится, считается при чтении
+	AvailableQuantity int64
+	// Дата создания
+	CreatedAt time.Time
+	// Дата обновления
+	UpdatedAt time.Time
+}
+
+type KitComponent struct {
+	// UUID детали
+	PartUuid string
+	// Количество деталей в одном комплекте
+	Quantity int64
+}
@@ -10,8 +10,10 @@ type Part struct {
 	Description string
 	// Цена за единицу
 	Price float64
-	// Количество на складе
+	// Количество на всех складах
 	StockQuantity int64
+	// Остатки по складам: код склада -> количество. В сумме равны StockQuantity
+	WarehouseStock map[string]int64
 	// Категория
 	Category Category
 	// Размеры детали
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// PartPrice — запись истории цен детали. Действует последняя запись
+// с EffectiveFrom не позже нужного момента.
+type PartPrice struct {
+	// UUID детали
+	PartUuid string
+	// Цена за единицу
+	Price float64
+	// Момент, с которого действует цена
+	EffectiveFrom time.Time
+	// Дата создания записи
+	CreatedAt time.Time
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+type StockMovement struct {
+	Uuid     string
+	PartUuid string
+	// Изменение остатка: положительное — приход, отрицательное — расход
+	Delta int64
+	// Причина изменения
+	Reason StockMovementReason
+	// Идентификатор связанной сущности (например, UUID заказа)
+	ReferenceID string
+	// Кто изменил остаток
+	Actor string
+	// Остаток после изменения
+	StockAfter int64
+	// Код склада. Пусто во входящем движении — склад выбирается по приоритетам
+	WarehouseCode string
+	// Остаток на складе после изменения
+	WarehouseStockAfter int64
+	// Дата движения
+	CreatedAt time.Time
+}
+
+type StockMovementReason int32
+
+const (
+	StockMovementReasonUnspecified      StockMovementReason = 0
+	StockMovementReasonRestock          StockMovementReason = 1
+	StockMovementReasonReservation      StockMovementReason = 2
+	StockMovementReasonRelease          StockMovementReason = 3
+	StockMovementReasonSale             StockMovementReason = 4
+	StockMovementReasonManualAdjustment StockMovementReason = 5
+)
+
+type StockMovementsFilter struct {
+	PartUuid    string
+	ReferenceID string
+	// Пусто — не фильтруем по складу
+	WarehouseCode string
+	Limit         int
+	Offset        int
+}
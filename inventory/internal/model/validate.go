@@ -0,0 +1,128 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Код склада входит в имя поля документа детали (warehouse_stock.<code>),
+// поэтому точки и '$' в нём недопустимы
+var warehouseCodePattern = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+// ValidatePart проверяет редактируемые поля детали.
+func ValidatePart(part Part) error {
+	if strings.TrimSpace(part.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPart)
+	}
+
+	if part.Price < 0 {
+		return fmt.Errorf("%w: price must not be negative", ErrInvalidPart)
+	}
+
+	if part.Category < CategoryEngine || part.Category > CategoryWing {
+		return fmt.Errorf("%w: unknown category", ErrInvalidPart)
+	}
+
+	return nil
+}
+
+// ValidateCompatibilityRule проверяет, что у правила заданы поля, нужные его типу.
+func ValidateCompatibilityRule(rule CompatibilityRule) error {
+	if rule.Subject.IsEmpty() {
+		return fmt.Errorf("%w: subject selector is required", ErrInvalidCompatibilityRule)
+	}
+
+	if err := validateSelector(rule.Subject); err != nil {
+		return err
+	}
+
+	switch rule.Type {
+	case CompatibilityRuleTypeRequires, CompatibilityRuleTypeExcludes, CompatibilityRuleTypeCompatibleWith:
+		if rule.Target.IsEmpty() {
+			return fmt.Errorf("%w: target selector is required", ErrInvalidCompatibilityRule)
+		}
+		if err := validateSelector(rule.Target); err != nil {
+			return err
+		}
+	case CompatibilityRuleTypeMaxPerOrder:
+		if rule.MaxQuantity <= 0 {
+			return fmt.Errorf("%w: max_quantity must be positive", ErrInvalidCompatibilityRule)
+		}
+	default:
+		return fmt.Errorf("%w: unknown rule type", ErrInvalidCompatibilityRule)
+	}
+
+	return nil
+}
+
+func validateSelector(selector PartSelector) error {
+	if selector.Category < CategoryUnspecified || selector.Category > CategoryWing {
+		return fmt.Errorf("%w: unknown category", ErrInvalidCompatibilityRule)
+	}
+
+	if selector.MetadataValue != "" && selector.MetadataKey == "" {
+		return fmt.Errorf("%w: metadata_value requires metadata_key", ErrInvalidCompatibilityRule)
+	}
+
+	return nil
+}
+
+// ValidateWarehouse проверяет код и название склада.
+func ValidateWarehouse(warehouse Warehouse) error {
+	if !ValidWarehouseCode(warehouse.Code) {
+		return fmt.Errorf("%w: code must match %s", ErrInvalidWarehouse, warehouseCodePattern)
+	}
+
+	if strings.TrimSpace(warehouse.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidWarehouse)
+	}
+
+	return nil
+}
+
+// ValidWarehouseCode сообщает, можно ли использовать строку как код склада.
+func ValidWarehouseCode(code string) bool {
+	return warehouseCodePattern.MatchString(code)
+}
+
+// ValidatePartPrice проверяет запись истории цен.
+func ValidatePartPrice(price PartPrice) error {
+	if price.Price < 0 {
+		return fmt.Errorf("%w: price must not be negative", ErrInvalidPartPrice)
+	}
+
+	if price.EffectiveFrom.IsZero() {
+		return fmt.Errorf("%w: effective_from is required", ErrInvalidPartPrice)
+	}
+
+	return nil
+}
+
+// ValidateKit проверяет название, состав и цену комплекта.
+func ValidateKit(kit Kit) error {
+	if strings.TrimSpace(kit.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidKit)
+	}
+
+	if len(kit.Components) == 0 {
+		return fmt.Errorf("%w: at least one component is required", ErrInvalidKit)
+	}
+
+	seen := make(map[string]struct{}, len(kit.Components))
+	for _, component := range kit.Components {
+		if component.Quantity <= 0 {
+			return fmt.Errorf("%w: quantity of part %s must be positive", ErrInvalidKit, component.PartUuid)
+		}
+		if _, ok := seen[component.PartUuid]; ok {
+			return fmt.Errorf("%w: part %s is listed more than once", ErrInvalidKit, component.PartUuid)
+		}
+		seen[component.PartUuid] = struct{}{}
+	}
+
+	if kit.PriceOverride != nil && *kit.PriceOverride < 0 {
+		return fmt.Errorf("%w: price_override must not be negative", ErrInvalidKit)
+	}
+
+	return nil
+}
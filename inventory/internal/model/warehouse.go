@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type Warehouse struct {
+	// Код склада, используется в остатках деталей и в списке приоритетов
+	Code string
+	// Название
+	Name string
+	// Расположение
+	Location string
+	// Дата создания
+	CreatedAt time.Time
+}
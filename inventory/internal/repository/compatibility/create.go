@@ -0,0 +1,13 @@
+package compatibility
+
+import (
+	"context"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreateRule(ctx context.Context, rule model.CompatibilityRule) error {
+	_, err := r.collection.InsertOne(ctx, repoConverter.ToRepoCompatibilityRule(rule))
+	return err
+}
@@ -0,0 +1,37 @@
+package compatibility
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func newRule(createdAt time.Time) model.CompatibilityRule {
+	return model.CompatibilityRule{
+		Uuid:        gofakeit.UUID(),
+		Type:        model.CompatibilityRuleTypeRequires,
+		Subject:     model.PartSelector{Category: model.CategoryEngine},
+		Target:      model.PartSelector{Category: model.CategoryFuel, MetadataKey: "fuel_type", MetadataValue: "hydrogen"},
+		Description: gofakeit.Sentence(),
+		CreatedAt:   createdAt.UTC().Truncate(time.Millisecond),
+	}
+}
+
+func (s *RepositorySuite) TestCreateAndListRulesOldestFirst() {
+	second := newRule(time.Now())
+	first := newRule(time.Now().Add(-time.Minute))
+	first.Type = model.CompatibilityRuleTypeMaxPerOrder
+	first.Target = model.PartSelector{}
+	first.MaxQuantity = 2
+
+	s.Require().NoError(s.repository.CreateRule(s.ctx, second))
+	s.Require().NoError(s.repository.CreateRule(s.ctx, first))
+
+	rules, err := s.repository.ListRules(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(rules, 2)
+	s.Require().Equal(first, rules[0])
+	s.Require().Equal(second, rules[1])
+}
@@ -0,0 +1,25 @@
+package compatibility
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestDeleteRule() {
+	rule := newRule(time.Now())
+	s.Require().NoError(s.repository.CreateRule(s.ctx, rule))
+
+	s.Require().NoError(s.repository.DeleteRule(s.ctx, rule.Uuid))
+
+	rules, err := s.repository.ListRules(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(rules)
+}
+
+func (s *RepositorySuite) TestDeleteRuleNotFound() {
+	err := s.repository.DeleteRule(s.ctx, gofakeit.UUID())
+	s.Require().ErrorIs(err, model.ErrCompatibilityRuleNotFound)
+}
@@ -0,0 +1,40 @@
+package compatibility
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) ListRules(ctx context.Context) ([]model.CompatibilityRule, error) {
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoRules []repoModel.CompatibilityRule
+	if err = cursor.All(ctx, &repoRules); err != nil {
+		return nil, err
+	}
+
+	rules := make([]model.CompatibilityRule, 0, len(repoRules))
+	for _, repoRule := range repoRules {
+		rules = append(rules, repoConverter.ToModelCompatibilityRule(repoRule))
+	}
+
+	return rules, nil
+}
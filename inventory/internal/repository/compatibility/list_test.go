@@ -0,0 +1,7 @@
+package compatibility
+
+func (s *RepositorySuite) TestListRulesEmpty() {
+	rules, err := s.repository.ListRules(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(rules)
+}
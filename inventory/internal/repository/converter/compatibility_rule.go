@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func ToRepoCompatibilityRule(rule model.CompatibilityRule) repoModel.CompatibilityRule {
+	return repoModel.CompatibilityRule{
+		Uuid:             rule.Uuid,
+		Type:             repoModel.CompatibilityRuleType(rule.Type),
+		Subject:          ToRepoPartSelector(rule.Subject),
+		Target:           ToRepoPartSelector(rule.Target),
+		MaxQuantity:      rule.MaxQuantity,
+		MatchMetadataKey: rule.MatchMetadataKey,
+		Description:      rule.Description,
+		CreatedAt:        rule.CreatedAt,
+	}
+}
+
+func ToModelCompatibilityRule(rule repoModel.CompatibilityRule) model.CompatibilityRule {
+	return model.CompatibilityRule{
+		Uuid:             rule.Uuid,
+		Type:             model.CompatibilityRuleType(rule.Type),
+		Subject:          ToModelPartSelector(rule.Subject),
+		Target:           ToModelPartSelector(rule.Target),
+		MaxQuantity:      rule.MaxQuantity,
+		MatchMetadataKey: rule.MatchMetadataKey,
+		Description:      rule.Description,
+		CreatedAt:        rule.CreatedAt,
+	}
+}
+
+func ToRepoPartSelector(selector model.PartSelector) repoModel.PartSelector {
+	return repoModel.PartSelector{
+		Category:      ToRepoCategory(selector.Category),
+		Tag:           selector.Tag,
+		MetadataKey:   selector.MetadataKey,
+		MetadataValue: selector.MetadataValue,
+	}
+}
+
+func ToModelPartSelector(selector repoModel.PartSelector) model.PartSelector {
+	return model.PartSelector{
+		Category:      ToModelCategory(selector.Category),
+		Tag:           selector.Tag,
+		MetadataKey:   selector.MetadataKey,
+		MetadataValue: selector.MetadataValue,
+	}
+}
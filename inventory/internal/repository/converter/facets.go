@@ -0,0 +1,51 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func ToModelPartFacets(facets repoModel.PartFacets) model.PartFacets {
+	categories := make([]model.CategoryFacet, 0, len(facets.Categories))
+	for _, facet := range facets.Categories {
+		categories = append(categories, model.CategoryFacet{
+			Category: ToModelCategory(facet.Category),
+			Count:    facet.Count,
+		})
+	}
+
+	var priceRange model.PriceRange
+	if len(facets.PriceRange) > 0 {
+		priceRange = model.PriceRange{
+			Min: facets.PriceRange[0].Min,
+			Max: facets.PriceRange[0].Max,
+		}
+	}
+
+	return model.PartFacets{
+		Categories:            categories,
+		ManufacturerCountries: ToModelFacetCounts(facets.ManufacturerCountries),
+		ManufacturerNames:     ToModelFacetCounts(facets.ManufacturerNames),
+		Tags:                  ToModelFacetCounts(facets.Tags),
+		PriceRange:            priceRange,
+	}
+}
+
+func ToModelFacetCounts(counts []repoModel.FacetCount) []model.FacetCount {
+	result := make([]model.FacetCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, model.FacetCount{
+			Value: count.Value,
+			Count: count.Count,
+		})
+	}
+	return result
+}
+
+func ToRepoCategories(categories []model.Category) []repoModel.Category {
+	result := make([]repoModel.Category, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, ToRepoCategory(category))
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func ToRepoKit(kit model.Kit) repoModel.Kit {
+	components := make([]repoModel.KitComponent, 0, len(kit.Components))
+	for _, component := range kit.Components {
+		components = append(components, repoModel.KitComponent{
+			PartUuid: component.PartUuid,
+			Quantity: component.Quantity,
+		})
+	}
+
+	return repoModel.Kit{
+		Uuid:          kit.Uuid,
+		Name:          kit.Name,
+		Description:   kit.Description,
+		Components:    components,
+		PriceOverride: kit.PriceOverride,
+		CreatedAt:     kit.CreatedAt,
+		UpdatedAt:     kit.UpdatedAt,
+	}
+}
+
+func ToModelKit(kit repoModel.Kit) model.Kit {
+	components := make([]model.KitComponent, 0, len(kit.Components))
+	for _, component := range kit.Components {
+		components = append(components, model.KitComponent{
+			PartUuid: component.PartUuid,
+			Quantity: component.Quantity,
+		})
+	}
+
+	return model.Kit{
+		Uuid:          kit.Uuid,
+		Name:          kit.Name,
+		Description:   kit.Description,
+		Components:    components,
+		PriceOverride: kit.PriceOverride,
+		CreatedAt:     kit.CreatedAt,
+		UpdatedAt:     kit.UpdatedAt,
+	}
+}
@@ -7,35 +7,37 @@ import (
 
 func ToRepoPart(part model.Part) repoModel.Part {
 	return repoModel.Part{
-		Uuid:          part.Uuid,
-		Name:          part.Name,
-		Description:   part.Description,
-		Price:         part.Price,
-		StockQuantity: part.StockQuantity,
-		Category:      ToRepoCategory(part.Category),
-		Dimensions:    ToRepoDimensions(part.Dimensions),
-		Manufacturer:  ToRepoManufacturer(part.Manufacturer),
-		Tags:          part.Tags,
-		Metadata:      ToRepoValueMap(part.Metadata),
-		CreatedAt:     part.CreatedAt,
-		UpdatedAt:     part.UpdatedAt,
+		Uuid:           part.Uuid,
+		Name:           part.Name,
+		Description:    part.Description,
+		Price:          part.Price,
+		StockQuantity:  part.StockQuantity,
+		WarehouseStock: part.WarehouseStock,
+		Category:       ToRepoCategory(part.Category),
+		Dimensions:     ToRepoDimensions(part.Dimensions),
+		Manufacturer:   ToRepoManufacturer(part.Manufacturer),
+		Tags:           part.Tags,
+		Metadata:       ToRepoValueMap(part.Metadata),
+		CreatedAt:      part.CreatedAt,
+		UpdatedAt:      part.UpdatedAt,
 	}
 }
 
 func ToModelPart(part repoModel.Part) model.Part {
 	return model.Part{
-		Uuid:          part.Uuid,
-		Name:          part.Name,
-		Description:   part.Description,
-		Price:         part.Price,
-		StockQuantity: part.StockQuantity,
-		Category:      ToModelCategory(part.Category),
-		Dimensions:    ToModelDimensions(part.Dimensions),
-		Manufacturer:  ToModelManufacturer(part.Manufacturer),
-		Tags:          part.Tags,
-		Metadata:      ToModelValueMap(part.Metadata),
-		CreatedAt:     part.CreatedAt,
-		UpdatedAt:     part.UpdatedAt,
+		Uuid:           part.Uuid,
+		Name:           part.Name,
+		Description:    part.Description,
+		Price:          part.Price,
+		StockQuantity:  part.StockQuantity,
+		WarehouseStock: part.WarehouseStock,
+		Category:       ToModelCategory(part.Category),
+		Dimensions:     ToModelDimensions(part.Dimensions),
+		Manufacturer:   ToModelManufacturer(part.Manufacturer),
+		Tags:           part.Tags,
+		Metadata:       ToModelValueMap(part.Metadata),
+		CreatedAt:      part.CreatedAt,
+		UpdatedAt:      part.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func ToRepoPartPrice(price model.PartPrice) repoModel.PartPrice {
+	return repoModel.PartPrice{
+		PartUuid:      price.PartUuid,
+		Price:         price.Price,
+		EffectiveFrom: price.EffectiveFrom,
+		CreatedAt:     price.CreatedAt,
+	}
+}
+
+func ToModelPartPrice(price repoModel.PartPrice) model.PartPrice {
+	return model.PartPrice{
+		PartUuid:      price.PartUuid,
+		Price:         price.Price,
+		EffectiveFrom: price.EffectiveFrom,
+		CreatedAt:     price.CreatedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func ToRepoStockMovement(movement model.StockMovement) repoModel.StockMovement {
+	return repoModel.StockMovement{
+		Uuid:                movement.Uuid,
+		PartUuid:            movement.PartUuid,
+		Delta:               movement.Delta,
+		Reason:              repoModel.StockMovementReason(movement.Reason),
+		ReferenceID:         movement.ReferenceID,
+		Actor:               movement.Actor,
+		StockAfter:          movement.StockAfter,
+		WarehouseCode:       movement.WarehouseCode,
+		WarehouseStockAfter: movement.WarehouseStockAfter,
+		CreatedAt:           movement.CreatedAt,
+	}
+}
+
+func ToModelStockMovement(movement repoModel.StockMovement) model.StockMovement {
+	return model.StockMovement{
+		Uuid:                movement.Uuid,
+		PartUuid:            movement.PartUuid,
+		Delta:               movement.Delta,
+		Reason:              model.StockMovementReason(movement.Reason),
+		ReferenceID:         movement.ReferenceID,
+		Actor:               movement.Actor,
+		StockAfter:          movement.StockAfter,
+		WarehouseCode:       movement.WarehouseCode,
+		WarehouseStockAfter: movement.WarehouseStockAfter,
+		CreatedAt:           movement.CreatedAt,
+	}
+}
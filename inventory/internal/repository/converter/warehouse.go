@@ -0,0 +1,24 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func ToRepoWarehouse(warehouse model.Warehouse) repoModel.Warehouse {
+	return repoModel.Warehouse{
+		Code:      warehouse.Code,
+		Name:      warehouse.Name,
+		Location:  warehouse.Location,
+		CreatedAt: warehouse.CreatedAt,
+	}
+}
+
+func ToModelWarehouse(warehouse repoModel.Warehouse) model.Warehouse {
+	return model.Warehouse{
+		Code:      warehouse.Code,
+		Name:      warehouse.Name,
+		Location:  warehouse.Location,
+		CreatedAt: warehouse.CreatedAt,
+	}
+}
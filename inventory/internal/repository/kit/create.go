@@ -0,0 +1,13 @@
+package kit
+
+import (
+	"context"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreateKit(ctx context.Context, kit model.Kit) error {
+	_, err := r.collection.InsertOne(ctx, repoConverter.ToRepoKit(kit))
+	return err
+}
@@ -0,0 +1,54 @@
+package kit
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestCreateAndGetKit() {
+	override := 990.5
+	kit := newKit()
+	kit.PriceOverride = &override
+
+	s.Require().NoError(s.repository.CreateKit(s.ctx, kit))
+
+	res, err := s.repository.GetKit(s.ctx, kit.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(kit.Name, res.Name)
+	s.Require().Equal(kit.Components, res.Components)
+	s.Require().NotNil(res.PriceOverride)
+	s.Require().Equal(override, *res.PriceOverride)
+}
+
+func (s *RepositorySuite) TestCreateKitWithoutOverride() {
+	kit := newKit()
+
+	s.Require().NoError(s.repository.CreateKit(s.ctx, kit))
+
+	res, err := s.repository.GetKit(s.ctx, kit.Uuid)
+	s.Require().NoError(err)
+	s.Require().Nil(res.PriceOverride)
+}
+
+func (s *RepositorySuite) TestGetKitNotFound() {
+	_, err := s.repository.GetKit(s.ctx, gofakeit.UUID())
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+}
+
+func newKit() model.Kit {
+	now := time.Now().Truncate(time.Millisecond)
+	return model.Kit{
+		Uuid:        gofakeit.UUID(),
+		Name:        gofakeit.Name(),
+		Description: gofakeit.Sentence(),
+		Components: []model.KitComponent{
+			{PartUuid: gofakeit.UUID(), Quantity: 1},
+			{PartUuid: gofakeit.UUID(), Quantity: 4},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
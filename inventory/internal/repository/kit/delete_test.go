@@ -0,0 +1,22 @@
+package kit
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestDeleteKit() {
+	kit := newKit()
+	s.Require().NoError(s.repository.CreateKit(s.ctx, kit))
+
+	s.Require().NoError(s.repository.DeleteKit(s.ctx, kit.Uuid))
+
+	_, err := s.repository.GetKit(s.ctx, kit.Uuid)
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+}
+
+func (s *RepositorySuite) TestDeleteKitNotFound() {
+	err := s.repository.DeleteKit(s.ctx, gofakeit.UUID())
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+}
@@ -0,0 +1,26 @@
+package kit
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (r *repository) GetKit(ctx context.Context, uuid string) (model.Kit, error) {
+	var kit repoModel.Kit
+	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&kit)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Kit{}, model.ErrKitNotFound
+		}
+		return model.Kit{}, err
+	}
+
+	return repoConverter.ToModelKit(kit), nil
+}
@@ -0,0 +1,43 @@
+package kit
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) ListKits(ctx context.Context, uuids []string) ([]model.Kit, error) {
+	query := bson.M{}
+	if len(uuids) > 0 {
+		query["uuid"] = bson.M{"$in": uuids}
+	}
+
+	cursor, err := r.collection.Find(ctx, query, options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "uuid", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoKits []repoModel.Kit
+	if err = cursor.All(ctx, &repoKits); err != nil {
+		return nil, err
+	}
+
+	kits := make([]model.Kit, 0, len(repoKits))
+	for _, repoKit := range repoKits {
+		kits = append(kits, repoConverter.ToModelKit(repoKit))
+	}
+
+	return kits, nil
+}
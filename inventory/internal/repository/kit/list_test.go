@@ -0,0 +1,20 @@
+package kit
+
+func (s *RepositorySuite) TestListKits() {
+	first, second, third := newKit(), newKit(), newKit()
+	first.Name, second.Name, third.Name = "B kit", "A kit", "C kit"
+	s.Require().NoError(s.repository.CreateKit(s.ctx, first))
+	s.Require().NoError(s.repository.CreateKit(s.ctx, second))
+	s.Require().NoError(s.repository.CreateKit(s.ctx, third))
+
+	all, err := s.repository.ListKits(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Len(all, 3)
+	s.Require().Equal(second.Uuid, all[0].Uuid)
+
+	selected, err := s.repository.ListKits(s.ctx, []string{first.Uuid, third.Uuid})
+	s.Require().NoError(err)
+	s.Require().Len(selected, 2)
+	s.Require().Equal(first.Uuid, selected[0].Uuid)
+	s.Require().Equal(third.Uuid, selected[1].Uuid)
+}
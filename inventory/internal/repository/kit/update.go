@@ -0,0 +1,41 @@
+package kit
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+// UpdateKit заменяет редактируемые поля комплекта, created_at не меняется.
+func (r *repository) UpdateKit(ctx context.Context, kit model.Kit) error {
+	repoKit := repoConverter.ToRepoKit(kit)
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":        repoKit.Name,
+			"description": repoKit.Description,
+			"components":  repoKit.Components,
+			"updated_at":  repoKit.UpdatedAt,
+		},
+	}
+	// Без override цена снова считается по составу
+	if repoKit.PriceOverride != nil {
+		update["$set"].(bson.M)["price_override"] = *repoKit.PriceOverride
+	} else {
+		update["$unset"] = bson.M{"price_override": ""}
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"uuid": kit.Uuid}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return model.ErrKitNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package kit
+
+import (
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestUpdateKitClearsOverride() {
+	override := 100.0
+	kit := newKit()
+	kit.PriceOverride = &override
+	s.Require().NoError(s.repository.CreateKit(s.ctx, kit))
+
+	kit.Name = "Updated"
+	kit.Components = kit.Components[:1]
+	kit.PriceOverride = nil
+	kit.UpdatedAt = time.Now().Add(time.Minute).Truncate(time.Millisecond)
+	s.Require().NoError(s.repository.UpdateKit(s.ctx, kit))
+
+	res, err := s.repository.GetKit(s.ctx, kit.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal("Updated", res.Name)
+	s.Require().Len(res.Components, 1)
+	s.Require().Nil(res.PriceOverride)
+	s.Require().True(res.CreatedAt.Equal(kit.CreatedAt))
+}
+
+func (s *RepositorySuite) TestUpdateKitNotFound() {
+	err := s.repository.UpdateKit(s.ctx, newKit())
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+}
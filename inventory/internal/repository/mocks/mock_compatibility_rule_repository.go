@@ -0,0 +1,192 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompatibilityRuleRepository is an autogenerated mock type for the CompatibilityRuleRepository type
+type CompatibilityRuleRepository struct {
+	mock.Mock
+}
+
+type CompatibilityRuleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompatibilityRuleRepository) EXPECT() *CompatibilityRuleRepository_Expecter {
+	return &CompatibilityRuleRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateRule provides a mock function with given fields: ctx, rule
+func (_m *CompatibilityRuleRepository) CreateRule(ctx context.Context, rule model.CompatibilityRule) error {
+	ret := _m.Called(ctx, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.CompatibilityRule) error); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompatibilityRuleRepository_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
+type CompatibilityRuleRepository_CreateRule_Call struct {
+	*mock.Call
+}
+
+// CreateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule model.CompatibilityRule
+func (_e *CompatibilityRuleRepository_Expecter) CreateRule(ctx interface{}, rule interface{}) *CompatibilityRuleRepository_CreateRule_Call {
+	return &CompatibilityRuleRepository_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, rule)}
+}
+
+func (_c *CompatibilityRuleRepository_CreateRule_Call) Run(run func(ctx context.Context, rule model.CompatibilityRule)) *CompatibilityRuleRepository_CreateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.CompatibilityRule))
+	})
+	return _c
+}
+
+func (_c *CompatibilityRuleRepository_CreateRule_Call) Return(_a0 error) *CompatibilityRuleRepository_CreateRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompatibilityRuleRepository_CreateRule_Call) RunAndReturn(run func(context.Context, model.CompatibilityRule) error) *CompatibilityRuleRepository_CreateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRule provides a mock function with given fields: ctx, uuid
+func (_m *CompatibilityRuleRepository) DeleteRule(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompatibilityRuleRepository_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type CompatibilityRuleRepository_DeleteRule_Call struct {
+	*mock.Call
+}
+
+// DeleteRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *CompatibilityRuleRepository_Expecter) DeleteRule(ctx interface{}, uuid interface{}) *CompatibilityRuleRepository_DeleteRule_Call {
+	return &CompatibilityRuleRepository_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, uuid)}
+}
+
+func (_c *CompatibilityRuleRepository_DeleteRule_Call) Run(run func(ctx context.Context, uuid string)) *CompatibilityRuleRepository_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CompatibilityRuleRepository_DeleteRule_Call) Return(_a0 error) *CompatibilityRuleRepository_DeleteRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompatibilityRuleRepository_DeleteRule_Call) RunAndReturn(run func(context.Context, string) error) *CompatibilityRuleRepository_DeleteRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRules provides a mock function with given fields: ctx
+func (_m *CompatibilityRuleRepository) ListRules(ctx context.Context) ([]model.CompatibilityRule, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRules")
+	}
+
+	var r0 []model.CompatibilityRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.CompatibilityRule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.CompatibilityRule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CompatibilityRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompatibilityRuleRepository_ListRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRules'
+type CompatibilityRuleRepository_ListRules_Call struct {
+	*mock.Call
+}
+
+// ListRules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CompatibilityRuleRepository_Expecter) ListRules(ctx interface{}) *CompatibilityRuleRepository_ListRules_Call {
+	return &CompatibilityRuleRepository_ListRules_Call{Call: _e.mock.On("ListRules", ctx)}
+}
+
+func (_c *CompatibilityRuleRepository_ListRules_Call) Run(run func(ctx context.Context)) *CompatibilityRuleRepository_ListRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CompatibilityRuleRepository_ListRules_Call) Return(_a0 []model.CompatibilityRule, _a1 error) *CompatibilityRuleRepository_ListRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CompatibilityRuleRepository_ListRules_Call) RunAndReturn(run func(context.Context) ([]model.CompatibilityRule, error)) *CompatibilityRuleRepository_ListRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompatibilityRuleRepository creates a new instance of CompatibilityRuleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompatibilityRuleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompatibilityRuleRepository {
+	mock := &CompatibilityRuleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,297 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KitRepository is an autogenerated mock type for the KitRepository type
+type KitRepository struct {
+	mock.Mock
+}
+
+type KitRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KitRepository) EXPECT() *KitRepository_Expecter {
+	return &KitRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateKit provides a mock function with given fields: ctx, kit
+func (_m *KitRepository) CreateKit(ctx context.Context, kit model.Kit) error {
+	ret := _m.Called(ctx, kit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Kit) error); ok {
+		r0 = rf(ctx, kit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KitRepository_CreateKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKit'
+type KitRepository_CreateKit_Call struct {
+	*mock.Call
+}
+
+// CreateKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kit model.Kit
+func (_e *KitRepository_Expecter) CreateKit(ctx interface{}, kit interface{}) *KitRepository_CreateKit_Call {
+	return &KitRepository_CreateKit_Call{Call: _e.mock.On("CreateKit", ctx, kit)}
+}
+
+func (_c *KitRepository_CreateKit_Call) Run(run func(ctx context.Context, kit model.Kit)) *KitRepository_CreateKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Kit))
+	})
+	return _c
+}
+
+func (_c *KitRepository_CreateKit_Call) Return(_a0 error) *KitRepository_CreateKit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KitRepository_CreateKit_Call) RunAndReturn(run func(context.Context, model.Kit) error) *KitRepository_CreateKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteKit provides a mock function with given fields: ctx, uuid
+func (_m *KitRepository) DeleteKit(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KitRepository_DeleteKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteKit'
+type KitRepository_DeleteKit_Call struct {
+	*mock.Call
+}
+
+// DeleteKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *KitRepository_Expecter) DeleteKit(ctx interface{}, uuid interface{}) *KitRepository_DeleteKit_Call {
+	return &KitRepository_DeleteKit_Call{Call: _e.mock.On("DeleteKit", ctx, uuid)}
+}
+
+func (_c *KitRepository_DeleteKit_Call) Run(run func(ctx context.Context, uuid string)) *KitRepository_DeleteKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KitRepository_DeleteKit_Call) Return(_a0 error) *KitRepository_DeleteKit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KitRepository_DeleteKit_Call) RunAndReturn(run func(context.Context, string) error) *KitRepository_DeleteKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKit provides a mock function with given fields: ctx, uuid
+func (_m *KitRepository) GetKit(ctx context.Context, uuid string) (model.Kit, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKit")
+	}
+
+	var r0 model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Kit, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Kit); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Get(0).(model.Kit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KitRepository_GetKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKit'
+type KitRepository_GetKit_Call struct {
+	*mock.Call
+}
+
+// GetKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *KitRepository_Expecter) GetKit(ctx interface{}, uuid interface{}) *KitRepository_GetKit_Call {
+	return &KitRepository_GetKit_Call{Call: _e.mock.On("GetKit", ctx, uuid)}
+}
+
+func (_c *KitRepository_GetKit_Call) Run(run func(ctx context.Context, uuid string)) *KitRepository_GetKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KitRepository_GetKit_Call) Return(_a0 model.Kit, _a1 error) *KitRepository_GetKit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KitRepository_GetKit_Call) RunAndReturn(run func(context.Context, string) (model.Kit, error)) *KitRepository_GetKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKits provides a mock function with given fields: ctx, uuids
+func (_m *KitRepository) ListKits(ctx context.Context, uuids []string) ([]model.Kit, error) {
+	ret := _m.Called(ctx, uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKits")
+	}
+
+	var r0 []model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]model.Kit, error)); ok {
+		return rf(ctx, uuids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.Kit); ok {
+		r0 = rf(ctx, uuids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Kit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, uuids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KitRepository_ListKits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKits'
+type KitRepository_ListKits_Call struct {
+	*mock.Call
+}
+
+// ListKits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuids []string
+func (_e *KitRepository_Expecter) ListKits(ctx interface{}, uuids interface{}) *KitRepository_ListKits_Call {
+	return &KitRepository_ListKits_Call{Call: _e.mock.On("ListKits", ctx, uuids)}
+}
+
+func (_c *KitRepository_ListKits_Call) Run(run func(ctx context.Context, uuids []string)) *KitRepository_ListKits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *KitRepository_ListKits_Call) Return(_a0 []model.Kit, _a1 error) *KitRepository_ListKits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KitRepository_ListKits_Call) RunAndReturn(run func(context.Context, []string) ([]model.Kit, error)) *KitRepository_ListKits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateKit provides a mock function with given fields: ctx, kit
+func (_m *KitRepository) UpdateKit(ctx context.Context, kit model.Kit) error {
+	ret := _m.Called(ctx, kit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Kit) error); ok {
+		r0 = rf(ctx, kit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KitRepository_UpdateKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateKit'
+type KitRepository_UpdateKit_Call struct {
+	*mock.Call
+}
+
+// UpdateKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kit model.Kit
+func (_e *KitRepository_Expecter) UpdateKit(ctx interface{}, kit interface{}) *KitRepository_UpdateKit_Call {
+	return &KitRepository_UpdateKit_Call{Call: _e.mock.On("UpdateKit", ctx, kit)}
+}
+
+func (_c *KitRepository_UpdateKit_Call) Run(run func(ctx context.Context, kit model.Kit)) *KitRepository_UpdateKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Kit))
+	})
+	return _c
+}
+
+func (_c *KitRepository_UpdateKit_Call) Return(_a0 error) *KitRepository_UpdateKit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KitRepository_UpdateKit_Call) RunAndReturn(run func(context.Context, model.Kit) error) *KitRepository_UpdateKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKitRepository creates a new instance of KitRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKitRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KitRepository {
+	mock := &KitRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,302 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PartCacheRepository is an autogenerated mock type for the PartCacheRepository type
+type PartCacheRepository struct {
+	mock.Mock
+}
+
+type PartCacheRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartCacheRepository) EXPECT() *PartCacheRepository_Expecter {
+	return &PartCacheRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetPart provides a mock function with given fields: ctx, uuid
+func (_m *PartCacheRepository) GetPart(ctx context.Context, uuid string) (model.Part, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Part, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Part); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartCacheRepository_GetPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPart'
+type PartCacheRepository_GetPart_Call struct {
+	*mock.Call
+}
+
+// GetPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *PartCacheRepository_Expecter) GetPart(ctx interface{}, uuid interface{}) *PartCacheRepository_GetPart_Call {
+	return &PartCacheRepository_GetPart_Call{Call: _e.mock.On("GetPart", ctx, uuid)}
+}
+
+func (_c *PartCacheRepository_GetPart_Call) Run(run func(ctx context.Context, uuid string)) *PartCacheRepository_GetPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartCacheRepository_GetPart_Call) Return(_a0 model.Part, _a1 error) *PartCacheRepository_GetPart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartCacheRepository_GetPart_Call) RunAndReturn(run func(context.Context, string) (model.Part, error)) *PartCacheRepository_GetPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetParts provides a mock function with given fields: ctx, filter
+func (_m *PartCacheRepository) GetParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParts")
+	}
+
+	var r0 []model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) ([]model.Part, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter) []model.Part); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartCacheRepository_GetParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParts'
+type PartCacheRepository_GetParts_Call struct {
+	*mock.Call
+}
+
+// GetParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+func (_e *PartCacheRepository_Expecter) GetParts(ctx interface{}, filter interface{}) *PartCacheRepository_GetParts_Call {
+	return &PartCacheRepository_GetParts_Call{Call: _e.mock.On("GetParts", ctx, filter)}
+}
+
+func (_c *PartCacheRepository_GetParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter)) *PartCacheRepository_GetParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter))
+	})
+	return _c
+}
+
+func (_c *PartCacheRepository_GetParts_Call) Return(_a0 []model.Part, _a1 error) *PartCacheRepository_GetParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartCacheRepository_GetParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter) ([]model.Part, error)) *PartCacheRepository_GetParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function with given fields: ctx, uuid
+func (_m *PartCacheRepository) Invalidate(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartCacheRepository_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type PartCacheRepository_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *PartCacheRepository_Expecter) Invalidate(ctx interface{}, uuid interface{}) *PartCacheRepository_Invalidate_Call {
+	return &PartCacheRepository_Invalidate_Call{Call: _e.mock.On("Invalidate", ctx, uuid)}
+}
+
+func (_c *PartCacheRepository_Invalidate_Call) Run(run func(ctx context.Context, uuid string)) *PartCacheRepository_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartCacheRepository_Invalidate_Call) Return(_a0 error) *PartCacheRepository_Invalidate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartCacheRepository_Invalidate_Call) RunAndReturn(run func(context.Context, string) error) *PartCacheRepository_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPart provides a mock function with given fields: ctx, part, ttl
+func (_m *PartCacheRepository) SetPart(ctx context.Context, part model.Part, ttl time.Duration) error {
+	ret := _m.Called(ctx, part, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part, time.Duration) error); ok {
+		r0 = rf(ctx, part, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartCacheRepository_SetPart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPart'
+type PartCacheRepository_SetPart_Call struct {
+	*mock.Call
+}
+
+// SetPart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+//   - ttl time.Duration
+func (_e *PartCacheRepository_Expecter) SetPart(ctx interface{}, part interface{}, ttl interface{}) *PartCacheRepository_SetPart_Call {
+	return &PartCacheRepository_SetPart_Call{Call: _e.mock.On("SetPart", ctx, part, ttl)}
+}
+
+func (_c *PartCacheRepository_SetPart_Call) Run(run func(ctx context.Context, part model.Part, ttl time.Duration)) *PartCacheRepository_SetPart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *PartCacheRepository_SetPart_Call) Return(_a0 error) *PartCacheRepository_SetPart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartCacheRepository_SetPart_Call) RunAndReturn(run func(context.Context, model.Part, time.Duration) error) *PartCacheRepository_SetPart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetParts provides a mock function with given fields: ctx, filter, parts, ttl
+func (_m *PartCacheRepository) SetParts(ctx context.Context, filter model.PartsFilter, parts []model.Part, ttl time.Duration) error {
+	ret := _m.Called(ctx, filter, parts, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, []model.Part, time.Duration) error); ok {
+		r0 = rf(ctx, filter, parts, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartCacheRepository_SetParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetParts'
+type PartCacheRepository_SetParts_Call struct {
+	*mock.Call
+}
+
+// SetParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+//   - parts []model.Part
+//   - ttl time.Duration
+func (_e *PartCacheRepository_Expecter) SetParts(ctx interface{}, filter interface{}, parts interface{}, ttl interface{}) *PartCacheRepository_SetParts_Call {
+	return &PartCacheRepository_SetParts_Call{Call: _e.mock.On("SetParts", ctx, filter, parts, ttl)}
+}
+
+func (_c *PartCacheRepository_SetParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter, parts []model.Part, ttl time.Duration)) *PartCacheRepository_SetParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].([]model.Part), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *PartCacheRepository_SetParts_Call) Return(_a0 error) *PartCacheRepository_SetParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartCacheRepository_SetParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter, []model.Part, time.Duration) error) *PartCacheRepository_SetParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartCacheRepository creates a new instance of PartCacheRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartCacheRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartCacheRepository {
+	mock := &PartCacheRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,380 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PartPriceRepository is an autogenerated mock type for the PartPriceRepository type
+type PartPriceRepository struct {
+	mock.Mock
+}
+
+type PartPriceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartPriceRepository) EXPECT() *PartPriceRepository_Expecter {
+	return &PartPriceRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddPrice provides a mock function with given fields: ctx, price
+func (_m *PartPriceRepository) AddPrice(ctx context.Context, price model.PartPrice) error {
+	ret := _m.Called(ctx, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartPrice) error); ok {
+		r0 = rf(ctx, price)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartPriceRepository_AddPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPrice'
+type PartPriceRepository_AddPrice_Call struct {
+	*mock.Call
+}
+
+// AddPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - price model.PartPrice
+func (_e *PartPriceRepository_Expecter) AddPrice(ctx interface{}, price interface{}) *PartPriceRepository_AddPrice_Call {
+	return &PartPriceRepository_AddPrice_Call{Call: _e.mock.On("AddPrice", ctx, price)}
+}
+
+func (_c *PartPriceRepository_AddPrice_Call) Run(run func(ctx context.Context, price model.PartPrice)) *PartPriceRepository_AddPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartPrice))
+	})
+	return _c
+}
+
+func (_c *PartPriceRepository_AddPrice_Call) Return(_a0 error) *PartPriceRepository_AddPrice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartPriceRepository_AddPrice_Call) RunAndReturn(run func(context.Context, model.PartPrice) error) *PartPriceRepository_AddPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BackfillPrices provides a mock function with given fields: ctx
+func (_m *PartPriceRepository) BackfillPrices(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillPrices")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceRepository_BackfillPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillPrices'
+type PartPriceRepository_BackfillPrices_Call struct {
+	*mock.Call
+}
+
+// BackfillPrices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PartPriceRepository_Expecter) BackfillPrices(ctx interface{}) *PartPriceRepository_BackfillPrices_Call {
+	return &PartPriceRepository_BackfillPrices_Call{Call: _e.mock.On("BackfillPrices", ctx)}
+}
+
+func (_c *PartPriceRepository_BackfillPrices_Call) Run(run func(ctx context.Context)) *PartPriceRepository_BackfillPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PartPriceRepository_BackfillPrices_Call) Return(_a0 int64, _a1 error) *PartPriceRepository_BackfillPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceRepository_BackfillPrices_Call) RunAndReturn(run func(context.Context) (int64, error)) *PartPriceRepository_BackfillPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEffectivePrices provides a mock function with given fields: ctx, partUuids, at
+func (_m *PartPriceRepository) GetEffectivePrices(ctx context.Context, partUuids []string, at time.Time) (map[string]model.PartPrice, error) {
+	ret := _m.Called(ctx, partUuids, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEffectivePrices")
+	}
+
+	var r0 map[string]model.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) (map[string]model.PartPrice, error)); ok {
+		return rf(ctx, partUuids, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) map[string]model.PartPrice); ok {
+		r0 = rf(ctx, partUuids, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]model.PartPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, partUuids, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceRepository_GetEffectivePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEffectivePrices'
+type PartPriceRepository_GetEffectivePrices_Call struct {
+	*mock.Call
+}
+
+// GetEffectivePrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuids []string
+//   - at time.Time
+func (_e *PartPriceRepository_Expecter) GetEffectivePrices(ctx interface{}, partUuids interface{}, at interface{}) *PartPriceRepository_GetEffectivePrices_Call {
+	return &PartPriceRepository_GetEffectivePrices_Call{Call: _e.mock.On("GetEffectivePrices", ctx, partUuids, at)}
+}
+
+func (_c *PartPriceRepository_GetEffectivePrices_Call) Run(run func(ctx context.Context, partUuids []string, at time.Time)) *PartPriceRepository_GetEffectivePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PartPriceRepository_GetEffectivePrices_Call) Return(_a0 map[string]model.PartPrice, _a1 error) *PartPriceRepository_GetEffectivePrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceRepository_GetEffectivePrices_Call) RunAndReturn(run func(context.Context, []string, time.Time) (map[string]model.PartPrice, error)) *PartPriceRepository_GetEffectivePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextPriceChange provides a mock function with given fields: ctx, partUuids, after
+func (_m *PartPriceRepository) GetNextPriceChange(ctx context.Context, partUuids []string, after time.Time) (time.Time, error) {
+	ret := _m.Called(ctx, partUuids, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextPriceChange")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) (time.Time, error)); ok {
+		return rf(ctx, partUuids, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) time.Time); ok {
+		r0 = rf(ctx, partUuids, after)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, partUuids, after)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceRepository_GetNextPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextPriceChange'
+type PartPriceRepository_GetNextPriceChange_Call struct {
+	*mock.Call
+}
+
+// GetNextPriceChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuids []string
+//   - after time.Time
+func (_e *PartPriceRepository_Expecter) GetNextPriceChange(ctx interface{}, partUuids interface{}, after interface{}) *PartPriceRepository_GetNextPriceChange_Call {
+	return &PartPriceRepository_GetNextPriceChange_Call{Call: _e.mock.On("GetNextPriceChange", ctx, partUuids, after)}
+}
+
+func (_c *PartPriceRepository_GetNextPriceChange_Call) Run(run func(ctx context.Context, partUuids []string, after time.Time)) *PartPriceRepository_GetNextPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PartPriceRepository_GetNextPriceChange_Call) Return(_a0 time.Time, _a1 error) *PartPriceRepository_GetNextPriceChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceRepository_GetNextPriceChange_Call) RunAndReturn(run func(context.Context, []string, time.Time) (time.Time, error)) *PartPriceRepository_GetNextPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceAt provides a mock function with given fields: ctx, partUuid, at
+func (_m *PartPriceRepository) GetPriceAt(ctx context.Context, partUuid string, at time.Time) (model.PartPrice, error) {
+	ret := _m.Called(ctx, partUuid, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceAt")
+	}
+
+	var r0 model.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (model.PartPrice, error)); ok {
+		return rf(ctx, partUuid, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) model.PartPrice); ok {
+		r0 = rf(ctx, partUuid, at)
+	} else {
+		r0 = ret.Get(0).(model.PartPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, partUuid, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceRepository_GetPriceAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceAt'
+type PartPriceRepository_GetPriceAt_Call struct {
+	*mock.Call
+}
+
+// GetPriceAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid string
+//   - at time.Time
+func (_e *PartPriceRepository_Expecter) GetPriceAt(ctx interface{}, partUuid interface{}, at interface{}) *PartPriceRepository_GetPriceAt_Call {
+	return &PartPriceRepository_GetPriceAt_Call{Call: _e.mock.On("GetPriceAt", ctx, partUuid, at)}
+}
+
+func (_c *PartPriceRepository_GetPriceAt_Call) Run(run func(ctx context.Context, partUuid string, at time.Time)) *PartPriceRepository_GetPriceAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PartPriceRepository_GetPriceAt_Call) Return(_a0 model.PartPrice, _a1 error) *PartPriceRepository_GetPriceAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceRepository_GetPriceAt_Call) RunAndReturn(run func(context.Context, string, time.Time) (model.PartPrice, error)) *PartPriceRepository_GetPriceAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPrices provides a mock function with given fields: ctx, partUuid
+func (_m *PartPriceRepository) ListPrices(ctx context.Context, partUuid string) ([]model.PartPrice, error) {
+	ret := _m.Called(ctx, partUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPrices")
+	}
+
+	var r0 []model.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.PartPrice, error)); ok {
+		return rf(ctx, partUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.PartPrice); ok {
+		r0 = rf(ctx, partUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PartPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, partUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceRepository_ListPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrices'
+type PartPriceRepository_ListPrices_Call struct {
+	*mock.Call
+}
+
+// ListPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid string
+func (_e *PartPriceRepository_Expecter) ListPrices(ctx interface{}, partUuid interface{}) *PartPriceRepository_ListPrices_Call {
+	return &PartPriceRepository_ListPrices_Call{Call: _e.mock.On("ListPrices", ctx, partUuid)}
+}
+
+func (_c *PartPriceRepository_ListPrices_Call) Run(run func(ctx context.Context, partUuid string)) *PartPriceRepository_ListPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartPriceRepository_ListPrices_Call) Return(_a0 []model.PartPrice, _a1 error) *PartPriceRepository_ListPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceRepository_ListPrices_Call) RunAndReturn(run func(context.Context, string) ([]model.PartPrice, error)) *PartPriceRepository_ListPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartPriceRepository creates a new instance of PartPriceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartPriceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartPriceRepository {
+	mock := &PartPriceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -25,6 +25,100 @@ func (_m *PartRepository) EXPECT() *PartRepository_Expecter {
 	return &PartRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *PartRepository) CreatePart(ctx context.Context, part model.Part) error {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) error); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type PartRepository_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+func (_e *PartRepository_Expecter) CreatePart(ctx interface{}, part interface{}) *PartRepository_CreatePart_Call {
+	return &PartRepository_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *PartRepository_CreatePart_Call) Run(run func(ctx context.Context, part model.Part)) *PartRepository_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part))
+	})
+	return _c
+}
+
+func (_c *PartRepository_CreatePart_Call) Return(_a0 error) *PartRepository_CreatePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_CreatePart_Call) RunAndReturn(run func(context.Context, model.Part) error) *PartRepository_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid
+func (_m *PartRepository) DeletePart(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type PartRepository_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *PartRepository_Expecter) DeletePart(ctx interface{}, uuid interface{}) *PartRepository_DeletePart_Call {
+	return &PartRepository_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid)}
+}
+
+func (_c *PartRepository_DeletePart_Call) Run(run func(ctx context.Context, uuid string)) *PartRepository_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartRepository_DeletePart_Call) Return(_a0 error) *PartRepository_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_DeletePart_Call) RunAndReturn(run func(context.Context, string) error) *PartRepository_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *PartRepository) GetPart(ctx context.Context, uuid string) (model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -82,6 +176,64 @@ func (_c *PartRepository_GetPart_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// GetPartFacets provides a mock function with given fields: ctx, filter, topTagsLimit
+func (_m *PartRepository) GetPartFacets(ctx context.Context, filter model.PartsFilter, topTagsLimit int) (model.PartFacets, error) {
+	ret := _m.Called(ctx, filter, topTagsLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartFacets")
+	}
+
+	var r0 model.PartFacets
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, int) (model.PartFacets, error)); ok {
+		return rf(ctx, filter, topTagsLimit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, int) model.PartFacets); ok {
+		r0 = rf(ctx, filter, topTagsLimit)
+	} else {
+		r0 = ret.Get(0).(model.PartFacets)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter, int) error); ok {
+		r1 = rf(ctx, filter, topTagsLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_GetPartFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartFacets'
+type PartRepository_GetPartFacets_Call struct {
+	*mock.Call
+}
+
+// GetPartFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+//   - topTagsLimit int
+func (_e *PartRepository_Expecter) GetPartFacets(ctx interface{}, filter interface{}, topTagsLimit interface{}) *PartRepository_GetPartFacets_Call {
+	return &PartRepository_GetPartFacets_Call{Call: _e.mock.On("GetPartFacets", ctx, filter, topTagsLimit)}
+}
+
+func (_c *PartRepository_GetPartFacets_Call) Run(run func(ctx context.Context, filter model.PartsFilter, topTagsLimit int)) *PartRepository_GetPartFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *PartRepository_GetPartFacets_Call) Return(_a0 model.PartFacets, _a1 error) *PartRepository_GetPartFacets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_GetPartFacets_Call) RunAndReturn(run func(context.Context, model.PartsFilter, int) (model.PartFacets, error)) *PartRepository_GetPartFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx
 func (_m *PartRepository) ListParts(ctx context.Context) ([]model.Part, error) {
 	ret := _m.Called(ctx)
@@ -140,6 +292,102 @@ func (_c *PartRepository_ListParts_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
+// StreamParts provides a mock function with given fields: ctx, filter, chunkSize, fn
+func (_m *PartRepository) StreamParts(ctx context.Context, filter model.PartsFilter, chunkSize int, fn func([]model.Part) error) error {
+	ret := _m.Called(ctx, filter, chunkSize, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, int, func([]model.Part) error) error); ok {
+		r0 = rf(ctx, filter, chunkSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_StreamParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamParts'
+type PartRepository_StreamParts_Call struct {
+	*mock.Call
+}
+
+// StreamParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+//   - chunkSize int
+//   - fn func([]model.Part) error
+func (_e *PartRepository_Expecter) StreamParts(ctx interface{}, filter interface{}, chunkSize interface{}, fn interface{}) *PartRepository_StreamParts_Call {
+	return &PartRepository_StreamParts_Call{Call: _e.mock.On("StreamParts", ctx, filter, chunkSize, fn)}
+}
+
+func (_c *PartRepository_StreamParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter, chunkSize int, fn func([]model.Part) error)) *PartRepository_StreamParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(int), args[3].(func([]model.Part) error))
+	})
+	return _c
+}
+
+func (_c *PartRepository_StreamParts_Call) Return(_a0 error) *PartRepository_StreamParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_StreamParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter, int, func([]model.Part) error) error) *PartRepository_StreamParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, part
+func (_m *PartRepository) UpdatePart(ctx context.Context, part model.Part) error {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) error); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type PartRepository_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+func (_e *PartRepository_Expecter) UpdatePart(ctx interface{}, part interface{}) *PartRepository_UpdatePart_Call {
+	return &PartRepository_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, part)}
+}
+
+func (_c *PartRepository_UpdatePart_Call) Run(run func(ctx context.Context, part model.Part)) *PartRepository_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part))
+	})
+	return _c
+}
+
+func (_c *PartRepository_UpdatePart_Call) Return(_a0 error) *PartRepository_UpdatePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_UpdatePart_Call) RunAndReturn(run func(context.Context, model.Part) error) *PartRepository_UpdatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPartRepository creates a new instance of PartRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPartRepository(t interface {
@@ -0,0 +1,272 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockRepository is an autogenerated mock type for the StockRepository type
+type StockRepository struct {
+	mock.Mock
+}
+
+type StockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockRepository) EXPECT() *StockRepository_Expecter {
+	return &StockRepository_Expecter{mock: &_m.Mock}
+}
+
+// AdjustStock provides a mock function with given fields: ctx, movement
+func (_m *StockRepository) AdjustStock(ctx context.Context, movement model.StockMovement) (model.StockMovement, error) {
+	ret := _m.Called(ctx, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockMovement) (model.StockMovement, error)); ok {
+		return rf(ctx, movement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockMovement) model.StockMovement); ok {
+		r0 = rf(ctx, movement)
+	} else {
+		r0 = ret.Get(0).(model.StockMovement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.StockMovement) error); ok {
+		r1 = rf(ctx, movement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type StockRepository_AdjustStock_Call struct {
+	*mock.Call
+}
+
+// AdjustStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movement model.StockMovement
+func (_e *StockRepository_Expecter) AdjustStock(ctx interface{}, movement interface{}) *StockRepository_AdjustStock_Call {
+	return &StockRepository_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, movement)}
+}
+
+func (_c *StockRepository_AdjustStock_Call) Run(run func(ctx context.Context, movement model.StockMovement)) *StockRepository_AdjustStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.StockMovement))
+	})
+	return _c
+}
+
+func (_c *StockRepository_AdjustStock_Call) Return(_a0 model.StockMovement, _a1 error) *StockRepository_AdjustStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_AdjustStock_Call) RunAndReturn(run func(context.Context, model.StockMovement) (model.StockMovement, error)) *StockRepository_AdjustStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AllocateUnassignedStock provides a mock function with given fields: ctx, warehouseCode
+func (_m *StockRepository) AllocateUnassignedStock(ctx context.Context, warehouseCode string) (int64, error) {
+	ret := _m.Called(ctx, warehouseCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllocateUnassignedStock")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, warehouseCode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, warehouseCode)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, warehouseCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_AllocateUnassignedStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllocateUnassignedStock'
+type StockRepository_AllocateUnassignedStock_Call struct {
+	*mock.Call
+}
+
+// AllocateUnassignedStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - warehouseCode string
+func (_e *StockRepository_Expecter) AllocateUnassignedStock(ctx interface{}, warehouseCode interface{}) *StockRepository_AllocateUnassignedStock_Call {
+	return &StockRepository_AllocateUnassignedStock_Call{Call: _e.mock.On("AllocateUnassignedStock", ctx, warehouseCode)}
+}
+
+func (_c *StockRepository_AllocateUnassignedStock_Call) Run(run func(ctx context.Context, warehouseCode string)) *StockRepository_AllocateUnassignedStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StockRepository_AllocateUnassignedStock_Call) Return(_a0 int64, _a1 error) *StockRepository_AllocateUnassignedStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_AllocateUnassignedStock_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *StockRepository_AllocateUnassignedStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWarehouseStock provides a mock function with given fields: ctx, partUuid
+func (_m *StockRepository) GetWarehouseStock(ctx context.Context, partUuid string) (map[string]int64, error) {
+	ret := _m.Called(ctx, partUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWarehouseStock")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]int64, error)); ok {
+		return rf(ctx, partUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]int64); ok {
+		r0 = rf(ctx, partUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, partUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_GetWarehouseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWarehouseStock'
+type StockRepository_GetWarehouseStock_Call struct {
+	*mock.Call
+}
+
+// GetWarehouseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid string
+func (_e *StockRepository_Expecter) GetWarehouseStock(ctx interface{}, partUuid interface{}) *StockRepository_GetWarehouseStock_Call {
+	return &StockRepository_GetWarehouseStock_Call{Call: _e.mock.On("GetWarehouseStock", ctx, partUuid)}
+}
+
+func (_c *StockRepository_GetWarehouseStock_Call) Run(run func(ctx context.Context, partUuid string)) *StockRepository_GetWarehouseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StockRepository_GetWarehouseStock_Call) Return(_a0 map[string]int64, _a1 error) *StockRepository_GetWarehouseStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_GetWarehouseStock_Call) RunAndReturn(run func(context.Context, string) (map[string]int64, error)) *StockRepository_GetWarehouseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockMovements provides a mock function with given fields: ctx, filter
+func (_m *StockRepository) ListStockMovements(ctx context.Context, filter model.StockMovementsFilter) ([]model.StockMovement, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStockMovements")
+	}
+
+	var r0 []model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockMovementsFilter) ([]model.StockMovement, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockMovementsFilter) []model.StockMovement); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.StockMovementsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_ListStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockMovements'
+type StockRepository_ListStockMovements_Call struct {
+	*mock.Call
+}
+
+// ListStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.StockMovementsFilter
+func (_e *StockRepository_Expecter) ListStockMovements(ctx interface{}, filter interface{}) *StockRepository_ListStockMovements_Call {
+	return &StockRepository_ListStockMovements_Call{Call: _e.mock.On("ListStockMovements", ctx, filter)}
+}
+
+func (_c *StockRepository_ListStockMovements_Call) Run(run func(ctx context.Context, filter model.StockMovementsFilter)) *StockRepository_ListStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.StockMovementsFilter))
+	})
+	return _c
+}
+
+func (_c *StockRepository_ListStockMovements_Call) Return(_a0 []model.StockMovement, _a1 error) *StockRepository_ListStockMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_ListStockMovements_Call) RunAndReturn(run func(context.Context, model.StockMovementsFilter) ([]model.StockMovement, error)) *StockRepository_ListStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockRepository creates a new instance of StockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockRepository {
+	mock := &StockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
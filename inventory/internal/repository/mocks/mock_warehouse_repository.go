@@ -0,0 +1,249 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WarehouseRepository is an autogenerated mock type for the WarehouseRepository type
+type WarehouseRepository struct {
+	mock.Mock
+}
+
+type WarehouseRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WarehouseRepository) EXPECT() *WarehouseRepository_Expecter {
+	return &WarehouseRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateWarehouse provides a mock function with given fields: ctx, warehouse
+func (_m *WarehouseRepository) CreateWarehouse(ctx context.Context, warehouse model.Warehouse) error {
+	ret := _m.Called(ctx, warehouse)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWarehouse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Warehouse) error); ok {
+		r0 = rf(ctx, warehouse)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WarehouseRepository_CreateWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWarehouse'
+type WarehouseRepository_CreateWarehouse_Call struct {
+	*mock.Call
+}
+
+// CreateWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - warehouse model.Warehouse
+func (_e *WarehouseRepository_Expecter) CreateWarehouse(ctx interface{}, warehouse interface{}) *WarehouseRepository_CreateWarehouse_Call {
+	return &WarehouseRepository_CreateWarehouse_Call{Call: _e.mock.On("CreateWarehouse", ctx, warehouse)}
+}
+
+func (_c *WarehouseRepository_CreateWarehouse_Call) Run(run func(ctx context.Context, warehouse model.Warehouse)) *WarehouseRepository_CreateWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Warehouse))
+	})
+	return _c
+}
+
+func (_c *WarehouseRepository_CreateWarehouse_Call) Return(_a0 error) *WarehouseRepository_CreateWarehouse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WarehouseRepository_CreateWarehouse_Call) RunAndReturn(run func(context.Context, model.Warehouse) error) *WarehouseRepository_CreateWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnsureWarehouse provides a mock function with given fields: ctx, warehouse
+func (_m *WarehouseRepository) EnsureWarehouse(ctx context.Context, warehouse model.Warehouse) error {
+	ret := _m.Called(ctx, warehouse)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureWarehouse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Warehouse) error); ok {
+		r0 = rf(ctx, warehouse)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WarehouseRepository_EnsureWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureWarehouse'
+type WarehouseRepository_EnsureWarehouse_Call struct {
+	*mock.Call
+}
+
+// EnsureWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - warehouse model.Warehouse
+func (_e *WarehouseRepository_Expecter) EnsureWarehouse(ctx interface{}, warehouse interface{}) *WarehouseRepository_EnsureWarehouse_Call {
+	return &WarehouseRepository_EnsureWarehouse_Call{Call: _e.mock.On("EnsureWarehouse", ctx, warehouse)}
+}
+
+func (_c *WarehouseRepository_EnsureWarehouse_Call) Run(run func(ctx context.Context, warehouse model.Warehouse)) *WarehouseRepository_EnsureWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Warehouse))
+	})
+	return _c
+}
+
+func (_c *WarehouseRepository_EnsureWarehouse_Call) Return(_a0 error) *WarehouseRepository_EnsureWarehouse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WarehouseRepository_EnsureWarehouse_Call) RunAndReturn(run func(context.Context, model.Warehouse) error) *WarehouseRepository_EnsureWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWarehouse provides a mock function with given fields: ctx, code
+func (_m *WarehouseRepository) GetWarehouse(ctx context.Context, code string) (model.Warehouse, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWarehouse")
+	}
+
+	var r0 model.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Warehouse, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Warehouse); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(model.Warehouse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarehouseRepository_GetWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWarehouse'
+type WarehouseRepository_GetWarehouse_Call struct {
+	*mock.Call
+}
+
+// GetWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *WarehouseRepository_Expecter) GetWarehouse(ctx interface{}, code interface{}) *WarehouseRepository_GetWarehouse_Call {
+	return &WarehouseRepository_GetWarehouse_Call{Call: _e.mock.On("GetWarehouse", ctx, code)}
+}
+
+func (_c *WarehouseRepository_GetWarehouse_Call) Run(run func(ctx context.Context, code string)) *WarehouseRepository_GetWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WarehouseRepository_GetWarehouse_Call) Return(_a0 model.Warehouse, _a1 error) *WarehouseRepository_GetWarehouse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WarehouseRepository_GetWarehouse_Call) RunAndReturn(run func(context.Context, string) (model.Warehouse, error)) *WarehouseRepository_GetWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWarehouses provides a mock function with given fields: ctx
+func (_m *WarehouseRepository) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWarehouses")
+	}
+
+	var r0 []model.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Warehouse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Warehouse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarehouseRepository_ListWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWarehouses'
+type WarehouseRepository_ListWarehouses_Call struct {
+	*mock.Call
+}
+
+// ListWarehouses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WarehouseRepository_Expecter) ListWarehouses(ctx interface{}) *WarehouseRepository_ListWarehouses_Call {
+	return &WarehouseRepository_ListWarehouses_Call{Call: _e.mock.On("ListWarehouses", ctx)}
+}
+
+func (_c *WarehouseRepository_ListWarehouses_Call) Run(run func(ctx context.Context)) *WarehouseRepository_ListWarehouses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WarehouseRepository_ListWarehouses_Call) Return(_a0 []model.Warehouse, _a1 error) *WarehouseRepository_ListWarehouses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WarehouseRepository_ListWarehouses_Call) RunAndReturn(run func(context.Context) ([]model.Warehouse, error)) *WarehouseRepository_ListWarehouses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWarehouseRepository creates a new instance of WarehouseRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWarehouseRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WarehouseRepository {
+	mock := &WarehouseRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CompatibilityRule struct {
+	ID               primitive.ObjectID    `bson:"_id,omitempty"`
+	Uuid             string                `bson:"uuid"`
+	Type             CompatibilityRuleType `bson:"type"`
+	Subject          PartSelector          `bson:"subject"`
+	Target           PartSelector          `bson:"target"`
+	MaxQuantity      int64                 `bson:"max_quantity,omitempty"`
+	MatchMetadataKey string                `bson:"match_metadata_key,omitempty"`
+	Description      string                `bson:"description,omitempty"`
+	CreatedAt        time.Time             `bson:"created_at"`
+}
+
+type CompatibilityRuleType int32
+
+type PartSelector struct {
+	Category      Category `bson:"category,omitempty"`
+	Tag           string   `bson:"tag,omitempty"`
+	MetadataKey   string   `bson:"metadata_key,omitempty"`
+	MetadataValue string   `bson:"metadata_value,omitempty"`
+}
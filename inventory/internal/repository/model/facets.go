@@ -0,0 +1,25 @@
+package model
+
+type PartFacets struct {
+	Categories            []CategoryFacet `bson:"categories"`
+	ManufacturerCountries []FacetCount    `bson:"manufacturer_countries"`
+	ManufacturerNames     []FacetCount    `bson:"manufacturer_names"`
+	Tags                  []FacetCount    `bson:"tags"`
+	// $facet всегда возвращает массив, даже для одной группы
+	PriceRange []PriceRange `bson:"price_range"`
+}
+
+type FacetCount struct {
+	Value string `bson:"_id"`
+	Count int64  `bson:"count"`
+}
+
+type CategoryFacet struct {
+	Category Category `bson:"_id"`
+	Count    int64    `bson:"count"`
+}
+
+type PriceRange struct {
+	Min float64 `bson:"min"`
+	Max float64 `bson:"max"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Kit struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	Uuid          string             `bson:"uuid"`
+	Name          string             `bson:"name"`
+	Description   string             `bson:"description"`
+	Components    []KitComponent     `bson:"components"`
+	PriceOverride *float64           `bson:"price_override,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+type KitComponent struct {
+	PartUuid string `bson:"part_uuid"`
+	Quantity int64  `bson:"quantity"`
+}
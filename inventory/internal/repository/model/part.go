@@ -13,13 +13,15 @@ type Part struct {
 	Description   string             `bson:"description"`
 	Price         float64            `bson:"price"`
 	StockQuantity int64              `bson:"stock_quantity"`
-	Category      Category           `bson:"category"`
-	Dimensions    *Dimensions        `bson:"dimensions,omitempty"`
-	Manufacturer  *Manufacturer      `bson:"manufacturer,omitempty"`
-	Tags          []string           `bson:"tags"`
-	Metadata      map[string]*Value  `bson:"metadata"`
-	CreatedAt     time.Time          `bson:"created_at"`
-	UpdatedAt     time.Time          `bson:"updated_at"`
+	// Остатки по складам: код склада -> количество
+	WarehouseStock map[string]int64  `bson:"warehouse_stock,omitempty"`
+	Category       Category          `bson:"category"`
+	Dimensions     *Dimensions       `bson:"dimensions,omitempty"`
+	Manufacturer   *Manufacturer     `bson:"manufacturer,omitempty"`
+	Tags           []string          `bson:"tags"`
+	Metadata       map[string]*Value `bson:"metadata"`
+	CreatedAt      time.Time         `bson:"created_at"`
+	UpdatedAt      time.Time         `bson:"updated_at"`
 }
 
 type Category int32
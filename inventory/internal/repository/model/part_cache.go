@@ -0,0 +1,7 @@
+package model
+
+// PartsCacheView — закэшированный результат ListParts.
+// BSON-документ не может быть массивом, поэтому список завернут в структуру
+type PartsCacheView struct {
+	Parts []Part `bson:"parts"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PartPrice struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	PartUuid      string             `bson:"part_uuid"`
+	Price         float64            `bson:"price"`
+	EffectiveFrom time.Time          `bson:"effective_from"`
+	CreatedAt     time.Time          `bson:"created_at"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type StockMovement struct {
+	ID                  primitive.ObjectID  `bson:"_id,omitempty"`
+	Uuid                string              `bson:"uuid"`
+	PartUuid            string              `bson:"part_uuid"`
+	Delta               int64               `bson:"delta"`
+	Reason              StockMovementReason `bson:"reason"`
+	ReferenceID         string              `bson:"reference_id,omitempty"`
+	Actor               string              `bson:"actor"`
+	StockAfter          int64               `bson:"stock_after"`
+	WarehouseCode       string              `bson:"warehouse_code,omitempty"`
+	WarehouseStockAfter int64               `bson:"warehouse_stock_after"`
+	CreatedAt           time.Time           `bson:"created_at"`
+}
+
+type StockMovementReason int32
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Warehouse struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Code      string             `bson:"code"`
+	Name      string             `bson:"name"`
+	Location  string             `bson:"location,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
@@ -0,0 +1,13 @@
+package part
+
+import (
+	"context"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreatePart(ctx context.Context, part model.Part) error {
+	_, err := r.collection.InsertOne(ctx, repoConverter.ToRepoPart(part))
+	return err
+}
@@ -0,0 +1,34 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestCreatePartSuccess() {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	part := model.Part{
+		Uuid:        gofakeit.UUID(),
+		Name:        gofakeit.Name(),
+		Description: gofakeit.Sentence(),
+		Price:       gofakeit.Price(100, 1000),
+		Category:    model.CategoryWing,
+		Tags:        []string{gofakeit.Word()},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	err := s.repository.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetPart(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+	s.Equal(part.Name, result.Name)
+	s.Equal(part.Price, result.Price)
+	s.Equal(model.CategoryWing, result.Category)
+	s.Equal(int64(0), result.StockQuantity)
+	s.True(now.Equal(result.CreatedAt))
+}
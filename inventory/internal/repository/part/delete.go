@@ -0,0 +1,22 @@
+package part
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (r *repository) DeletePart(ctx context.Context, uuid string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": uuid})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (s *RepositorySuite) TestDeletePartSuccess() {
+	existing := repoModel.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name()}
+	_, err := s.db.Collection("parts").InsertOne(s.ctx, existing)
+	s.Require().NoError(err)
+
+	err = s.repository.DeletePart(s.ctx, existing.Uuid)
+	s.Require().NoError(err)
+
+	_, err = s.repository.GetPart(s.ctx, existing.Uuid)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *RepositorySuite) TestDeletePartNotFound() {
+	err := s.repository.DeletePart(s.ctx, gofakeit.UUID())
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
@@ -0,0 +1,112 @@
+package part
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) GetPartFacets(ctx context.Context, filter model.PartsFilter, topTagsLimit int) (model.PartFacets, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: partsFilterToBSON(filter)}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "categories", Value: countByField("$category")},
+			{Key: "manufacturer_countries", Value: append(
+				bson.A{bson.D{{Key: "$match", Value: bson.M{"manufacturer.country": bson.M{"$nin": bson.A{nil, ""}}}}}},
+				countByField("$manufacturer.country")...,
+			)},
+			{Key: "manufacturer_names", Value: append(
+				bson.A{bson.D{{Key: "$match", Value: bson.M{"manufacturer.name": bson.M{"$nin": bson.A{nil, ""}}}}}},
+				countByField("$manufacturer.name")...,
+			)},
+			{Key: "tags", Value: append(
+				append(bson.A{bson.D{{Key: "$unwind", Value: "$tags"}}}, countByField("$tags")...),
+				bson.D{{Key: "$limit", Value: topTagsLimit}},
+			)},
+			{Key: "price_range", Value: append(
+				effectivePriceStages(),
+				bson.D{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: nil},
+					{Key: "min", Value: bson.D{{Key: "$min", Value: "$effective_price"}}},
+					{Key: "max", Value: bson.D{{Key: "$max", Value: "$effective_price"}}},
+				}}},
+			)},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return model.PartFacets{}, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	// $facet всегда возвращает ровно один документ
+	var repoFacets repoModel.PartFacets
+	if cursor.Next(ctx) {
+		if err = cursor.Decode(&repoFacets); err != nil {
+			return model.PartFacets{}, err
+		}
+	}
+	if err = cursor.Err(); err != nil {
+		return model.PartFacets{}, err
+	}
+
+	return repoConverter.ToModelPartFacets(repoFacets), nil
+}
+
+// effectivePriceStages добавляет документу поле effective_price — цену, действующую сейчас,
+// как её подставляет ListParts. Детали без истории цен сохраняют цену из документа.
+func effectivePriceStages() bson.A {
+	return bson.A{
+		bson.D{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: partPricesCollection},
+			{Key: "let", Value: bson.D{{Key: "part_uuid", Value: "$uuid"}}},
+			{Key: "pipeline", Value: bson.A{
+				bson.D{{Key: "$match", Value: bson.D{{Key: "$expr", Value: bson.D{{Key: "$and", Value: bson.A{
+					bson.D{{Key: "$eq", Value: bson.A{"$part_uuid", "$$part_uuid"}}},
+					bson.D{{Key: "$lte", Value: bson.A{"$effective_from", "$$NOW"}}},
+				}}}}}}},
+				// Порядок как в part_price: при равном effective_from действует более поздняя запись
+				bson.D{{Key: "$sort", Value: bson.D{
+					{Key: "effective_from", Value: -1},
+					{Key: "created_at", Value: -1},
+					{Key: "_id", Value: -1},
+				}}},
+				bson.D{{Key: "$limit", Value: 1}},
+				bson.D{{Key: "$project", Value: bson.D{{Key: "price", Value: 1}}}},
+			}},
+			{Key: "as", Value: "effective_prices"},
+		}}},
+		bson.D{{Key: "$addFields", Value: bson.D{
+			{Key: "effective_price", Value: bson.D{{Key: "$ifNull", Value: bson.A{
+				bson.D{{Key: "$arrayElemAt", Value: bson.A{"$effective_prices.price", 0}}},
+				"$price",
+			}}}},
+		}}},
+	}
+}
+
+// countByField группирует документы по значению поля и сортирует группы по убыванию количества.
+func countByField(field string) bson.A {
+	return bson.A{
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: field},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{
+			{Key: "count", Value: -1},
+			{Key: "_id", Value: 1},
+		}}},
+	}
+}
@@ -0,0 +1,108 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (s *RepositorySuite) TestGetPartFacetsCountsEveryFacet() {
+	_ = s.db.Collection("parts").Drop(s.ctx)
+
+	parts := []interface{}{
+		facetTestPart(repoModel.CategoryEngine, 100, "Rolls", "UK", []string{"engine", "heavy"}),
+		facetTestPart(repoModel.CategoryEngine, 300, "Rolls", "UK", []string{"engine"}),
+		facetTestPart(repoModel.CategoryWing, 50, "Airbus", "France", []string{"wing", "light"}),
+	}
+	_, err := s.db.Collection("parts").InsertMany(s.ctx, parts)
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetPartFacets(s.ctx, model.PartsFilter{}, 10)
+	s.Require().NoError(err)
+
+	s.Require().Equal([]model.CategoryFacet{
+		{Category: model.CategoryEngine, Count: 2},
+		{Category: model.CategoryWing, Count: 1},
+	}, result.Categories)
+	s.Require().Equal([]model.FacetCount{{Value: "UK", Count: 2}, {Value: "France", Count: 1}}, result.ManufacturerCountries)
+	s.Require().Equal([]model.FacetCount{{Value: "Rolls", Count: 2}, {Value: "Airbus", Count: 1}}, result.ManufacturerNames)
+	s.Require().Equal(model.FacetCount{Value: "engine", Count: 2}, result.Tags[0])
+	s.Require().Len(result.Tags, 4)
+	s.Require().Equal(model.PriceRange{Min: 50, Max: 300}, result.PriceRange)
+}
+
+func (s *RepositorySuite) TestGetPartFacetsAppliesFilterAndTagLimit() {
+	_ = s.db.Collection("parts").Drop(s.ctx)
+
+	parts := []interface{}{
+		facetTestPart(repoModel.CategoryEngine, 100, "Rolls", "UK", []string{"engine", "heavy"}),
+		facetTestPart(repoModel.CategoryWing, 50, "Airbus", "France", []string{"wing", "light"}),
+	}
+	_, err := s.db.Collection("parts").InsertMany(s.ctx, parts)
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetPartFacets(s.ctx, model.PartsFilter{
+		ManufacturerCountries: []string{"France"},
+	}, 1)
+	s.Require().NoError(err)
+
+	s.Require().Equal([]model.CategoryFacet{{Category: model.CategoryWing, Count: 1}}, result.Categories)
+	s.Require().Len(result.Tags, 1)
+	s.Require().Equal(model.PriceRange{Min: 50, Max: 50}, result.PriceRange)
+}
+
+func (s *RepositorySuite) TestGetPartFacetsUsesEffectivePrices() {
+	_ = s.db.Collection("parts").Drop(s.ctx)
+	_ = s.db.Collection(partPricesCollection).Drop(s.ctx)
+	defer func() { _ = s.db.Collection(partPricesCollection).Drop(s.ctx) }()
+
+	repriced := facetTestPart(repoModel.CategoryEngine, 100, "Rolls", "UK", nil)
+	scheduled := facetTestPart(repoModel.CategoryEngine, 200, "Rolls", "UK", nil)
+	_, err := s.db.Collection("parts").InsertMany(s.ctx, []interface{}{repriced, scheduled})
+	s.Require().NoError(err)
+
+	now := time.Now()
+	_, err = s.db.Collection(partPricesCollection).InsertMany(s.ctx, []interface{}{
+		// Действующая цена заменяет цену документа
+		repoModel.PartPrice{PartUuid: repriced.Uuid, Price: 150, EffectiveFrom: now.Add(-time.Hour), CreatedAt: now},
+		// Запланированная цена ещё не действует
+		repoModel.PartPrice{PartUuid: scheduled.Uuid, Price: 900, EffectiveFrom: now.Add(time.Hour), CreatedAt: now},
+	})
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetPartFacets(s.ctx, model.PartsFilter{}, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(model.PriceRange{Min: 150, Max: 200}, result.PriceRange)
+}
+
+func (s *RepositorySuite) TestGetPartFacetsEmptyCollection() {
+	_ = s.db.Collection("parts").Drop(s.ctx)
+
+	result, err := s.repository.GetPartFacets(s.ctx, model.PartsFilter{}, 10)
+	s.Require().NoError(err)
+	s.Require().Empty(result.Categories)
+	s.Require().Empty(result.Tags)
+	s.Require().Equal(model.PriceRange{}, result.PriceRange)
+}
+
+func facetTestPart(category repoModel.Category, price float64, manufacturer, country string, tags []string) repoModel.Part {
+	return repoModel.Part{
+		Uuid:          gofakeit.UUID(),
+		Name:          gofakeit.Name(),
+		Description:   gofakeit.Sentence(),
+		Price:         price,
+		StockQuantity: int64(gofakeit.IntRange(1, 100)),
+		Category:      category,
+		Manufacturer: &repoModel.Manufacturer{
+			Name:    manufacturer,
+			Country: country,
+			Website: gofakeit.URL(),
+		},
+		Tags:      tags,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,32 @@
+package part
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+// partsFilterToBSON строит Mongo-фильтр с той же семантикой, что и фильтрация в сервисе:
+// OR внутри одного поля, AND между разными полями, пустое поле — не фильтруем.
+func partsFilterToBSON(filter model.PartsFilter) bson.M {
+	query := bson.M{}
+
+	if len(filter.Uuids) > 0 {
+		query["uuid"] = bson.M{"$in": filter.Uuids}
+	}
+	if len(filter.Names) > 0 {
+		query["name"] = bson.M{"$in": filter.Names}
+	}
+	if len(filter.Categories) > 0 {
+		query["category"] = bson.M{"$in": repoConverter.ToRepoCategories(filter.Categories)}
+	}
+	if len(filter.ManufacturerCountries) > 0 {
+		query["manufacturer.country"] = bson.M{"$in": filter.ManufacturerCountries}
+	}
+	if len(filter.Tags) > 0 {
+		query["tags"] = bson.M{"$in": filter.Tags}
+	}
+
+	return query
+}
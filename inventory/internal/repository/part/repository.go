@@ -15,11 +15,16 @@ import (
 
 var _ def.PartRepository = (*repository)(nil)
 
+// partPricesCollection — история цен из part_price; нужна фасету диапазона цен
+const partPricesCollection = "part_prices"
+
 type repository struct {
 	collection *mongo.Collection
 }
 
-func NewRepository(ctx context.Context, db *mongo.Database) *repository {
+// NewRepository создаёт репозиторий и, если коллекция пуста, заполняет её
+// seedCount тестовыми деталями. При seedCount = 0 коллекция не заполняется.
+func NewRepository(ctx context.Context, db *mongo.Database, seedCount int) *repository {
 	collection := db.Collection("parts")
 
 	indexModels := []mongo.IndexModel{
@@ -40,7 +45,7 @@ func NewRepository(ctx context.Context, db *mongo.Database) *repository {
 		collection: collection,
 	}
 
-	err = r.initParts(ctx, 100)
+	err = r.initParts(ctx, seedCount)
 	if err != nil {
 		logger.Error(ctx, "failed to initialize parts", zap.Error(err))
 		return nil
@@ -0,0 +1,59 @@
+package part
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) StreamParts(ctx context.Context, filter model.PartsFilter, chunkSize int, fn func([]model.Part) error) error {
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "uuid", Value: 1}}).
+		SetBatchSize(int32(chunkSize))
+
+	cursor, err := r.collection.Find(ctx, partsFilterToBSON(filter), findOptions)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Контекст мог быть отменён клиентом, а курсор на сервере всё равно нужно закрыть
+		if cerr := cursor.Close(context.WithoutCancel(ctx)); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	chunk := make([]model.Part, 0, chunkSize)
+	for cursor.Next(ctx) {
+		var repoPart repoModel.Part
+		if err = cursor.Decode(&repoPart); err != nil {
+			return err
+		}
+
+		chunk = append(chunk, repoConverter.ToModelPart(repoPart))
+		if len(chunk) < chunkSize {
+			continue
+		}
+
+		if err = fn(chunk); err != nil {
+			return err
+		}
+		chunk = make([]model.Part, 0, chunkSize)
+	}
+
+	if err = cursor.Err(); err != nil {
+		return err
+	}
+
+	if len(chunk) > 0 {
+		return fn(chunk)
+	}
+
+	return nil
+}
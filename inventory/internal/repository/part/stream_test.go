@@ -0,0 +1,76 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestStreamPartsInChunks() {
+	var (
+		chunks []int
+		uuids  []string
+	)
+
+	err := s.repository.StreamParts(s.ctx, model.PartsFilter{}, 30, func(parts []model.Part) error {
+		chunks = append(chunks, len(parts))
+		for _, part := range parts {
+			uuids = append(uuids, part.Uuid)
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// initParts добавляет 100 частей
+	s.Require().Equal([]int{30, 30, 30, 10}, chunks)
+	s.Require().True(sort.StringsAreSorted(uuids))
+}
+
+func (s *RepositorySuite) TestStreamPartsAppliesFilter() {
+	all, err := s.repository.ListParts(s.ctx)
+	s.Require().NoError(err)
+
+	filter := model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	var want int
+	for _, part := range all {
+		if part.Category == model.CategoryEngine {
+			want++
+		}
+	}
+
+	var got int
+	err = s.repository.StreamParts(s.ctx, filter, 7, func(parts []model.Part) error {
+		for _, part := range parts {
+			s.Require().Equal(model.CategoryEngine, part.Category)
+		}
+		got += len(parts)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(want, got)
+}
+
+func (s *RepositorySuite) TestStreamPartsStopsOnCallbackError() {
+	sendErr := errors.New("client gone")
+	calls := 0
+
+	err := s.repository.StreamParts(s.ctx, model.PartsFilter{}, 10, func([]model.Part) error {
+		calls++
+		return sendErr
+	})
+	s.Require().ErrorIs(err, sendErr)
+	s.Require().Equal(1, calls)
+}
+
+func (s *RepositorySuite) TestStreamPartsWithContextCancellation() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := s.repository.StreamParts(ctx, model.PartsFilter{}, 10, func([]model.Part) error {
+		s.Fail("no parts expected after cancellation")
+		return nil
+	})
+	s.Require().Error(err)
+}
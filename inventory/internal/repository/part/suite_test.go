@@ -55,7 +55,7 @@ func (s *RepositorySuite) SetupTest() {
 	if s.db != nil {
 		_ = s.db.Collection("parts").Drop(s.ctx)
 	}
-	s.repository = NewRepository(s.ctx, s.db)
+	s.repository = NewRepository(s.ctx, s.db, 100)
 }
 
 func (s *RepositorySuite) TearDownTest() {
@@ -0,0 +1,40 @@
+package part
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+// UpdatePart заменяет редактируемые поля детали.
+// stock_quantity и created_at не трогаем: остаток меняется только через журнал движений.
+func (r *repository) UpdatePart(ctx context.Context, part model.Part) error {
+	repoPart := repoConverter.ToRepoPart(part)
+
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"uuid": part.Uuid},
+		bson.M{"$set": bson.M{
+			"name":         repoPart.Name,
+			"description":  repoPart.Description,
+			"price":        repoPart.Price,
+			"category":     repoPart.Category,
+			"dimensions":   repoPart.Dimensions,
+			"manufacturer": repoPart.Manufacturer,
+			"tags":         repoPart.Tags,
+			"metadata":     repoPart.Metadata,
+			"updated_at":   repoPart.UpdatedAt,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (s *RepositorySuite) TestUpdatePartSuccess() {
+	createdAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Millisecond)
+	existing := repoModel.Part{
+		Uuid:          gofakeit.UUID(),
+		Name:          "Old name",
+		Price:         100,
+		StockQuantity: 7,
+		Category:      repoModel.CategoryEngine,
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+	}
+	_, err := s.db.Collection("parts").InsertOne(s.ctx, existing)
+	s.Require().NoError(err)
+
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	err = s.repository.UpdatePart(s.ctx, model.Part{
+		Uuid:          existing.Uuid,
+		Name:          "New name",
+		Price:         150,
+		StockQuantity: 999,
+		Category:      model.CategoryFuel,
+		Manufacturer:  &model.Manufacturer{Name: "ACME", Country: "Germany"},
+		UpdatedAt:     updatedAt,
+	})
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetPart(s.ctx, existing.Uuid)
+	s.Require().NoError(err)
+	s.Equal("New name", result.Name)
+	s.Equal(150.0, result.Price)
+	s.Equal(model.CategoryFuel, result.Category)
+	s.Equal("ACME", result.Manufacturer.Name)
+	// Остаток и дата создания не меняются
+	s.Equal(int64(7), result.StockQuantity)
+	s.True(createdAt.Equal(result.CreatedAt))
+	s.True(updatedAt.Equal(result.UpdatedAt))
+}
+
+func (s *RepositorySuite) TestUpdatePartNotFound() {
+	err := s.repository.UpdatePart(s.ctx, model.Part{Uuid: gofakeit.UUID(), Name: "Name"})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
@@ -0,0 +1,57 @@
+package part_cache
+
+import (
+	"context"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (r *repository) GetPart(ctx context.Context, uuid string) (model.Part, error) {
+	data, err := r.cache.Get(ctx, r.getPartKey(uuid))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			return model.Part{}, model.ErrCacheMiss
+		}
+		return model.Part{}, err
+	}
+
+	var part repoModel.Part
+	if err := bson.Unmarshal(data, &part); err != nil {
+		return model.Part{}, err
+	}
+
+	return repoConverter.ToModelPart(part), nil
+}
+
+func (r *repository) GetParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
+	key, err := r.getListKey(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := r.cache.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			return nil, model.ErrCacheMiss
+		}
+		return nil, err
+	}
+
+	var view repoModel.PartsCacheView
+	if err := bson.Unmarshal(data, &view); err != nil {
+		return nil, err
+	}
+
+	parts := make([]model.Part, 0, len(view.Parts))
+	for _, part := range view.Parts {
+		parts = append(parts, repoConverter.ToModelPart(part))
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,27 @@
+package part_cache
+
+import (
+	"context"
+)
+
+func (r *repository) Invalidate(ctx context.Context, uuid string) error {
+	if err := r.cache.Del(ctx, r.getPartKey(uuid)); err != nil {
+		return err
+	}
+
+	listKeys, err := r.cache.SMembers(ctx, listKeysSetKey)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range listKeys {
+		if err := r.cache.Del(ctx, key); err != nil {
+			return err
+		}
+		if err := r.cache.SRem(ctx, listKeysSetKey, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
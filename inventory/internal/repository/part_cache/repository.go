@@ -0,0 +1,66 @@
+package part_cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/cache"
+)
+
+var _ def.PartCacheRepository = (*repository)(nil)
+
+const (
+	partKeyPrefix = "inventory:part:"
+	listKeyPrefix = "inventory:parts:list:"
+	// Множество ключей закэшированных списков — нужно, чтобы сбросить их все при записи
+	listKeysSetKey = "inventory:parts:lists"
+)
+
+type repository struct {
+	cache cache.RedisClient
+}
+
+func NewRepository(cache cache.RedisClient) *repository {
+	return &repository{
+		cache: cache,
+	}
+}
+
+func (r *repository) getPartKey(uuid string) string {
+	return fmt.Sprintf("%s%s", partKeyPrefix, uuid)
+}
+
+// getListKey строит ключ по фильтру. Значения внутри полей фильтра объединяются
+// через OR, поэтому порядок не важен — сортируем, чтобы одинаковые фильтры
+// попадали в один ключ.
+func (r *repository) getListKey(filter model.PartsFilter) (string, error) {
+	normalized := model.PartsFilter{
+		Uuids:                 sortedCopy(filter.Uuids),
+		Names:                 sortedCopy(filter.Names),
+		Categories:            sortedCopy(filter.Categories),
+		ManufacturerCountries: sortedCopy(filter.ManufacturerCountries),
+		Tags:                  sortedCopy(filter.Tags),
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return listKeyPrefix + hex.EncodeToString(sum[:]), nil
+}
+
+func sortedCopy[T ~string | ~int32](values []T) []T {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,51 @@
+package part_cache
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (r *repository) SetPart(ctx context.Context, part model.Part, ttl time.Duration) error {
+	data, err := bson.Marshal(repoConverter.ToRepoPart(part))
+	if err != nil {
+		return err
+	}
+
+	return r.cache.SetWithTTL(ctx, r.getPartKey(part.Uuid), data, ttl)
+}
+
+func (r *repository) SetParts(ctx context.Context, filter model.PartsFilter, parts []model.Part, ttl time.Duration) error {
+	key, err := r.getListKey(filter)
+	if err != nil {
+		return err
+	}
+
+	view := repoModel.PartsCacheView{Parts: make([]repoModel.Part, 0, len(parts))}
+	for _, part := range parts {
+		view.Parts = append(view.Parts, repoConverter.ToRepoPart(part))
+	}
+
+	data, err := bson.Marshal(view)
+	if err != nil {
+		return err
+	}
+
+	// Ключ регистрируем до записи списка: если SetWithTTL не выполнится, в множестве
+	// останется лишний ключ, а не список, о котором Invalidate не знает
+	if err := r.cache.SAdd(ctx, listKeysSetKey, key); err != nil {
+		return err
+	}
+	// Множество должно пережить самый долгоживущий список: TTL списков разный
+	// (запланированная смена цены его сокращает), поэтому срок только продлевается
+	if err := r.cache.ExtendExpire(ctx, listKeysSetKey, ttl); err != nil {
+		return err
+	}
+
+	return r.cache.SetWithTTL(ctx, key, data, ttl)
+}
@@ -0,0 +1,39 @@
+package part_cache
+
+import (
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestSetPartsMixedTTLKeepsIndex() {
+	longFilter := model.PartsFilter{Names: []string{"engine"}}
+	shortFilter := model.PartsFilter{Names: []string{"wing"}}
+	parts := []model.Part{{Uuid: "part-1", Name: "engine"}}
+
+	s.Require().NoError(s.repository.SetParts(s.ctx, longFilter, parts, time.Minute))
+	// Короткий TTL, например до запланированной смены цены, не сокращает срок множества
+	s.Require().NoError(s.repository.SetParts(s.ctx, shortFilter, parts, 2*time.Second))
+
+	longKey, err := s.repository.getListKey(longFilter)
+	s.Require().NoError(err)
+	s.Require().Greater(s.ttl(listKeysSetKey), 50*time.Second)
+	s.Require().GreaterOrEqual(s.ttl(listKeysSetKey), s.ttl(longKey))
+
+	// После истечения короткого списка Invalidate по-прежнему видит долгий
+	time.Sleep(2100 * time.Millisecond)
+	s.Require().NoError(s.repository.Invalidate(s.ctx, "part-1"))
+
+	_, err = s.repository.GetParts(s.ctx, longFilter)
+	s.Require().ErrorIs(err, model.ErrCacheMiss)
+}
+
+func (s *RepositorySuite) TestSetPartsExtendsIndexTTL() {
+	parts := []model.Part{{Uuid: "part-1", Name: "engine"}}
+
+	s.Require().NoError(s.repository.SetParts(s.ctx, model.PartsFilter{Names: []string{"engine"}}, parts, 2*time.Second))
+	s.Require().LessOrEqual(s.ttl(listKeysSetKey), 2*time.Second)
+
+	s.Require().NoError(s.repository.SetParts(s.ctx, model.PartsFilter{Names: []string{"wing"}}, parts, time.Minute))
+	s.Require().Greater(s.ttl(listKeysSetKey), 50*time.Second)
+}
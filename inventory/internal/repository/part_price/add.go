@@ -0,0 +1,13 @@
+package part_price
+
+import (
+	"context"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+func (r *repository) AddPrice(ctx context.Context, price model.PartPrice) error {
+	_, err := r.prices.InsertOne(ctx, repoConverter.ToRepoPartPrice(price))
+	return err
+}
@@ -0,0 +1,75 @@
+package part_price
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// BackfillPrices нужен для деталей, созданных до появления истории цен (и для
+// тестовых деталей): их цена считается действующей с момента создания.
+func (r *repository) BackfillPrices(ctx context.Context) (int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: r.prices.Name()},
+			{Key: "let", Value: bson.D{{Key: "uuid", Value: "$uuid"}}},
+			{Key: "pipeline", Value: mongo.Pipeline{
+				{{Key: "$match", Value: bson.D{{Key: "$expr", Value: bson.D{
+					{Key: "$eq", Value: bson.A{"$part_uuid", "$$uuid"}},
+				}}}}},
+				{{Key: "$limit", Value: 1}},
+				{{Key: "$project", Value: bson.D{{Key: "_id", Value: 1}}}},
+			}},
+			{Key: "as", Value: "prices"},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "prices", Value: bson.D{{Key: "$size", Value: 0}}}}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "uuid", Value: 1},
+			{Key: "price", Value: 1},
+			{Key: "created_at", Value: 1},
+		}}},
+	}
+
+	cursor, err := r.parts.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var parts []repoModel.Part
+	if err = cursor.All(ctx, &parts); err != nil {
+		return 0, err
+	}
+
+	if len(parts) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	documents := make([]any, 0, len(parts))
+	for _, part := range parts {
+		documents = append(documents, repoModel.PartPrice{
+			PartUuid:      part.Uuid,
+			Price:         part.Price,
+			EffectiveFrom: part.CreatedAt,
+			CreatedAt:     now,
+		})
+	}
+
+	result, err := r.prices.InsertMany(ctx, documents)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(result.InsertedIDs)), nil
+}
@@ -0,0 +1,48 @@
+package part_price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (s *RepositorySuite) TestBackfillPrices() {
+	createdAt := time.Now().Add(-24 * time.Hour).Truncate(time.Millisecond)
+	legacyUUID := s.insertPart(250, createdAt)
+	trackedUUID := s.insertPart(300, createdAt)
+	s.addPrice(trackedUUID, 310, createdAt.Add(time.Hour))
+
+	count, err := s.repository.BackfillPrices(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), count)
+
+	price, err := s.repository.GetPriceAt(s.ctx, legacyUUID, createdAt)
+	s.Require().NoError(err)
+	s.Require().Equal(250.0, price.Price)
+
+	prices, err := s.repository.ListPrices(s.ctx, trackedUUID)
+	s.Require().NoError(err)
+	s.Require().Len(prices, 1)
+
+	// Повторный запуск ничего не добавляет
+	count, err = s.repository.BackfillPrices(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+}
+
+func (s *RepositorySuite) insertPart(price float64, createdAt time.Time) string {
+	partUUID := gofakeit.UUID()
+	_, err := s.db.Collection("parts").InsertOne(s.ctx, repoModel.Part{
+		Uuid:      partUUID,
+		Name:      gofakeit.Name(),
+		Price:     price,
+		Category:  repoModel.CategoryEngine,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	})
+	s.Require().NoError(err)
+
+	return partUUID
+}
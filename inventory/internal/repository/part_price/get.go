@@ -0,0 +1,102 @@
+package part_price
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) GetPriceAt(ctx context.Context, partUuid string, at time.Time) (model.PartPrice, error) {
+	var price repoModel.PartPrice
+	err := r.prices.FindOne(ctx,
+		bson.M{
+			"part_uuid":      partUuid,
+			"effective_from": bson.M{"$lte": at},
+		},
+		options.FindOne().SetSort(latestFirst),
+	).Decode(&price)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.PartPrice{}, model.ErrPartPriceNotFound
+		}
+		return model.PartPrice{}, err
+	}
+
+	return repoConverter.ToModelPartPrice(price), nil
+}
+
+func (r *repository) GetEffectivePrices(ctx context.Context, partUuids []string, at time.Time) (map[string]model.PartPrice, error) {
+	if len(partUuids) == 0 {
+		return map[string]model.PartPrice{}, nil
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"part_uuid":      bson.M{"$in": partUuids},
+			"effective_from": bson.M{"$lte": at},
+		}}},
+		{{Key: "$sort", Value: append(bson.D{{Key: "part_uuid", Value: 1}}, latestFirst...)}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$part_uuid"},
+			{Key: "price", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$price"}}}},
+	}
+
+	cursor, err := r.prices.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoPrices []repoModel.PartPrice
+	if err = cursor.All(ctx, &repoPrices); err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]model.PartPrice, len(repoPrices))
+	for _, repoPrice := range repoPrices {
+		prices[repoPrice.PartUuid] = repoConverter.ToModelPartPrice(repoPrice)
+	}
+
+	return prices, nil
+}
+
+func (r *repository) GetNextPriceChange(ctx context.Context, partUuids []string, after time.Time) (time.Time, error) {
+	if len(partUuids) == 0 {
+		return time.Time{}, nil
+	}
+
+	var price repoModel.PartPrice
+	err := r.prices.FindOne(ctx,
+		bson.M{
+			"part_uuid":      bson.M{"$in": partUuids},
+			"effective_from": bson.M{"$gt": after},
+		},
+		options.FindOne().
+			SetSort(bson.D{{Key: "effective_from", Value: 1}}).
+			SetProjection(bson.M{"effective_from": 1}),
+	).Decode(&price)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+
+	return price.EffectiveFrom, nil
+}
@@ -0,0 +1,87 @@
+package part_price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestGetPriceAt() {
+	partUUID := gofakeit.UUID()
+	start := time.Now().Add(-48 * time.Hour).Truncate(time.Millisecond)
+
+	s.addPrice(partUUID, 100, start)
+	s.addPrice(partUUID, 120, start.Add(24*time.Hour))
+	s.addPrice(partUUID, 150, start.Add(72*time.Hour))
+
+	price, err := s.repository.GetPriceAt(s.ctx, partUUID, start.Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().Equal(100.0, price.Price)
+
+	price, err = s.repository.GetPriceAt(s.ctx, partUUID, start.Add(24*time.Hour))
+	s.Require().NoError(err)
+	s.Require().Equal(120.0, price.Price)
+	s.Require().True(price.EffectiveFrom.Equal(start.Add(24 * time.Hour)))
+
+	_, err = s.repository.GetPriceAt(s.ctx, partUUID, start.Add(-time.Second))
+	s.Require().ErrorIs(err, model.ErrPartPriceNotFound)
+}
+
+func (s *RepositorySuite) TestGetPriceAtPrefersLaterRecordForSameMoment() {
+	partUUID := gofakeit.UUID()
+	effectiveFrom := time.Now().Truncate(time.Millisecond)
+
+	s.addPrice(partUUID, 100, effectiveFrom)
+	s.addPrice(partUUID, 110, effectiveFrom)
+
+	price, err := s.repository.GetPriceAt(s.ctx, partUUID, effectiveFrom)
+	s.Require().NoError(err)
+	s.Require().Equal(110.0, price.Price)
+}
+
+func (s *RepositorySuite) TestGetEffectivePrices() {
+	now := time.Now()
+	first, second, withoutPrice := gofakeit.UUID(), gofakeit.UUID(), gofakeit.UUID()
+
+	s.addPrice(first, 100, now.Add(-time.Hour))
+	s.addPrice(first, 200, now.Add(time.Hour))
+	s.addPrice(second, 10, now.Add(-2*time.Hour))
+	s.addPrice(second, 20, now.Add(-time.Hour))
+	s.addPrice(withoutPrice, 1, now.Add(time.Hour))
+
+	prices, err := s.repository.GetEffectivePrices(s.ctx, []string{first, second, withoutPrice}, now)
+	s.Require().NoError(err)
+	s.Require().Len(prices, 2)
+	s.Require().Equal(100.0, prices[first].Price)
+	s.Require().Equal(20.0, prices[second].Price)
+}
+
+func (s *RepositorySuite) TestGetNextPriceChange() {
+	now := time.Now().Truncate(time.Millisecond)
+	first, second := gofakeit.UUID(), gofakeit.UUID()
+
+	s.addPrice(first, 100, now.Add(-time.Hour))
+	s.addPrice(first, 200, now.Add(2*time.Hour))
+	s.addPrice(second, 20, now.Add(time.Hour))
+	s.addPrice(gofakeit.UUID(), 1, now.Add(time.Minute))
+
+	next, err := s.repository.GetNextPriceChange(s.ctx, []string{first, second}, now)
+	s.Require().NoError(err)
+	s.Require().True(next.Equal(now.Add(time.Hour)))
+
+	next, err = s.repository.GetNextPriceChange(s.ctx, []string{first}, now.Add(3*time.Hour))
+	s.Require().NoError(err)
+	s.Require().True(next.IsZero())
+}
+
+func (s *RepositorySuite) addPrice(partUUID string, price float64, effectiveFrom time.Time) {
+	err := s.repository.AddPrice(s.ctx, model.PartPrice{
+		PartUuid:      partUUID,
+		Price:         price,
+		EffectiveFrom: effectiveFrom,
+		CreatedAt:     time.Now(),
+	})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,41 @@
+package part_price
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) ListPrices(ctx context.Context, partUuid string) ([]model.PartPrice, error) {
+	cursor, err := r.prices.Find(ctx,
+		bson.M{"part_uuid": partUuid},
+		options.Find().SetSort(latestFirst),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoPrices []repoModel.PartPrice
+	if err = cursor.All(ctx, &repoPrices); err != nil {
+		return nil, err
+	}
+
+	prices := make([]model.PartPrice, 0, len(repoPrices))
+	for _, repoPrice := range repoPrices {
+		prices = append(prices, repoConverter.ToModelPartPrice(repoPrice))
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,30 @@
+package part_price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+)
+
+func (s *RepositorySuite) TestListPricesLatestFirst() {
+	partUUID := gofakeit.UUID()
+	now := time.Now()
+
+	s.addPrice(partUUID, 100, now.Add(-time.Hour))
+	s.addPrice(partUUID, 150, now.Add(time.Hour))
+	s.addPrice(partUUID, 120, now)
+	s.addPrice(gofakeit.UUID(), 1, now)
+
+	prices, err := s.repository.ListPrices(s.ctx, partUUID)
+	s.Require().NoError(err)
+	s.Require().Len(prices, 3)
+	s.Require().Equal(150.0, prices[0].Price)
+	s.Require().Equal(120.0, prices[1].Price)
+	s.Require().Equal(100.0, prices[2].Price)
+}
+
+func (s *RepositorySuite) TestListPricesEmpty() {
+	prices, err := s.repository.ListPrices(s.ctx, gofakeit.UUID())
+	s.Require().NoError(err)
+	s.Require().Empty(prices)
+}
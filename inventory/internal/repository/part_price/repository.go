@@ -0,0 +1,48 @@
+package part_price
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+)
+
+var _ def.PartPriceRepository = (*repository)(nil)
+
+type repository struct {
+	parts  *mongo.Collection
+	prices *mongo.Collection
+}
+
+func NewRepository(ctx context.Context, db *mongo.Database) *repository {
+	prices := db.Collection("part_prices")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "part_uuid", Value: 1}, {Key: "effective_from", Value: -1}},
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := prices.Indexes().CreateMany(indexCtx, indexModels)
+	if err != nil {
+		panic(err)
+	}
+
+	return &repository{
+		parts:  db.Collection("parts"),
+		prices: prices,
+	}
+}
+
+// Записи с одинаковым effective_from различаем по времени создания: действует более поздняя
+var latestFirst = bson.D{
+	{Key: "effective_from", Value: -1},
+	{Key: "created_at", Value: -1},
+	{Key: "_id", Value: -1},
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
 )
@@ -10,4 +11,99 @@ type PartRepository interface {
 	GetPart(ctx context.Context, uuid string) (model.Part, error)
 
 	ListParts(ctx context.Context) ([]model.Part, error)
+
+	// StreamParts читает детали по фильтру курсором, упорядочив по uuid, и передаёт их в fn
+	// порциями по chunkSize. Ошибка fn прерывает чтение и возвращается как есть
+	StreamParts(ctx context.Context, filter model.PartsFilter, chunkSize int, fn func([]model.Part) error) error
+
+	GetPartFacets(ctx context.Context, filter model.PartsFilter, topTagsLimit int) (model.PartFacets, error)
+
+	CreatePart(ctx context.Context, part model.Part) error
+
+	UpdatePart(ctx context.Context, part model.Part) error
+
+	DeletePart(ctx context.Context, uuid string) error
+}
+
+type StockRepository interface {
+	AdjustStock(ctx context.Context, movement model.StockMovement) (model.StockMovement, error)
+
+	ListStockMovements(ctx context.Context, filter model.StockMovementsFilter) ([]model.StockMovement, error)
+
+	// GetWarehouseStock возвращает остатки детали по складам
+	GetWarehouseStock(ctx context.Context, partUuid string) (map[string]int64, error)
+
+	// AllocateUnassignedStock переносит на склад остаток деталей, у которых ещё нет разбивки по складам.
+	// Возвращает количество обновлённых деталей
+	AllocateUnassignedStock(ctx context.Context, warehouseCode string) (int64, error)
+}
+
+type WarehouseRepository interface {
+	CreateWarehouse(ctx context.Context, warehouse model.Warehouse) error
+
+	// EnsureWarehouse создаёт склад, если склада с таким кодом ещё нет
+	EnsureWarehouse(ctx context.Context, warehouse model.Warehouse) error
+
+	GetWarehouse(ctx context.Context, code string) (model.Warehouse, error)
+
+	ListWarehouses(ctx context.Context) ([]model.Warehouse, error)
+}
+
+type KitRepository interface {
+	CreateKit(ctx context.Context, kit model.Kit) error
+
+	GetKit(ctx context.Context, uuid string) (model.Kit, error)
+
+	// ListKits возвращает комплекты с указанными UUID или все, если список пуст
+	ListKits(ctx context.Context, uuids []string) ([]model.Kit, error)
+
+	UpdateKit(ctx context.Context, kit model.Kit) error
+
+	DeleteKit(ctx context.Context, uuid string) error
+}
+
+// PartPriceRepository хранит историю цен деталей, включая запланированные.
+type PartPriceRepository interface {
+	AddPrice(ctx context.Context, price model.PartPrice) error
+
+	// ListPrices возвращает историю цен детали, начиная с самой поздней
+	ListPrices(ctx context.Context, partUuid string) ([]model.PartPrice, error)
+
+	// GetPriceAt возвращает цену, действовавшую в момент at, или model.ErrPartPriceNotFound
+	GetPriceAt(ctx context.Context, partUuid string, at time.Time) (model.PartPrice, error)
+
+	// GetEffectivePrices возвращает действующие в момент at цены деталей.
+	// Детали без цены на этот момент в результат не попадают
+	GetEffectivePrices(ctx context.Context, partUuids []string, at time.Time) (map[string]model.PartPrice, error)
+
+	// GetNextPriceChange возвращает ближайший после after момент смены цены одной из деталей.
+	// Нулевое время означает, что изменений не запланировано
+	GetNextPriceChange(ctx context.Context, partUuids []string, after time.Time) (time.Time, error)
+
+	// BackfillPrices записывает текущую цену деталей без истории как действующую с даты их создания.
+	// Возвращает количество добавленных записей
+	BackfillPrices(ctx context.Context) (int64, error)
+}
+
+type CompatibilityRuleRepository interface {
+	CreateRule(ctx context.Context, rule model.CompatibilityRule) error
+
+	ListRules(ctx context.Context) ([]model.CompatibilityRule, error)
+
+	DeleteRule(ctx context.Context, uuid string) error
+}
+
+// PartCacheRepository — read-through кэш деталей в Redis.
+// Get-методы возвращают model.ErrCacheMiss, если записи нет.
+type PartCacheRepository interface {
+	GetPart(ctx context.Context, uuid string) (model.Part, error)
+
+	SetPart(ctx context.Context, part model.Part, ttl time.Duration) error
+
+	GetParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
+
+	SetParts(ctx context.Context, filter model.PartsFilter, parts []model.Part, ttl time.Duration) error
+
+	// Invalidate удаляет деталь и все закэшированные списки — любой из них мог её содержать
+	Invalidate(ctx context.Context, uuid string) error
 }
@@ -0,0 +1,83 @@
+package stock
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// AdjustStock атомарно меняет остаток детали на складе movement.WarehouseCode
+// и общий stock_quantity, затем дописывает движение в журнал.
+// Mongo развернут без replica set, поэтому многодокументные транзакции недоступны:
+// остатки хранятся в документе детали и меняются одним условным $inc (остаток склада
+// не уходит в минус), а если запись в журнал не удалась, изменение откатывается обратным $inc.
+func (r *repository) AdjustStock(ctx context.Context, movement model.StockMovement) (model.StockMovement, error) {
+	warehouseField := warehouseStockField(movement.WarehouseCode)
+
+	query := bson.M{"uuid": movement.PartUuid}
+	if movement.Delta < 0 {
+		query[warehouseField] = bson.M{"$gte": -movement.Delta}
+	}
+
+	var part repoModel.Part
+	err := r.parts.FindOneAndUpdate(ctx,
+		query,
+		bson.M{
+			"$inc": bson.M{"stock_quantity": movement.Delta, warehouseField: movement.Delta},
+			"$set": bson.M{"updated_at": movement.CreatedAt},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&part)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.StockMovement{}, r.notAdjustedReason(ctx, movement.PartUuid)
+		}
+		return model.StockMovement{}, err
+	}
+
+	movement.StockAfter = part.StockQuantity
+	movement.WarehouseStockAfter = part.WarehouseStock[movement.WarehouseCode]
+
+	_, err = r.movements.InsertOne(ctx, repoConverter.ToRepoStockMovement(movement))
+	if err != nil {
+		_, rerr := r.parts.UpdateOne(ctx,
+			bson.M{"uuid": movement.PartUuid},
+			bson.M{"$inc": bson.M{"stock_quantity": -movement.Delta, warehouseField: -movement.Delta}},
+		)
+		if rerr != nil {
+			logger.Error(ctx, "failed to revert stock quantity",
+				zap.String("partUUID", movement.PartUuid),
+				zap.Int64("delta", movement.Delta),
+				zap.Error(rerr),
+			)
+		}
+		return model.StockMovement{}, err
+	}
+
+	return movement, nil
+}
+
+// notAdjustedReason различает отсутствующую деталь и нехватку остатка.
+func (r *repository) notAdjustedReason(ctx context.Context, partUUID string) error {
+	count, err := r.parts.CountDocuments(ctx, bson.M{"uuid": partUUID})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+	return model.ErrInsufficientStock
+}
+
+func warehouseStockField(warehouseCode string) string {
+	return "warehouse_stock." + warehouseCode
+}
@@ -0,0 +1,120 @@
+package stock
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (s *RepositorySuite) TestAdjustStockUpdatesQuantityAndRecordsMovement() {
+	partUUID := s.insertPart(10)
+
+	movement := newMovement(partUUID, -4, model.StockMovementReasonReservation)
+
+	result, err := s.repository.AdjustStock(s.ctx, movement)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(6), result.StockAfter)
+	s.Require().Equal(int64(6), result.WarehouseStockAfter)
+
+	var part repoModel.Part
+	err = s.db.Collection("parts").FindOne(s.ctx, bson.M{"uuid": partUUID}).Decode(&part)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(6), part.StockQuantity)
+
+	var stored repoModel.StockMovement
+	err = s.db.Collection("stock_movements").FindOne(s.ctx, bson.M{"uuid": movement.Uuid}).Decode(&stored)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(-4), stored.Delta)
+	s.Require().Equal(int64(6), stored.StockAfter)
+	s.Require().Equal(movement.ReferenceID, stored.ReferenceID)
+	s.Require().Equal(movement.Actor, stored.Actor)
+}
+
+func (s *RepositorySuite) TestAdjustStockInsufficientStock() {
+	partUUID := s.insertPart(2)
+
+	_, err := s.repository.AdjustStock(s.ctx, newMovement(partUUID, -3, model.StockMovementReasonSale))
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+
+	count, err := s.db.Collection("stock_movements").CountDocuments(s.ctx, bson.M{"part_uuid": partUUID})
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+}
+
+func (s *RepositorySuite) TestAdjustStockChecksWarehouseStockNotTotal() {
+	partUUID := s.insertPart(5)
+
+	restock := newMovement(partUUID, 4, model.StockMovementReasonRestock)
+	restock.WarehouseCode = "plesetsk"
+	result, err := s.repository.AdjustStock(s.ctx, restock)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(9), result.StockAfter)
+	s.Require().Equal(int64(4), result.WarehouseStockAfter)
+
+	sale := newMovement(partUUID, -6, model.StockMovementReasonSale)
+	sale.WarehouseCode = "plesetsk"
+	_, err = s.repository.AdjustStock(s.ctx, sale)
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+
+	stock, err := s.repository.GetWarehouseStock(s.ctx, partUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int64{testWarehouse: 5, "plesetsk": 4}, stock)
+}
+
+func (s *RepositorySuite) TestAdjustStockPartNotFound() {
+	_, err := s.repository.AdjustStock(s.ctx, newMovement(gofakeit.UUID(), 1, model.StockMovementReasonRestock))
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *RepositorySuite) TestAdjustStockRevertsQuantityWhenMovementNotRecorded() {
+	partUUID := s.insertPart(5)
+
+	movement := newMovement(partUUID, 3, model.StockMovementReasonRestock)
+	_, err := s.repository.AdjustStock(s.ctx, movement)
+	s.Require().NoError(err)
+
+	// Повторная запись с тем же UUID нарушает уникальный индекс журнала
+	_, err = s.repository.AdjustStock(s.ctx, movement)
+	s.Require().Error(err)
+
+	var part repoModel.Part
+	err = s.db.Collection("parts").FindOne(s.ctx, bson.M{"uuid": partUUID}).Decode(&part)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(8), part.StockQuantity)
+}
+
+const testWarehouse = "baikonur"
+
+func (s *RepositorySuite) insertPart(stock int64) string {
+	partUUID := gofakeit.UUID()
+	_, err := s.db.Collection("parts").InsertOne(s.ctx, repoModel.Part{
+		Uuid:           partUUID,
+		Name:           gofakeit.Name(),
+		Price:          gofakeit.Price(100, 1000),
+		StockQuantity:  stock,
+		WarehouseStock: map[string]int64{testWarehouse: stock},
+		Category:       repoModel.CategoryEngine,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+	s.Require().NoError(err)
+
+	return partUUID
+}
+
+func newMovement(partUUID string, delta int64, reason model.StockMovementReason) model.StockMovement {
+	return model.StockMovement{
+		Uuid:          gofakeit.UUID(),
+		PartUuid:      partUUID,
+		Delta:         delta,
+		Reason:        reason,
+		ReferenceID:   gofakeit.UUID(),
+		Actor:         gofakeit.UUID(),
+		CreatedAt:     time.Now(),
+		WarehouseCode: testWarehouse,
+	}
+}
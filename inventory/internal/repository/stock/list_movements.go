@@ -0,0 +1,51 @@
+package stock
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) ListStockMovements(ctx context.Context, filter model.StockMovementsFilter) ([]model.StockMovement, error) {
+	query := bson.M{"part_uuid": filter.PartUuid}
+	if filter.ReferenceID != "" {
+		query["reference_id"] = filter.ReferenceID
+	}
+	if filter.WarehouseCode != "" {
+		query["warehouse_code"] = filter.WarehouseCode
+	}
+
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(filter.Offset)).
+		SetLimit(int64(filter.Limit))
+
+	cursor, err := r.movements.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoMovements []repoModel.StockMovement
+	if err = cursor.All(ctx, &repoMovements); err != nil {
+		return nil, err
+	}
+
+	movements := make([]model.StockMovement, 0, len(repoMovements))
+	for _, repoMovement := range repoMovements {
+		movements = append(movements, repoConverter.ToModelStockMovement(repoMovement))
+	}
+
+	return movements, nil
+}
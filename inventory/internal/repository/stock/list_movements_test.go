@@ -0,0 +1,81 @@
+package stock
+
+import (
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestListStockMovementsNewestFirst() {
+	partUUID := s.insertPart(0)
+
+	first := newMovement(partUUID, 5, model.StockMovementReasonRestock)
+	first.CreatedAt = time.Now().Add(-time.Minute)
+	second := newMovement(partUUID, -2, model.StockMovementReasonReservation)
+
+	_, err := s.repository.AdjustStock(s.ctx, first)
+	s.Require().NoError(err)
+	_, err = s.repository.AdjustStock(s.ctx, second)
+	s.Require().NoError(err)
+
+	result, err := s.repository.ListStockMovements(s.ctx, model.StockMovementsFilter{PartUuid: partUUID, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(result, 2)
+	s.Require().Equal(second.Uuid, result[0].Uuid)
+	s.Require().Equal(int64(3), result[0].StockAfter)
+	s.Require().Equal(first.Uuid, result[1].Uuid)
+}
+
+func (s *RepositorySuite) TestListStockMovementsFiltersByReference() {
+	partUUID := s.insertPart(10)
+
+	reservation := newMovement(partUUID, -1, model.StockMovementReasonReservation)
+	_, err := s.repository.AdjustStock(s.ctx, reservation)
+	s.Require().NoError(err)
+	_, err = s.repository.AdjustStock(s.ctx, newMovement(partUUID, -1, model.StockMovementReasonReservation))
+	s.Require().NoError(err)
+
+	result, err := s.repository.ListStockMovements(s.ctx, model.StockMovementsFilter{
+		PartUuid:    partUUID,
+		ReferenceID: reservation.ReferenceID,
+		Limit:       10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+	s.Require().Equal(reservation.Uuid, result[0].Uuid)
+}
+
+func (s *RepositorySuite) TestListStockMovementsPagination() {
+	partUUID := s.insertPart(0)
+
+	for range 3 {
+		_, err := s.repository.AdjustStock(s.ctx, newMovement(partUUID, 1, model.StockMovementReasonRestock))
+		s.Require().NoError(err)
+	}
+
+	result, err := s.repository.ListStockMovements(s.ctx, model.StockMovementsFilter{PartUuid: partUUID, Limit: 2, Offset: 2})
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+}
+
+func (s *RepositorySuite) TestListStockMovementsFiltersByWarehouse() {
+	partUUID := s.insertPart(0)
+
+	_, err := s.repository.AdjustStock(s.ctx, newMovement(partUUID, 2, model.StockMovementReasonRestock))
+	s.Require().NoError(err)
+
+	other := newMovement(partUUID, 3, model.StockMovementReasonRestock)
+	other.WarehouseCode = "plesetsk"
+	_, err = s.repository.AdjustStock(s.ctx, other)
+	s.Require().NoError(err)
+
+	result, err := s.repository.ListStockMovements(s.ctx, model.StockMovementsFilter{
+		PartUuid:      partUUID,
+		WarehouseCode: "plesetsk",
+		Limit:         10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(result, 1)
+	s.Require().Equal(other.Uuid, result[0].Uuid)
+	s.Require().Equal(int64(3), result[0].WarehouseStockAfter)
+}
@@ -0,0 +1,49 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+)
+
+var _ def.StockRepository = (*repository)(nil)
+
+type repository struct {
+	parts     *mongo.Collection
+	movements *mongo.Collection
+}
+
+func NewRepository(ctx context.Context, db *mongo.Database) *repository {
+	movements := db.Collection("stock_movements")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "part_uuid", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "reference_id", Value: 1}},
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := movements.Indexes().CreateMany(indexCtx, indexModels)
+	if err != nil {
+		panic(err)
+	}
+
+	return &repository{
+		parts:     db.Collection("parts"),
+		movements: movements,
+	}
+}
@@ -0,0 +1,54 @@
+package stock
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (r *repository) GetWarehouseStock(ctx context.Context, partUUID string) (map[string]int64, error) {
+	var part repoModel.Part
+	err := r.parts.FindOne(ctx,
+		bson.M{"uuid": partUUID},
+		options.FindOne().SetProjection(bson.M{"warehouse_stock": 1}),
+	).Decode(&part)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrPartNotFound
+		}
+		return nil, err
+	}
+
+	if part.WarehouseStock == nil {
+		return map[string]int64{}, nil
+	}
+
+	return part.WarehouseStock, nil
+}
+
+// AllocateUnassignedStock нужен для деталей, созданных до появления складов (и для
+// тестовых деталей): весь их stock_quantity считается лежащим на warehouseCode.
+func (r *repository) AllocateUnassignedStock(ctx context.Context, warehouseCode string) (int64, error) {
+	result, err := r.parts.UpdateMany(ctx,
+		bson.M{
+			"warehouse_stock": bson.M{"$exists": false},
+			"stock_quantity":  bson.M{"$gt": 0},
+		},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"warehouse_stock": bson.M{warehouseCode: "$stock_quantity"},
+			}}},
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
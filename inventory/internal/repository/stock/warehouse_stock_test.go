@@ -0,0 +1,43 @@
+package stock
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (s *RepositorySuite) TestGetWarehouseStockPartNotFound() {
+	_, err := s.repository.GetWarehouseStock(s.ctx, gofakeit.UUID())
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *RepositorySuite) TestAllocateUnassignedStock() {
+	legacyUUID := gofakeit.UUID()
+	_, err := s.db.Collection("parts").InsertOne(s.ctx, repoModel.Part{
+		Uuid:          legacyUUID,
+		Name:          gofakeit.Name(),
+		StockQuantity: 7,
+		Category:      repoModel.CategoryWing,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	})
+	s.Require().NoError(err)
+	allocatedUUID := s.insertPart(3)
+
+	count, err := s.repository.AllocateUnassignedStock(s.ctx, "vostochny")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), count)
+
+	stock, err := s.repository.GetWarehouseStock(s.ctx, legacyUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int64{"vostochny": 7}, stock)
+
+	var part repoModel.Part
+	err = s.db.Collection("parts").FindOne(s.ctx, bson.M{"uuid": allocatedUUID}).Decode(&part)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int64{testWarehouse: 3}, part.WarehouseStock)
+}
@@ -0,0 +1,33 @@
+package warehouse
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreateWarehouse(ctx context.Context, warehouse model.Warehouse) error {
+	_, err := r.collection.InsertOne(ctx, repoConverter.ToRepoWarehouse(warehouse))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrWarehouseAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) EnsureWarehouse(ctx context.Context, warehouse model.Warehouse) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"code": warehouse.Code},
+		bson.M{"$setOnInsert": repoConverter.ToRepoWarehouse(warehouse)},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
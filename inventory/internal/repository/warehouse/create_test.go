@@ -0,0 +1,40 @@
+package warehouse
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func newWarehouse(code string) model.Warehouse {
+	return model.Warehouse{
+		Code:      code,
+		Name:      gofakeit.City(),
+		Location:  gofakeit.Country(),
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+func (s *RepositorySuite) TestCreateWarehouseDuplicateCode() {
+	s.Require().NoError(s.repository.CreateWarehouse(s.ctx, newWarehouse("baikonur")))
+
+	err := s.repository.CreateWarehouse(s.ctx, newWarehouse("baikonur"))
+	s.Require().ErrorIs(err, model.ErrWarehouseAlreadyExists)
+}
+
+func (s *RepositorySuite) TestEnsureWarehouseKeepsExisting() {
+	existing := newWarehouse("plesetsk")
+	s.Require().NoError(s.repository.CreateWarehouse(s.ctx, existing))
+
+	s.Require().NoError(s.repository.EnsureWarehouse(s.ctx, newWarehouse("plesetsk")))
+	s.Require().NoError(s.repository.EnsureWarehouse(s.ctx, newWarehouse("vostochny")))
+
+	warehouse, err := s.repository.GetWarehouse(s.ctx, "plesetsk")
+	s.Require().NoError(err)
+	s.Require().Equal(existing, warehouse)
+
+	_, err = s.repository.GetWarehouse(s.ctx, "vostochny")
+	s.Require().NoError(err)
+}
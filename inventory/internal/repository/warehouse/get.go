@@ -0,0 +1,26 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+)
+
+func (r *repository) GetWarehouse(ctx context.Context, code string) (model.Warehouse, error) {
+	var warehouse repoModel.Warehouse
+	err := r.collection.FindOne(ctx, bson.M{"code": code}).Decode(&warehouse)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return model.Warehouse{}, model.ErrWarehouseNotFound
+		}
+		return model.Warehouse{}, err
+	}
+
+	return repoConverter.ToModelWarehouse(warehouse), nil
+}
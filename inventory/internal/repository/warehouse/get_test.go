@@ -0,0 +1,10 @@
+package warehouse
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *RepositorySuite) TestGetWarehouseNotFound() {
+	_, err := s.repository.GetWarehouse(s.ctx, "missing")
+	s.Require().ErrorIs(err, model.ErrWarehouseNotFound)
+}
@@ -0,0 +1,38 @@
+package warehouse
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (r *repository) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "code", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := cursor.Close(ctx); cerr != nil {
+			logger.Error(ctx, "failed to close cursor", zap.Error(cerr))
+		}
+	}()
+
+	var repoWarehouses []repoModel.Warehouse
+	if err = cursor.All(ctx, &repoWarehouses); err != nil {
+		return nil, err
+	}
+
+	warehouses := make([]model.Warehouse, 0, len(repoWarehouses))
+	for _, repoWarehouse := range repoWarehouses {
+		warehouses = append(warehouses, repoConverter.ToModelWarehouse(repoWarehouse))
+	}
+
+	return warehouses, nil
+}
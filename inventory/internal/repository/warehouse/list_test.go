@@ -0,0 +1,12 @@
+package warehouse
+
+func (s *RepositorySuite) TestListWarehousesSortedByCode() {
+	s.Require().NoError(s.repository.CreateWarehouse(s.ctx, newWarehouse("vostochny")))
+	s.Require().NoError(s.repository.CreateWarehouse(s.ctx, newWarehouse("baikonur")))
+
+	warehouses, err := s.repository.ListWarehouses(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(warehouses, 2)
+	s.Require().Equal("baikonur", warehouses[0].Code)
+	s.Require().Equal("vostochny", warehouses[1].Code)
+}
@@ -0,0 +1,41 @@
+package warehouse
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+)
+
+var _ def.WarehouseRepository = (*repository)(nil)
+
+type repository struct {
+	collection *mongo.Collection
+}
+
+func NewRepository(ctx context.Context, db *mongo.Database) *repository {
+	collection := db.Collection("warehouses")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "code", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateMany(indexCtx, indexModels)
+	if err != nil {
+		panic(err)
+	}
+
+	return &repository{
+		collection: collection,
+	}
+}
@@ -0,0 +1,26 @@
+package catalog
+
+import (
+	"context"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ExportParts(ctx context.Context) ([]model.Part, error) {
+	parts, err := s.partService.ListParts(ctx, model.PartsFilter{})
+	if err != nil {
+		logger.Error(ctx, "failed to export parts", zap.Error(err))
+		return nil, err
+	}
+
+	// Стабильный порядок, чтобы выгрузки можно было сравнивать diff'ом
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].Uuid < parts[j].Uuid
+	})
+
+	return parts, nil
+}
@@ -0,0 +1,33 @@
+package catalog
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestExportPartsSortedByUUID() {
+	parts := []model.Part{
+		{Uuid: "c0000000-0000-0000-0000-000000000000"},
+		{Uuid: "a0000000-0000-0000-0000-000000000000"},
+		{Uuid: "b0000000-0000-0000-0000-000000000000"},
+	}
+
+	s.partService.On("ListParts", s.ctx, model.PartsFilter{}).Return(parts, nil)
+
+	res, err := s.service.ExportParts(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal("a0000000-0000-0000-0000-000000000000", res[0].Uuid)
+	s.Require().Equal("b0000000-0000-0000-0000-000000000000", res[1].Uuid)
+	s.Require().Equal("c0000000-0000-0000-0000-000000000000", res[2].Uuid)
+}
+
+func (s *ServiceSuite) TestExportPartsError() {
+	serviceErr := gofakeit.Error()
+
+	s.partService.On("ListParts", s.ctx, model.PartsFilter{}).Return(nil, serviceErr)
+
+	res, err := s.service.ExportParts(s.ctx)
+	s.Require().ErrorIs(err, serviceErr)
+	s.Require().Nil(res)
+}
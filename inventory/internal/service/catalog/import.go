@@ -0,0 +1,151 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ImportParts(ctx context.Context, rows []model.CatalogRow, dryRun bool) (model.ImportReport, error) {
+	report := model.ImportReport{
+		DryRun: dryRun,
+		Rows:   make([]model.ImportRowResult, 0, len(rows)),
+	}
+
+	// Первая строка для каждого uuid — повторы в одном файле считаем ошибкой
+	seen := make(map[string]int, len(rows))
+
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		result := model.ImportRowResult{Row: row.Row, Uuid: row.Part.Uuid}
+
+		action, err := s.importRow(ctx, row, seen, dryRun)
+		if err != nil {
+			result.Action = model.ImportActionFailed
+			result.Error = err.Error()
+		} else {
+			result.Action = action
+		}
+
+		switch result.Action {
+		case model.ImportActionCreated:
+			report.Created++
+		case model.ImportActionUpdated:
+			report.Updated++
+		case model.ImportActionUnchanged:
+			report.Unchanged++
+		case model.ImportActionFailed:
+			report.Failed++
+		}
+
+		report.Rows = append(report.Rows, result)
+	}
+
+	logger.Debug(ctx,
+		"catalog import finished",
+		zap.Bool("dryRun", dryRun),
+		zap.Int("created", report.Created),
+		zap.Int("updated", report.Updated),
+		zap.Int("unchanged", report.Unchanged),
+		zap.Int("failed", report.Failed),
+	)
+
+	return report, nil
+}
+
+func (s *service) importRow(ctx context.Context, row model.CatalogRow, seen map[string]int, dryRun bool) (model.ImportAction, error) {
+	if row.ParseErr != nil {
+		return "", row.ParseErr
+	}
+
+	part := row.Part
+
+	if _, err := uuid.Parse(part.Uuid); err != nil {
+		return "", fmt.Errorf("invalid uuid: %w", err)
+	}
+
+	if first, ok := seen[part.Uuid]; ok {
+		return "", fmt.Errorf("duplicate uuid, first seen in row %d", first)
+	}
+	seen[part.Uuid] = row.Row
+
+	if err := model.ValidatePart(part); err != nil {
+		return "", err
+	}
+
+	if part.StockQuantity < 0 {
+		return "", fmt.Errorf("%w: stock_quantity must not be negative", model.ErrInvalidPart)
+	}
+
+	existing, err := s.partService.GetPart(ctx, part.Uuid)
+	switch {
+	case errors.Is(err, model.ErrPartNotFound):
+		if dryRun {
+			return model.ImportActionCreated, nil
+		}
+		return model.ImportActionCreated, s.createPart(ctx, part)
+	case err != nil:
+		return "", err
+	}
+
+	// Остаток существующей детали меняется только через журнал движений, поэтому из файла не берётся
+	if sameInfo(existing, part) {
+		return model.ImportActionUnchanged, nil
+	}
+
+	if dryRun {
+		return model.ImportActionUpdated, nil
+	}
+
+	if _, err := s.partService.UpdatePart(ctx, part); err != nil {
+		return "", err
+	}
+
+	return model.ImportActionUpdated, nil
+}
+
+// createPart создаёт деталь и проводит её начальный остаток приходом через журнал движений.
+func (s *service) createPart(ctx context.Context, part model.Part) error {
+	created, err := s.partService.CreatePart(ctx, part)
+	if err != nil {
+		return err
+	}
+
+	if part.StockQuantity == 0 {
+		return nil
+	}
+
+	_, err = s.stockService.AdjustStock(ctx, model.StockMovement{
+		PartUuid: created.Uuid,
+		Delta:    part.StockQuantity,
+		Reason:   model.StockMovementReasonRestock,
+		Actor:    importActor,
+	})
+	if err != nil {
+		return fmt.Errorf("part created, but initial stock was not set: %w", err)
+	}
+
+	return nil
+}
+
+// sameInfo сравнивает редактируемые поля детали. Пустые и nil коллекции считаются равными.
+func sameInfo(a, b model.Part) bool {
+	return a.Name == b.Name &&
+		a.Description == b.Description &&
+		a.Price == b.Price &&
+		a.Category == b.Category &&
+		reflect.DeepEqual(a.Dimensions, b.Dimensions) &&
+		reflect.DeepEqual(a.Manufacturer, b.Manufacturer) &&
+		(len(a.Tags) == 0 && len(b.Tags) == 0 || reflect.DeepEqual(a.Tags, b.Tags)) &&
+		(len(a.Metadata) == 0 && len(b.Metadata) == 0 || reflect.DeepEqual(a.Metadata, b.Metadata))
+}
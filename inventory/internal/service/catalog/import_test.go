@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func validPart() model.Part {
+	return model.Part{
+		Uuid:     gofakeit.UUID(),
+		Name:     gofakeit.Name(),
+		Price:    100,
+		Category: model.CategoryEngine,
+		Tags:     []string{"main"},
+	}
+}
+
+func (s *ServiceSuite) TestImportPartsCreatesWithInitialStock() {
+	part := validPart()
+	part.StockQuantity = 15
+
+	s.partService.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, model.ErrPartNotFound)
+	s.partService.On("CreatePart", s.ctx, part).Return(part, nil)
+	s.stockService.On("AdjustStock", s.ctx, model.StockMovement{
+		PartUuid: part.Uuid,
+		Delta:    15,
+		Reason:   model.StockMovementReasonRestock,
+		Actor:    importActor,
+	}).Return(model.StockMovement{StockAfter: 15}, nil)
+
+	report, err := s.service.ImportParts(s.ctx, []model.CatalogRow{{Row: 1, Part: part}}, false)
+	s.Require().NoError(err)
+	s.Require().Equal(1, report.Created)
+	s.Require().Equal([]model.ImportRowResult{{Row: 1, Uuid: part.Uuid, Action: model.ImportActionCreated}}, report.Rows)
+}
+
+func (s *ServiceSuite) TestImportPartsUpdatesChangedAndSkipsUnchanged() {
+	changed := validPart()
+	unchanged := validPart()
+
+	existingChanged := changed
+	existingChanged.Price = 50
+	existingChanged.StockQuantity = 3
+
+	// Остаток в файле для существующей детали игнорируется
+	existingUnchanged := unchanged
+	existingUnchanged.StockQuantity = 99
+
+	s.partService.On("GetPart", s.ctx, changed.Uuid).Return(existingChanged, nil)
+	s.partService.On("GetPart", s.ctx, unchanged.Uuid).Return(existingUnchanged, nil)
+	s.partService.On("UpdatePart", s.ctx, changed).Return(changed, nil)
+
+	report, err := s.service.ImportParts(s.ctx, []model.CatalogRow{
+		{Row: 1, Part: changed},
+		{Row: 2, Part: unchanged},
+	}, false)
+	s.Require().NoError(err)
+	s.Require().Equal(1, report.Updated)
+	s.Require().Equal(1, report.Unchanged)
+	s.Require().Equal(model.ImportActionUpdated, report.Rows[0].Action)
+	s.Require().Equal(model.ImportActionUnchanged, report.Rows[1].Action)
+}
+
+func (s *ServiceSuite) TestImportPartsDryRunDoesNotWrite() {
+	created := validPart()
+	created.StockQuantity = 5
+	updated := validPart()
+
+	existing := updated
+	existing.Name = "Old name"
+
+	s.partService.On("GetPart", s.ctx, created.Uuid).Return(model.Part{}, model.ErrPartNotFound)
+	s.partService.On("GetPart", s.ctx, updated.Uuid).Return(existing, nil)
+
+	report, err := s.service.ImportParts(s.ctx, []model.CatalogRow{
+		{Row: 1, Part: created},
+		{Row: 2, Part: updated},
+	}, true)
+	s.Require().NoError(err)
+	s.Require().True(report.DryRun)
+	s.Require().Equal(1, report.Created)
+	s.Require().Equal(1, report.Updated)
+	s.partService.AssertNotCalled(s.T(), "CreatePart", mock.Anything, mock.Anything)
+	s.partService.AssertNotCalled(s.T(), "UpdatePart", mock.Anything, mock.Anything)
+	s.stockService.AssertNotCalled(s.T(), "AdjustStock", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestImportPartsReportsRowErrors() {
+	ok := validPart()
+	duplicate := ok
+
+	invalidName := validPart()
+	invalidName.Name = ""
+
+	negativeStock := validPart()
+	negativeStock.StockQuantity = -1
+
+	badUUID := validPart()
+	badUUID.Uuid = "not-a-uuid"
+
+	s.partService.On("GetPart", s.ctx, ok.Uuid).Return(ok, nil)
+
+	report, err := s.service.ImportParts(s.ctx, []model.CatalogRow{
+		{Row: 1, ParseErr: errors.New("invalid json")},
+		{Row: 2, Part: ok},
+		{Row: 3, Part: duplicate},
+		{Row: 4, Part: invalidName},
+		{Row: 5, Part: negativeStock},
+		{Row: 6, Part: badUUID},
+	}, false)
+	s.Require().NoError(err)
+	s.Require().Equal(5, report.Failed)
+	s.Require().Equal(1, report.Unchanged)
+
+	s.Require().Equal("invalid json", report.Rows[0].Error)
+	s.Require().Contains(report.Rows[2].Error, "duplicate uuid, first seen in row 2")
+	s.Require().Contains(report.Rows[3].Error, "name is required")
+	s.Require().Contains(report.Rows[4].Error, "stock_quantity must not be negative")
+	s.Require().Contains(report.Rows[5].Error, "invalid uuid")
+}
+
+func (s *ServiceSuite) TestImportPartsInitialStockError() {
+	part := validPart()
+	part.StockQuantity = 1
+
+	s.partService.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, model.ErrPartNotFound)
+	s.partService.On("CreatePart", s.ctx, part).Return(part, nil)
+	s.stockService.On("AdjustStock", s.ctx, mock.Anything).Return(model.StockMovement{}, gofakeit.Error())
+
+	report, err := s.service.ImportParts(s.ctx, []model.CatalogRow{{Row: 1, Part: part}}, false)
+	s.Require().NoError(err)
+	s.Require().Equal(1, report.Failed)
+	s.Require().Contains(report.Rows[0].Error, "part created, but initial stock was not set")
+}
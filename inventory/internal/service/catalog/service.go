@@ -0,0 +1,22 @@
+package catalog
+
+import (
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+)
+
+var _ def.CatalogService = (*service)(nil)
+
+// importActor записывается в журнал движений остатков при импорте начального остатка
+const importActor = "catalog-import"
+
+type service struct {
+	partService  def.PartService
+	stockService def.StockService
+}
+
+func NewService(partService def.PartService, stockService def.StockService) *service {
+	return &service{
+		partService:  partService,
+		stockService: stockService,
+	}
+}
@@ -0,0 +1,43 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/service/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	partService  *mocks.PartService
+	stockService *mocks.StockService
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+
+	s.ctx = context.Background()
+
+	s.partService = mocks.NewPartService(s.T())
+	s.stockService = mocks.NewStockService(s.T())
+
+	s.service = NewService(
+		s.partService,
+		s.stockService,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,52 @@
+package compatibility
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) CreateRule(ctx context.Context, rule model.CompatibilityRule) (model.CompatibilityRule, error) {
+	if err := model.ValidateCompatibilityRule(rule); err != nil {
+		logger.Error(ctx,
+			"invalid compatibility rule",
+			zap.Any("rule", rule),
+			zap.Error(err),
+		)
+		return model.CompatibilityRule{}, err
+	}
+
+	rule.Uuid = uuid.NewString()
+	rule.CreatedAt = time.Now()
+
+	// Поля, не относящиеся к типу правила, не сохраняем, чтобы они не вводили в заблуждение
+	if rule.Type == model.CompatibilityRuleTypeMaxPerOrder {
+		rule.Target = model.PartSelector{}
+	} else {
+		rule.MaxQuantity = 0
+	}
+	if rule.Type != model.CompatibilityRuleTypeCompatibleWith {
+		rule.MatchMetadataKey = ""
+	}
+
+	if err := s.ruleRepository.CreateRule(ctx, rule); err != nil {
+		logger.Error(ctx,
+			"failed to create compatibility rule",
+			zap.Any("rule", rule),
+			zap.Error(err),
+		)
+		return model.CompatibilityRule{}, err
+	}
+
+	logger.Debug(ctx,
+		"compatibility rule created successfully",
+		zap.Any("rule", rule),
+	)
+
+	return rule, nil
+}
@@ -0,0 +1,77 @@
+package compatibility
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateRuleSuccess() {
+	rule := model.CompatibilityRule{
+		Type:        model.CompatibilityRuleTypeRequires,
+		Subject:     model.PartSelector{Category: model.CategoryEngine},
+		Target:      model.PartSelector{Category: model.CategoryFuel},
+		MaxQuantity: 3,
+		Description: "engine needs fuel",
+	}
+
+	s.ruleRepository.On("CreateRule", s.ctx, mock.MatchedBy(func(r model.CompatibilityRule) bool {
+		return r.Uuid != "" && !r.CreatedAt.IsZero() && r.MaxQuantity == 0 &&
+			r.Subject == rule.Subject && r.Target == rule.Target
+	})).Return(nil)
+
+	res, err := s.service.CreateRule(s.ctx, rule)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Uuid)
+	s.Require().Zero(res.MaxQuantity)
+	s.Require().Equal(rule.Description, res.Description)
+}
+
+func (s *ServiceSuite) TestCreateRuleMaxPerOrderDropsTarget() {
+	rule := model.CompatibilityRule{
+		Type:             model.CompatibilityRuleTypeMaxPerOrder,
+		Subject:          model.PartSelector{Category: model.CategoryEngine},
+		Target:           model.PartSelector{Tag: "ignored"},
+		MaxQuantity:      2,
+		MatchMetadataKey: "ignored",
+	}
+
+	s.ruleRepository.On("CreateRule", s.ctx, mock.Anything).Return(nil)
+
+	res, err := s.service.CreateRule(s.ctx, rule)
+	s.Require().NoError(err)
+	s.Require().True(res.Target.IsEmpty())
+	s.Require().Empty(res.MatchMetadataKey)
+	s.Require().Equal(int64(2), res.MaxQuantity)
+}
+
+func (s *ServiceSuite) TestCreateRuleRejectsInvalidRules() {
+	rules := []model.CompatibilityRule{
+		{Type: model.CompatibilityRuleTypeUnspecified, Subject: model.PartSelector{Tag: "a"}},
+		{Type: model.CompatibilityRuleTypeRequires, Target: model.PartSelector{Tag: "a"}},
+		{Type: model.CompatibilityRuleTypeExcludes, Subject: model.PartSelector{Tag: "a"}},
+		{Type: model.CompatibilityRuleTypeMaxPerOrder, Subject: model.PartSelector{Tag: "a"}},
+		{Type: model.CompatibilityRuleTypeCompatibleWith, Subject: model.PartSelector{Category: 42}, Target: model.PartSelector{Tag: "a"}},
+		{Type: model.CompatibilityRuleTypeRequires, Subject: model.PartSelector{MetadataValue: "x"}, Target: model.PartSelector{Tag: "a"}},
+	}
+
+	for _, rule := range rules {
+		res, err := s.service.CreateRule(s.ctx, rule)
+		s.Require().ErrorIs(err, model.ErrInvalidCompatibilityRule)
+		s.Require().Empty(res)
+	}
+}
+
+func (s *ServiceSuite) TestCreateRuleRepositoryError() {
+	repoErr := gofakeit.Error()
+	s.ruleRepository.On("CreateRule", s.ctx, mock.Anything).Return(repoErr)
+
+	res, err := s.service.CreateRule(s.ctx, model.CompatibilityRule{
+		Type:        model.CompatibilityRuleTypeMaxPerOrder,
+		Subject:     model.PartSelector{Tag: "heavy"},
+		MaxQuantity: 1,
+	})
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Empty(res)
+}
@@ -0,0 +1,27 @@
+package compatibility
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) DeleteRule(ctx context.Context, ruleUUID string) error {
+	if err := s.ruleRepository.DeleteRule(ctx, ruleUUID); err != nil {
+		logger.Error(ctx,
+			"failed to delete compatibility rule",
+			zap.String("uuid", ruleUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Debug(ctx,
+		"compatibility rule deleted successfully",
+		zap.String("uuid", ruleUUID),
+	)
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package compatibility
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeleteRuleSuccess() {
+	ruleUUID := gofakeit.UUID()
+	s.ruleRepository.On("DeleteRule", s.ctx, ruleUUID).Return(nil)
+
+	err := s.service.DeleteRule(s.ctx, ruleUUID)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeleteRuleNotFound() {
+	ruleUUID := gofakeit.UUID()
+	s.ruleRepository.On("DeleteRule", s.ctx, ruleUUID).Return(model.ErrCompatibilityRuleNotFound)
+
+	err := s.service.DeleteRule(s.ctx, ruleUUID)
+	s.Require().ErrorIs(err, model.ErrCompatibilityRuleNotFound)
+}
@@ -0,0 +1,23 @@
+package compatibility
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ListRules(ctx context.Context) ([]model.CompatibilityRule, error) {
+	rules, err := s.ruleRepository.ListRules(ctx)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list compatibility rules",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return rules, nil
+}
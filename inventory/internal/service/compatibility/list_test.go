@@ -0,0 +1,25 @@
+package compatibility
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListRulesSuccess() {
+	rules := []model.CompatibilityRule{{Uuid: gofakeit.UUID()}, {Uuid: gofakeit.UUID()}}
+	s.ruleRepository.On("ListRules", s.ctx).Return(rules, nil)
+
+	res, err := s.service.ListRules(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(rules, res)
+}
+
+func (s *ServiceSuite) TestListRulesRepositoryError() {
+	repoErr := gofakeit.Error()
+	s.ruleRepository.On("ListRules", s.ctx).Return(nil, repoErr)
+
+	res, err := s.service.ListRules(s.ctx)
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Nil(res)
+}
@@ -0,0 +1,216 @@
+package compatibility
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+type configurationItem struct {
+	part     model.Part
+	quantity int64
+}
+
+var categoryNames = map[model.Category]string{
+	model.CategoryEngine:   "ENGINE",
+	model.CategoryFuel:     "FUEL",
+	model.CategoryPorthole: "PORTHOLE",
+	model.CategoryWing:     "WING",
+}
+
+// evaluateRule проверяет одно правило. Деталь никогда не сравнивается сама с собой:
+// если она подходит и под subject, и под target, нужна другая деталь.
+func evaluateRule(rule model.CompatibilityRule, items []configurationItem) (model.RuleViolation, bool) {
+	subjects := matchingItems(rule.Subject, items)
+	if len(subjects) == 0 {
+		return model.RuleViolation{}, false
+	}
+
+	var (
+		offenders []string
+		message   string
+	)
+
+	switch rule.Type {
+	case model.CompatibilityRuleTypeRequires:
+		targets := matchingItems(rule.Target, items)
+		for _, subject := range subjects {
+			if !hasOtherPart(targets, subject.part.Uuid) {
+				offenders = appendUnique(offenders, subject.part.Uuid)
+			}
+		}
+		message = fmt.Sprintf("parts matching %s require a part matching %s",
+			describeSelector(rule.Subject), describeSelector(rule.Target))
+
+	case model.CompatibilityRuleTypeExcludes:
+		targets := matchingItems(rule.Target, items)
+		for _, subject := range subjects {
+			for _, target := range targets {
+				if subject.part.Uuid != target.part.Uuid {
+					offenders = appendUnique(offenders, subject.part.Uuid, target.part.Uuid)
+				}
+			}
+		}
+		message = fmt.Sprintf("parts matching %s cannot be combined with parts matching %s",
+			describeSelector(rule.Subject), describeSelector(rule.Target))
+
+	case model.CompatibilityRuleTypeMaxPerOrder:
+		var total int64
+		for _, subject := range subjects {
+			total += subject.quantity
+		}
+		if total > rule.MaxQuantity {
+			for _, subject := range subjects {
+				offenders = appendUnique(offenders, subject.part.Uuid)
+			}
+		}
+		message = fmt.Sprintf("at most %d parts matching %s allowed, got %d",
+			rule.MaxQuantity, describeSelector(rule.Subject), total)
+
+	case model.CompatibilityRuleTypeCompatibleWith:
+		targets := matchingItems(rule.Target, items)
+		for _, subject := range subjects {
+			for _, target := range targets {
+				if subject.part.Uuid != target.part.Uuid && !compatible(rule.MatchMetadataKey, subject.part, target.part) {
+					offenders = appendUnique(offenders, subject.part.Uuid, target.part.Uuid)
+				}
+			}
+		}
+		if rule.MatchMetadataKey != "" {
+			message = fmt.Sprintf("parts matching %s and %s must have the same metadata %q",
+				describeSelector(rule.Subject), describeSelector(rule.Target), rule.MatchMetadataKey)
+		} else {
+			message = fmt.Sprintf("parts matching %s and %s must share a tag",
+				describeSelector(rule.Subject), describeSelector(rule.Target))
+		}
+	}
+
+	if len(offenders) == 0 {
+		return model.RuleViolation{}, false
+	}
+
+	if rule.Description != "" {
+		message = rule.Description
+	}
+
+	return model.RuleViolation{
+		RuleUuid:  rule.Uuid,
+		Type:      rule.Type,
+		Message:   message,
+		PartUuids: offenders,
+	}, true
+}
+
+func matchingItems(selector model.PartSelector, items []configurationItem) []configurationItem {
+	var matched []configurationItem
+	for _, item := range items {
+		if matchesSelector(selector, item.part) {
+			matched = append(matched, item)
+		}
+	}
+	return matched
+}
+
+func matchesSelector(selector model.PartSelector, part model.Part) bool {
+	if selector.Category != model.CategoryUnspecified && part.Category != selector.Category {
+		return false
+	}
+
+	if selector.Tag != "" && !slices.Contains(part.Tags, selector.Tag) {
+		return false
+	}
+
+	if selector.MetadataKey != "" {
+		value, ok := metadataString(part, selector.MetadataKey)
+		if !ok {
+			return false
+		}
+		if selector.MetadataValue != "" && value != selector.MetadataValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compatible сравнивает детали по ключу метаданных, а если он не задан — по общим тегам.
+func compatible(matchMetadataKey string, a, b model.Part) bool {
+	if matchMetadataKey != "" {
+		aValue, aOk := metadataString(a, matchMetadataKey)
+		bValue, bOk := metadataString(b, matchMetadataKey)
+		return aOk && bOk && aValue == bValue
+	}
+
+	for _, tag := range a.Tags {
+		if slices.Contains(b.Tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataString приводит значение метаданных к строке, в которой оно задаётся в селекторе.
+func metadataString(part model.Part, key string) (string, bool) {
+	value, ok := part.Metadata[key]
+	if !ok || value == nil {
+		return "", false
+	}
+
+	switch {
+	case value.StringValue != "":
+		return value.StringValue, true
+	case value.Int64Value != 0:
+		return strconv.FormatInt(value.Int64Value, 10), true
+	case value.DoubleValue != 0:
+		return strconv.FormatFloat(value.DoubleValue, 'f', -1, 64), true
+	case value.BoolValue:
+		return "true", true
+	default:
+		return "", true
+	}
+}
+
+func hasOtherPart(items []configurationItem, partUuid string) bool {
+	for _, item := range items {
+		if item.part.Uuid != partUuid {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, candidates ...string) []string {
+	for _, candidate := range candidates {
+		if !slices.Contains(values, candidate) {
+			values = append(values, candidate)
+		}
+	}
+	return values
+}
+
+// describeSelector формирует описание селектора для сообщений о нарушениях,
+// например: category ENGINE, tag "heavy", metadata fuel_type=hydrogen.
+func describeSelector(selector model.PartSelector) string {
+	var parts []string
+
+	if selector.Category != model.CategoryUnspecified {
+		parts = append(parts, "category "+categoryNames[selector.Category])
+	}
+
+	if selector.Tag != "" {
+		parts = append(parts, fmt.Sprintf("tag %q", selector.Tag))
+	}
+
+	if selector.MetadataKey != "" {
+		if selector.MetadataValue != "" {
+			parts = append(parts, fmt.Sprintf("metadata %s=%s", selector.MetadataKey, selector.MetadataValue))
+		} else {
+			parts = append(parts, "metadata "+selector.MetadataKey)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,20 @@
+package compatibility
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+)
+
+var _ def.CompatibilityService = (*service)(nil)
+
+type service struct {
+	ruleRepository repository.CompatibilityRuleRepository
+	partService    def.PartService
+}
+
+func NewService(ruleRepository repository.CompatibilityRuleRepository, partService def.PartService) *service {
+	return &service{
+		ruleRepository: ruleRepository,
+		partService:    partService,
+	}
+}
@@ -0,0 +1,93 @@
+package compatibility
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ValidateConfiguration(ctx context.Context, partUuids []string) ([]model.RuleViolation, error) {
+	if len(partUuids) == 0 {
+		return nil, nil
+	}
+
+	items, err := s.loadItems(ctx, partUuids)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to load configuration parts",
+			zap.Strings("part_uuids", partUuids),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	rules, err := s.ruleRepository.ListRules(ctx)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list compatibility rules",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var violations []model.RuleViolation
+	for _, rule := range rules {
+		if violation, ok := evaluateRule(rule, items); ok {
+			violations = append(violations, violation)
+		}
+	}
+
+	logger.Debug(ctx,
+		"configuration validated",
+		zap.Strings("part_uuids", partUuids),
+		zap.Int("rules", len(rules)),
+		zap.Int("violations", len(violations)),
+	)
+
+	return violations, nil
+}
+
+// loadItems сворачивает повторяющиеся UUID в количество и загружает детали.
+// Порядок деталей совпадает с порядком первого упоминания в запросе.
+func (s *service) loadItems(ctx context.Context, partUuids []string) ([]configurationItem, error) {
+	quantities := make(map[string]int64, len(partUuids))
+	uniqueUuids := make([]string, 0, len(partUuids))
+	for _, partUuid := range partUuids {
+		if _, ok := quantities[partUuid]; !ok {
+			uniqueUuids = append(uniqueUuids, partUuid)
+		}
+		quantities[partUuid]++
+	}
+
+	parts, err := s.partService.ListParts(ctx, model.PartsFilter{Uuids: uniqueUuids})
+	if err != nil {
+		return nil, err
+	}
+
+	partsByUuid := make(map[string]model.Part, len(parts))
+	for _, part := range parts {
+		partsByUuid[part.Uuid] = part
+	}
+
+	items := make([]configurationItem, 0, len(uniqueUuids))
+	var missing []string
+	for _, partUuid := range uniqueUuids {
+		part, ok := partsByUuid[partUuid]
+		if !ok {
+			missing = append(missing, partUuid)
+			continue
+		}
+		items = append(items, configurationItem{part: part, quantity: quantities[partUuid]})
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, strings.Join(missing, ", "))
+	}
+
+	return items, nil
+}
@@ -0,0 +1,171 @@
+package compatibility
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func newPart(category model.Category, tags []string, metadata map[string]*model.Value) model.Part {
+	return model.Part{
+		Uuid:     gofakeit.UUID(),
+		Name:     gofakeit.Name(),
+		Category: category,
+		Tags:     tags,
+		Metadata: metadata,
+	}
+}
+
+func (s *ServiceSuite) expectParts(parts ...model.Part) {
+	uuids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		uuids = append(uuids, part.Uuid)
+	}
+	s.partService.On("ListParts", s.ctx, model.PartsFilter{Uuids: uuids}).Return(parts, nil)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationEmpty() {
+	res, err := s.service.ValidateConfiguration(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationNoViolations() {
+	engine := newPart(model.CategoryEngine, nil, nil)
+	fuel := newPart(model.CategoryFuel, nil, nil)
+	s.expectParts(engine, fuel)
+	s.ruleRepository.On("ListRules", s.ctx).Return([]model.CompatibilityRule{{
+		Uuid:    gofakeit.UUID(),
+		Type:    model.CompatibilityRuleTypeRequires,
+		Subject: model.PartSelector{Category: model.CategoryEngine},
+		Target:  model.PartSelector{Category: model.CategoryFuel},
+	}}, nil)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{engine.Uuid, fuel.Uuid})
+	s.Require().NoError(err)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationRequires() {
+	engine := newPart(model.CategoryEngine, nil, nil)
+	wing := newPart(model.CategoryWing, nil, nil)
+	s.expectParts(engine, wing)
+	rule := model.CompatibilityRule{
+		Uuid:    gofakeit.UUID(),
+		Type:    model.CompatibilityRuleTypeRequires,
+		Subject: model.PartSelector{Category: model.CategoryEngine},
+		Target:  model.PartSelector{Category: model.CategoryFuel},
+	}
+	s.ruleRepository.On("ListRules", s.ctx).Return([]model.CompatibilityRule{rule}, nil)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{engine.Uuid, wing.Uuid})
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Require().Equal(rule.Uuid, res[0].RuleUuid)
+	s.Require().Equal(model.CompatibilityRuleTypeRequires, res[0].Type)
+	s.Require().Equal([]string{engine.Uuid}, res[0].PartUuids)
+	s.Require().Equal("parts matching category ENGINE require a part matching category FUEL", res[0].Message)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationExcludesUsesDescription() {
+	ion := newPart(model.CategoryEngine, []string{"ion"}, nil)
+	chemical := newPart(model.CategoryFuel, []string{"chemical"}, nil)
+	s.expectParts(ion, chemical)
+	s.ruleRepository.On("ListRules", s.ctx).Return([]model.CompatibilityRule{{
+		Uuid:        gofakeit.UUID(),
+		Type:        model.CompatibilityRuleTypeExcludes,
+		Subject:     model.PartSelector{Tag: "ion"},
+		Target:      model.PartSelector{Tag: "chemical"},
+		Description: "ion engines do not burn chemical fuel",
+	}}, nil)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{ion.Uuid, chemical.Uuid})
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Require().Equal("ion engines do not burn chemical fuel", res[0].Message)
+	s.Require().Equal([]string{ion.Uuid, chemical.Uuid}, res[0].PartUuids)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationMaxPerOrderCountsDuplicates() {
+	engine := newPart(model.CategoryEngine, nil, nil)
+	s.expectParts(engine)
+	s.ruleRepository.On("ListRules", s.ctx).Return([]model.CompatibilityRule{{
+		Uuid:        gofakeit.UUID(),
+		Type:        model.CompatibilityRuleTypeMaxPerOrder,
+		Subject:     model.PartSelector{Category: model.CategoryEngine},
+		MaxQuantity: 2,
+	}}, nil)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{engine.Uuid, engine.Uuid, engine.Uuid})
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Require().Equal([]string{engine.Uuid}, res[0].PartUuids)
+	s.Require().Equal("at most 2 parts matching category ENGINE allowed, got 3", res[0].Message)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationCompatibleWithMetadata() {
+	engine := newPart(model.CategoryEngine, nil, map[string]*model.Value{"fuel_type": {StringValue: "hydrogen"}})
+	fuel := newPart(model.CategoryFuel, nil, map[string]*model.Value{"fuel_type": {StringValue: "methane"}})
+	matchingFuel := newPart(model.CategoryFuel, nil, map[string]*model.Value{"fuel_type": {StringValue: "hydrogen"}})
+	rule := model.CompatibilityRule{
+		Uuid:             gofakeit.UUID(),
+		Type:             model.CompatibilityRuleTypeCompatibleWith,
+		Subject:          model.PartSelector{Category: model.CategoryEngine},
+		Target:           model.PartSelector{Category: model.CategoryFuel},
+		MatchMetadataKey: "fuel_type",
+	}
+
+	s.expectParts(engine, fuel)
+	s.ruleRepository.On("ListRules", s.ctx).Return([]model.CompatibilityRule{rule}, nil)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{engine.Uuid, fuel.Uuid})
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Require().Equal([]string{engine.Uuid, fuel.Uuid}, res[0].PartUuids)
+
+	s.expectParts(engine, matchingFuel)
+
+	res, err = s.service.ValidateConfiguration(s.ctx, []string{engine.Uuid, matchingFuel.Uuid})
+	s.Require().NoError(err)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationCompatibleWithTags() {
+	wing := newPart(model.CategoryWing, []string{"mk2"}, nil)
+	porthole := newPart(model.CategoryPorthole, []string{"mk1"}, nil)
+	s.expectParts(wing, porthole)
+	s.ruleRepository.On("ListRules", s.ctx).Return([]model.CompatibilityRule{{
+		Uuid:    gofakeit.UUID(),
+		Type:    model.CompatibilityRuleTypeCompatibleWith,
+		Subject: model.PartSelector{Category: model.CategoryWing},
+		Target:  model.PartSelector{Category: model.CategoryPorthole},
+	}}, nil)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{wing.Uuid, porthole.Uuid})
+	s.Require().NoError(err)
+	s.Require().Len(res, 1)
+	s.Require().Equal(model.CompatibilityRuleTypeCompatibleWith, res[0].Type)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationPartNotFound() {
+	engine := newPart(model.CategoryEngine, nil, nil)
+	missing := gofakeit.UUID()
+	s.partService.On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{engine.Uuid, missing}}).
+		Return([]model.Part{engine}, nil)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{engine.Uuid, missing})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Contains(err.Error(), missing)
+	s.Require().Nil(res)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationRepositoryError() {
+	engine := newPart(model.CategoryEngine, nil, nil)
+	repoErr := gofakeit.Error()
+	s.expectParts(engine)
+	s.ruleRepository.On("ListRules", s.ctx).Return(nil, repoErr)
+
+	res, err := s.service.ValidateConfiguration(s.ctx, []string{engine.Uuid})
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Nil(res)
+}
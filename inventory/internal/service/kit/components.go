@@ -0,0 +1,87 @@
+package kit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+// loadParts загружает детали из состава комплектов одним запросом.
+// Цены деталей берутся из PartService, то есть с учётом истории цен.
+func (s *service) loadParts(ctx context.Context, kits ...model.Kit) (map[string]model.Part, error) {
+	var partUuids []string
+	seen := make(map[string]struct{})
+	for _, kit := range kits {
+		for _, component := range kit.Components {
+			if _, ok := seen[component.PartUuid]; ok {
+				continue
+			}
+			seen[component.PartUuid] = struct{}{}
+			partUuids = append(partUuids, component.PartUuid)
+		}
+	}
+
+	if len(partUuids) == 0 {
+		return map[string]model.Part{}, nil
+	}
+
+	parts, err := s.partService.ListParts(ctx, model.PartsFilter{Uuids: partUuids})
+	if err != nil {
+		return nil, err
+	}
+
+	partsByUuid := make(map[string]model.Part, len(parts))
+	for _, part := range parts {
+		partsByUuid[part.Uuid] = part
+	}
+
+	return partsByUuid, nil
+}
+
+// checkComponents проверяет, что все детали из состава комплекта существуют.
+func checkComponents(kit model.Kit, parts map[string]model.Part) error {
+	var missing []string
+	for _, component := range kit.Components {
+		if _, ok := parts[component.PartUuid]; !ok {
+			missing = append(missing, component.PartUuid)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", model.ErrPartNotFound, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// enrich заполняет цену и доступное количество комплекта по загруженным деталям.
+// Если деталь из состава удалена, комплект недоступен, а её цена в сумму не входит.
+func enrich(kit model.Kit, parts map[string]model.Part) model.Kit {
+	var price float64
+	available := int64(math.MaxInt64)
+	for _, component := range kit.Components {
+		part, ok := parts[component.PartUuid]
+		if !ok {
+			available = 0
+			continue
+		}
+
+		price += part.Price * float64(component.Quantity)
+		available = min(available, part.StockQuantity/component.Quantity)
+	}
+
+	if len(kit.Components) == 0 {
+		available = 0
+	}
+
+	kit.Price = price
+	if kit.PriceOverride != nil {
+		kit.Price = *kit.PriceOverride
+	}
+	kit.AvailableQuantity = available
+
+	return kit
+}
@@ -0,0 +1,63 @@
+package kit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) CreateKit(ctx context.Context, kit model.Kit) (model.Kit, error) {
+	if err := model.ValidateKit(kit); err != nil {
+		logger.Error(ctx,
+			"invalid kit",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	parts, err := s.loadParts(ctx, kit)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to load kit components",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	if err := checkComponents(kit, parts); err != nil {
+		logger.Error(ctx,
+			"kit references unknown parts",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	now := time.Now()
+	kit.Uuid = uuid.NewString()
+	kit.CreatedAt = now
+	kit.UpdatedAt = now
+
+	if err := s.kitRepository.CreateKit(ctx, kit); err != nil {
+		logger.Error(ctx,
+			"failed to create kit",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	logger.Debug(ctx,
+		"kit created successfully",
+		zap.String("uuid", kit.Uuid),
+	)
+
+	return enrich(kit, parts), nil
+}
@@ -0,0 +1,96 @@
+package kit
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateKitSuccess() {
+	engine, wing := newPart(100, 7), newPart(25, 10)
+	kit := model.Kit{
+		Name: "Starter kit",
+		Components: []model.KitComponent{
+			{PartUuid: engine.Uuid, Quantity: 1},
+			{PartUuid: wing.Uuid, Quantity: 4},
+		},
+	}
+
+	s.partService.On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{engine.Uuid, wing.Uuid}}).
+		Return([]model.Part{engine, wing}, nil)
+	s.kitRepository.On("CreateKit", s.ctx, mock.MatchedBy(func(k model.Kit) bool {
+		return k.Uuid != "" && !k.CreatedAt.IsZero() && k.CreatedAt.Equal(k.UpdatedAt) && k.Name == kit.Name
+	})).Return(nil)
+
+	res, err := s.service.CreateKit(s.ctx, kit)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Uuid)
+	s.Require().Equal(200.0, res.Price)
+	s.Require().Equal(int64(2), res.AvailableQuantity)
+}
+
+func (s *ServiceSuite) TestCreateKitUnknownPart() {
+	engine := newPart(100, 7)
+	missing := gofakeit.UUID()
+	kit := model.Kit{
+		Name: "Starter kit",
+		Components: []model.KitComponent{
+			{PartUuid: engine.Uuid, Quantity: 1},
+			{PartUuid: missing, Quantity: 1},
+		},
+	}
+
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return([]model.Part{engine}, nil)
+
+	res, err := s.service.CreateKit(s.ctx, kit)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().ErrorContains(err, missing)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestCreateKitInvalid() {
+	negative := -1.0
+	kits := []model.Kit{
+		{Components: []model.KitComponent{{PartUuid: gofakeit.UUID(), Quantity: 1}}},
+		{Name: "empty"},
+		{Name: "zero", Components: []model.KitComponent{{PartUuid: gofakeit.UUID()}}},
+		{Name: "negative", Components: []model.KitComponent{{PartUuid: gofakeit.UUID(), Quantity: 1}}, PriceOverride: &negative},
+	}
+
+	uuid := gofakeit.UUID()
+	kits = append(kits, model.Kit{Name: "duplicate", Components: []model.KitComponent{
+		{PartUuid: uuid, Quantity: 1},
+		{PartUuid: uuid, Quantity: 2},
+	}})
+
+	for _, kit := range kits {
+		res, err := s.service.CreateKit(s.ctx, kit)
+		s.Require().ErrorIs(err, model.ErrInvalidKit)
+		s.Require().Empty(res)
+	}
+}
+
+func (s *ServiceSuite) TestCreateKitRepositoryError() {
+	engine := newPart(100, 7)
+	repoErr := gofakeit.Error()
+
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return([]model.Part{engine}, nil)
+	s.kitRepository.On("CreateKit", s.ctx, mock.Anything).Return(repoErr)
+
+	res, err := s.service.CreateKit(s.ctx, model.Kit{
+		Name:       "Single",
+		Components: []model.KitComponent{{PartUuid: engine.Uuid, Quantity: 1}},
+	})
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Empty(res)
+}
+
+func newPart(price float64, stock int64) model.Part {
+	return model.Part{
+		Uuid:          gofakeit.UUID(),
+		Name:          gofakeit.Name(),
+		Price:         price,
+		StockQuantity: stock,
+	}
+}
@@ -0,0 +1,27 @@
+package kit
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) DeleteKit(ctx context.Context, uuid string) error {
+	if err := s.kitRepository.DeleteKit(ctx, uuid); err != nil {
+		logger.Error(ctx,
+			"failed to delete kit",
+			zap.String("uuid", uuid),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Debug(ctx,
+		"kit deleted successfully",
+		zap.String("uuid", uuid),
+	)
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package kit
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeleteKitSuccess() {
+	uuid := gofakeit.UUID()
+	s.kitRepository.On("DeleteKit", s.ctx, uuid).Return(nil)
+
+	err := s.service.DeleteKit(s.ctx, uuid)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeleteKitNotFound() {
+	uuid := gofakeit.UUID()
+	s.kitRepository.On("DeleteKit", s.ctx, uuid).Return(model.ErrKitNotFound)
+
+	err := s.service.DeleteKit(s.ctx, uuid)
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+}
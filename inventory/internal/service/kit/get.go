@@ -0,0 +1,34 @@
+package kit
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) GetKit(ctx context.Context, uuid string) (model.Kit, error) {
+	kit, err := s.kitRepository.GetKit(ctx, uuid)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get kit",
+			zap.String("uuid", uuid),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	parts, err := s.loadParts(ctx, kit)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to load kit components",
+			zap.String("uuid", uuid),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	return enrich(kit, parts), nil
+}
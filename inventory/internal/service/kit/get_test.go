@@ -0,0 +1,57 @@
+package kit
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGetKitPriceOverride() {
+	engine := newPart(100, 3)
+	override := 75.5
+	kit := model.Kit{
+		Uuid:          gofakeit.UUID(),
+		Name:          "Discounted",
+		Components:    []model.KitComponent{{PartUuid: engine.Uuid, Quantity: 1}},
+		PriceOverride: &override,
+	}
+
+	s.kitRepository.On("GetKit", s.ctx, kit.Uuid).Return(kit, nil)
+	s.partService.On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{engine.Uuid}}).
+		Return([]model.Part{engine}, nil)
+
+	res, err := s.service.GetKit(s.ctx, kit.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(override, res.Price)
+	s.Require().Equal(int64(3), res.AvailableQuantity)
+}
+
+func (s *ServiceSuite) TestGetKitDeletedComponent() {
+	engine := newPart(100, 3)
+	kit := model.Kit{
+		Uuid: gofakeit.UUID(),
+		Name: "Broken",
+		Components: []model.KitComponent{
+			{PartUuid: engine.Uuid, Quantity: 1},
+			{PartUuid: gofakeit.UUID(), Quantity: 1},
+		},
+	}
+
+	s.kitRepository.On("GetKit", s.ctx, kit.Uuid).Return(kit, nil)
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return([]model.Part{engine}, nil)
+
+	res, err := s.service.GetKit(s.ctx, kit.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(100.0, res.Price)
+	s.Require().Zero(res.AvailableQuantity)
+}
+
+func (s *ServiceSuite) TestGetKitNotFound() {
+	uuid := gofakeit.UUID()
+	s.kitRepository.On("GetKit", s.ctx, uuid).Return(model.Kit{}, model.ErrKitNotFound)
+
+	res, err := s.service.GetKit(s.ctx, uuid)
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+	s.Require().Empty(res)
+}
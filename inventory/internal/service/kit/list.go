@@ -0,0 +1,38 @@
+package kit
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ListKits(ctx context.Context, uuids []string) ([]model.Kit, error) {
+	kits, err := s.kitRepository.ListKits(ctx, uuids)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list kits",
+			zap.Strings("uuids", uuids),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	parts, err := s.loadParts(ctx, kits...)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to load kit components",
+			zap.Strings("uuids", uuids),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	for i := range kits {
+		kits[i] = enrich(kits[i], parts)
+	}
+
+	return kits, nil
+}
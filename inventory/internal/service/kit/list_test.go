@@ -0,0 +1,61 @@
+package kit
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListKitsLoadsPartsOnce() {
+	engine, fuel, wing := newPart(100, 5), newPart(10, 30), newPart(20, 8)
+	first := model.Kit{
+		Uuid: gofakeit.UUID(),
+		Components: []model.KitComponent{
+			{PartUuid: engine.Uuid, Quantity: 1},
+			{PartUuid: fuel.Uuid, Quantity: 10},
+		},
+	}
+	second := model.Kit{
+		Uuid: gofakeit.UUID(),
+		Components: []model.KitComponent{
+			{PartUuid: engine.Uuid, Quantity: 2},
+			{PartUuid: wing.Uuid, Quantity: 2},
+		},
+	}
+
+	s.kitRepository.On("ListKits", s.ctx, []string(nil)).Return([]model.Kit{first, second}, nil)
+	s.partService.On("ListParts", s.ctx, model.PartsFilter{Uuids: []string{engine.Uuid, fuel.Uuid, wing.Uuid}}).
+		Return([]model.Part{engine, fuel, wing}, nil).Once()
+
+	res, err := s.service.ListKits(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Len(res, 2)
+	s.Require().Equal(200.0, res[0].Price)
+	s.Require().Equal(int64(3), res[0].AvailableQuantity)
+	s.Require().Equal(240.0, res[1].Price)
+	s.Require().Equal(int64(2), res[1].AvailableQuantity)
+}
+
+func (s *ServiceSuite) TestListKitsEmpty() {
+	s.kitRepository.On("ListKits", s.ctx, mock.Anything).Return([]model.Kit{}, nil)
+
+	res, err := s.service.ListKits(s.ctx, []string{gofakeit.UUID()})
+	s.Require().NoError(err)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestListKitsPartServiceError() {
+	kit := model.Kit{
+		Uuid:       gofakeit.UUID(),
+		Components: []model.KitComponent{{PartUuid: gofakeit.UUID(), Quantity: 1}},
+	}
+	partErr := gofakeit.Error()
+
+	s.kitRepository.On("ListKits", s.ctx, mock.Anything).Return([]model.Kit{kit}, nil)
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return(nil, partErr)
+
+	res, err := s.service.ListKits(s.ctx, nil)
+	s.Require().ErrorIs(err, partErr)
+	s.Require().Nil(res)
+}
@@ -0,0 +1,20 @@
+package kit
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+)
+
+var _ def.KitService = (*service)(nil)
+
+type service struct {
+	kitRepository repository.KitRepository
+	partService   def.PartService
+}
+
+func NewService(kitRepository repository.KitRepository, partService def.PartService) *service {
+	return &service{
+		kitRepository: kitRepository,
+		partService:   partService,
+	}
+}
@@ -0,0 +1,44 @@
+package kit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+	serviceMocks "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	kitRepository *mocks.KitRepository
+	partService   *serviceMocks.PartService
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+
+	s.ctx = context.Background()
+
+	s.kitRepository = mocks.NewKitRepository(s.T())
+	s.partService = serviceMocks.NewPartService(s.T())
+
+	s.service = NewService(
+		s.kitRepository,
+		s.partService,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
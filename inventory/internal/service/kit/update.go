@@ -0,0 +1,70 @@
+package kit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) UpdateKit(ctx context.Context, kit model.Kit) (model.Kit, error) {
+	if err := model.ValidateKit(kit); err != nil {
+		logger.Error(ctx,
+			"invalid kit",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	existing, err := s.kitRepository.GetKit(ctx, kit.Uuid)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get kit",
+			zap.String("uuid", kit.Uuid),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	parts, err := s.loadParts(ctx, kit)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to load kit components",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	if err := checkComponents(kit, parts); err != nil {
+		logger.Error(ctx,
+			"kit references unknown parts",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	kit.CreatedAt = existing.CreatedAt
+	kit.UpdatedAt = time.Now()
+
+	if err := s.kitRepository.UpdateKit(ctx, kit); err != nil {
+		logger.Error(ctx,
+			"failed to update kit",
+			zap.Any("kit", kit),
+			zap.Error(err),
+		)
+		return model.Kit{}, err
+	}
+
+	logger.Debug(ctx,
+		"kit updated successfully",
+		zap.String("uuid", kit.Uuid),
+	)
+
+	return enrich(kit, parts), nil
+}
@@ -0,0 +1,62 @@
+package kit
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdateKitKeepsCreatedAt() {
+	engine := newPart(100, 4)
+	createdAt := time.Now().Add(-time.Hour)
+	existing := model.Kit{Uuid: gofakeit.UUID(), CreatedAt: createdAt}
+	kit := model.Kit{
+		Uuid:       existing.Uuid,
+		Name:       "Renamed",
+		Components: []model.KitComponent{{PartUuid: engine.Uuid, Quantity: 2}},
+	}
+
+	s.kitRepository.On("GetKit", s.ctx, kit.Uuid).Return(existing, nil)
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return([]model.Part{engine}, nil)
+	s.kitRepository.On("UpdateKit", s.ctx, mock.MatchedBy(func(k model.Kit) bool {
+		return k.CreatedAt.Equal(createdAt) && k.UpdatedAt.After(createdAt)
+	})).Return(nil)
+
+	res, err := s.service.UpdateKit(s.ctx, kit)
+	s.Require().NoError(err)
+	s.Require().Equal("Renamed", res.Name)
+	s.Require().Equal(200.0, res.Price)
+	s.Require().Equal(int64(2), res.AvailableQuantity)
+}
+
+func (s *ServiceSuite) TestUpdateKitNotFound() {
+	kit := model.Kit{
+		Uuid:       gofakeit.UUID(),
+		Name:       "Missing",
+		Components: []model.KitComponent{{PartUuid: gofakeit.UUID(), Quantity: 1}},
+	}
+
+	s.kitRepository.On("GetKit", s.ctx, kit.Uuid).Return(model.Kit{}, model.ErrKitNotFound)
+
+	res, err := s.service.UpdateKit(s.ctx, kit)
+	s.Require().ErrorIs(err, model.ErrKitNotFound)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestUpdateKitUnknownPart() {
+	kit := model.Kit{
+		Uuid:       gofakeit.UUID(),
+		Name:       "Unknown part",
+		Components: []model.KitComponent{{PartUuid: gofakeit.UUID(), Quantity: 1}},
+	}
+
+	s.kitRepository.On("GetKit", s.ctx, kit.Uuid).Return(model.Kit{Uuid: kit.Uuid}, nil)
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return([]model.Part{}, nil)
+
+	res, err := s.service.UpdateKit(s.ctx, kit)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Empty(res)
+}
@@ -0,0 +1,156 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CatalogService is an autogenerated mock type for the CatalogService type
+type CatalogService struct {
+	mock.Mock
+}
+
+type CatalogService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CatalogService) EXPECT() *CatalogService_Expecter {
+	return &CatalogService_Expecter{mock: &_m.Mock}
+}
+
+// ExportParts provides a mock function with given fields: ctx
+func (_m *CatalogService) ExportParts(ctx context.Context) ([]model.Part, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportParts")
+	}
+
+	var r0 []model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.Part, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []model.Part); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogService_ExportParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportParts'
+type CatalogService_ExportParts_Call struct {
+	*mock.Call
+}
+
+// ExportParts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CatalogService_Expecter) ExportParts(ctx interface{}) *CatalogService_ExportParts_Call {
+	return &CatalogService_ExportParts_Call{Call: _e.mock.On("ExportParts", ctx)}
+}
+
+func (_c *CatalogService_ExportParts_Call) Run(run func(ctx context.Context)) *CatalogService_ExportParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CatalogService_ExportParts_Call) Return(_a0 []model.Part, _a1 error) *CatalogService_ExportParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogService_ExportParts_Call) RunAndReturn(run func(context.Context) ([]model.Part, error)) *CatalogService_ExportParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportParts provides a mock function with given fields: ctx, rows, dryRun
+func (_m *CatalogService) ImportParts(ctx context.Context, rows []model.CatalogRow, dryRun bool) (model.ImportReport, error) {
+	ret := _m.Called(ctx, rows, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportParts")
+	}
+
+	var r0 model.ImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.CatalogRow, bool) (model.ImportReport, error)); ok {
+		return rf(ctx, rows, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.CatalogRow, bool) model.ImportReport); ok {
+		r0 = rf(ctx, rows, dryRun)
+	} else {
+		r0 = ret.Get(0).(model.ImportReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.CatalogRow, bool) error); ok {
+		r1 = rf(ctx, rows, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogService_ImportParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportParts'
+type CatalogService_ImportParts_Call struct {
+	*mock.Call
+}
+
+// ImportParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rows []model.CatalogRow
+//   - dryRun bool
+func (_e *CatalogService_Expecter) ImportParts(ctx interface{}, rows interface{}, dryRun interface{}) *CatalogService_ImportParts_Call {
+	return &CatalogService_ImportParts_Call{Call: _e.mock.On("ImportParts", ctx, rows, dryRun)}
+}
+
+func (_c *CatalogService_ImportParts_Call) Run(run func(ctx context.Context, rows []model.CatalogRow, dryRun bool)) *CatalogService_ImportParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.CatalogRow), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *CatalogService_ImportParts_Call) Return(_a0 model.ImportReport, _a1 error) *CatalogService_ImportParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogService_ImportParts_Call) RunAndReturn(run func(context.Context, []model.CatalogRow, bool) (model.ImportReport, error)) *CatalogService_ImportParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCatalogService creates a new instance of CatalogService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCatalogService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CatalogService {
+	mock := &CatalogService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,317 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KitService is an autogenerated mock type for the KitService type
+type KitService struct {
+	mock.Mock
+}
+
+type KitService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KitService) EXPECT() *KitService_Expecter {
+	return &KitService_Expecter{mock: &_m.Mock}
+}
+
+// CreateKit provides a mock function with given fields: ctx, kit
+func (_m *KitService) CreateKit(ctx context.Context, kit model.Kit) (model.Kit, error) {
+	ret := _m.Called(ctx, kit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKit")
+	}
+
+	var r0 model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Kit) (model.Kit, error)); ok {
+		return rf(ctx, kit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Kit) model.Kit); ok {
+		r0 = rf(ctx, kit)
+	} else {
+		r0 = ret.Get(0).(model.Kit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Kit) error); ok {
+		r1 = rf(ctx, kit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KitService_CreateKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKit'
+type KitService_CreateKit_Call struct {
+	*mock.Call
+}
+
+// CreateKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kit model.Kit
+func (_e *KitService_Expecter) CreateKit(ctx interface{}, kit interface{}) *KitService_CreateKit_Call {
+	return &KitService_CreateKit_Call{Call: _e.mock.On("CreateKit", ctx, kit)}
+}
+
+func (_c *KitService_CreateKit_Call) Run(run func(ctx context.Context, kit model.Kit)) *KitService_CreateKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Kit))
+	})
+	return _c
+}
+
+func (_c *KitService_CreateKit_Call) Return(_a0 model.Kit, _a1 error) *KitService_CreateKit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KitService_CreateKit_Call) RunAndReturn(run func(context.Context, model.Kit) (model.Kit, error)) *KitService_CreateKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteKit provides a mock function with given fields: ctx, uuid
+func (_m *KitService) DeleteKit(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KitService_DeleteKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteKit'
+type KitService_DeleteKit_Call struct {
+	*mock.Call
+}
+
+// DeleteKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *KitService_Expecter) DeleteKit(ctx interface{}, uuid interface{}) *KitService_DeleteKit_Call {
+	return &KitService_DeleteKit_Call{Call: _e.mock.On("DeleteKit", ctx, uuid)}
+}
+
+func (_c *KitService_DeleteKit_Call) Run(run func(ctx context.Context, uuid string)) *KitService_DeleteKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KitService_DeleteKit_Call) Return(_a0 error) *KitService_DeleteKit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KitService_DeleteKit_Call) RunAndReturn(run func(context.Context, string) error) *KitService_DeleteKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKit provides a mock function with given fields: ctx, uuid
+func (_m *KitService) GetKit(ctx context.Context, uuid string) (model.Kit, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKit")
+	}
+
+	var r0 model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (model.Kit, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) model.Kit); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Get(0).(model.Kit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KitService_GetKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKit'
+type KitService_GetKit_Call struct {
+	*mock.Call
+}
+
+// GetKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *KitService_Expecter) GetKit(ctx interface{}, uuid interface{}) *KitService_GetKit_Call {
+	return &KitService_GetKit_Call{Call: _e.mock.On("GetKit", ctx, uuid)}
+}
+
+func (_c *KitService_GetKit_Call) Run(run func(ctx context.Context, uuid string)) *KitService_GetKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KitService_GetKit_Call) Return(_a0 model.Kit, _a1 error) *KitService_GetKit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KitService_GetKit_Call) RunAndReturn(run func(context.Context, string) (model.Kit, error)) *KitService_GetKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKits provides a mock function with given fields: ctx, uuids
+func (_m *KitService) ListKits(ctx context.Context, uuids []string) ([]model.Kit, error) {
+	ret := _m.Called(ctx, uuids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKits")
+	}
+
+	var r0 []model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]model.Kit, error)); ok {
+		return rf(ctx, uuids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.Kit); ok {
+		r0 = rf(ctx, uuids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Kit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, uuids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KitService_ListKits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKits'
+type KitService_ListKits_Call struct {
+	*mock.Call
+}
+
+// ListKits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuids []string
+func (_e *KitService_Expecter) ListKits(ctx interface{}, uuids interface{}) *KitService_ListKits_Call {
+	return &KitService_ListKits_Call{Call: _e.mock.On("ListKits", ctx, uuids)}
+}
+
+func (_c *KitService_ListKits_Call) Run(run func(ctx context.Context, uuids []string)) *KitService_ListKits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *KitService_ListKits_Call) Return(_a0 []model.Kit, _a1 error) *KitService_ListKits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KitService_ListKits_Call) RunAndReturn(run func(context.Context, []string) ([]model.Kit, error)) *KitService_ListKits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateKit provides a mock function with given fields: ctx, kit
+func (_m *KitService) UpdateKit(ctx context.Context, kit model.Kit) (model.Kit, error) {
+	ret := _m.Called(ctx, kit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKit")
+	}
+
+	var r0 model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Kit) (model.Kit, error)); ok {
+		return rf(ctx, kit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Kit) model.Kit); ok {
+		r0 = rf(ctx, kit)
+	} else {
+		r0 = ret.Get(0).(model.Kit)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Kit) error); ok {
+		r1 = rf(ctx, kit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KitService_UpdateKit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateKit'
+type KitService_UpdateKit_Call struct {
+	*mock.Call
+}
+
+// UpdateKit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kit model.Kit
+func (_e *KitService_Expecter) UpdateKit(ctx interface{}, kit interface{}) *KitService_UpdateKit_Call {
+	return &KitService_UpdateKit_Call{Call: _e.mock.On("UpdateKit", ctx, kit)}
+}
+
+func (_c *KitService_UpdateKit_Call) Run(run func(ctx context.Context, kit model.Kit)) *KitService_UpdateKit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Kit))
+	})
+	return _c
+}
+
+func (_c *KitService_UpdateKit_Call) Return(_a0 model.Kit, _a1 error) *KitService_UpdateKit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KitService_UpdateKit_Call) RunAndReturn(run func(context.Context, model.Kit) (model.Kit, error)) *KitService_UpdateKit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKitService creates a new instance of KitService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKitService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KitService {
+	mock := &KitService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,262 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PartPriceService is an autogenerated mock type for the PartPriceService type
+type PartPriceService struct {
+	mock.Mock
+}
+
+type PartPriceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartPriceService) EXPECT() *PartPriceService_Expecter {
+	return &PartPriceService_Expecter{mock: &_m.Mock}
+}
+
+// GetPartPriceAt provides a mock function with given fields: ctx, partUuid, at
+func (_m *PartPriceService) GetPartPriceAt(ctx context.Context, partUuid string, at time.Time) (model.PartPrice, error) {
+	ret := _m.Called(ctx, partUuid, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartPriceAt")
+	}
+
+	var r0 model.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (model.PartPrice, error)); ok {
+		return rf(ctx, partUuid, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) model.PartPrice); ok {
+		r0 = rf(ctx, partUuid, at)
+	} else {
+		r0 = ret.Get(0).(model.PartPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, partUuid, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceService_GetPartPriceAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartPriceAt'
+type PartPriceService_GetPartPriceAt_Call struct {
+	*mock.Call
+}
+
+// GetPartPriceAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid string
+//   - at time.Time
+func (_e *PartPriceService_Expecter) GetPartPriceAt(ctx interface{}, partUuid interface{}, at interface{}) *PartPriceService_GetPartPriceAt_Call {
+	return &PartPriceService_GetPartPriceAt_Call{Call: _e.mock.On("GetPartPriceAt", ctx, partUuid, at)}
+}
+
+func (_c *PartPriceService_GetPartPriceAt_Call) Run(run func(ctx context.Context, partUuid string, at time.Time)) *PartPriceService_GetPartPriceAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PartPriceService_GetPartPriceAt_Call) Return(_a0 model.PartPrice, _a1 error) *PartPriceService_GetPartPriceAt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceService_GetPartPriceAt_Call) RunAndReturn(run func(context.Context, string, time.Time) (model.PartPrice, error)) *PartPriceService_GetPartPriceAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitPriceHistory provides a mock function with given fields: ctx
+func (_m *PartPriceService) InitPriceHistory(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitPriceHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartPriceService_InitPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitPriceHistory'
+type PartPriceService_InitPriceHistory_Call struct {
+	*mock.Call
+}
+
+// InitPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PartPriceService_Expecter) InitPriceHistory(ctx interface{}) *PartPriceService_InitPriceHistory_Call {
+	return &PartPriceService_InitPriceHistory_Call{Call: _e.mock.On("InitPriceHistory", ctx)}
+}
+
+func (_c *PartPriceService_InitPriceHistory_Call) Run(run func(ctx context.Context)) *PartPriceService_InitPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PartPriceService_InitPriceHistory_Call) Return(_a0 error) *PartPriceService_InitPriceHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartPriceService_InitPriceHistory_Call) RunAndReturn(run func(context.Context) error) *PartPriceService_InitPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPartPrices provides a mock function with given fields: ctx, partUuid
+func (_m *PartPriceService) ListPartPrices(ctx context.Context, partUuid string) ([]model.PartPrice, error) {
+	ret := _m.Called(ctx, partUuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPartPrices")
+	}
+
+	var r0 []model.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.PartPrice, error)); ok {
+		return rf(ctx, partUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.PartPrice); ok {
+		r0 = rf(ctx, partUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PartPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, partUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceService_ListPartPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPartPrices'
+type PartPriceService_ListPartPrices_Call struct {
+	*mock.Call
+}
+
+// ListPartPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUuid string
+func (_e *PartPriceService_Expecter) ListPartPrices(ctx interface{}, partUuid interface{}) *PartPriceService_ListPartPrices_Call {
+	return &PartPriceService_ListPartPrices_Call{Call: _e.mock.On("ListPartPrices", ctx, partUuid)}
+}
+
+func (_c *PartPriceService_ListPartPrices_Call) Run(run func(ctx context.Context, partUuid string)) *PartPriceService_ListPartPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartPriceService_ListPartPrices_Call) Return(_a0 []model.PartPrice, _a1 error) *PartPriceService_ListPartPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceService_ListPartPrices_Call) RunAndReturn(run func(context.Context, string) ([]model.PartPrice, error)) *PartPriceService_ListPartPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SchedulePartPrice provides a mock function with given fields: ctx, price
+func (_m *PartPriceService) SchedulePartPrice(ctx context.Context, price model.PartPrice) (model.PartPrice, error) {
+	ret := _m.Called(ctx, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulePartPrice")
+	}
+
+	var r0 model.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartPrice) (model.PartPrice, error)); ok {
+		return rf(ctx, price)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartPrice) model.PartPrice); ok {
+		r0 = rf(ctx, price)
+	} else {
+		r0 = ret.Get(0).(model.PartPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PartPrice) error); ok {
+		r1 = rf(ctx, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartPriceService_SchedulePartPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePartPrice'
+type PartPriceService_SchedulePartPrice_Call struct {
+	*mock.Call
+}
+
+// SchedulePartPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - price model.PartPrice
+func (_e *PartPriceService_Expecter) SchedulePartPrice(ctx interface{}, price interface{}) *PartPriceService_SchedulePartPrice_Call {
+	return &PartPriceService_SchedulePartPrice_Call{Call: _e.mock.On("SchedulePartPrice", ctx, price)}
+}
+
+func (_c *PartPriceService_SchedulePartPrice_Call) Run(run func(ctx context.Context, price model.PartPrice)) *PartPriceService_SchedulePartPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartPrice))
+	})
+	return _c
+}
+
+func (_c *PartPriceService_SchedulePartPrice_Call) Return(_a0 model.PartPrice, _a1 error) *PartPriceService_SchedulePartPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartPriceService_SchedulePartPrice_Call) RunAndReturn(run func(context.Context, model.PartPrice) (model.PartPrice, error)) *PartPriceService_SchedulePartPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartPriceService creates a new instance of PartPriceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartPriceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartPriceService {
+	mock := &PartPriceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
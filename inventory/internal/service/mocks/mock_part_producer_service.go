@@ -0,0 +1,181 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PartProducerService is an autogenerated mock type for the PartProducerService type
+type PartProducerService struct {
+	mock.Mock
+}
+
+type PartProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartProducerService) EXPECT() *PartProducerService_Expecter {
+	return &PartProducerService_Expecter{mock: &_m.Mock}
+}
+
+// ProducePartCreated provides a mock function with given fields: ctx, event
+func (_m *PartProducerService) ProducePartCreated(ctx context.Context, event model.PartCreatedEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProducePartCreated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartCreatedEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartProducerService_ProducePartCreated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProducePartCreated'
+type PartProducerService_ProducePartCreated_Call struct {
+	*mock.Call
+}
+
+// ProducePartCreated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.PartCreatedEvent
+func (_e *PartProducerService_Expecter) ProducePartCreated(ctx interface{}, event interface{}) *PartProducerService_ProducePartCreated_Call {
+	return &PartProducerService_ProducePartCreated_Call{Call: _e.mock.On("ProducePartCreated", ctx, event)}
+}
+
+func (_c *PartProducerService_ProducePartCreated_Call) Run(run func(ctx context.Context, event model.PartCreatedEvent)) *PartProducerService_ProducePartCreated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartCreatedEvent))
+	})
+	return _c
+}
+
+func (_c *PartProducerService_ProducePartCreated_Call) Return(_a0 error) *PartProducerService_ProducePartCreated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerService_ProducePartCreated_Call) RunAndReturn(run func(context.Context, model.PartCreatedEvent) error) *PartProducerService_ProducePartCreated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProducePartDeleted provides a mock function with given fields: ctx, event
+func (_m *PartProducerService) ProducePartDeleted(ctx context.Context, event model.PartDeletedEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProducePartDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartDeletedEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartProducerService_ProducePartDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProducePartDeleted'
+type PartProducerService_ProducePartDeleted_Call struct {
+	*mock.Call
+}
+
+// ProducePartDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.PartDeletedEvent
+func (_e *PartProducerService_Expecter) ProducePartDeleted(ctx interface{}, event interface{}) *PartProducerService_ProducePartDeleted_Call {
+	return &PartProducerService_ProducePartDeleted_Call{Call: _e.mock.On("ProducePartDeleted", ctx, event)}
+}
+
+func (_c *PartProducerService_ProducePartDeleted_Call) Run(run func(ctx context.Context, event model.PartDeletedEvent)) *PartProducerService_ProducePartDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartDeletedEvent))
+	})
+	return _c
+}
+
+func (_c *PartProducerService_ProducePartDeleted_Call) Return(_a0 error) *PartProducerService_ProducePartDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerService_ProducePartDeleted_Call) RunAndReturn(run func(context.Context, model.PartDeletedEvent) error) *PartProducerService_ProducePartDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProducePartUpdated provides a mock function with given fields: ctx, event
+func (_m *PartProducerService) ProducePartUpdated(ctx context.Context, event model.PartUpdatedEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProducePartUpdated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartUpdatedEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartProducerService_ProducePartUpdated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProducePartUpdated'
+type PartProducerService_ProducePartUpdated_Call struct {
+	*mock.Call
+}
+
+// ProducePartUpdated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.PartUpdatedEvent
+func (_e *PartProducerService_Expecter) ProducePartUpdated(ctx interface{}, event interface{}) *PartProducerService_ProducePartUpdated_Call {
+	return &PartProducerService_ProducePartUpdated_Call{Call: _e.mock.On("ProducePartUpdated", ctx, event)}
+}
+
+func (_c *PartProducerService_ProducePartUpdated_Call) Run(run func(ctx context.Context, event model.PartUpdatedEvent)) *PartProducerService_ProducePartUpdated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartUpdatedEvent))
+	})
+	return _c
+}
+
+func (_c *PartProducerService_ProducePartUpdated_Call) Return(_a0 error) *PartProducerService_ProducePartUpdated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerService_ProducePartUpdated_Call) RunAndReturn(run func(context.Context, model.PartUpdatedEvent) error) *PartProducerService_ProducePartUpdated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartProducerService creates a new instance of PartProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartProducerService {
+	mock := &PartProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
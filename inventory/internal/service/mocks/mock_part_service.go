@@ -25,6 +25,110 @@ func (_m *PartService) EXPECT() *PartService_Expecter {
 	return &PartService_Expecter{mock: &_m.Mock}
 }
 
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *PartService) CreatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) (model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type PartService_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+func (_e *PartService_Expecter) CreatePart(ctx interface{}, part interface{}) *PartService_CreatePart_Call {
+	return &PartService_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *PartService_CreatePart_Call) Run(run func(ctx context.Context, part model.Part)) *PartService_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part))
+	})
+	return _c
+}
+
+func (_c *PartService_CreatePart_Call) Return(_a0 model.Part, _a1 error) *PartService_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_CreatePart_Call) RunAndReturn(run func(context.Context, model.Part) (model.Part, error)) *PartService_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid
+func (_m *PartService) DeletePart(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartService_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type PartService_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *PartService_Expecter) DeletePart(ctx interface{}, uuid interface{}) *PartService_DeletePart_Call {
+	return &PartService_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid)}
+}
+
+func (_c *PartService_DeletePart_Call) Run(run func(ctx context.Context, uuid string)) *PartService_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartService_DeletePart_Call) Return(_a0 error) *PartService_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartService_DeletePart_Call) RunAndReturn(run func(context.Context, string) error) *PartService_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *PartService) GetPart(ctx context.Context, uuid string) (model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -82,6 +186,64 @@ func (_c *PartService_GetPart_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
+// GetPartFacets provides a mock function with given fields: ctx, filter, topTagsLimit
+func (_m *PartService) GetPartFacets(ctx context.Context, filter model.PartsFilter, topTagsLimit int) (model.PartFacets, error) {
+	ret := _m.Called(ctx, filter, topTagsLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartFacets")
+	}
+
+	var r0 model.PartFacets
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, int) (model.PartFacets, error)); ok {
+		return rf(ctx, filter, topTagsLimit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, int) model.PartFacets); ok {
+		r0 = rf(ctx, filter, topTagsLimit)
+	} else {
+		r0 = ret.Get(0).(model.PartFacets)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PartsFilter, int) error); ok {
+		r1 = rf(ctx, filter, topTagsLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_GetPartFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartFacets'
+type PartService_GetPartFacets_Call struct {
+	*mock.Call
+}
+
+// GetPartFacets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+//   - topTagsLimit int
+func (_e *PartService_Expecter) GetPartFacets(ctx interface{}, filter interface{}, topTagsLimit interface{}) *PartService_GetPartFacets_Call {
+	return &PartService_GetPartFacets_Call{Call: _e.mock.On("GetPartFacets", ctx, filter, topTagsLimit)}
+}
+
+func (_c *PartService_GetPartFacets_Call) Run(run func(ctx context.Context, filter model.PartsFilter, topTagsLimit int)) *PartService_GetPartFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *PartService_GetPartFacets_Call) Return(_a0 model.PartFacets, _a1 error) *PartService_GetPartFacets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_GetPartFacets_Call) RunAndReturn(run func(context.Context, model.PartsFilter, int) (model.PartFacets, error)) *PartService_GetPartFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx, filter
 func (_m *PartService) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
 	ret := _m.Called(ctx, filter)
@@ -141,6 +303,112 @@ func (_c *PartService_ListParts_Call) RunAndReturn(run func(context.Context, mod
 	return _c
 }
 
+// StreamParts provides a mock function with given fields: ctx, filter, chunkSize, send
+func (_m *PartService) StreamParts(ctx context.Context, filter model.PartsFilter, chunkSize int, send func([]model.Part) error) error {
+	ret := _m.Called(ctx, filter, chunkSize, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PartsFilter, int, func([]model.Part) error) error); ok {
+		r0 = rf(ctx, filter, chunkSize, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartService_StreamParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamParts'
+type PartService_StreamParts_Call struct {
+	*mock.Call
+}
+
+// StreamParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.PartsFilter
+//   - chunkSize int
+//   - send func([]model.Part) error
+func (_e *PartService_Expecter) StreamParts(ctx interface{}, filter interface{}, chunkSize interface{}, send interface{}) *PartService_StreamParts_Call {
+	return &PartService_StreamParts_Call{Call: _e.mock.On("StreamParts", ctx, filter, chunkSize, send)}
+}
+
+func (_c *PartService_StreamParts_Call) Run(run func(ctx context.Context, filter model.PartsFilter, chunkSize int, send func([]model.Part) error)) *PartService_StreamParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PartsFilter), args[2].(int), args[3].(func([]model.Part) error))
+	})
+	return _c
+}
+
+func (_c *PartService_StreamParts_Call) Return(_a0 error) *PartService_StreamParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartService_StreamParts_Call) RunAndReturn(run func(context.Context, model.PartsFilter, int, func([]model.Part) error) error) *PartService_StreamParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, part
+func (_m *PartService) UpdatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) (model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Part) model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Get(0).(model.Part)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type PartService_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part model.Part
+func (_e *PartService_Expecter) UpdatePart(ctx interface{}, part interface{}) *PartService_UpdatePart_Call {
+	return &PartService_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, part)}
+}
+
+func (_c *PartService_UpdatePart_Call) Run(run func(ctx context.Context, part model.Part)) *PartService_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Part))
+	})
+	return _c
+}
+
+func (_c *PartService_UpdatePart_Call) Return(_a0 model.Part, _a1 error) *PartService_UpdatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_UpdatePart_Call) RunAndReturn(run func(context.Context, model.Part) (model.Part, error)) *PartService_UpdatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPartService creates a new instance of PartService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPartService(t interface {
@@ -0,0 +1,134 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockProducerService is an autogenerated mock type for the StockProducerService type
+type StockProducerService struct {
+	mock.Mock
+}
+
+type StockProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockProducerService) EXPECT() *StockProducerService_Expecter {
+	return &StockProducerService_Expecter{mock: &_m.Mock}
+}
+
+// ProduceStockLevelChanged provides a mock function with given fields: ctx, event
+func (_m *StockProducerService) ProduceStockLevelChanged(ctx context.Context, event model.StockLevelChangedEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceStockLevelChanged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockLevelChangedEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockProducerService_ProduceStockLevelChanged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceStockLevelChanged'
+type StockProducerService_ProduceStockLevelChanged_Call struct {
+	*mock.Call
+}
+
+// ProduceStockLevelChanged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.StockLevelChangedEvent
+func (_e *StockProducerService_Expecter) ProduceStockLevelChanged(ctx interface{}, event interface{}) *StockProducerService_ProduceStockLevelChanged_Call {
+	return &StockProducerService_ProduceStockLevelChanged_Call{Call: _e.mock.On("ProduceStockLevelChanged", ctx, event)}
+}
+
+func (_c *StockProducerService_ProduceStockLevelChanged_Call) Run(run func(ctx context.Context, event model.StockLevelChangedEvent)) *StockProducerService_ProduceStockLevelChanged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.StockLevelChangedEvent))
+	})
+	return _c
+}
+
+func (_c *StockProducerService_ProduceStockLevelChanged_Call) Return(_a0 error) *StockProducerService_ProduceStockLevelChanged_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockProducerService_ProduceStockLevelChanged_Call) RunAndReturn(run func(context.Context, model.StockLevelChangedEvent) error) *StockProducerService_ProduceStockLevelChanged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceStockLow provides a mock function with given fields: ctx, event
+func (_m *StockProducerService) ProduceStockLow(ctx context.Context, event model.StockLowEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceStockLow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StockLowEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockProducerService_ProduceStockLow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceStockLow'
+type StockProducerService_ProduceStockLow_Call struct {
+	*mock.Call
+}
+
+// ProduceStockLow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.StockLowEvent
+func (_e *StockProducerService_Expecter) ProduceStockLow(ctx interface{}, event interface{}) *StockProducerService_ProduceStockLow_Call {
+	return &StockProducerService_ProduceStockLow_Call{Call: _e.mock.On("ProduceStockLow", ctx, event)}
+}
+
+func (_c *StockProducerService_ProduceStockLow_Call) Run(run func(ctx context.Context, event model.StockLowEvent)) *StockProducerService_ProduceStockLow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.StockLowEvent))
+	})
+	return _c
+}
+
+func (_c *StockProducerService_ProduceStockLow_Call) Return(_a0 error) *StockProducerService_ProduceStockLow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockProducerService_ProduceStockLow_Call) RunAndReturn(run func(context.Context, model.StockLowEvent) error) *StockProducerService_ProduceStockLow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockProducerService creates a new instance of StockProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockProducerService {
+	mock := &StockProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,47 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	inventoryMetrics "github.com/nkolesnikov999/micro2-OK/inventory/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// recordCacheLookup учитывает результат чтения из кэша в метриках.
+// Ошибка Redis не прерывает запрос: она логируется и считается промахом.
+func recordCacheLookup(ctx context.Context, cache string, err error) {
+	attrs := metric.WithAttributes(attribute.String("cache", cache))
+
+	if err == nil {
+		inventoryMetrics.CacheHitsTotal.Add(ctx, 1, attrs)
+		return
+	}
+
+	if !errors.Is(err, model.ErrCacheMiss) {
+		logger.Error(ctx,
+			"failed to read parts cache",
+			zap.String("cache", cache),
+			zap.Error(err),
+		)
+	}
+
+	inventoryMetrics.CacheMissesTotal.Add(ctx, 1, attrs)
+}
+
+// invalidateCache сбрасывает кэш после записи. Запись в MongoDB уже прошла,
+// поэтому ошибку только логируем — устаревшие данные доживут до TTL.
+func (s *service) invalidateCache(ctx context.Context, uuid string) {
+	if err := s.partCacheRepository.Invalidate(ctx, uuid); err != nil {
+		logger.Error(ctx,
+			"failed to invalidate parts cache",
+			zap.String("uuid", uuid),
+			zap.Error(err),
+		)
+	}
+}
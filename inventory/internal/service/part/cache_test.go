@@ -0,0 +1,165 @@
+package part
+
+import (
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+)
+
+func (s *ServiceSuite) TestGetPartCacheHit() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Category: model.CategoryFuel}
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+
+	res, err := s.newService(cache).GetPart(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(part, res)
+	s.partRepository.AssertNotCalled(s.T(), "GetPart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestGetPartCacheMissStoresPart() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Category: model.CategoryFuel}
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, model.ErrCacheMiss)
+	cache.On("SetPart", mock.Anything, part, partCacheTTL).Return(nil)
+	s.partRepository.On("GetPart", mock.Anything, part.Uuid).Return(part, nil)
+
+	res, err := s.newService(cache).GetPart(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(part, res)
+}
+
+func (s *ServiceSuite) TestGetPartFallsBackToMongoOnCacheError() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Category: model.CategoryFuel}
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, gofakeit.Error())
+	cache.On("SetPart", mock.Anything, part, partCacheTTL).Return(gofakeit.Error())
+	s.partRepository.On("GetPart", mock.Anything, part.Uuid).Return(part, nil)
+
+	res, err := s.newService(cache).GetPart(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(part, res)
+}
+
+func (s *ServiceSuite) TestGetPartNotFoundIsNotCached() {
+	partUuid := gofakeit.UUID()
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, partUuid).Return(model.Part{}, model.ErrCacheMiss)
+	s.partRepository.On("GetPart", mock.Anything, partUuid).Return(model.Part{}, model.ErrPartNotFound)
+
+	_, err := s.newService(cache).GetPart(s.ctx, partUuid)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	cache.AssertNotCalled(s.T(), "SetPart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestGetPartConcurrentMissesLoadOnce() {
+	const callers = 10
+
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Category: model.CategoryFuel}
+
+	var lookups sync.WaitGroup
+	lookups.Add(callers)
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, model.ErrCacheMiss).
+		Run(func(mock.Arguments) { lookups.Done() }).Times(callers)
+	cache.On("SetPart", mock.Anything, part, partCacheTTL).Return(nil).Once()
+
+	// Первый запрос в MongoDB ждет, пока все клиенты промахнутся мимо кэша
+	s.partRepository.On("GetPart", mock.Anything, part.Uuid).Return(part, nil).
+		Run(func(mock.Arguments) {
+			lookups.Wait()
+			time.Sleep(50 * time.Millisecond)
+		}).Once()
+
+	svc := s.newService(cache)
+
+	var wg sync.WaitGroup
+	results := make([]model.Part, callers)
+	errs := make([]error, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = svc.GetPart(s.ctx, part.Uuid)
+		}()
+	}
+	wg.Wait()
+
+	for i := range callers {
+		s.Require().NoError(errs[i])
+		s.Require().Equal(part, results[i])
+	}
+}
+
+func (s *ServiceSuite) TestListPartsCacheHit() {
+	filter := model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	parts := []model.Part{{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Category: model.CategoryEngine}}
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetParts", s.ctx, filter).Return(parts, nil)
+
+	res, err := s.newService(cache).ListParts(s.ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Equal(parts, res)
+	s.partRepository.AssertNotCalled(s.T(), "ListParts", mock.Anything)
+}
+
+func (s *ServiceSuite) TestListPartsCacheMissStoresFilteredParts() {
+	filter := model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+	engine := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Category: model.CategoryEngine}
+	wing := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Category: model.CategoryWing}
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetParts", s.ctx, filter).Return(nil, model.ErrCacheMiss)
+	cache.On("SetParts", mock.Anything, filter, []model.Part{engine}, listCacheTTL).Return(nil)
+	s.partRepository.On("ListParts", mock.Anything).Return([]model.Part{engine, wing}, nil)
+
+	res, err := s.newService(cache).ListParts(s.ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Equal([]model.Part{engine}, res)
+}
+
+func (s *ServiceSuite) TestWritesInvalidateCache() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 10, Category: model.CategoryFuel}
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("Invalidate", s.ctx, part.Uuid).Return(nil).Times(3)
+
+	s.partRepository.On("CreatePart", s.ctx, mock.Anything).Return(nil)
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.Anything).Return(nil)
+	s.partRepository.On("DeletePart", s.ctx, part.Uuid).Return(nil)
+	s.partProducerService.On("ProducePartCreated", s.ctx, mock.Anything).Return(nil)
+	s.partProducerService.On("ProducePartUpdated", s.ctx, mock.Anything).Return(nil)
+	s.partProducerService.On("ProducePartDeleted", s.ctx, mock.Anything).Return(nil)
+
+	svc := s.newService(cache)
+
+	_, err := svc.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+	_, err = svc.UpdatePart(s.ctx, part)
+	s.Require().NoError(err)
+	s.Require().NoError(svc.DeletePart(s.ctx, part.Uuid))
+}
+
+func (s *ServiceSuite) TestWriteSucceedsWhenInvalidationFails() {
+	partUuid := gofakeit.UUID()
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("Invalidate", s.ctx, partUuid).Return(gofakeit.Error())
+
+	s.partRepository.On("DeletePart", s.ctx, partUuid).Return(nil)
+	s.partProducerService.On("ProducePartDeleted", s.ctx, mock.Anything).Return(nil)
+
+	s.Require().NoError(s.newService(cache).DeletePart(s.ctx, partUuid))
+}
@@ -0,0 +1,72 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) CreatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	if err := model.ValidatePart(part); err != nil {
+		logger.Error(ctx,
+			"invalid part",
+			zap.Any("part", part),
+			zap.Error(err),
+		)
+		return model.Part{}, err
+	}
+
+	now := time.Now()
+	if part.Uuid == "" {
+		part.Uuid = uuid.NewString()
+	}
+	part.StockQuantity = 0
+	part.CreatedAt = now
+	part.UpdatedAt = now
+
+	if err := s.partRepository.CreatePart(ctx, part); err != nil {
+		logger.Error(ctx,
+			"failed to create part",
+			zap.Any("part", part),
+			zap.Error(err),
+		)
+		return model.Part{}, err
+	}
+
+	// Без записи в истории действует цена из документа детали, поэтому ошибку только логируем
+	if err := s.partPriceRepository.AddPrice(ctx, model.PartPrice{
+		PartUuid:      part.Uuid,
+		Price:         part.Price,
+		EffectiveFrom: now,
+		CreatedAt:     now,
+	}); err != nil {
+		logger.Error(ctx,
+			"failed to record part price",
+			zap.String("uuid", part.Uuid),
+			zap.Error(err),
+		)
+	}
+
+	s.invalidateCache(ctx, part.Uuid)
+
+	// Деталь уже сохранена, поэтому ошибку публикации только логируем
+	_ = s.partProducerService.ProducePartCreated(ctx, model.PartCreatedEvent{
+		EventUUID: uuid.NewString(),
+		PartUUID:  part.Uuid,
+		Name:      part.Name,
+		Category:  part.Category,
+		Price:     part.Price,
+	})
+
+	logger.Debug(ctx,
+		"part created successfully",
+		zap.Any("part", part),
+	)
+
+	return part, nil
+}
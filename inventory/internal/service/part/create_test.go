@@ -0,0 +1,83 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreatePartSuccess() {
+	part := model.Part{
+		Name:          gofakeit.Name(),
+		Description:   gofakeit.Sentence(),
+		Price:         gofakeit.Price(100, 1000),
+		StockQuantity: 50,
+		Category:      model.CategoryEngine,
+		Manufacturer:  fakeManufacturer(),
+		Tags:          fakeTags(),
+	}
+
+	s.partRepository.On("CreatePart", s.ctx, mock.MatchedBy(func(p model.Part) bool {
+		return p.Uuid != "" && p.Name == part.Name && p.StockQuantity == 0 &&
+			!p.CreatedAt.IsZero() && p.CreatedAt.Equal(p.UpdatedAt)
+	})).Return(nil)
+	s.partProducerService.On("ProducePartCreated", s.ctx, mock.MatchedBy(func(e model.PartCreatedEvent) bool {
+		return e.EventUUID != "" && e.PartUUID != "" && e.Name == part.Name &&
+			e.Category == part.Category && e.Price == part.Price
+	})).Return(nil)
+
+	res, err := s.service.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Uuid)
+	s.Require().Equal(int64(0), res.StockQuantity)
+	s.Require().Equal(part.Name, res.Name)
+}
+
+func (s *ServiceSuite) TestCreatePartInvalid() {
+	cases := []model.Part{
+		{Name: " ", Price: 1, Category: model.CategoryEngine},
+		{Name: "Name", Price: -1, Category: model.CategoryEngine},
+		{Name: "Name", Price: 1, Category: model.CategoryUnspecified},
+		{Name: "Name", Price: 1, Category: model.Category(42)},
+	}
+
+	for _, part := range cases {
+		res, err := s.service.CreatePart(s.ctx, part)
+		s.Require().ErrorIs(err, model.ErrInvalidPart)
+		s.Require().Empty(res)
+	}
+}
+
+func (s *ServiceSuite) TestCreatePartRepositoryError() {
+	repoErr := gofakeit.Error()
+
+	s.partRepository.On("CreatePart", s.ctx, mock.Anything).Return(repoErr)
+
+	res, err := s.service.CreatePart(s.ctx, model.Part{Name: "Name", Price: 1, Category: model.CategoryFuel})
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Empty(res)
+	s.partProducerService.AssertNotCalled(s.T(), "ProducePartCreated", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreatePartIgnoresProducerError() {
+	s.partRepository.On("CreatePart", s.ctx, mock.Anything).Return(nil)
+	s.partProducerService.On("ProducePartCreated", s.ctx, mock.Anything).Return(gofakeit.Error())
+
+	res, err := s.service.CreatePart(s.ctx, model.Part{Name: "Name", Price: 1, Category: model.CategoryFuel})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Uuid)
+}
+
+func (s *ServiceSuite) TestCreatePartKeepsProvidedUuid() {
+	partUuid := gofakeit.UUID()
+
+	s.partRepository.On("CreatePart", s.ctx, mock.MatchedBy(func(p model.Part) bool {
+		return p.Uuid == partUuid
+	})).Return(nil)
+	s.partProducerService.On("ProducePartCreated", s.ctx, mock.Anything).Return(nil)
+
+	res, err := s.service.CreatePart(s.ctx, model.Part{Uuid: partUuid, Name: "Name", Price: 1, Category: model.CategoryFuel})
+	s.Require().NoError(err)
+	s.Require().Equal(partUuid, res.Uuid)
+}
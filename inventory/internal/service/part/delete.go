@@ -0,0 +1,36 @@
+package part
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) DeletePart(ctx context.Context, partUUID string) error {
+	if err := s.partRepository.DeletePart(ctx, partUUID); err != nil {
+		logger.Error(ctx,
+			"failed to delete part",
+			zap.String("uuid", partUUID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.invalidateCache(ctx, partUUID)
+
+	_ = s.partProducerService.ProducePartDeleted(ctx, model.PartDeletedEvent{
+		EventUUID: uuid.NewString(),
+		PartUUID:  partUUID,
+	})
+
+	logger.Debug(ctx,
+		"part deleted successfully",
+		zap.String("uuid", partUUID),
+	)
+
+	return nil
+}
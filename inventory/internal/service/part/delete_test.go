@@ -0,0 +1,30 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeletePartSuccess() {
+	uuid := gofakeit.UUID()
+
+	s.partRepository.On("DeletePart", s.ctx, uuid).Return(nil)
+	s.partProducerService.On("ProducePartDeleted", s.ctx, mock.MatchedBy(func(e model.PartDeletedEvent) bool {
+		return e.EventUUID != "" && e.PartUUID == uuid
+	})).Return(nil)
+
+	err := s.service.DeletePart(s.ctx, uuid)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeletePartNotFound() {
+	uuid := gofakeit.UUID()
+
+	s.partRepository.On("DeletePart", s.ctx, uuid).Return(model.ErrPartNotFound)
+
+	err := s.service.DeletePart(s.ctx, uuid)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.partProducerService.AssertNotCalled(s.T(), "ProducePartDeleted", mock.Anything, mock.Anything)
+}
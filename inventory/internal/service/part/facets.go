@@ -0,0 +1,42 @@
+package part
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const (
+	defaultTopTagsLimit = 10
+	maxTopTagsLimit     = 100
+)
+
+func (s *service) GetPartFacets(ctx context.Context, filter model.PartsFilter, topTagsLimit int) (model.PartFacets, error) {
+	switch {
+	case topTagsLimit <= 0:
+		topTagsLimit = defaultTopTagsLimit
+	case topTagsLimit > maxTopTagsLimit:
+		topTagsLimit = maxTopTagsLimit
+	}
+
+	facets, err := s.partRepository.GetPartFacets(ctx, filter, topTagsLimit)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get part facets",
+			zap.Any("filter", filter),
+			zap.Int("topTagsLimit", topTagsLimit),
+			zap.Error(err),
+		)
+		return model.PartFacets{}, err
+	}
+
+	logger.Debug(ctx,
+		"part facets calculated successfully",
+		zap.Any("facets", facets),
+	)
+
+	return facets, nil
+}
@@ -0,0 +1,59 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGetPartFacetsSuccess() {
+	var (
+		filter = model.PartsFilter{Categories: []model.Category{model.CategoryEngine}}
+		facets = model.PartFacets{
+			Categories: []model.CategoryFacet{
+				{Category: model.CategoryEngine, Count: 3},
+			},
+			ManufacturerCountries: []model.FacetCount{{Value: "Germany", Count: 2}, {Value: "USA", Count: 1}},
+			ManufacturerNames:     []model.FacetCount{{Value: gofakeit.Company(), Count: 3}},
+			Tags:                  []model.FacetCount{{Value: "engine", Count: 3}},
+			PriceRange:            model.PriceRange{Min: 100, Max: 900},
+		}
+	)
+
+	s.partRepository.On("GetPartFacets", s.ctx, filter, 5).Return(facets, nil)
+
+	res, err := s.service.GetPartFacets(s.ctx, filter, 5)
+	s.Require().NoError(err)
+	s.Require().Equal(facets, res)
+}
+
+func (s *ServiceSuite) TestGetPartFacetsDefaultTopTagsLimit() {
+	filter := model.PartsFilter{}
+
+	s.partRepository.On("GetPartFacets", s.ctx, filter, defaultTopTagsLimit).Return(model.PartFacets{}, nil)
+
+	_, err := s.service.GetPartFacets(s.ctx, filter, 0)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestGetPartFacetsTopTagsLimitCapped() {
+	filter := model.PartsFilter{}
+
+	s.partRepository.On("GetPartFacets", s.ctx, filter, maxTopTagsLimit).Return(model.PartFacets{}, nil)
+
+	_, err := s.service.GetPartFacets(s.ctx, filter, maxTopTagsLimit+1)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestGetPartFacetsRepositoryError() {
+	var (
+		filter  = model.PartsFilter{}
+		repoErr = gofakeit.Error()
+	)
+
+	s.partRepository.On("GetPartFacets", s.ctx, filter, defaultTopTagsLimit).Return(model.PartFacets{}, repoErr)
+
+	res, err := s.service.GetPartFacets(s.ctx, filter, 0)
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Empty(res)
+}
@@ -2,15 +2,26 @@ package part
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
+	inventoryMetrics "github.com/nkolesnikov999/micro2-OK/inventory/internal/metrics"
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 )
 
 func (s *service) GetPart(ctx context.Context, uuid string) (model.Part, error) {
-	part, err := s.partRepository.GetPart(ctx, uuid)
+	part, err := s.partCacheRepository.GetPart(ctx, uuid)
+	recordCacheLookup(ctx, inventoryMetrics.CachePart, err)
+	if err == nil {
+		return part, nil
+	}
+
+	// Одновременные промахи по одной детали ждут результата первого запроса
+	result, err, _ := s.group.Do("part:"+uuid, func() (any, error) {
+		return s.loadPart(ctx, uuid)
+	})
 	if err != nil {
 		logger.Error(ctx,
 			"failed to get part",
@@ -20,6 +31,8 @@ func (s *service) GetPart(ctx context.Context, uuid string) (model.Part, error)
 		return model.Part{}, err
 	}
 
+	part = result.(model.Part)
+
 	logger.Debug(ctx,
 		"part retrieved successfully",
 		zap.Any("part", part),
@@ -27,3 +40,35 @@ func (s *service) GetPart(ctx context.Context, uuid string) (model.Part, error)
 
 	return part, nil
 }
+
+func (s *service) loadPart(ctx context.Context, uuid string) (model.Part, error) {
+	part, err := s.partRepository.GetPart(ctx, uuid)
+	if err != nil {
+		return model.Part{}, err
+	}
+
+	now := time.Now()
+	parts := []model.Part{part}
+	if err := s.applyEffectivePrices(ctx, parts, now); err != nil {
+		return model.Part{}, err
+	}
+	part = parts[0]
+
+	ttl, err := s.cacheTTL(ctx, s.partCacheTTL, parts, now)
+	if err != nil {
+		return model.Part{}, err
+	}
+	if ttl == 0 {
+		return part, nil
+	}
+
+	if err := s.partCacheRepository.SetPart(ctx, part, ttl); err != nil {
+		logger.Error(ctx,
+			"failed to cache part",
+			zap.String("uuid", uuid),
+			zap.Error(err),
+		)
+	}
+
+	return part, nil
+}
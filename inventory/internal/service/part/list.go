@@ -2,15 +2,31 @@ package part
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"go.uber.org/zap"
 
+	inventoryMetrics "github.com/nkolesnikov999/micro2-OK/inventory/internal/metrics"
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 )
 
 func (s *service) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
-	allParts, err := s.partRepository.ListParts(ctx)
+	parts, err := s.partCacheRepository.GetParts(ctx, filter)
+	recordCacheLookup(ctx, inventoryMetrics.CacheList, err)
+	if err == nil {
+		return parts, nil
+	}
+
+	flightKey, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err, _ := s.group.Do("list:"+string(flightKey), func() (any, error) {
+		return s.loadParts(ctx, filter)
+	})
 	if err != nil {
 		logger.Error(ctx,
 			"failed to list parts",
@@ -20,13 +36,52 @@ func (s *service) ListParts(ctx context.Context, filter model.PartsFilter) ([]mo
 		return nil, err
 	}
 
+	parts = result.([]model.Part)
+
+	logger.Debug(ctx,
+		"parts filtered successfully",
+		zap.Int("count", len(parts)),
+	)
+
+	return parts, nil
+}
+
+func (s *service) loadParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
+	allParts, err := s.partRepository.ListParts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := filterParts(allParts, filter)
+
+	now := time.Now()
+	if err := s.applyEffectivePrices(ctx, parts, now); err != nil {
+		return nil, err
+	}
+
+	ttl, err := s.cacheTTL(ctx, s.listCacheTTL, parts, now)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == 0 {
+		return parts, nil
+	}
+
+	if err := s.partCacheRepository.SetParts(ctx, filter, parts, ttl); err != nil {
+		logger.Error(ctx,
+			"failed to cache parts",
+			zap.Any("filter", filter),
+			zap.Error(err),
+		)
+	}
+
+	return parts, nil
+}
+
+func filterParts(allParts []model.Part, filter model.PartsFilter) []model.Part {
 	// Если фильтр пустой или все поля пустые, возвращаем все детали
 	if len(filter.Uuids) == 0 && len(filter.Names) == 0 && len(filter.Categories) == 0 && len(filter.ManufacturerCountries) == 0 && len(filter.Tags) == 0 {
-		logger.Debug(ctx,
-			"all parts returned",
-			// zap.Any("parts", allParts),
-		)
-		return allParts, nil
+		return allParts
 	}
 
 	// Создаем set'ы для O(1) проверки (OR внутри одного поля)
@@ -71,12 +126,7 @@ func (s *service) ListParts(ctx context.Context, filter model.PartsFilter) ([]mo
 		parts = append(parts, part)
 	}
 
-	logger.Debug(ctx,
-		"parts filtered successfully",
-		zap.Any("parts", parts),
-	)
-
-	return parts, nil
+	return parts
 }
 
 func makeStringSet(values []string) map[string]struct{} {
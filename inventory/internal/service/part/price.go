@@ -0,0 +1,55 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+// Записи, которые истекли бы раньше, не кэшируем: цена вот-вот сменится
+const minCacheTTL = time.Second
+
+// applyEffectivePrices подставляет в детали цены, действующие в момент now.
+// Детали без истории цен сохраняют цену из документа.
+func (s *service) applyEffectivePrices(ctx context.Context, parts []model.Part, now time.Time) error {
+	prices, err := s.partPriceRepository.GetEffectivePrices(ctx, partUuids(parts), now)
+	if err != nil {
+		return err
+	}
+
+	for i := range parts {
+		if price, ok := prices[parts[i].Uuid]; ok {
+			parts[i].Price = price.Price
+		}
+	}
+
+	return nil
+}
+
+// cacheTTL сокращает TTL записи кэша, чтобы она не пережила запланированную смену цены.
+// Ноль означает, что кэшировать не нужно.
+func (s *service) cacheTTL(ctx context.Context, ttl time.Duration, parts []model.Part, now time.Time) (time.Duration, error) {
+	next, err := s.partPriceRepository.GetNextPriceChange(ctx, partUuids(parts), now)
+	if err != nil {
+		return 0, err
+	}
+
+	if !next.IsZero() && next.Sub(now) < ttl {
+		ttl = next.Sub(now)
+	}
+
+	if ttl < minCacheTTL {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+func partUuids(parts []model.Part) []string {
+	uuids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		uuids = append(uuids, part.Uuid)
+	}
+	return uuids
+}
@@ -0,0 +1,171 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+)
+
+func (s *ServiceSuite) TestGetPartReturnsEffectivePrice() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryFuel}
+	effective := part
+	effective.Price = 140
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", mock.Anything, []string{part.Uuid}, mock.Anything).
+		Return(map[string]model.PartPrice{part.Uuid: {PartUuid: part.Uuid, Price: 140}}, nil)
+	s.partPriceRepository.On("GetNextPriceChange", mock.Anything, []string{part.Uuid}, mock.Anything).Return(time.Time{}, nil)
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, model.ErrCacheMiss)
+	cache.On("SetPart", mock.Anything, effective, partCacheTTL).Return(nil)
+	s.partRepository.On("GetPart", mock.Anything, part.Uuid).Return(part, nil)
+
+	res, err := s.newService(cache).GetPart(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(140.0, res.Price)
+}
+
+func (s *ServiceSuite) TestGetPartCacheExpiresAtScheduledPriceChange() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryFuel}
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", mock.Anything, mock.Anything, mock.Anything).Return(map[string]model.PartPrice{}, nil)
+	s.partPriceRepository.On("GetNextPriceChange", mock.Anything, mock.Anything, mock.Anything).
+		Return(time.Now().Add(10*time.Second), nil)
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, model.ErrCacheMiss)
+	cache.On("SetPart", mock.Anything, part, mock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 0 && ttl <= 10*time.Second
+	})).Return(nil)
+	s.partRepository.On("GetPart", mock.Anything, part.Uuid).Return(part, nil)
+
+	_, err := s.newService(cache).GetPart(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestGetPartNotCachedRightBeforePriceChange() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryFuel}
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", mock.Anything, mock.Anything, mock.Anything).Return(map[string]model.PartPrice{}, nil)
+	s.partPriceRepository.On("GetNextPriceChange", mock.Anything, mock.Anything, mock.Anything).
+		Return(time.Now().Add(100*time.Millisecond), nil)
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("GetPart", s.ctx, part.Uuid).Return(model.Part{}, model.ErrCacheMiss)
+	s.partRepository.On("GetPart", mock.Anything, part.Uuid).Return(part, nil)
+
+	res, err := s.newService(cache).GetPart(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(part, res)
+	cache.AssertNotCalled(s.T(), "SetPart", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestGetPartPriceHistoryError() {
+	part := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryFuel}
+	repoErr := gofakeit.Error()
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", mock.Anything, mock.Anything, mock.Anything).Return(nil, repoErr)
+	s.partRepository.On("GetPart", mock.Anything, part.Uuid).Return(part, nil)
+
+	_, err := s.newService(s.partCacheRepository).GetPart(s.ctx, part.Uuid)
+	s.Require().ErrorIs(err, repoErr)
+}
+
+func (s *ServiceSuite) TestListPartsReturnsEffectivePrices() {
+	withHistory := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryEngine}
+	withoutHistory := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 50, Category: model.CategoryWing}
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", mock.Anything, []string{withHistory.Uuid, withoutHistory.Uuid}, mock.Anything).
+		Return(map[string]model.PartPrice{withHistory.Uuid: {PartUuid: withHistory.Uuid, Price: 90}}, nil)
+	s.partPriceRepository.On("GetNextPriceChange", mock.Anything, mock.Anything, mock.Anything).Return(time.Time{}, nil)
+	s.partRepository.On("ListParts", mock.Anything).Return([]model.Part{withHistory, withoutHistory}, nil)
+
+	res, err := s.newService(s.partCacheRepository).ListParts(s.ctx, model.PartsFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(res, 2)
+	s.Require().Equal(90.0, res[0].Price)
+	s.Require().Equal(50.0, res[1].Price)
+}
+
+func (s *ServiceSuite) TestCreatePartRecordsPrice() {
+	part := model.Part{Name: gofakeit.Name(), Price: 250, Category: model.CategoryPorthole}
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("AddPrice", s.ctx, mock.MatchedBy(func(p model.PartPrice) bool {
+		return p.PartUuid != "" && p.Price == part.Price && !p.EffectiveFrom.IsZero() && p.EffectiveFrom.Equal(p.CreatedAt)
+	})).Return(nil)
+	s.partRepository.On("CreatePart", s.ctx, mock.Anything).Return(nil)
+	s.partProducerService.On("ProducePartCreated", s.ctx, mock.Anything).Return(nil)
+
+	res, err := s.newService(s.partCacheRepository).CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+	s.Require().Equal(part.Price, res.Price)
+}
+
+func (s *ServiceSuite) TestUpdatePartRecordsPriceChange() {
+	existing := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryEngine}
+	update := existing
+	update.Price = 130
+
+	// Действующая цена отличается от сохранённой в документе: сработала запланированная смена
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", s.ctx, []string{existing.Uuid}, mock.Anything).
+		Return(map[string]model.PartPrice{existing.Uuid: {PartUuid: existing.Uuid, Price: 120}}, nil)
+	s.partPriceRepository.On("AddPrice", s.ctx, mock.MatchedBy(func(p model.PartPrice) bool {
+		return p.PartUuid == existing.Uuid && p.Price == update.Price && !p.EffectiveFrom.IsZero()
+	})).Return(nil)
+	s.partRepository.On("GetPart", s.ctx, existing.Uuid).Return(existing, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.Anything).Return(nil)
+	s.partProducerService.On("ProducePartUpdated", s.ctx, mock.MatchedBy(func(e model.PartUpdatedEvent) bool {
+		return e.Price == update.Price && e.PreviousPrice == 120
+	})).Return(nil)
+
+	_, err := s.newService(s.partCacheRepository).UpdatePart(s.ctx, update)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestUpdatePartKeepsHistoryWhenPriceUnchanged() {
+	existing := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryEngine}
+	update := existing
+	update.Name = gofakeit.Name()
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", s.ctx, []string{existing.Uuid}, mock.Anything).
+		Return(map[string]model.PartPrice{existing.Uuid: {PartUuid: existing.Uuid, Price: 100}}, nil)
+	s.partRepository.On("GetPart", s.ctx, existing.Uuid).Return(existing, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.Anything).Return(nil)
+	s.partProducerService.On("ProducePartUpdated", s.ctx, mock.Anything).Return(nil)
+
+	_, err := s.newService(s.partCacheRepository).UpdatePart(s.ctx, update)
+	s.Require().NoError(err)
+	s.partPriceRepository.AssertNotCalled(s.T(), "AddPrice", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdatePartPriceRecordError() {
+	existing := model.Part{Uuid: gofakeit.UUID(), Name: gofakeit.Name(), Price: 100, Category: model.CategoryEngine}
+	update := existing
+	update.Price = 130
+	repoErr := gofakeit.Error()
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", s.ctx, mock.Anything, mock.Anything).Return(map[string]model.PartPrice{}, nil)
+	s.partPriceRepository.On("AddPrice", s.ctx, mock.Anything).Return(repoErr)
+	s.partRepository.On("GetPart", s.ctx, existing.Uuid).Return(existing, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.Anything).Return(nil)
+
+	cache := mocks.NewPartCacheRepository(s.T())
+	cache.On("Invalidate", s.ctx, existing.Uuid).Return(nil)
+
+	_, err := s.newService(cache).UpdatePart(s.ctx, update)
+	s.Require().ErrorIs(err, repoErr)
+	s.partProducerService.AssertNotCalled(s.T(), "ProducePartUpdated", mock.Anything, mock.Anything)
+}
@@ -1,6 +1,10 @@
 package part
 
 import (
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
 	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
 )
@@ -8,11 +12,32 @@ import (
 var _ def.PartService = (*service)(nil)
 
 type service struct {
-	partRepository repository.PartRepository
+	partRepository      repository.PartRepository
+	partPriceRepository repository.PartPriceRepository
+	partCacheRepository repository.PartCacheRepository
+	partProducerService def.PartProducerService
+
+	partCacheTTL time.Duration
+	listCacheTTL time.Duration
+
+	// Схлопывает одновременные промахи кэша по одному ключу в один запрос к MongoDB
+	group singleflight.Group
 }
 
-func NewService(partRepository repository.PartRepository) *service {
+func NewService(
+	partRepository repository.PartRepository,
+	partPriceRepository repository.PartPriceRepository,
+	partCacheRepository repository.PartCacheRepository,
+	partProducerService def.PartProducerService,
+	partCacheTTL time.Duration,
+	listCacheTTL time.Duration,
+) *service {
 	return &service{
-		partRepository: partRepository,
+		partRepository:      partRepository,
+		partPriceRepository: partPriceRepository,
+		partCacheRepository: partCacheRepository,
+		partProducerService: partProducerService,
+		partCacheTTL:        partCacheTTL,
+		listCacheTTL:        listCacheTTL,
 	}
 }
@@ -0,0 +1,46 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) StreamParts(ctx context.Context, filter model.PartsFilter, chunkSize int, send func([]model.Part) error) error {
+	// Цены считаем на момент начала выгрузки, чтобы все порции были согласованы
+	now := time.Now()
+	sent := 0
+
+	err := s.partRepository.StreamParts(ctx, filter, chunkSize, func(parts []model.Part) error {
+		if err := s.applyEffectivePrices(ctx, parts, now); err != nil {
+			return err
+		}
+
+		if err := send(parts); err != nil {
+			return err
+		}
+
+		sent += len(parts)
+		return nil
+	})
+	if err != nil {
+		logger.Error(ctx,
+			"failed to stream parts",
+			zap.Any("filter", filter),
+			zap.Int("sent", sent),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	logger.Debug(ctx,
+		"parts streamed successfully",
+		zap.Int("count", sent),
+	)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+)
+
+func (s *ServiceSuite) TestStreamPartsSendsChunksWithEffectivePrices() {
+	filter := model.PartsFilter{Tags: []string{"export"}}
+	first := []model.Part{{Uuid: gofakeit.UUID(), Price: 10}, {Uuid: gofakeit.UUID(), Price: 20}}
+	second := []model.Part{{Uuid: gofakeit.UUID(), Price: 30}}
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", s.ctx, mock.Anything, mock.Anything).
+		Return(map[string]model.PartPrice{first[1].Uuid: {PartUuid: first[1].Uuid, Price: 25}}, nil).Twice()
+
+	s.partRepository.On("StreamParts", s.ctx, filter, 2, mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(3).(func([]model.Part) error)
+			s.Require().NoError(fn(first))
+			s.Require().NoError(fn(second))
+		}).Return(nil)
+
+	var received [][]model.Part
+	err := s.newService(s.partCacheRepository).StreamParts(s.ctx, filter, 2, func(parts []model.Part) error {
+		received = append(received, parts)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(received, 2)
+	s.Require().Equal(25.0, received[0][1].Price)
+	s.Require().Equal(30.0, received[1][0].Price)
+	s.partCacheRepository.AssertNotCalled(s.T(), "GetParts", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestStreamPartsSendError() {
+	sendErr := gofakeit.Error()
+
+	s.partRepository.On("StreamParts", s.ctx, model.PartsFilter{}, 10, mock.Anything).
+		Return(func(_ context.Context, _ model.PartsFilter, _ int, fn func([]model.Part) error) error {
+			return fn([]model.Part{{Uuid: gofakeit.UUID(), CreatedAt: time.Now()}})
+		})
+
+	err := s.service.StreamParts(s.ctx, model.PartsFilter{}, 10, func([]model.Part) error {
+		return sendErr
+	})
+	s.Require().ErrorIs(err, sendErr)
+}
+
+func (s *ServiceSuite) TestStreamPartsRepositoryError() {
+	repoErr := gofakeit.Error()
+
+	s.partRepository.On("StreamParts", s.ctx, model.PartsFilter{}, 10, mock.Anything).Return(repoErr)
+
+	err := s.service.StreamParts(s.ctx, model.PartsFilter{}, 10, func([]model.Part) error {
+		s.Fail("nothing should be sent")
+		return nil
+	})
+	s.Require().ErrorIs(err, repoErr)
+}
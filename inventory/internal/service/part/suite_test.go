@@ -3,32 +3,72 @@ package part
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	inventoryMetrics "github.com/nkolesnikov999/micro2-OK/inventory/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+	serviceMocks "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/mocks"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 )
 
+const (
+	partCacheTTL = time.Minute
+	listCacheTTL = 30 * time.Second
+)
+
 type ServiceSuite struct {
 	suite.Suite
 
 	ctx context.Context
 
-	partRepository *mocks.PartRepository
+	partRepository      *mocks.PartRepository
+	partPriceRepository *mocks.PartPriceRepository
+	partCacheRepository *mocks.PartCacheRepository
+	partProducerService *serviceMocks.PartProducerService
 
 	service *service
 }
 
 func (s *ServiceSuite) SetupTest() {
 	logger.InitForBenchmark()
+	_ = inventoryMetrics.InitMetrics("inventory-service-test")
 
 	s.ctx = context.Background()
 
 	s.partRepository = mocks.NewPartRepository(s.T())
+	s.partProducerService = serviceMocks.NewPartProducerService(s.T())
+
+	// По умолчанию истории цен нет и действует цена из документа,
+	// работа с историей проверяется в price_test.go на отдельном моке
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partPriceRepository.On("GetEffectivePrices", mock.Anything, mock.Anything, mock.Anything).Return(map[string]model.PartPrice{}, nil).Maybe()
+	s.partPriceRepository.On("GetNextPriceChange", mock.Anything, mock.Anything, mock.Anything).Return(time.Time{}, nil).Maybe()
+	s.partPriceRepository.On("AddPrice", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// По умолчанию кэш пуст: тесты сервиса проверяют работу с MongoDB,
+	// поведение кэша проверяется в cache_test.go на отдельном моке
+	s.partCacheRepository = mocks.NewPartCacheRepository(s.T())
+	s.partCacheRepository.On("GetPart", mock.Anything, mock.Anything).Return(model.Part{}, model.ErrCacheMiss).Maybe()
+	s.partCacheRepository.On("SetPart", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.partCacheRepository.On("GetParts", mock.Anything, mock.Anything).Return(nil, model.ErrCacheMiss).Maybe()
+	s.partCacheRepository.On("SetParts", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.partCacheRepository.On("Invalidate", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	s.service = s.newService(s.partCacheRepository)
+}
 
-	s.service = NewService(
+func (s *ServiceSuite) newService(partCacheRepository *mocks.PartCacheRepository) *service {
+	return NewService(
 		s.partRepository,
+		s.partPriceRepository,
+		partCacheRepository,
+		s.partProducerService,
+		partCacheTTL,
+		listCacheTTL,
 	)
 }
 
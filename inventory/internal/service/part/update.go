@@ -0,0 +1,98 @@
+package part
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) UpdatePart(ctx context.Context, part model.Part) (model.Part, error) {
+	if err := model.ValidatePart(part); err != nil {
+		logger.Error(ctx,
+			"invalid part",
+			zap.Any("part", part),
+			zap.Error(err),
+		)
+		return model.Part{}, err
+	}
+
+	existing, err := s.partRepository.GetPart(ctx, part.Uuid)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get part",
+			zap.String("uuid", part.Uuid),
+			zap.Error(err),
+		)
+		return model.Part{}, err
+	}
+
+	now := time.Now()
+
+	// Предыдущая цена — действующая, а не сохранённая в документе
+	previous := []model.Part{existing}
+	if err := s.applyEffectivePrices(ctx, previous, now); err != nil {
+		logger.Error(ctx,
+			"failed to get effective part price",
+			zap.String("uuid", part.Uuid),
+			zap.Error(err),
+		)
+		return model.Part{}, err
+	}
+	existing = previous[0]
+
+	part.StockQuantity = existing.StockQuantity
+	part.CreatedAt = existing.CreatedAt
+	part.UpdatedAt = now
+
+	if err := s.partRepository.UpdatePart(ctx, part); err != nil {
+		logger.Error(ctx,
+			"failed to update part",
+			zap.Any("part", part),
+			zap.Error(err),
+		)
+		return model.Part{}, err
+	}
+
+	// Новая цена действует сразу. Если запись не удалась, повторный UpdatePart её добавит
+	var priceErr error
+	if part.Price != existing.Price {
+		priceErr = s.partPriceRepository.AddPrice(ctx, model.PartPrice{
+			PartUuid:      part.Uuid,
+			Price:         part.Price,
+			EffectiveFrom: now,
+			CreatedAt:     now,
+		})
+	}
+
+	s.invalidateCache(ctx, part.Uuid)
+
+	if priceErr != nil {
+		logger.Error(ctx,
+			"failed to record part price",
+			zap.String("uuid", part.Uuid),
+			zap.Error(priceErr),
+		)
+		return model.Part{}, priceErr
+	}
+
+	_ = s.partProducerService.ProducePartUpdated(ctx, model.PartUpdatedEvent{
+		EventUUID:     uuid.NewString(),
+		PartUUID:      part.Uuid,
+		Name:          part.Name,
+		Category:      part.Category,
+		Price:         part.Price,
+		PreviousPrice: existing.Price,
+	})
+
+	logger.Debug(ctx,
+		"part updated successfully",
+		zap.Any("part", part),
+	)
+
+	return part, nil
+}
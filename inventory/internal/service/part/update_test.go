@@ -0,0 +1,60 @@
+package part
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdatePartSuccess() {
+	existing := model.Part{
+		Uuid:          gofakeit.UUID(),
+		Name:          "Old name",
+		Price:         100,
+		StockQuantity: 12,
+		Category:      model.CategoryEngine,
+		CreatedAt:     time.Now().Add(-time.Hour),
+		UpdatedAt:     time.Now().Add(-time.Hour),
+	}
+	update := model.Part{
+		Uuid:          existing.Uuid,
+		Name:          "New name",
+		Price:         120,
+		StockQuantity: 999,
+		Category:      model.CategoryWing,
+	}
+
+	s.partRepository.On("GetPart", s.ctx, existing.Uuid).Return(existing, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.MatchedBy(func(p model.Part) bool {
+		return p.Uuid == existing.Uuid && p.Name == update.Name && p.StockQuantity == existing.StockQuantity &&
+			p.CreatedAt.Equal(existing.CreatedAt) && p.UpdatedAt.After(existing.UpdatedAt)
+	})).Return(nil)
+	s.partProducerService.On("ProducePartUpdated", s.ctx, mock.MatchedBy(func(e model.PartUpdatedEvent) bool {
+		return e.EventUUID != "" && e.PartUUID == existing.Uuid && e.Name == update.Name &&
+			e.Price == update.Price && e.PreviousPrice == existing.Price && e.Category == update.Category
+	})).Return(nil)
+
+	res, err := s.service.UpdatePart(s.ctx, update)
+	s.Require().NoError(err)
+	s.Require().Equal(update.Name, res.Name)
+	s.Require().Equal(existing.StockQuantity, res.StockQuantity)
+}
+
+func (s *ServiceSuite) TestUpdatePartNotFound() {
+	uuid := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, uuid).Return(model.Part{}, model.ErrPartNotFound)
+
+	res, err := s.service.UpdatePart(s.ctx, model.Part{Uuid: uuid, Name: "Name", Price: 1, Category: model.CategoryFuel})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestUpdatePartInvalid() {
+	res, err := s.service.UpdatePart(s.ctx, model.Part{Uuid: gofakeit.UUID(), Price: 1, Category: model.CategoryFuel})
+	s.Require().ErrorIs(err, model.ErrInvalidPart)
+	s.Require().Empty(res)
+}
@@ -0,0 +1,45 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) GetPartPriceAt(ctx context.Context, partUuid string, at time.Time) (model.PartPrice, error) {
+	price, err := s.partPriceRepository.GetPriceAt(ctx, partUuid, at)
+	if err == nil {
+		return price, nil
+	}
+	if !errors.Is(err, model.ErrPartPriceNotFound) {
+		logger.Error(ctx,
+			"failed to get part price",
+			zap.String("uuid", partUuid),
+			zap.Time("at", at),
+			zap.Error(err),
+		)
+		return model.PartPrice{}, err
+	}
+
+	part, err := s.partRepository.GetPart(ctx, partUuid)
+	if err != nil {
+		return model.PartPrice{}, err
+	}
+
+	// Деталь без истории цен: действует цена из документа с момента создания
+	if at.Before(part.CreatedAt) {
+		return model.PartPrice{}, model.ErrPartPriceNotFound
+	}
+
+	return model.PartPrice{
+		PartUuid:      part.Uuid,
+		Price:         part.Price,
+		EffectiveFrom: part.CreatedAt,
+		CreatedAt:     part.CreatedAt,
+	}, nil
+}
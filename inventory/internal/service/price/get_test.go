@@ -0,0 +1,66 @@
+package price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGetPartPriceAtFromHistory() {
+	at := time.Now().Add(-time.Hour)
+	price := model.PartPrice{PartUuid: gofakeit.UUID(), Price: 99, EffectiveFrom: at.Add(-time.Hour)}
+
+	s.partPriceRepository.On("GetPriceAt", s.ctx, price.PartUuid, at).Return(price, nil)
+
+	res, err := s.service.GetPartPriceAt(s.ctx, price.PartUuid, at)
+	s.Require().NoError(err)
+	s.Require().Equal(price, res)
+}
+
+func (s *ServiceSuite) TestGetPartPriceAtFallsBackToPartPrice() {
+	part := model.Part{Uuid: gofakeit.UUID(), Price: 75, CreatedAt: time.Now().Add(-24 * time.Hour)}
+	at := time.Now()
+
+	s.partPriceRepository.On("GetPriceAt", s.ctx, part.Uuid, at).Return(model.PartPrice{}, model.ErrPartPriceNotFound)
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+
+	res, err := s.service.GetPartPriceAt(s.ctx, part.Uuid, at)
+	s.Require().NoError(err)
+	s.Require().Equal(part.Price, res.Price)
+	s.Require().Equal(part.CreatedAt, res.EffectiveFrom)
+}
+
+func (s *ServiceSuite) TestGetPartPriceAtBeforePartCreated() {
+	part := model.Part{Uuid: gofakeit.UUID(), Price: 75, CreatedAt: time.Now()}
+	at := part.CreatedAt.Add(-time.Hour)
+
+	s.partPriceRepository.On("GetPriceAt", s.ctx, part.Uuid, at).Return(model.PartPrice{}, model.ErrPartPriceNotFound)
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+
+	_, err := s.service.GetPartPriceAt(s.ctx, part.Uuid, at)
+	s.Require().ErrorIs(err, model.ErrPartPriceNotFound)
+}
+
+func (s *ServiceSuite) TestGetPartPriceAtPartNotFound() {
+	partUuid := gofakeit.UUID()
+	at := time.Now()
+
+	s.partPriceRepository.On("GetPriceAt", s.ctx, partUuid, at).Return(model.PartPrice{}, model.ErrPartPriceNotFound)
+	s.partRepository.On("GetPart", s.ctx, partUuid).Return(model.Part{}, model.ErrPartNotFound)
+
+	_, err := s.service.GetPartPriceAt(s.ctx, partUuid, at)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
+
+func (s *ServiceSuite) TestGetPartPriceAtRepositoryError() {
+	partUuid := gofakeit.UUID()
+	at := time.Now()
+	repoErr := gofakeit.Error()
+
+	s.partPriceRepository.On("GetPriceAt", s.ctx, partUuid, at).Return(model.PartPrice{}, repoErr)
+
+	_, err := s.service.GetPartPriceAt(s.ctx, partUuid, at)
+	s.Require().ErrorIs(err, repoErr)
+}
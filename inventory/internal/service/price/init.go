@@ -0,0 +1,29 @@
+package price
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) InitPriceHistory(ctx context.Context) error {
+	added, err := s.partPriceRepository.BackfillPrices(ctx)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to backfill part prices",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if added > 0 {
+		logger.Info(ctx,
+			"price history created for parts without it",
+			zap.Int64("parts", added),
+		)
+	}
+
+	return nil
+}
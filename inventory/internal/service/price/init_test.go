@@ -0,0 +1,18 @@
+package price
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+)
+
+func (s *ServiceSuite) TestInitPriceHistory() {
+	s.partPriceRepository.On("BackfillPrices", s.ctx).Return(int64(4), nil)
+
+	s.Require().NoError(s.service.InitPriceHistory(s.ctx))
+}
+
+func (s *ServiceSuite) TestInitPriceHistoryError() {
+	repoErr := gofakeit.Error()
+	s.partPriceRepository.On("BackfillPrices", s.ctx).Return(int64(0), repoErr)
+
+	s.Require().ErrorIs(s.service.InitPriceHistory(s.ctx), repoErr)
+}
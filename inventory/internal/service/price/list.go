@@ -0,0 +1,31 @@
+package price
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ListPartPrices(ctx context.Context, partUuid string) ([]model.PartPrice, error) {
+	prices, err := s.partPriceRepository.ListPrices(ctx, partUuid)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list part prices",
+			zap.String("uuid", partUuid),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	// Пустая история бывает только у неизвестной детали
+	if len(prices) == 0 {
+		if _, err := s.partRepository.GetPart(ctx, partUuid); err != nil {
+			return nil, err
+		}
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,35 @@
+package price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListPartPricesSuccess() {
+	partUuid := gofakeit.UUID()
+	prices := []model.PartPrice{
+		{PartUuid: partUuid, Price: 120, EffectiveFrom: time.Now().Add(time.Hour)},
+		{PartUuid: partUuid, Price: 100, EffectiveFrom: time.Now().Add(-time.Hour)},
+	}
+
+	s.partPriceRepository.On("ListPrices", s.ctx, partUuid).Return(prices, nil)
+
+	res, err := s.service.ListPartPrices(s.ctx, partUuid)
+	s.Require().NoError(err)
+	s.Require().Equal(prices, res)
+	s.partRepository.AssertNotCalled(s.T(), "GetPart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestListPartPricesPartNotFound() {
+	partUuid := gofakeit.UUID()
+
+	s.partPriceRepository.On("ListPrices", s.ctx, partUuid).Return([]model.PartPrice{}, nil)
+	s.partRepository.On("GetPart", s.ctx, partUuid).Return(model.Part{}, model.ErrPartNotFound)
+
+	_, err := s.service.ListPartPrices(s.ctx, partUuid)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
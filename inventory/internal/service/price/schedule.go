@@ -0,0 +1,60 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) SchedulePartPrice(ctx context.Context, price model.PartPrice) (model.PartPrice, error) {
+	if err := model.ValidatePartPrice(price); err != nil {
+		return model.PartPrice{}, err
+	}
+
+	now := time.Now()
+	// Прошлое не переписываем: цена на момент заказа должна оставаться прежней
+	if !price.EffectiveFrom.After(now) {
+		return model.PartPrice{}, fmt.Errorf("%w: effective_from must be in the future", model.ErrInvalidPartPrice)
+	}
+
+	if _, err := s.partRepository.GetPart(ctx, price.PartUuid); err != nil {
+		logger.Error(ctx,
+			"failed to get part",
+			zap.String("uuid", price.PartUuid),
+			zap.Error(err),
+		)
+		return model.PartPrice{}, err
+	}
+
+	price.CreatedAt = now
+
+	if err := s.partPriceRepository.AddPrice(ctx, price); err != nil {
+		logger.Error(ctx,
+			"failed to schedule part price",
+			zap.Any("price", price),
+			zap.Error(err),
+		)
+		return model.PartPrice{}, err
+	}
+
+	// Закэшированная деталь могла получить TTL дольше, чем до новой смены цены
+	if err := s.partCacheRepository.Invalidate(ctx, price.PartUuid); err != nil {
+		logger.Error(ctx,
+			"failed to invalidate parts cache",
+			zap.String("uuid", price.PartUuid),
+			zap.Error(err),
+		)
+	}
+
+	logger.Debug(ctx,
+		"part price scheduled",
+		zap.Any("price", price),
+	)
+
+	return price, nil
+}
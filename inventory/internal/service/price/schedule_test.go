@@ -0,0 +1,67 @@
+package price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestSchedulePartPriceSuccess() {
+	price := model.PartPrice{
+		PartUuid:      gofakeit.UUID(),
+		Price:         180,
+		EffectiveFrom: time.Now().Add(24 * time.Hour),
+	}
+
+	s.partRepository.On("GetPart", s.ctx, price.PartUuid).Return(model.Part{Uuid: price.PartUuid}, nil)
+	s.partPriceRepository.On("AddPrice", s.ctx, mock.MatchedBy(func(p model.PartPrice) bool {
+		return p.PartUuid == price.PartUuid && p.Price == price.Price &&
+			p.EffectiveFrom.Equal(price.EffectiveFrom) && !p.CreatedAt.IsZero()
+	})).Return(nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, price.PartUuid).Return(nil)
+
+	res, err := s.service.SchedulePartPrice(s.ctx, price)
+	s.Require().NoError(err)
+	s.Require().Equal(price.Price, res.Price)
+	s.Require().False(res.CreatedAt.IsZero())
+}
+
+func (s *ServiceSuite) TestSchedulePartPriceInvalid() {
+	partUuid := gofakeit.UUID()
+	cases := []model.PartPrice{
+		{PartUuid: partUuid, Price: -1, EffectiveFrom: time.Now().Add(time.Hour)},
+		{PartUuid: partUuid, Price: 10},
+		{PartUuid: partUuid, Price: 10, EffectiveFrom: time.Now().Add(-time.Hour)},
+	}
+
+	for _, price := range cases {
+		res, err := s.service.SchedulePartPrice(s.ctx, price)
+		s.Require().ErrorIs(err, model.ErrInvalidPartPrice)
+		s.Require().Empty(res)
+	}
+}
+
+func (s *ServiceSuite) TestSchedulePartPricePartNotFound() {
+	price := model.PartPrice{PartUuid: gofakeit.UUID(), Price: 10, EffectiveFrom: time.Now().Add(time.Hour)}
+
+	s.partRepository.On("GetPart", s.ctx, price.PartUuid).Return(model.Part{}, model.ErrPartNotFound)
+
+	_, err := s.service.SchedulePartPrice(s.ctx, price)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.partPriceRepository.AssertNotCalled(s.T(), "AddPrice", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestSchedulePartPriceRepositoryError() {
+	price := model.PartPrice{PartUuid: gofakeit.UUID(), Price: 10, EffectiveFrom: time.Now().Add(time.Hour)}
+	repoErr := gofakeit.Error()
+
+	s.partRepository.On("GetPart", s.ctx, price.PartUuid).Return(model.Part{Uuid: price.PartUuid}, nil)
+	s.partPriceRepository.On("AddPrice", s.ctx, mock.Anything).Return(repoErr)
+
+	_, err := s.service.SchedulePartPrice(s.ctx, price)
+	s.Require().ErrorIs(err, repoErr)
+	s.partCacheRepository.AssertNotCalled(s.T(), "Invalidate", mock.Anything, mock.Anything)
+}
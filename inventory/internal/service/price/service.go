@@ -0,0 +1,26 @@
+package price
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+)
+
+var _ def.PartPriceService = (*service)(nil)
+
+type service struct {
+	partPriceRepository repository.PartPriceRepository
+	partRepository      repository.PartRepository
+	partCacheRepository repository.PartCacheRepository
+}
+
+func NewService(
+	partPriceRepository repository.PartPriceRepository,
+	partRepository repository.PartRepository,
+	partCacheRepository repository.PartCacheRepository,
+) *service {
+	return &service{
+		partPriceRepository: partPriceRepository,
+		partRepository:      partRepository,
+		partCacheRepository: partCacheRepository,
+	}
+}
@@ -0,0 +1,46 @@
+package price
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	partPriceRepository *mocks.PartPriceRepository
+	partRepository      *mocks.PartRepository
+	partCacheRepository *mocks.PartCacheRepository
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+
+	s.ctx = context.Background()
+
+	s.partPriceRepository = mocks.NewPartPriceRepository(s.T())
+	s.partRepository = mocks.NewPartRepository(s.T())
+	s.partCacheRepository = mocks.NewPartCacheRepository(s.T())
+
+	s.service = NewService(
+		s.partPriceRepository,
+		s.partRepository,
+		s.partCacheRepository,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,103 @@
+package part_producer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	eventsV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/events/v1"
+)
+
+var _ def.PartProducerService = (*service)(nil)
+
+// События одной детали публикуются с ключом part_uuid,
+// чтобы попадать в одну партицию и читаться по порядку.
+type service struct {
+	partCreatedProducer kafka.Producer
+	partUpdatedProducer kafka.Producer
+	partDeletedProducer kafka.Producer
+}
+
+func NewService(partCreatedProducer, partUpdatedProducer, partDeletedProducer kafka.Producer) *service {
+	return &service{
+		partCreatedProducer: partCreatedProducer,
+		partUpdatedProducer: partUpdatedProducer,
+		partDeletedProducer: partDeletedProducer,
+	}
+}
+
+func (p *service) ProducePartCreated(ctx context.Context, event model.PartCreatedEvent) error {
+	msg := &eventsV1.PartCreated{
+		EventUuid: event.EventUUID,
+		PartUuid:  event.PartUUID,
+		Name:      event.Name,
+		Category:  converter.ToProtoCategory(event.Category).String(),
+		Price:     event.Price,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal PartCreated", zap.Error(err))
+		return err
+	}
+
+	err = p.partCreatedProducer.Send(ctx, []byte(event.PartUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish PartCreated", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *service) ProducePartUpdated(ctx context.Context, event model.PartUpdatedEvent) error {
+	msg := &eventsV1.PartUpdated{
+		EventUuid:     event.EventUUID,
+		PartUuid:      event.PartUUID,
+		Name:          event.Name,
+		Category:      converter.ToProtoCategory(event.Category).String(),
+		Price:         event.Price,
+		PreviousPrice: event.PreviousPrice,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal PartUpdated", zap.Error(err))
+		return err
+	}
+
+	err = p.partUpdatedProducer.Send(ctx, []byte(event.PartUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish PartUpdated", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *service) ProducePartDeleted(ctx context.Context, event model.PartDeletedEvent) error {
+	msg := &eventsV1.PartDeleted{
+		EventUuid: event.EventUUID,
+		PartUuid:  event.PartUUID,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal PartDeleted", zap.Error(err))
+		return err
+	}
+
+	err = p.partDeletedProducer.Send(ctx, []byte(event.PartUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish PartDeleted", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package stock_producer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	eventsV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/events/v1"
+)
+
+var _ def.StockProducerService = (*service)(nil)
+
+type service struct {
+	stockLevelChangedProducer kafka.Producer
+	stockLowProducer          kafka.Producer
+}
+
+func NewService(stockLevelChangedProducer, stockLowProducer kafka.Producer) *service {
+	return &service{
+		stockLevelChangedProducer: stockLevelChangedProducer,
+		stockLowProducer:          stockLowProducer,
+	}
+}
+
+func (p *service) ProduceStockLevelChanged(ctx context.Context, event model.StockLevelChangedEvent) error {
+	msg := &eventsV1.StockLevelChanged{
+		EventUuid:      event.EventUUID,
+		PartUuid:       event.PartUUID,
+		MovementUuid:   event.MovementUUID,
+		Delta:          event.Delta,
+		StockQuantity:  event.StockQuantity,
+		Reason:         converter.ToProtoStockMovementReason(event.Reason).String(),
+		ReferenceId:    event.ReferenceID,
+		WarehouseCode:  event.WarehouseCode,
+		WarehouseStock: event.WarehouseStock,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal StockLevelChanged", zap.Error(err))
+		return err
+	}
+
+	err = p.stockLevelChangedProducer.Send(ctx, []byte(event.PartUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish StockLevelChanged", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *service) ProduceStockLow(ctx context.Context, event model.StockLowEvent) error {
+	msg := &eventsV1.StockLow{
+		EventUuid:     event.EventUUID,
+		PartUuid:      event.PartUUID,
+		StockQuantity: event.StockQuantity,
+		Threshold:     event.Threshold,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal StockLow", zap.Error(err))
+		return err
+	}
+
+	err = p.stockLowProducer.Send(ctx, []byte(event.PartUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish StockLow", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
 )
@@ -9,4 +10,82 @@ import (
 type PartService interface {
 	GetPart(ctx context.Context, uuid string) (model.Part, error)
 	ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
+	// StreamParts передаёт в send детали по фильтру порциями по chunkSize, минуя кэш.
+	// Ошибка send (например, клиент отключился) прерывает выгрузку.
+	StreamParts(ctx context.Context, filter model.PartsFilter, chunkSize int, send func([]model.Part) error) error
+	GetPartFacets(ctx context.Context, filter model.PartsFilter, topTagsLimit int) (model.PartFacets, error)
+
+	// CreatePart добавляет деталь с нулевым остатком. Даты заполняются сервисом,
+	// UUID — если не задан вызывающим (например, при импорте каталога).
+	CreatePart(ctx context.Context, part model.Part) (model.Part, error)
+	// UpdatePart заменяет редактируемые поля детали. Остаток и дата создания не меняются.
+	UpdatePart(ctx context.Context, part model.Part) (model.Part, error)
+	DeletePart(ctx context.Context, uuid string) error
+}
+
+type StockService interface {
+	// AdjustStock меняет остаток детали на складе и записывает движение в журнал.
+	// Заполняет UUID движения, дату и остатки после изменения. Если склад не задан,
+	// выбирает его по списку приоритетов.
+	AdjustStock(ctx context.Context, movement model.StockMovement) (model.StockMovement, error)
+	ListStockMovements(ctx context.Context, filter model.StockMovementsFilter) ([]model.StockMovement, error)
+}
+
+type WarehouseService interface {
+	CreateWarehouse(ctx context.Context, warehouse model.Warehouse) (model.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]model.Warehouse, error)
+	// InitWarehouses создаёт склады из списка приоритетов и переносит на первый из них
+	// остаток деталей, у которых ещё нет разбивки по складам. Вызывается при старте.
+	InitWarehouses(ctx context.Context) error
+}
+
+type PartPriceService interface {
+	// SchedulePartPrice добавляет в историю цену, которая начнёт действовать в будущем.
+	SchedulePartPrice(ctx context.Context, price model.PartPrice) (model.PartPrice, error)
+	ListPartPrices(ctx context.Context, partUuid string) ([]model.PartPrice, error)
+	// GetPartPriceAt возвращает цену, действовавшую в момент at. История удалённых деталей сохраняется.
+	GetPartPriceAt(ctx context.Context, partUuid string, at time.Time) (model.PartPrice, error)
+	// InitPriceHistory добавляет в историю цены деталей, у которых её ещё нет. Вызывается при старте.
+	InitPriceHistory(ctx context.Context) error
+}
+
+type KitService interface {
+	// CreateKit сохраняет комплект. Все детали из состава должны существовать.
+	CreateKit(ctx context.Context, kit model.Kit) (model.Kit, error)
+	// GetKit и ListKits возвращают комплекты с рассчитанными ценой и доступным количеством.
+	GetKit(ctx context.Context, uuid string) (model.Kit, error)
+	// ListKits возвращает комплекты по списку UUID, пустой список — все комплекты.
+	ListKits(ctx context.Context, uuids []string) ([]model.Kit, error)
+	// UpdateKit заменяет название, описание, состав и ручную цену. Дата создания не меняется.
+	UpdateKit(ctx context.Context, kit model.Kit) (model.Kit, error)
+	DeleteKit(ctx context.Context, uuid string) error
+}
+
+type CatalogService interface {
+	// ImportParts создаёт или обновляет детали по uuid и возвращает результат по каждой строке.
+	// В режиме dryRun строки только проверяются, изменения не сохраняются.
+	ImportParts(ctx context.Context, rows []model.CatalogRow, dryRun bool) (model.ImportReport, error)
+	// ExportParts возвращает весь каталог, отсортированный по uuid.
+	ExportParts(ctx context.Context) ([]model.Part, error)
+}
+
+type CompatibilityService interface {
+	CreateRule(ctx context.Context, rule model.CompatibilityRule) (model.CompatibilityRule, error)
+	ListRules(ctx context.Context) ([]model.CompatibilityRule, error)
+	DeleteRule(ctx context.Context, uuid string) error
+
+	// ValidateConfiguration проверяет набор деталей по всем правилам и возвращает нарушения.
+	// Повтор UUID означает несколько единиц одной детали. Неизвестные детали — ErrPartNotFound.
+	ValidateConfiguration(ctx context.Context, partUuids []string) ([]model.RuleViolation, error)
+}
+
+type PartProducerService interface {
+	ProducePartCreated(ctx context.Context, event model.PartCreatedEvent) error
+	ProducePartUpdated(ctx context.Context, event model.PartUpdatedEvent) error
+	ProducePartDeleted(ctx context.Context, event model.PartDeletedEvent) error
+}
+
+type StockProducerService interface {
+	ProduceStockLevelChanged(ctx context.Context, event model.StockLevelChangedEvent) error
+	ProduceStockLow(ctx context.Context, event model.StockLowEvent) error
 }
@@ -0,0 +1,174 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) AdjustStock(ctx context.Context, movement model.StockMovement) (model.StockMovement, error) {
+	if err := validateMovement(movement); err != nil {
+		logger.Error(ctx,
+			"invalid stock movement",
+			zap.Any("movement", movement),
+			zap.Error(err),
+		)
+		return model.StockMovement{}, err
+	}
+
+	movement.Uuid = uuid.NewString()
+	movement.CreatedAt = time.Now()
+
+	result, err := s.adjustWarehouseStock(ctx, movement)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to adjust stock",
+			zap.Any("movement", movement),
+			zap.Error(err),
+		)
+		return model.StockMovement{}, err
+	}
+
+	// Остаток входит в закэшированные детали и списки
+	if err := s.partCacheRepository.Invalidate(ctx, result.PartUuid); err != nil {
+		logger.Error(ctx,
+			"failed to invalidate parts cache",
+			zap.String("part_uuid", result.PartUuid),
+			zap.Error(err),
+		)
+	}
+
+	s.produceStockEvents(ctx, result)
+
+	logger.Debug(ctx,
+		"stock adjusted successfully",
+		zap.Any("movement", result),
+	)
+
+	return result, nil
+}
+
+// adjustWarehouseStock проводит движение по заданному складу или по складам-кандидатам
+// в порядке приоритета. Остаток кандидата мог уменьшиться после чтения, поэтому при
+// нехватке пробуем следующий.
+func (s *service) adjustWarehouseStock(ctx context.Context, movement model.StockMovement) (model.StockMovement, error) {
+	if movement.WarehouseCode != "" {
+		if _, err := s.warehouseRepository.GetWarehouse(ctx, movement.WarehouseCode); err != nil {
+			return model.StockMovement{}, err
+		}
+		return s.stockRepository.AdjustStock(ctx, movement)
+	}
+
+	candidates, err := s.candidateWarehouses(ctx, movement)
+	if err != nil {
+		return model.StockMovement{}, err
+	}
+
+	for _, warehouseCode := range candidates {
+		movement.WarehouseCode = warehouseCode
+
+		result, err := s.stockRepository.AdjustStock(ctx, movement)
+		if errors.Is(err, model.ErrInsufficientStock) {
+			continue
+		}
+		return result, err
+	}
+
+	return model.StockMovement{}, model.ErrInsufficientStock
+}
+
+// candidateWarehouses возвращает склады, подходящие для движения без явного склада.
+// Приход идёт на первый склад из списка приоритетов. Для расхода подходят склады
+// с достаточным остатком: сначала из списка приоритетов, затем остальные по коду.
+func (s *service) candidateWarehouses(ctx context.Context, movement model.StockMovement) ([]string, error) {
+	if movement.Delta > 0 {
+		if len(s.warehousePriority) == 0 {
+			return nil, model.ErrWarehouseNotFound
+		}
+		return s.warehousePriority[:1], nil
+	}
+
+	stock, err := s.stockRepository.GetWarehouseStock(ctx, movement.PartUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	others := make([]string, 0, len(stock))
+	for warehouseCode := range stock {
+		if !slices.Contains(s.warehousePriority, warehouseCode) {
+			others = append(others, warehouseCode)
+		}
+	}
+	slices.Sort(others)
+
+	var candidates []string
+	for _, warehouseCode := range append(slices.Clone(s.warehousePriority), others...) {
+		if stock[warehouseCode] >= -movement.Delta {
+			candidates = append(candidates, warehouseCode)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, model.ErrInsufficientStock
+	}
+
+	return candidates, nil
+}
+
+// produceStockEvents публикует StockLevelChanged и, если остаток только что
+// опустился до порога, StockLow. Остаток уже изменён, поэтому ошибки публикации
+// не возвращаются клиенту — они логируются продюсером.
+func (s *service) produceStockEvents(ctx context.Context, movement model.StockMovement) {
+	_ = s.stockProducerService.ProduceStockLevelChanged(ctx, model.StockLevelChangedEvent{
+		EventUUID:      uuid.NewString(),
+		PartUUID:       movement.PartUuid,
+		MovementUUID:   movement.Uuid,
+		Delta:          movement.Delta,
+		StockQuantity:  movement.StockAfter,
+		Reason:         movement.Reason,
+		ReferenceID:    movement.ReferenceID,
+		WarehouseCode:  movement.WarehouseCode,
+		WarehouseStock: movement.WarehouseStockAfter,
+	})
+
+	stockBefore := movement.StockAfter - movement.Delta
+	if stockBefore > s.lowStockThreshold && movement.StockAfter <= s.lowStockThreshold {
+		_ = s.stockProducerService.ProduceStockLow(ctx, model.StockLowEvent{
+			EventUUID:     uuid.NewString(),
+			PartUUID:      movement.PartUuid,
+			StockQuantity: movement.StockAfter,
+			Threshold:     s.lowStockThreshold,
+		})
+	}
+}
+
+// validateMovement проверяет, что знак изменения соответствует причине:
+// приход и снятие резерва увеличивают остаток, резерв и продажа — уменьшают.
+func validateMovement(movement model.StockMovement) error {
+	if movement.Delta == 0 {
+		return model.ErrInvalidStockDelta
+	}
+
+	switch movement.Reason {
+	case model.StockMovementReasonRestock, model.StockMovementReasonRelease:
+		if movement.Delta < 0 {
+			return model.ErrInvalidStockDelta
+		}
+	case model.StockMovementReasonReservation, model.StockMovementReasonSale:
+		if movement.Delta > 0 {
+			return model.ErrInvalidStockDelta
+		}
+	case model.StockMovementReasonManualAdjustment:
+	default:
+		return model.ErrInvalidStockReason
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestAdjustStockSuccess() {
+	movement := model.StockMovement{
+		PartUuid:    gofakeit.UUID(),
+		Delta:       -3,
+		Reason:      model.StockMovementReasonReservation,
+		ReferenceID: gofakeit.UUID(),
+		Actor:       gofakeit.UUID(),
+	}
+
+	s.stockRepository.On("GetWarehouseStock", s.ctx, movement.PartUuid).Return(map[string]int64{"baikonur": 100}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.Uuid != "" && !m.CreatedAt.IsZero() && m.WarehouseCode == "baikonur" &&
+			m.PartUuid == movement.PartUuid && m.Delta == movement.Delta && m.Actor == movement.Actor
+	})).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 7
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, movement.PartUuid).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.MatchedBy(func(e model.StockLevelChangedEvent) bool {
+		return e.EventUUID != "" && e.MovementUUID != "" && e.PartUUID == movement.PartUuid &&
+			e.Delta == movement.Delta && e.StockQuantity == 7 && e.ReferenceID == movement.ReferenceID
+	})).Return(nil)
+
+	res, err := s.service.AdjustStock(s.ctx, movement)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.Uuid)
+	s.Require().Equal(int64(7), res.StockAfter)
+	s.Require().Equal(movement.ReferenceID, res.ReferenceID)
+}
+
+func (s *ServiceSuite) TestAdjustStockRejectsInvalidMovements() {
+	cases := []struct {
+		movement model.StockMovement
+		err      error
+	}{
+		{movement: model.StockMovement{Delta: 0, Reason: model.StockMovementReasonManualAdjustment}, err: model.ErrInvalidStockDelta},
+		{movement: model.StockMovement{Delta: -1, Reason: model.StockMovementReasonRestock}, err: model.ErrInvalidStockDelta},
+		{movement: model.StockMovement{Delta: -1, Reason: model.StockMovementReasonRelease}, err: model.ErrInvalidStockDelta},
+		{movement: model.StockMovement{Delta: 1, Reason: model.StockMovementReasonReservation}, err: model.ErrInvalidStockDelta},
+		{movement: model.StockMovement{Delta: 1, Reason: model.StockMovementReasonSale}, err: model.ErrInvalidStockDelta},
+		{movement: model.StockMovement{Delta: 1, Reason: model.StockMovementReasonUnspecified}, err: model.ErrInvalidStockReason},
+	}
+
+	for _, tc := range cases {
+		res, err := s.service.AdjustStock(s.ctx, tc.movement)
+		s.Require().ErrorIs(err, tc.err)
+		s.Require().Empty(res)
+	}
+}
+
+func (s *ServiceSuite) TestAdjustStockManualAdjustmentAllowsBothSigns() {
+	s.stockRepository.On("GetWarehouseStock", s.ctx, mock.Anything).Return(map[string]int64{"baikonur": 100}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.Anything).Return(model.StockMovement{}, nil).Twice()
+	s.partCacheRepository.On("Invalidate", s.ctx, mock.Anything).Return(nil).Twice()
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(nil).Twice()
+
+	_, err := s.service.AdjustStock(s.ctx, model.StockMovement{Delta: 4, Reason: model.StockMovementReasonManualAdjustment})
+	s.Require().NoError(err)
+
+	_, err = s.service.AdjustStock(s.ctx, model.StockMovement{Delta: -4, Reason: model.StockMovementReasonManualAdjustment})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestAdjustStockRepositoryError() {
+	s.stockRepository.On("GetWarehouseStock", s.ctx, mock.Anything).Return(map[string]int64{"baikonur": 100}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.Anything).Return(model.StockMovement{}, model.ErrInsufficientStock)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: gofakeit.UUID(),
+		Delta:    -100,
+		Reason:   model.StockMovementReasonSale,
+	})
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Empty(res)
+}
+
+func (s *ServiceSuite) TestAdjustStockProducesStockLowWhenThresholdCrossed() {
+	partUUID := gofakeit.UUID()
+
+	s.stockRepository.On("GetWarehouseStock", s.ctx, mock.Anything).Return(map[string]int64{"baikonur": 100}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.Anything).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = lowStockThreshold
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, mock.Anything).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(nil)
+	s.stockProducerService.On("ProduceStockLow", s.ctx, mock.MatchedBy(func(e model.StockLowEvent) bool {
+		return e.EventUUID != "" && e.PartUUID == partUUID &&
+			e.StockQuantity == lowStockThreshold && e.Threshold == lowStockThreshold
+	})).Return(nil)
+
+	_, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: partUUID,
+		Delta:    -2,
+		Reason:   model.StockMovementReasonSale,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestAdjustStockSkipsStockLowWhenAlreadyBelowThreshold() {
+	s.stockRepository.On("GetWarehouseStock", s.ctx, mock.Anything).Return(map[string]int64{"baikonur": 100}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.Anything).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 1
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, mock.Anything).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(nil)
+
+	_, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: gofakeit.UUID(),
+		Delta:    -2,
+		Reason:   model.StockMovementReasonSale,
+	})
+	s.Require().NoError(err)
+	s.stockProducerService.AssertNotCalled(s.T(), "ProduceStockLow", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAdjustStockIgnoresProducerError() {
+	s.stockRepository.On("AdjustStock", s.ctx, mock.Anything).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 20
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, mock.Anything).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(gofakeit.Error())
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: gofakeit.UUID(),
+		Delta:    10,
+		Reason:   model.StockMovementReasonRestock,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(20), res.StockAfter)
+}
+
+func (s *ServiceSuite) TestAdjustStockIgnoresCacheInvalidationError() {
+	s.stockRepository.On("AdjustStock", s.ctx, mock.Anything).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 20
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, mock.Anything).Return(gofakeit.Error())
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(nil)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: gofakeit.UUID(),
+		Delta:    10,
+		Reason:   model.StockMovementReasonRestock,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(20), res.StockAfter)
+}
@@ -0,0 +1,39 @@
+package stock
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const (
+	defaultMovementsLimit = 50
+	maxMovementsLimit     = 500
+)
+
+func (s *service) ListStockMovements(ctx context.Context, filter model.StockMovementsFilter) ([]model.StockMovement, error) {
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultMovementsLimit
+	case filter.Limit > maxMovementsLimit:
+		filter.Limit = maxMovementsLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	movements, err := s.stockRepository.ListStockMovements(ctx, filter)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list stock movements",
+			zap.Any("filter", filter),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return movements, nil
+}
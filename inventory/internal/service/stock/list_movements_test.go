@@ -0,0 +1,49 @@
+package stock
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListStockMovementsAppliesDefaultLimit() {
+	partUUID := gofakeit.UUID()
+	movements := []model.StockMovement{{Uuid: gofakeit.UUID(), PartUuid: partUUID}}
+
+	s.stockRepository.On("ListStockMovements", s.ctx, model.StockMovementsFilter{
+		PartUuid: partUUID,
+		Limit:    defaultMovementsLimit,
+	}).Return(movements, nil)
+
+	res, err := s.service.ListStockMovements(s.ctx, model.StockMovementsFilter{PartUuid: partUUID})
+	s.Require().NoError(err)
+	s.Require().Equal(movements, res)
+}
+
+func (s *ServiceSuite) TestListStockMovementsCapsLimit() {
+	partUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ListStockMovements", s.ctx, model.StockMovementsFilter{
+		PartUuid: partUUID,
+		Limit:    maxMovementsLimit,
+		Offset:   10,
+	}).Return([]model.StockMovement{}, nil)
+
+	_, err := s.service.ListStockMovements(s.ctx, model.StockMovementsFilter{
+		PartUuid: partUUID,
+		Limit:    maxMovementsLimit * 2,
+		Offset:   10,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestListStockMovementsRepositoryError() {
+	repoErr := gofakeit.Error()
+
+	s.stockRepository.On("ListStockMovements", s.ctx, model.StockMovementsFilter{Limit: defaultMovementsLimit}).
+		Return(nil, repoErr)
+
+	res, err := s.service.ListStockMovements(s.ctx, model.StockMovementsFilter{})
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Nil(res)
+}
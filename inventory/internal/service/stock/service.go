@@ -0,0 +1,38 @@
+package stock
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+)
+
+var _ def.StockService = (*service)(nil)
+
+type service struct {
+	stockRepository      repository.StockRepository
+	warehouseRepository  repository.WarehouseRepository
+	partCacheRepository  repository.PartCacheRepository
+	stockProducerService def.StockProducerService
+
+	// Порог, при пересечении которого сверху вниз публикуется StockLow
+	lowStockThreshold int64
+	// Коды складов в порядке выбора, когда склад в движении не задан
+	warehousePriority []string
+}
+
+func NewService(
+	stockRepository repository.StockRepository,
+	warehouseRepository repository.WarehouseRepository,
+	partCacheRepository repository.PartCacheRepository,
+	stockProducerService def.StockProducerService,
+	lowStockThreshold int64,
+	warehousePriority []string,
+) *service {
+	return &service{
+		stockRepository:      stockRepository,
+		warehouseRepository:  warehouseRepository,
+		partCacheRepository:  partCacheRepository,
+		stockProducerService: stockProducerService,
+		lowStockThreshold:    lowStockThreshold,
+		warehousePriority:    warehousePriority,
+	}
+}
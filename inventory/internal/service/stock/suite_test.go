@@ -0,0 +1,56 @@
+package stock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+	serviceMocks "github.com/nkolesnikov999/micro2-OK/inventory/internal/service/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const lowStockThreshold = 5
+
+var warehousePriority = []string{"baikonur", "plesetsk"}
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	stockRepository      *mocks.StockRepository
+	warehouseRepository  *mocks.WarehouseRepository
+	partCacheRepository  *mocks.PartCacheRepository
+	stockProducerService *serviceMocks.StockProducerService
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+
+	s.ctx = context.Background()
+
+	s.stockRepository = mocks.NewStockRepository(s.T())
+	s.warehouseRepository = mocks.NewWarehouseRepository(s.T())
+	s.partCacheRepository = mocks.NewPartCacheRepository(s.T())
+	s.stockProducerService = serviceMocks.NewStockProducerService(s.T())
+
+	s.service = NewService(
+		s.stockRepository,
+		s.warehouseRepository,
+		s.partCacheRepository,
+		s.stockProducerService,
+		lowStockThreshold,
+		warehousePriority,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,133 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestAdjustStockRestockGoesToFirstPriorityWarehouse() {
+	s.stockRepository.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.WarehouseCode == "baikonur"
+	})).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 10
+		m.WarehouseStockAfter = 10
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, mock.Anything).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.MatchedBy(func(e model.StockLevelChangedEvent) bool {
+		return e.WarehouseCode == "baikonur" && e.WarehouseStock == 10
+	})).Return(nil)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: gofakeit.UUID(),
+		Delta:    10,
+		Reason:   model.StockMovementReasonRestock,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("baikonur", res.WarehouseCode)
+}
+
+func (s *ServiceSuite) TestAdjustStockPicksFirstWarehouseWithEnoughStock() {
+	partUUID := gofakeit.UUID()
+	s.stockRepository.On("GetWarehouseStock", s.ctx, partUUID).
+		Return(map[string]int64{"baikonur": 1, "plesetsk": 2, "kourou": 9}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.WarehouseCode == "kourou"
+	})).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 7
+		m.WarehouseStockAfter = 6
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, partUUID).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(nil)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: partUUID,
+		Delta:    -3,
+		Reason:   model.StockMovementReasonReservation,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("kourou", res.WarehouseCode)
+	s.Require().Equal(int64(6), res.WarehouseStockAfter)
+}
+
+func (s *ServiceSuite) TestAdjustStockTriesNextWarehouseOnRace() {
+	partUUID := gofakeit.UUID()
+	s.stockRepository.On("GetWarehouseStock", s.ctx, partUUID).
+		Return(map[string]int64{"baikonur": 5, "plesetsk": 5}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.WarehouseCode == "baikonur"
+	})).Return(model.StockMovement{}, model.ErrInsufficientStock).Once()
+	s.stockRepository.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.WarehouseCode == "plesetsk"
+	})).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 6
+		m.WarehouseStockAfter = 1
+		return m
+	}, nil).Once()
+	s.partCacheRepository.On("Invalidate", s.ctx, partUUID).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(nil)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: partUUID,
+		Delta:    -4,
+		Reason:   model.StockMovementReasonSale,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("plesetsk", res.WarehouseCode)
+}
+
+func (s *ServiceSuite) TestAdjustStockNoWarehouseWithEnoughStock() {
+	partUUID := gofakeit.UUID()
+	s.stockRepository.On("GetWarehouseStock", s.ctx, partUUID).
+		Return(map[string]int64{"baikonur": 2, "plesetsk": 2}, nil)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid: partUUID,
+		Delta:    -3,
+		Reason:   model.StockMovementReasonSale,
+	})
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Empty(res)
+	s.stockRepository.AssertNotCalled(s.T(), "AdjustStock", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAdjustStockExplicitWarehouse() {
+	s.warehouseRepository.On("GetWarehouse", s.ctx, "plesetsk").Return(model.Warehouse{Code: "plesetsk"}, nil)
+	s.stockRepository.On("AdjustStock", s.ctx, mock.MatchedBy(func(m model.StockMovement) bool {
+		return m.WarehouseCode == "plesetsk"
+	})).Return(func(_ context.Context, m model.StockMovement) model.StockMovement {
+		m.StockAfter = 20
+		m.WarehouseStockAfter = 8
+		return m
+	}, nil)
+	s.partCacheRepository.On("Invalidate", s.ctx, mock.Anything).Return(nil)
+	s.stockProducerService.On("ProduceStockLevelChanged", s.ctx, mock.Anything).Return(nil)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid:      gofakeit.UUID(),
+		Delta:         -2,
+		Reason:        model.StockMovementReasonSale,
+		WarehouseCode: "plesetsk",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(8), res.WarehouseStockAfter)
+	s.stockRepository.AssertNotCalled(s.T(), "GetWarehouseStock", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAdjustStockUnknownWarehouse() {
+	s.warehouseRepository.On("GetWarehouse", s.ctx, "missing").Return(model.Warehouse{}, model.ErrWarehouseNotFound)
+
+	res, err := s.service.AdjustStock(s.ctx, model.StockMovement{
+		PartUuid:      gofakeit.UUID(),
+		Delta:         5,
+		Reason:        model.StockMovementReasonRestock,
+		WarehouseCode: "missing",
+	})
+	s.Require().ErrorIs(err, model.ErrWarehouseNotFound)
+	s.Require().Empty(res)
+}
@@ -0,0 +1,40 @@
+package warehouse
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) CreateWarehouse(ctx context.Context, warehouse model.Warehouse) (model.Warehouse, error) {
+	if err := model.ValidateWarehouse(warehouse); err != nil {
+		logger.Error(ctx,
+			"invalid warehouse",
+			zap.Any("warehouse", warehouse),
+			zap.Error(err),
+		)
+		return model.Warehouse{}, err
+	}
+
+	warehouse.CreatedAt = time.Now()
+
+	if err := s.warehouseRepository.CreateWarehouse(ctx, warehouse); err != nil {
+		logger.Error(ctx,
+			"failed to create warehouse",
+			zap.Any("warehouse", warehouse),
+			zap.Error(err),
+		)
+		return model.Warehouse{}, err
+	}
+
+	logger.Debug(ctx,
+		"warehouse created successfully",
+		zap.Any("warehouse", warehouse),
+	)
+
+	return warehouse, nil
+}
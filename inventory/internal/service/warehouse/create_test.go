@@ -0,0 +1,44 @@
+package warehouse
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateWarehouseSuccess() {
+	warehouse := model.Warehouse{Code: "vostochny", Name: "Восточный", Location: gofakeit.City()}
+
+	s.warehouseRepository.On("CreateWarehouse", s.ctx, mock.MatchedBy(func(w model.Warehouse) bool {
+		return w.Code == warehouse.Code && !w.CreatedAt.IsZero()
+	})).Return(nil)
+
+	res, err := s.service.CreateWarehouse(s.ctx, warehouse)
+	s.Require().NoError(err)
+	s.Require().Equal(warehouse.Code, res.Code)
+	s.Require().False(res.CreatedAt.IsZero())
+}
+
+func (s *ServiceSuite) TestCreateWarehouseRejectsInvalid() {
+	warehouses := []model.Warehouse{
+		{Code: "", Name: "no code"},
+		{Code: "Bad.Code", Name: "dot in code"},
+		{Code: "$main", Name: "dollar in code"},
+		{Code: "main", Name: "  "},
+	}
+
+	for _, warehouse := range warehouses {
+		res, err := s.service.CreateWarehouse(s.ctx, warehouse)
+		s.Require().ErrorIs(err, model.ErrInvalidWarehouse)
+		s.Require().Empty(res)
+	}
+}
+
+func (s *ServiceSuite) TestCreateWarehouseAlreadyExists() {
+	s.warehouseRepository.On("CreateWarehouse", s.ctx, mock.Anything).Return(model.ErrWarehouseAlreadyExists)
+
+	res, err := s.service.CreateWarehouse(s.ctx, model.Warehouse{Code: "baikonur", Name: "Байконур"})
+	s.Require().ErrorIs(err, model.ErrWarehouseAlreadyExists)
+	s.Require().Empty(res)
+}
@@ -0,0 +1,58 @@
+package warehouse
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) InitWarehouses(ctx context.Context) error {
+	if len(s.warehousePriority) == 0 {
+		return fmt.Errorf("%w: warehouse priority list is empty", model.ErrInvalidWarehouse)
+	}
+
+	for _, code := range s.warehousePriority {
+		if !model.ValidWarehouseCode(code) {
+			return fmt.Errorf("%w: invalid code %q in warehouse priority list", model.ErrInvalidWarehouse, code)
+		}
+	}
+
+	// Склады из списка приоритетов создаются с кодом вместо названия,
+	// название можно поменять позже; существующие склады не трогаем
+	now := time.Now()
+	for _, code := range s.warehousePriority {
+		if err := s.warehouseRepository.EnsureWarehouse(ctx, model.Warehouse{Code: code, Name: code, CreatedAt: now}); err != nil {
+			logger.Error(ctx,
+				"failed to ensure warehouse",
+				zap.String("code", code),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	allocated, err := s.stockRepository.AllocateUnassignedStock(ctx, s.warehousePriority[0])
+	if err != nil {
+		logger.Error(ctx,
+			"failed to allocate unassigned stock",
+			zap.String("warehouse", s.warehousePriority[0]),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if allocated > 0 {
+		logger.Info(ctx,
+			"unassigned stock allocated to default warehouse",
+			zap.String("warehouse", s.warehousePriority[0]),
+			zap.Int64("parts", allocated),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package warehouse
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestInitWarehousesEnsuresAndAllocates() {
+	for _, code := range warehousePriority {
+		s.warehouseRepository.On("EnsureWarehouse", s.ctx, mock.MatchedBy(func(w model.Warehouse) bool {
+			return w.Code == code && w.Name == code
+		})).Return(nil).Once()
+	}
+	s.stockRepository.On("AllocateUnassignedStock", s.ctx, "baikonur").Return(int64(3), nil)
+
+	err := s.service.InitWarehouses(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestInitWarehousesRejectsInvalidPriority() {
+	for _, priority := range [][]string{nil, {"baikonur", "bad.code"}} {
+		err := NewService(s.warehouseRepository, s.stockRepository, priority).InitWarehouses(s.ctx)
+		s.Require().ErrorIs(err, model.ErrInvalidWarehouse)
+	}
+}
+
+func (s *ServiceSuite) TestInitWarehousesRepositoryError() {
+	repoErr := gofakeit.Error()
+	s.warehouseRepository.On("EnsureWarehouse", s.ctx, mock.Anything).Return(repoErr).Once()
+
+	err := s.service.InitWarehouses(s.ctx)
+	s.Require().ErrorIs(err, repoErr)
+}
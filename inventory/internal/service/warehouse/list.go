@@ -0,0 +1,23 @@
+package warehouse
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) ListWarehouses(ctx context.Context) ([]model.Warehouse, error) {
+	warehouses, err := s.warehouseRepository.ListWarehouses(ctx)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list warehouses",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return warehouses, nil
+}
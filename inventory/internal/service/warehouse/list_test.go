@@ -0,0 +1,25 @@
+package warehouse
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListWarehousesSuccess() {
+	warehouses := []model.Warehouse{{Code: "baikonur"}, {Code: "plesetsk"}}
+	s.warehouseRepository.On("ListWarehouses", s.ctx).Return(warehouses, nil)
+
+	res, err := s.service.ListWarehouses(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(warehouses, res)
+}
+
+func (s *ServiceSuite) TestListWarehousesRepositoryError() {
+	repoErr := gofakeit.Error()
+	s.warehouseRepository.On("ListWarehouses", s.ctx).Return(nil, repoErr)
+
+	res, err := s.service.ListWarehouses(s.ctx)
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Nil(res)
+}
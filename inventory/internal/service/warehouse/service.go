@@ -0,0 +1,28 @@
+package warehouse
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/inventory/internal/service"
+)
+
+var _ def.WarehouseService = (*service)(nil)
+
+type service struct {
+	warehouseRepository repository.WarehouseRepository
+	stockRepository     repository.StockRepository
+
+	// Коды складов в порядке приоритета, первый — склад по умолчанию
+	warehousePriority []string
+}
+
+func NewService(
+	warehouseRepository repository.WarehouseRepository,
+	stockRepository repository.StockRepository,
+	warehousePriority []string,
+) *service {
+	return &service{
+		warehouseRepository: warehouseRepository,
+		stockRepository:     stockRepository,
+		warehousePriority:   warehousePriority,
+	}
+}
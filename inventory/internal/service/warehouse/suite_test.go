@@ -0,0 +1,46 @@
+package warehouse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+var warehousePriority = []string{"baikonur", "plesetsk"}
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	warehouseRepository *mocks.WarehouseRepository
+	stockRepository     *mocks.StockRepository
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+
+	s.ctx = context.Background()
+
+	s.warehouseRepository = mocks.NewWarehouseRepository(s.T())
+	s.stockRepository = mocks.NewStockRepository(s.T())
+
+	s.service = NewService(
+		s.warehouseRepository,
+		s.stockRepository,
+		warehousePriority,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
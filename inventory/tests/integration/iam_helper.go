@@ -14,10 +14,16 @@ import (
 	userV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/user/v1"
 )
 
+// testUserPassword удовлетворяет парольной политике IAM
+const testUserPassword = "TestPassword-2024"
+
 // CreateTestSession создает тестового пользователя и сессию через IAM сервис
 func (env *TestEnvironment) CreateTestSession(ctx context.Context) (string, error) {
-	// Генерируем уникальный логин/емейл, чтобы избежать конфликта "user already exists"
-	login := gofakeit.Email()
+	// Генерируем уникальные логин и email, чтобы избежать конфликта "user already exists".
+	// Логин не может содержать @, вход по нему или по email равнозначен
+	suffix := gofakeit.UUID()
+	login := "test-user-" + suffix
+	email := "test-user-" + suffix + "@example.com"
 
 	// Подключаемся к IAM сервису
 	iamConn, err := grpc.DialContext(
@@ -39,9 +45,9 @@ func (env *TestEnvironment) CreateTestSession(ctx context.Context) (string, erro
 		Info: &userV1.UserRegistrationInfo{
 			Info: &commonV1.UserInfo{
 				Login: login,
-				Email: login,
+				Email: email,
 			},
-			Password: "testpassword123",
+			Password: testUserPassword,
 		},
 	})
 	if err != nil {
@@ -51,7 +57,7 @@ func (env *TestEnvironment) CreateTestSession(ctx context.Context) (string, erro
 	// Создаем сессию для пользователя
 	loginResp, err := authClient.Login(ctx, &authV1.LoginRequest{
 		Login:    login,
-		Password: "testpassword123",
+		Password: testUserPassword,
 	})
 	if err != nil {
 		return "", err
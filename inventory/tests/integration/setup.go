@@ -14,6 +14,7 @@ import (
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/app"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/kafka"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/mongo"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/network"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/path"
@@ -62,6 +63,9 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 	// Получаем переменные окружения для Redis (с дефолтным значением)
 	redisImageName := getEnvWithDefault(ctx, testcontainers.RedisImageNameKey, "redis:7-alpine")
 
+	// Получаем переменные окружения для Kafka (с дефолтным значением)
+	kafkaImageName := getEnvWithDefault(ctx, testcontainers.KafkaImageNameKey, "confluentinc/cp-kafka:7.9.0")
+
 	// Получаем порт gRPC для waitStrategy
 	grpcPort := getEnvWithLogging(ctx, grpcPortKey)
 
@@ -114,6 +118,24 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 	}
 	logger.Info(ctx, "✅ Контейнер Redis успешно запущен")
 
+	// Шаг 2.3: Запускаем контейнер с Kafka — inventory публикует в неё события каталога и остатков
+	generatedKafka, err := kafka.NewContainer(ctx,
+		kafka.WithNetworkName(generatedNetwork.Name()),
+		kafka.WithContainerName(testcontainers.KafkaContainerName),
+		kafka.WithImageName(kafkaImageName),
+		kafka.WithLogger(logger.Logger()),
+	)
+	if err != nil {
+		cleanupTestEnvironment(ctx, &TestEnvironment{
+			Network:  generatedNetwork,
+			Mongo:    generatedMongo,
+			Postgres: generatedPostgres,
+			Redis:    generatedRedis,
+		})
+		logger.Fatal(ctx, "не удалось запустить контейнер Kafka", zap.Error(err))
+	}
+	logger.Info(ctx, "✅ Контейнер Kafka успешно запущен")
+
 	// Шаг 3: Запускаем контейнер с IAM приложением
 	projectRoot := path.GetProjectRoot()
 
@@ -139,7 +161,24 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		"GRPC_HOST": "0.0.0.0",
 		"GRPC_PORT": iamGRPCPort,
 		// Session настройки
-		"SESSION_TTL": "24h",
+		"SESSION_TTL":              "24h",
+		"SESSION_SLIDING":          "false",
+		"SESSION_REFRESH_INTERVAL": "1m",
+		"SESSION_MAX_LIFETIME":     "720h",
+		// Парольная политика
+		"PASSWORD_MIN_LENGTH":      "10",
+		"PASSWORD_MAX_LENGTH":      "72",
+		"PASSWORD_REQUIRE_UPPER":   "true",
+		"PASSWORD_REQUIRE_LOWER":   "true",
+		"PASSWORD_REQUIRE_DIGIT":   "true",
+		"PASSWORD_REQUIRE_SPECIAL": "false",
+		"PASSWORD_DENYLIST_PATH":   "./iam/assets/breached_passwords.txt",
+		// Защита входа от подбора пароля
+		"LOGIN_THROTTLE_MAX_LOGIN_FAILURES": "5",
+		"LOGIN_THROTTLE_MAX_IP_FAILURES":    "50",
+		"LOGIN_THROTTLE_FAILURE_WINDOW":     "15m",
+		"LOGIN_THROTTLE_BASE_LOCKOUT":       "30s",
+		"LOGIN_THROTTLE_MAX_LOCKOUT":        "1h",
 		// Logger настройки
 		"LOGGER_LEVEL":   "debug",
 		"LOGGER_AS_JSON": "true",
@@ -164,6 +203,7 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 			Mongo:    generatedMongo,
 			Postgres: generatedPostgres,
 			Redis:    generatedRedis,
+			Kafka:    generatedKafka,
 		})
 		logger.Fatal(ctx, "не удалось запустить контейнер IAM", zap.Error(err))
 	}
@@ -176,6 +216,16 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		// IAM gRPC настройки - используем имя контейнера для внутренней сети
 		"IAM_GRPC_HOST": iamAppName,
 		"IAM_GRPC_PORT": iamGRPCPort,
+		// Kafka настройки - брокер доступен по имени контейнера внутри сети
+		testcontainers.KafkaBrokersKey: generatedKafka.Config().Brokers(),
+		// Redis настройки - кэш деталей, общий контейнер с IAM (ключи с разными префиксами)
+		"REDIS_HOST":               generatedRedis.Config().ContainerName,
+		"REDIS_PORT":               testcontainers.RedisPort,
+		"REDIS_CONNECTION_TIMEOUT": "5s",
+		"REDIS_MAX_IDLE":           "10",
+		"REDIS_IDLE_TIMEOUT":       "5m",
+		"REDIS_PART_CACHE_TTL":     "1m",
+		"REDIS_LIST_CACHE_TTL":     "30s",
 		// Logger настройки для inventory
 		"LOGGER_LEVEL":   "debug",
 		"LOGGER_AS_JSON": "true",
@@ -201,6 +251,7 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 			Mongo:    generatedMongo,
 			Postgres: generatedPostgres,
 			Redis:    generatedRedis,
+			Kafka:    generatedKafka,
 			IAM:      iamContainer,
 		})
 		logger.Fatal(ctx, "не удалось запустить контейнер приложения", zap.Error(err))
@@ -215,6 +266,7 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		IAM:      iamContainer,
 		Postgres: generatedPostgres,
 		Redis:    generatedRedis,
+		Kafka:    generatedKafka,
 	}
 }
 
@@ -62,6 +62,14 @@ func cleanupTestEnvironment(ctx context.Context, env *TestEnvironment) {
 		}
 	}
 
+	if env.Kafka != nil {
+		if err := env.Kafka.Terminate(ctx); err != nil {
+			logger.Error(ctx, "не удалось остановить контейнер Kafka", zap.Error(err))
+		} else {
+			logger.Info(ctx, "🛑 Контейнер Kafka остановлен")
+		}
+	}
+
 	if env.Network != nil {
 		if err := env.Network.Remove(ctx); err != nil {
 			logger.Error(ctx, "не удалось удалить сеть", zap.Error(err))
@@ -12,6 +12,7 @@ import (
 
 	repoModel "github.com/nkolesnikov999/micro2-OK/inventory/internal/repository/model"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/app"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/kafka"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/mongo"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/network"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/testcontainers/postgres"
@@ -28,6 +29,7 @@ type TestEnvironment struct {
 	IAM      *app.Container
 	Postgres *postgres.Container
 	Redis    *redis.Container
+	Kafka    *kafka.Container
 }
 
 // ... existing code ...
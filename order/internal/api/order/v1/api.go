@@ -1,13 +1,37 @@
 package v1
 
 import (
+	"context"
+
+	"github.com/google/uuid"
+
 	"github.com/nkolesnikov999/micro2-OK/order/internal/service"
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
 )
 
 type orderHandler struct {
-	service service.OrderService
+	service           service.OrderService
+	instrumentService service.PaymentInstrumentService
 }
 
-func NewHandler(service service.OrderService) *orderHandler {
-	return &orderHandler{service: service}
+func NewHandler(service service.OrderService, instrumentService service.PaymentInstrumentService) *orderHandler {
+	return &orderHandler{
+		service:           service,
+		instrumentService: instrumentService,
+	}
+}
+
+// userFromContext возвращает UUID пользователя, которого auth middleware положил в контекст.
+// Владелец заказов и инструментов — вызывающий, а не пользователь из тела запроса
+func userFromContext(ctx context.Context) (uuid.UUID, bool) {
+	user, ok := grpcAuth.GetUserFromContext(ctx)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(user.GetUuid())
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return userUUID, true
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/nkolesnikov999/micro2-OK/order/internal/converter"
 	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
 	orderV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/openapi/order/v1"
 )
@@ -14,17 +15,35 @@ func (h *orderHandler) CreateOrder(ctx context.Context, req *orderV1.CreateOrder
 		return &orderV1.InternalServerError{Code: http.StatusInternalServerError, Message: "internal server error"}, nil
 	}
 
-	if len(req.PartUuids) == 0 {
-		return &orderV1.BadRequestError{Code: http.StatusBadRequest, Message: "part_uuids must not be empty"}, nil
+	if len(req.PartUuids) == 0 && len(req.KitUuids) == 0 {
+		return &orderV1.BadRequestError{Code: http.StatusBadRequest, Message: "part_uuids must not be empty without kit_uuids"}, nil
 	}
 
-	order, err := h.service.CreateOrder(ctx, req.UserUUID, req.PartUuids)
+	// Заказ всегда создаётся от имени пользователя сессии: user_uuid из тела лишь сверяется
+	userUUID, ok := userFromContext(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{Code: http.StatusUnauthorized, Message: "Authentication required"}, nil
+	}
+	if requested, set := req.UserUUID.Get(); set && requested != userUUID {
+		return &orderV1.ForbiddenError{Code: http.StatusForbidden, Message: "user_uuid does not match the authenticated user"}, nil
+	}
+
+	order, err := h.service.CreateOrder(ctx, userUUID, req.PartUuids, req.KitUuids)
 	if err != nil {
+		var invalid *model.ConfigurationInvalidError
 		switch {
+		case errors.As(err, &invalid):
+			return &orderV1.ValidationError{
+				Code:       http.StatusUnprocessableEntity,
+				Message:    "parts configuration violates compatibility rules",
+				Violations: converter.ToAPIRuleViolations(invalid.Violations),
+			}, nil
 		case errors.Is(err, model.ErrEmptyPartUUIDs):
 			return &orderV1.BadRequestError{Code: http.StatusBadRequest, Message: "invalid request"}, nil
 		case errors.Is(err, model.ErrPartsNotFound):
 			return &orderV1.NotFoundError{Code: http.StatusNotFound, Message: "parts not found"}, nil
+		case errors.Is(err, model.ErrKitsNotFound):
+			return &orderV1.NotFoundError{Code: http.StatusNotFound, Message: "kits not found"}, nil
 		case errors.Is(err, model.ErrInventoryUnavailable):
 			return &orderV1.ServiceUnavailableError{Code: http.StatusServiceUnavailable, Message: "inventory service unavailable"}, nil
 		default:
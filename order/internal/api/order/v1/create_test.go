@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -12,11 +13,11 @@ import (
 
 func (s *APISuite) TestCreateOrderSuccess() {
 	var (
-		userUUID  = uuid.MustParse(gofakeit.UUID())
+		userUUID  = s.userUUID
 		partUUID1 = uuid.MustParse(gofakeit.UUID())
 		partUUID2 = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID1, partUUID2},
 		}
 		expectedOrder = model.Order{
@@ -28,7 +29,7 @@ func (s *APISuite) TestCreateOrderSuccess() {
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID1, partUUID2}).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID1, partUUID2}, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -42,10 +43,10 @@ func (s *APISuite) TestCreateOrderSuccess() {
 
 func (s *APISuite) TestCreateOrderWithSinglePart() {
 	var (
-		userUUID = uuid.MustParse(gofakeit.UUID())
+		userUUID = s.userUUID
 		partUUID = uuid.MustParse(gofakeit.UUID())
 		req      = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID},
 		}
 		expectedOrder = model.Order{
@@ -57,7 +58,7 @@ func (s *APISuite) TestCreateOrderWithSinglePart() {
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -71,7 +72,7 @@ func (s *APISuite) TestCreateOrderWithSinglePart() {
 
 func (s *APISuite) TestCreateOrderWithManyParts() {
 	var (
-		userUUID  = uuid.MustParse(gofakeit.UUID())
+		userUUID  = s.userUUID
 		numParts  = gofakeit.IntRange(5, 10)
 		partUUIDs = make([]uuid.UUID, numParts)
 	)
@@ -81,7 +82,7 @@ func (s *APISuite) TestCreateOrderWithManyParts() {
 	}
 
 	req := &orderV1.CreateOrderRequest{
-		UserUUID:  userUUID,
+		UserUUID:  orderV1.NewOptUUID(userUUID),
 		PartUuids: partUUIDs,
 	}
 
@@ -93,7 +94,7 @@ func (s *APISuite) TestCreateOrderWithManyParts() {
 		Status:     "PENDING",
 	}
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, partUUIDs).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, partUUIDs, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -118,9 +119,9 @@ func (s *APISuite) TestCreateOrderNilRequest() {
 
 func (s *APISuite) TestCreateOrderEmptyPartUUIDs() {
 	var (
-		userUUID = uuid.MustParse(gofakeit.UUID())
+		userUUID = s.userUUID
 		req      = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{}, // Empty part UUIDs
 		}
 	)
@@ -137,15 +138,15 @@ func (s *APISuite) TestCreateOrderEmptyPartUUIDs() {
 
 func (s *APISuite) TestCreateOrderServiceEmptyPartUUIDs() {
 	var (
-		userUUID = uuid.MustParse(gofakeit.UUID())
+		userUUID = s.userUUID
 		partUUID = uuid.MustParse(gofakeit.UUID())
 		req      = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID},
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}).Return(model.Order{}, model.ErrEmptyPartUUIDs)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}, []uuid.UUID(nil)).Return(model.Order{}, model.ErrEmptyPartUUIDs)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -159,16 +160,16 @@ func (s *APISuite) TestCreateOrderServiceEmptyPartUUIDs() {
 
 func (s *APISuite) TestCreateOrderPartsNotFound() {
 	var (
-		userUUID  = uuid.MustParse(gofakeit.UUID())
+		userUUID  = s.userUUID
 		partUUID1 = uuid.MustParse(gofakeit.UUID())
 		partUUID2 = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID1, partUUID2},
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID1, partUUID2}).Return(model.Order{}, model.ErrPartsNotFound)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID1, partUUID2}, []uuid.UUID(nil)).Return(model.Order{}, model.ErrPartsNotFound)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -182,16 +183,16 @@ func (s *APISuite) TestCreateOrderPartsNotFound() {
 
 func (s *APISuite) TestCreateOrderInventoryUnavailable() {
 	var (
-		userUUID  = uuid.MustParse(gofakeit.UUID())
+		userUUID  = s.userUUID
 		partUUID1 = uuid.MustParse(gofakeit.UUID())
 		partUUID2 = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID1, partUUID2},
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID1, partUUID2}).Return(model.Order{}, model.ErrInventoryUnavailable)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID1, partUUID2}, []uuid.UUID(nil)).Return(model.Order{}, model.ErrInventoryUnavailable)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -205,16 +206,16 @@ func (s *APISuite) TestCreateOrderInventoryUnavailable() {
 
 func (s *APISuite) TestCreateOrderServiceError() {
 	var (
-		userUUID   = uuid.MustParse(gofakeit.UUID())
+		userUUID   = s.userUUID
 		partUUID   = uuid.MustParse(gofakeit.UUID())
 		serviceErr = gofakeit.Error()
 		req        = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID},
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}).Return(model.Order{}, serviceErr)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}, []uuid.UUID(nil)).Return(model.Order{}, serviceErr)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -228,10 +229,10 @@ func (s *APISuite) TestCreateOrderServiceError() {
 
 func (s *APISuite) TestCreateOrderWithZeroPrice() {
 	var (
-		userUUID = uuid.MustParse(gofakeit.UUID())
+		userUUID = s.userUUID
 		partUUID = uuid.MustParse(gofakeit.UUID())
 		req      = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID},
 		}
 		expectedOrder = model.Order{
@@ -243,7 +244,7 @@ func (s *APISuite) TestCreateOrderWithZeroPrice() {
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -257,10 +258,10 @@ func (s *APISuite) TestCreateOrderWithZeroPrice() {
 
 func (s *APISuite) TestCreateOrderWithNegativePrice() {
 	var (
-		userUUID = uuid.MustParse(gofakeit.UUID())
+		userUUID = s.userUUID
 		partUUID = uuid.MustParse(gofakeit.UUID())
 		req      = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID},
 		}
 		expectedOrder = model.Order{
@@ -272,7 +273,7 @@ func (s *APISuite) TestCreateOrderWithNegativePrice() {
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -286,10 +287,10 @@ func (s *APISuite) TestCreateOrderWithNegativePrice() {
 
 func (s *APISuite) TestCreateOrderWithHighPrice() {
 	var (
-		userUUID = uuid.MustParse(gofakeit.UUID())
+		userUUID = s.userUUID
 		partUUID = uuid.MustParse(gofakeit.UUID())
 		req      = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID},
 		}
 		expectedOrder = model.Order{
@@ -301,7 +302,7 @@ func (s *APISuite) TestCreateOrderWithHighPrice() {
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID}, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -315,9 +316,9 @@ func (s *APISuite) TestCreateOrderWithHighPrice() {
 
 func (s *APISuite) TestCreateOrderWithSameUserAndPartUUIDs() {
 	var (
-		sharedUUID = uuid.MustParse(gofakeit.UUID())
+		sharedUUID = s.userUUID
 		req        = &orderV1.CreateOrderRequest{
-			UserUUID:  sharedUUID,
+			UserUUID:  orderV1.NewOptUUID(sharedUUID),
 			PartUuids: []uuid.UUID{sharedUUID}, // Same UUID for user and part
 		}
 		expectedOrder = model.Order{
@@ -329,7 +330,7 @@ func (s *APISuite) TestCreateOrderWithSameUserAndPartUUIDs() {
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, sharedUUID, []uuid.UUID{sharedUUID}).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, sharedUUID, []uuid.UUID{sharedUUID}, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -343,10 +344,10 @@ func (s *APISuite) TestCreateOrderWithSameUserAndPartUUIDs() {
 
 func (s *APISuite) TestCreateOrderWithDuplicatePartUUIDs() {
 	var (
-		userUUID = uuid.MustParse(gofakeit.UUID())
+		userUUID = s.userUUID
 		partUUID = uuid.MustParse(gofakeit.UUID())
 		req      = &orderV1.CreateOrderRequest{
-			UserUUID:  userUUID,
+			UserUUID:  orderV1.NewOptUUID(userUUID),
 			PartUuids: []uuid.UUID{partUUID, partUUID}, // Duplicate part UUIDs
 		}
 		expectedOrder = model.Order{
@@ -358,7 +359,7 @@ func (s *APISuite) TestCreateOrderWithDuplicatePartUUIDs() {
 		}
 	)
 
-	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID, partUUID}).Return(expectedOrder, nil)
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID{partUUID, partUUID}, []uuid.UUID(nil)).Return(expectedOrder, nil)
 
 	res, err := s.api.CreateOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -369,3 +370,117 @@ func (s *APISuite) TestCreateOrderWithDuplicatePartUUIDs() {
 	s.Require().Equal(expectedOrder.OrderUUID, createOrderResp.OrderUUID)
 	s.Require().Equal(float32(150.0), createOrderResp.TotalPrice)
 }
+
+func (s *APISuite) TestCreateOrderConfigurationViolations() {
+	var (
+		userUUID  = s.userUUID
+		partUUIDs = []uuid.UUID{uuid.MustParse(gofakeit.UUID()), uuid.MustParse(gofakeit.UUID())}
+		violation = model.RuleViolation{
+			RuleUuid:  uuid.MustParse(gofakeit.UUID()),
+			Type:      model.CompatibilityRuleTypeRequires,
+			Message:   gofakeit.Sentence(),
+			PartUuids: partUUIDs[:1],
+		}
+		req = &orderV1.CreateOrderRequest{
+			UserUUID:  orderV1.NewOptUUID(userUUID),
+			PartUuids: partUUIDs,
+		}
+	)
+
+	s.orderService.On("CreateOrder", s.ctx, userUUID, partUUIDs, []uuid.UUID(nil)).
+		Return(model.Order{}, &model.ConfigurationInvalidError{Violations: []model.RuleViolation{violation}})
+
+	res, err := s.api.CreateOrder(s.ctx, req)
+	s.Require().NoError(err)
+
+	validationErr, ok := res.(*orderV1.ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusUnprocessableEntity, validationErr.Code)
+	s.Require().Len(validationErr.Violations, 1)
+	s.Require().Equal(violation.RuleUuid, validationErr.Violations[0].RuleUUID)
+	s.Require().Equal(orderV1.CompatibilityRuleTypeCOMPATIBILITYRULETYPEREQUIRES, validationErr.Violations[0].Type)
+	s.Require().Equal(violation.Message, validationErr.Violations[0].Message)
+	s.Require().Equal(violation.PartUuids, validationErr.Violations[0].PartUuids)
+}
+
+func (s *APISuite) TestCreateOrderOnlyKits() {
+	var (
+		userUUID = s.userUUID
+		kitUUID  = uuid.MustParse(gofakeit.UUID())
+		req      = &orderV1.CreateOrderRequest{
+			UserUUID: orderV1.NewOptUUID(userUUID),
+			KitUuids: []uuid.UUID{kitUUID},
+		}
+		expectedOrder = model.Order{
+			OrderUUID:  uuid.New(),
+			UserUUID:   userUUID,
+			TotalPrice: 500,
+		}
+	)
+
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID(nil), []uuid.UUID{kitUUID}).Return(expectedOrder, nil)
+
+	res, err := s.api.CreateOrder(s.ctx, req)
+	s.Require().NoError(err)
+
+	createRes, ok := res.(*orderV1.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Require().Equal(expectedOrder.OrderUUID, createRes.OrderUUID)
+	s.Require().Equal(float32(500), createRes.TotalPrice)
+}
+
+func (s *APISuite) TestCreateOrderKitsNotFound() {
+	var (
+		userUUID = s.userUUID
+		kitUUID  = uuid.MustParse(gofakeit.UUID())
+		req      = &orderV1.CreateOrderRequest{
+			UserUUID: orderV1.NewOptUUID(userUUID),
+			KitUuids: []uuid.UUID{kitUUID},
+		}
+	)
+
+	s.orderService.On("CreateOrder", s.ctx, userUUID, []uuid.UUID(nil), []uuid.UUID{kitUUID}).
+		Return(model.Order{}, &model.KitsNotFoundError{MissingUUIDs: []string{kitUUID.String()}})
+
+	res, err := s.api.CreateOrder(s.ctx, req)
+	s.Require().NoError(err)
+
+	notFoundErr, ok := res.(*orderV1.NotFoundError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusNotFound, notFoundErr.Code)
+	s.Require().Equal("kits not found", notFoundErr.Message)
+}
+
+func (s *APISuite) TestCreateOrderWithoutUserUUIDUsesSessionUser() {
+	partUUID := uuid.New()
+	expectedOrder := model.Order{OrderUUID: uuid.New(), UserUUID: s.userUUID, PartUuids: []uuid.UUID{partUUID}, TotalPrice: 10}
+
+	s.orderService.On("CreateOrder", s.ctx, s.userUUID, []uuid.UUID{partUUID}, []uuid.UUID(nil)).Return(expectedOrder, nil)
+
+	res, err := s.api.CreateOrder(s.ctx, &orderV1.CreateOrderRequest{PartUuids: []uuid.UUID{partUUID}})
+	s.Require().NoError(err)
+
+	createOrderResp, ok := res.(*orderV1.CreateOrderResponse)
+	s.Require().True(ok)
+	s.Require().Equal(expectedOrder.OrderUUID, createOrderResp.OrderUUID)
+}
+
+func (s *APISuite) TestCreateOrderForAnotherUserForbidden() {
+	res, err := s.api.CreateOrder(s.ctx, &orderV1.CreateOrderRequest{
+		UserUUID:  orderV1.NewOptUUID(uuid.New()),
+		PartUuids: []uuid.UUID{uuid.New()},
+	})
+	s.Require().NoError(err)
+
+	forbidden, ok := res.(*orderV1.ForbiddenError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusForbidden, forbidden.Code)
+}
+
+func (s *APISuite) TestCreateOrderWithoutUser() {
+	res, err := s.api.CreateOrder(context.Background(), &orderV1.CreateOrderRequest{PartUuids: []uuid.UUID{uuid.New()}})
+	s.Require().NoError(err)
+
+	_, ok := res.(*orderV1.UnauthorizedError)
+	s.Require().True(ok)
+}
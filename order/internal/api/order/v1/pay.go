@@ -5,6 +5,8 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/google/uuid"
+
 	"github.com/nkolesnikov999/micro2-OK/order/internal/converter"
 	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
 	orderV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/openapi/order/v1"
@@ -15,14 +17,31 @@ func (h *orderHandler) PayOrder(ctx context.Context, req *orderV1.PayOrderReques
 		return &orderV1.InternalServerError{Code: http.StatusInternalServerError, Message: "internal server error"}, nil
 	}
 
-	paymentMethod := converter.ToModelPaymentMethod(req.PaymentMethod)
-	tx, err := h.service.PayOrder(ctx, params.OrderUUID, paymentMethod)
+	userUUID, ok := userFromContext(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{Code: http.StatusUnauthorized, Message: "Authentication required"}, nil
+	}
+
+	// Без способа оплаты и инструмента payment возьмёт инструмент пользователя по умолчанию
+	var paymentMethod string
+	if method, ok := req.PaymentMethod.Get(); ok {
+		if method == orderV1.PaymentMethodPAYMENTMETHODUNKNOWN {
+			return &orderV1.ValidationError{Code: http.StatusUnprocessableEntity, Message: "payment_method must be specified"}, nil
+		}
+		paymentMethod = converter.ToModelPaymentMethod(method)
+	}
+	tx, err := h.service.PayOrder(ctx, params.OrderUUID, userUUID, paymentMethod, req.InstrumentUUID.Or(uuid.Nil))
 	if err != nil {
+		var rejected *model.PaymentRejectedError
 		switch {
+		case errors.As(err, &rejected):
+			return &orderV1.ValidationError{Code: http.StatusUnprocessableEntity, Message: rejected.Reason}, nil
 		case errors.Is(err, model.ErrOrderNotFound):
 			return &orderV1.NotFoundError{Code: http.StatusNotFound, Message: "order not found"}, nil
 		case errors.Is(err, model.ErrOrderNotPayable):
 			return &orderV1.ConflictError{Code: http.StatusConflict, Message: "order cannot be paid"}, nil
+		case errors.Is(err, model.ErrPaymentUnavailable):
+			return &orderV1.BadGatewayError{Code: http.StatusBadGateway, Message: "payment gateway unavailable"}, nil
 		case errors.Is(err, model.ErrPaymentFailed):
 			return &orderV1.InternalServerError{Code: http.StatusInternalServerError, Message: "payment failed"}, nil
 		default:
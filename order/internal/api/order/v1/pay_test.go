@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -14,7 +15,7 @@ func (s *APISuite) TestPayOrderSuccess() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
@@ -22,7 +23,7 @@ func (s *APISuite) TestPayOrderSuccess() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return(expectedTransactionUUID, nil)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -37,7 +38,7 @@ func (s *APISuite) TestPayOrderWithSBP() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODSBP,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODSBP),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
@@ -45,7 +46,7 @@ func (s *APISuite) TestPayOrderWithSBP() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "SBP").Return(expectedTransactionUUID, nil)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "SBP", uuid.Nil).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -60,7 +61,7 @@ func (s *APISuite) TestPayOrderWithCreditCard() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCREDITCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCREDITCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
@@ -68,7 +69,7 @@ func (s *APISuite) TestPayOrderWithCreditCard() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CREDIT_CARD").Return(expectedTransactionUUID, nil)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CREDIT_CARD", uuid.Nil).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -83,7 +84,7 @@ func (s *APISuite) TestPayOrderWithInvestorMoney() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODINVESTORMONEY,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODINVESTORMONEY),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
@@ -91,7 +92,7 @@ func (s *APISuite) TestPayOrderWithInvestorMoney() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "INVESTOR_MONEY").Return(expectedTransactionUUID, nil)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "INVESTOR_MONEY", uuid.Nil).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -121,14 +122,14 @@ func (s *APISuite) TestPayOrderNotFound() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
 		}
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return("", model.ErrOrderNotFound)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return("", model.ErrOrderNotFound)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -144,14 +145,14 @@ func (s *APISuite) TestPayOrderAlreadyPaid() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
 		}
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return("", model.ErrOrderNotPayable)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return("", model.ErrOrderNotPayable)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -167,14 +168,14 @@ func (s *APISuite) TestPayOrderCannotPayCancelledOrder() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
 		}
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return("", model.ErrOrderNotPayable)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return("", model.ErrOrderNotPayable)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -190,14 +191,14 @@ func (s *APISuite) TestPayOrderPaymentFailed() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
 		}
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return("", model.ErrPaymentFailed)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return("", model.ErrPaymentFailed)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -209,19 +210,57 @@ func (s *APISuite) TestPayOrderPaymentFailed() {
 	s.Require().Contains(internalErr.Message, "payment failed")
 }
 
+func (s *APISuite) TestPayOrderPaymentRejected() {
+	var (
+		orderUUID = uuid.MustParse(gofakeit.UUID())
+		req       = &orderV1.PayOrderRequest{
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODSBP),
+		}
+		params = orderV1.PayOrderParams{
+			OrderUUID: orderUUID,
+		}
+		reason = "amount 700000.00 RUB for SBP must be between 1.00 and 600000.00"
+	)
+
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "SBP", uuid.Nil).Return("", &model.PaymentRejectedError{Reason: reason})
+
+	res, err := s.api.PayOrder(s.ctx, req, params)
+	s.Require().NoError(err)
+
+	validationErr, ok := res.(*orderV1.ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusUnprocessableEntity, validationErr.Code)
+	s.Require().Equal(reason, validationErr.Message)
+}
+
+func (s *APISuite) TestPayOrderPaymentUnavailable() {
+	orderUUID := uuid.MustParse(gofakeit.UUID())
+
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return("", model.ErrPaymentUnavailable)
+
+	res, err := s.api.PayOrder(s.ctx, &orderV1.PayOrderRequest{
+		PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
+	}, orderV1.PayOrderParams{OrderUUID: orderUUID})
+	s.Require().NoError(err)
+
+	gatewayErr, ok := res.(*orderV1.BadGatewayError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusBadGateway, gatewayErr.Code)
+}
+
 func (s *APISuite) TestPayOrderServiceError() {
 	var (
 		orderUUID  = uuid.MustParse(gofakeit.UUID())
 		serviceErr = gofakeit.Error()
 		req        = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
 		}
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return("", serviceErr)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return("", serviceErr)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -237,7 +276,7 @@ func (s *APISuite) TestPayOrderWithLongTransactionUUID() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODSBP,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODSBP),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
@@ -245,7 +284,7 @@ func (s *APISuite) TestPayOrderWithLongTransactionUUID() {
 		expectedTransactionUUID = gofakeit.UUID() + gofakeit.UUID() // long UUID
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "SBP").Return(expectedTransactionUUID, nil)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "SBP", uuid.Nil).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -260,7 +299,7 @@ func (s *APISuite) TestPayOrderWithEmptyTransactionUUID() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
@@ -268,7 +307,7 @@ func (s *APISuite) TestPayOrderWithEmptyTransactionUUID() {
 		expectedTransactionUUID = "" // empty transaction UUID
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return(expectedTransactionUUID, nil)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -294,7 +333,7 @@ func (s *APISuite) TestPayOrderWithDifferentPaymentMethods() {
 		var (
 			orderUUID = uuid.MustParse(gofakeit.UUID())
 			req       = &orderV1.PayOrderRequest{
-				PaymentMethod: pm.apiMethod,
+				PaymentMethod: orderV1.NewOptPaymentMethod(pm.apiMethod),
 			}
 			params = orderV1.PayOrderParams{
 				OrderUUID: orderUUID,
@@ -302,7 +341,7 @@ func (s *APISuite) TestPayOrderWithDifferentPaymentMethods() {
 			expectedTransactionUUID = gofakeit.UUID()
 		)
 
-		s.orderService.On("PayOrder", s.ctx, orderUUID, pm.serviceMethod).Return(expectedTransactionUUID, nil)
+		s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, pm.serviceMethod, uuid.Nil).Return(expectedTransactionUUID, nil)
 
 		res, err := s.api.PayOrder(s.ctx, req, params)
 		s.Require().NoError(err)
@@ -318,7 +357,7 @@ func (s *APISuite) TestPayOrderWithSameOrderUUID() {
 	var (
 		orderUUID = uuid.MustParse(gofakeit.UUID())
 		req       = &orderV1.PayOrderRequest{
-			PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+			PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODCARD),
 		}
 		params = orderV1.PayOrderParams{
 			OrderUUID: orderUUID,
@@ -326,7 +365,7 @@ func (s *APISuite) TestPayOrderWithSameOrderUUID() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.orderService.On("PayOrder", s.ctx, orderUUID, "CARD").Return(expectedTransactionUUID, nil)
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "CARD", uuid.Nil).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req, params)
 	s.Require().NoError(err)
@@ -336,3 +375,58 @@ func (s *APISuite) TestPayOrderWithSameOrderUUID() {
 	s.Require().True(ok)
 	s.Require().Equal(expectedTransactionUUID, payOrderResp.TransactionUUID)
 }
+
+func (s *APISuite) TestPayOrderWithInstrument() {
+	var (
+		orderUUID      = uuid.New()
+		instrumentUUID = uuid.New()
+		req            = &orderV1.PayOrderRequest{
+			InstrumentUUID: orderV1.NewOptUUID(instrumentUUID),
+		}
+		expectedTransactionUUID = gofakeit.UUID()
+	)
+
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "", instrumentUUID).Return(expectedTransactionUUID, nil)
+
+	res, err := s.api.PayOrder(s.ctx, req, orderV1.PayOrderParams{OrderUUID: orderUUID})
+	s.Require().NoError(err)
+
+	payOrderResp, ok := res.(*orderV1.PayOrderResponse)
+	s.Require().True(ok)
+	s.Require().Equal(expectedTransactionUUID, payOrderResp.TransactionUUID)
+}
+
+func (s *APISuite) TestPayOrderWithDefaultInstrument() {
+	orderUUID := uuid.New()
+	expectedTransactionUUID := gofakeit.UUID()
+
+	// Пустой запрос: payment возьмёт инструмент пользователя по умолчанию
+	s.orderService.On("PayOrder", s.ctx, orderUUID, s.userUUID, "", uuid.Nil).Return(expectedTransactionUUID, nil)
+
+	res, err := s.api.PayOrder(s.ctx, &orderV1.PayOrderRequest{}, orderV1.PayOrderParams{OrderUUID: orderUUID})
+	s.Require().NoError(err)
+
+	payOrderResp, ok := res.(*orderV1.PayOrderResponse)
+	s.Require().True(ok)
+	s.Require().Equal(expectedTransactionUUID, payOrderResp.TransactionUUID)
+}
+
+func (s *APISuite) TestPayOrderUnknownPaymentMethod() {
+	res, err := s.api.PayOrder(s.ctx,
+		&orderV1.PayOrderRequest{PaymentMethod: orderV1.NewOptPaymentMethod(orderV1.PaymentMethodPAYMENTMETHODUNKNOWN)},
+		orderV1.PayOrderParams{OrderUUID: uuid.New()},
+	)
+	s.Require().NoError(err)
+
+	validationErr, ok := res.(*orderV1.ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusUnprocessableEntity, validationErr.Code)
+}
+
+func (s *APISuite) TestPayOrderWithoutUser() {
+	res, err := s.api.PayOrder(context.Background(), &orderV1.PayOrderRequest{}, orderV1.PayOrderParams{OrderUUID: uuid.New()})
+	s.Require().NoError(err)
+
+	_, ok := res.(*orderV1.UnauthorizedError)
+	s.Require().True(ok)
+}
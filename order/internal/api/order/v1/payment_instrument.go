@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	orderV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/openapi/order/v1"
+)
+
+func (h *orderHandler) AddPaymentInstrument(ctx context.Context, req *orderV1.AddPaymentInstrumentRequest) (orderV1.AddPaymentInstrumentRes, error) {
+	if req == nil {
+		return &orderV1.InternalServerError{Code: http.StatusInternalServerError, Message: "internal server error"}, nil
+	}
+
+	userUUID, ok := userFromContext(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{Code: http.StatusUnauthorized, Message: "Authentication required"}, nil
+	}
+
+	instrument, err := h.instrumentService.AddPaymentInstrument(ctx, model.PaymentInstrument{
+		UserUUID:      userUUID,
+		PaymentMethod: converter.ToModelPaymentMethod(req.PaymentMethod),
+		CardToken:     req.CardToken.Value,
+		CardLast4:     req.CardLast4.Value,
+		SBPPhone:      req.SbpPhone.Value,
+		IsDefault:     req.IsDefault.Value,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidPaymentInstrument):
+			return &orderV1.ValidationError{Code: http.StatusUnprocessableEntity, Message: err.Error()}, nil
+		case errors.Is(err, model.ErrPaymentUnavailable):
+			return &orderV1.ServiceUnavailableError{Code: http.StatusServiceUnavailable, Message: "payment service unavailable"}, nil
+		default:
+			return &orderV1.InternalServerError{Code: http.StatusInternalServerError, Message: "internal server error"}, nil
+		}
+	}
+
+	return converter.ToAPIPaymentInstrument(instrument), nil
+}
+
+func (h *orderHandler) ListPaymentInstruments(ctx context.Context) (orderV1.ListPaymentInstrumentsRes, error) {
+	userUUID, ok := userFromContext(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{Code: http.StatusUnauthorized, Message: "Authentication required"}, nil
+	}
+
+	instruments, err := h.instrumentService.ListPaymentInstruments(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrPaymentUnavailable) {
+			return &orderV1.ServiceUnavailableError{Code: http.StatusServiceUnavailable, Message: "payment service unavailable"}, nil
+		}
+		return &orderV1.InternalServerError{Code: http.StatusInternalServerError, Message: "internal server error"}, nil
+	}
+
+	return &orderV1.ListPaymentInstrumentsResponse{
+		Instruments: converter.ToAPIPaymentInstruments(instruments),
+	}, nil
+}
+
+func (h *orderHandler) DeletePaymentInstrument(ctx context.Context, params orderV1.DeletePaymentInstrumentParams) (orderV1.DeletePaymentInstrumentRes, error) {
+	userUUID, ok := userFromContext(ctx)
+	if !ok {
+		return &orderV1.UnauthorizedError{Code: http.StatusUnauthorized, Message: "Authentication required"}, nil
+	}
+
+	err := h.instrumentService.DeletePaymentInstrument(ctx, userUUID, params.InstrumentUUID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPaymentInstrumentNotFound):
+			return &orderV1.NotFoundError{Code: http.StatusNotFound, Message: "payment instrument not found"}, nil
+		case errors.Is(err, model.ErrPaymentUnavailable):
+			return &orderV1.ServiceUnavailableError{Code: http.StatusServiceUnavailable, Message: "payment service unavailable"}, nil
+		default:
+			return &orderV1.InternalServerError{Code: http.StatusInternalServerError, Message: "internal server error"}, nil
+		}
+	}
+
+	return &orderV1.DeletePaymentInstrumentNoContent{}, nil
+}
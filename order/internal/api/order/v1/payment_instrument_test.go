@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+	orderV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/openapi/order/v1"
+	commonV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/common/v1"
+)
+
+// withUser кладёт пользователя в контекст так же, как auth middleware
+func withUser(ctx context.Context, userUUID uuid.UUID) context.Context {
+	return context.WithValue(ctx, grpcAuth.GetUserContextKey(), &commonV1.User{Uuid: userUUID.String()})
+}
+
+func (s *APISuite) TestAddPaymentInstrument() {
+	userUUID := uuid.New()
+	ctx := withUser(s.ctx, userUUID)
+	saved := model.PaymentInstrument{
+		UUID:          uuid.New(),
+		UserUUID:      userUUID,
+		PaymentMethod: "CARD",
+		CardLast4:     "4242",
+		IsDefault:     true,
+		CreatedAt:     time.Now(),
+	}
+
+	// Владелец берётся из сессии, а не из тела запроса
+	s.instrumentService.On("AddPaymentInstrument", ctx, model.PaymentInstrument{
+		UserUUID:      userUUID,
+		PaymentMethod: "CARD",
+		CardToken:     "tok_visa",
+		CardLast4:     "4242",
+		IsDefault:     true,
+	}).Return(saved, nil)
+
+	res, err := s.api.AddPaymentInstrument(ctx, &orderV1.AddPaymentInstrumentRequest{
+		PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+		CardToken:     orderV1.NewOptString("tok_visa"),
+		CardLast4:     orderV1.NewOptString("4242"),
+		IsDefault:     orderV1.NewOptBool(true),
+	})
+	s.Require().NoError(err)
+
+	dto, ok := res.(*orderV1.PaymentInstrumentDto)
+	s.Require().True(ok)
+	s.Require().Equal(saved.UUID, dto.InstrumentUUID)
+	s.Require().Equal(orderV1.PaymentMethodPAYMENTMETHODCARD, dto.PaymentMethod)
+	s.Require().Equal("4242", dto.CardLast4.Value)
+	s.Require().True(dto.IsDefault)
+}
+
+func (s *APISuite) TestAddPaymentInstrumentInvalid() {
+	ctx := withUser(s.ctx, uuid.New())
+	s.instrumentService.On("AddPaymentInstrument", ctx, mock.Anything).
+		Return(model.PaymentInstrument{}, model.ErrInvalidPaymentInstrument)
+
+	res, err := s.api.AddPaymentInstrument(ctx, &orderV1.AddPaymentInstrumentRequest{
+		PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODSBP,
+	})
+	s.Require().NoError(err)
+
+	validationErr, ok := res.(*orderV1.ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusUnprocessableEntity, validationErr.Code)
+}
+
+func (s *APISuite) TestAddPaymentInstrumentWithoutUser() {
+	res, err := s.api.AddPaymentInstrument(context.Background(), &orderV1.AddPaymentInstrumentRequest{
+		PaymentMethod: orderV1.PaymentMethodPAYMENTMETHODCARD,
+	})
+	s.Require().NoError(err)
+
+	_, ok := res.(*orderV1.UnauthorizedError)
+	s.Require().True(ok)
+}
+
+func (s *APISuite) TestListPaymentInstruments() {
+	userUUID := uuid.New()
+	ctx := withUser(s.ctx, userUUID)
+	instruments := []model.PaymentInstrument{
+		{UUID: uuid.New(), PaymentMethod: "CARD", CardLast4: "4242", IsDefault: true},
+		{UUID: uuid.New(), PaymentMethod: "SBP", SBPPhoneMasked: "+7******4567"},
+	}
+	s.instrumentService.On("ListPaymentInstruments", ctx, userUUID).Return(instruments, nil)
+
+	res, err := s.api.ListPaymentInstruments(ctx)
+	s.Require().NoError(err)
+
+	list, ok := res.(*orderV1.ListPaymentInstrumentsResponse)
+	s.Require().True(ok)
+	s.Require().Len(list.Instruments, 2)
+	s.Require().Equal(instruments[0].UUID, list.Instruments[0].InstrumentUUID)
+	s.Require().True(list.Instruments[0].IsDefault)
+	s.Require().Equal(orderV1.PaymentMethodPAYMENTMETHODSBP, list.Instruments[1].PaymentMethod)
+	s.Require().Equal("+7******4567", list.Instruments[1].SbpPhoneMasked.Value)
+}
+
+func (s *APISuite) TestListPaymentInstrumentsPaymentUnavailable() {
+	ctx := withUser(s.ctx, uuid.New())
+	s.instrumentService.On("ListPaymentInstruments", ctx, mock.Anything).Return(nil, model.ErrPaymentUnavailable)
+
+	res, err := s.api.ListPaymentInstruments(ctx)
+	s.Require().NoError(err)
+
+	_, ok := res.(*orderV1.ServiceUnavailableError)
+	s.Require().True(ok)
+}
+
+func (s *APISuite) TestDeletePaymentInstrument() {
+	userUUID, instrumentUUID := uuid.New(), uuid.New()
+	ctx := withUser(s.ctx, userUUID)
+	s.instrumentService.On("DeletePaymentInstrument", ctx, userUUID, instrumentUUID).Return(nil)
+
+	res, err := s.api.DeletePaymentInstrument(ctx, orderV1.DeletePaymentInstrumentParams{InstrumentUUID: instrumentUUID})
+	s.Require().NoError(err)
+
+	_, ok := res.(*orderV1.DeletePaymentInstrumentNoContent)
+	s.Require().True(ok)
+}
+
+func (s *APISuite) TestDeletePaymentInstrumentNotFound() {
+	ctx := withUser(s.ctx, uuid.New())
+	s.instrumentService.On("DeletePaymentInstrument", ctx, mock.Anything, mock.Anything).Return(model.ErrPaymentInstrumentNotFound)
+
+	res, err := s.api.DeletePaymentInstrument(ctx, orderV1.DeletePaymentInstrumentParams{InstrumentUUID: uuid.New()})
+	s.Require().NoError(err)
+
+	notFound, ok := res.(*orderV1.NotFoundError)
+	s.Require().True(ok)
+	s.Require().Equal(http.StatusNotFound, notFound.Code)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/nkolesnikov999/micro2-OK/order/internal/service/mocks"
@@ -12,20 +13,26 @@ import (
 type APISuite struct {
 	suite.Suite
 
-	ctx context.Context
+	ctx      context.Context
+	userUUID uuid.UUID
 
-	orderService *mocks.OrderService
+	orderService      *mocks.OrderService
+	instrumentService *mocks.PaymentInstrumentService
 
 	api *orderHandler
 }
 
 func (s *APISuite) SetupTest() {
-	s.ctx = context.Background()
+	// Все запросы идут от пользователя сессии, как после auth middleware
+	s.userUUID = uuid.New()
+	s.ctx = withUser(context.Background(), s.userUUID)
 
 	s.orderService = mocks.NewOrderService(s.T())
+	s.instrumentService = mocks.NewPaymentInstrumentService(s.T())
 
 	s.api = NewHandler(
 		s.orderService,
+		s.instrumentService,
 	)
 }
 
@@ -18,9 +18,27 @@ import (
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/metrics"
+	httpAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/http"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
 )
 
+// Права, выдаваемые ролями IAM
+const (
+	ordersReadPermission  = "orders:read"
+	ordersWritePermission = "orders:write"
+)
+
+// routePermissions — права на маршруты order API; шаблоны с префиксом ogen-сервера
+var routePermissions = httpAuth.RoutePermissions{
+	"GET /api/v1/orders/{order_uuid}":                      {ordersReadPermission},
+	"POST /api/v1/orders":                                  {ordersWritePermission},
+	"POST /api/v1/orders/{order_uuid}/pay":                 {ordersWritePermission},
+	"POST /api/v1/orders/{order_uuid}/cancel":              {ordersWritePermission},
+	"GET /api/v1/payment-instruments":                      {ordersReadPermission},
+	"POST /api/v1/payment-instruments":                     {ordersWritePermission},
+	"DELETE /api/v1/payment-instruments/{instrument_uuid}": {ordersWritePermission},
+}
+
 type App struct {
 	diContainer *diContainer
 	httpServer  *http.Server
@@ -39,7 +57,7 @@ func New(ctx context.Context) (*App, error) {
 
 func (a *App) Run(ctx context.Context) error {
 	// Канал для ошибок от компонентов
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 3)
 
 	// Контекст для остановки всех горутин
 	ctx, cancel := context.WithCancel(ctx)
@@ -52,6 +70,13 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	// Консьюмер итогов оплаты
+	go func() {
+		if err := a.runPaymentConsumer(ctx); err != nil {
+			errCh <- errors.Errorf("payment consumer crashed: %v", err)
+		}
+	}()
+
 	// HTTP сервер
 	go func() {
 		if err := a.runHTTPServer(ctx); err != nil {
@@ -162,6 +187,7 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 	// API routes with authentication
 	apiRouter := chi.NewRouter()
 	apiRouter.Use(authMiddleware.Handle)
+	apiRouter.Use(httpAuth.NewPermissionMiddleware(routePermissions).Handle)
 	apiRouter.Mount("/", orderServer)
 	router.Mount("/", apiRouter)
 
@@ -199,3 +225,17 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runPaymentConsumer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🚀 Payment Kafka consumer running (topics=%s, %s)",
+		config.AppConfig().PaymentConsumer.CompletedTopic(),
+		config.AppConfig().PaymentConsumer.FailedTopic(),
+	))
+
+	err := a.diContainer.PaymentConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -21,6 +21,8 @@ import (
 	orderRepository "github.com/nkolesnikov999/micro2-OK/order/internal/repository/order"
 	"github.com/nkolesnikov999/micro2-OK/order/internal/service"
 	orderconsumer "github.com/nkolesnikov999/micro2-OK/order/internal/service/consumer/order_consumer"
+	paymentconsumer "github.com/nkolesnikov999/micro2-OK/order/internal/service/consumer/payment_consumer"
+	instrumentService "github.com/nkolesnikov999/micro2-OK/order/internal/service/instrument"
 	orderService "github.com/nkolesnikov999/micro2-OK/order/internal/service/order"
 	orderPaidProducer "github.com/nkolesnikov999/micro2-OK/order/internal/service/producer/order_producer"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
@@ -28,6 +30,7 @@ import (
 	wrappedKafkaConsumer "github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka/consumer"
 	wrappedKafkaProducer "github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka/producer"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
 	httpAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/http"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/migrator"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
@@ -41,6 +44,7 @@ type diContainer struct {
 	orderV1Server *orderV1.Server
 
 	orderService             service.OrderService
+	instrumentService        service.PaymentInstrumentService
 	orderPaidProducerService service.OrderPaidProducerService
 
 	orderShipAssembledConsumerService service.ConsumerService
@@ -49,6 +53,12 @@ type diContainer struct {
 	orderShipAssembledConsumer wrappedKafka.Consumer
 	orderAssembledDecoder      kafkaConverter.OrderAssembledDecoder
 
+	paymentConsumerService  service.ConsumerService
+	paymentConsumerGroup    sarama.ConsumerGroup
+	paymentConsumer         wrappedKafka.Consumer
+	paymentCompletedDecoder kafkaConverter.PaymentCompletedDecoder
+	paymentFailedDecoder    kafkaConverter.PaymentFailedDecoder
+
 	orderRepository repository.OrderRepository
 
 	inventoryClient grpc.InventoryClient
@@ -72,7 +82,7 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) OrderV1Server(ctx context.Context) (*orderV1.Server, error) {
 	if d.orderV1Server == nil {
-		orderHandler := orderApi.NewHandler(d.OrderService(ctx))
+		orderHandler := orderApi.NewHandler(d.OrderService(ctx), d.PaymentInstrumentService(ctx))
 
 		server, err := orderV1.NewServer(
 			orderHandler,
@@ -91,7 +101,6 @@ func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 	if d.orderService == nil {
 		d.orderService = orderService.NewService(
 			d.OrderRepository(ctx),
-			d.OrderPaidProducerService(),
 			d.InventoryClient(ctx),
 			d.PaymentClient(ctx),
 		)
@@ -100,6 +109,14 @@ func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 	return d.orderService
 }
 
+func (d *diContainer) PaymentInstrumentService(ctx context.Context) service.PaymentInstrumentService {
+	if d.instrumentService == nil {
+		d.instrumentService = instrumentService.NewService(d.PaymentClient(ctx))
+	}
+
+	return d.instrumentService
+}
+
 func (d *diContainer) OrderShipAssembledConsumerService(ctx context.Context) service.ConsumerService {
 	if d.orderShipAssembledConsumerService == nil {
 		d.orderShipAssembledConsumerService = orderconsumer.NewService(
@@ -151,6 +168,72 @@ func (d *diContainer) OrderAssembledDecoder() kafkaConverter.OrderAssembledDecod
 	return d.orderAssembledDecoder
 }
 
+func (d *diContainer) PaymentConsumerService(ctx context.Context) service.ConsumerService {
+	if d.paymentConsumerService == nil {
+		d.paymentConsumerService = paymentconsumer.NewService(
+			d.PaymentConsumer(),
+			config.AppConfig().PaymentConsumer.CompletedTopic(),
+			config.AppConfig().PaymentConsumer.FailedTopic(),
+			d.PaymentCompletedDecoder(),
+			d.PaymentFailedDecoder(),
+			d.OrderRepository(ctx),
+			d.OrderPaidProducerService(),
+		)
+	}
+	return d.paymentConsumerService
+}
+
+func (d *diContainer) PaymentConsumerGroup() sarama.ConsumerGroup {
+	if d.paymentConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().PaymentConsumer.GroupID(),
+			config.AppConfig().PaymentConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create payment consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka payment consumer group", func(ctx context.Context) error {
+			return consumerGroup.Close()
+		})
+
+		d.paymentConsumerGroup = consumerGroup
+	}
+
+	return d.paymentConsumerGroup
+}
+
+func (d *diContainer) PaymentConsumer() wrappedKafka.Consumer {
+	if d.paymentConsumer == nil {
+		d.paymentConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.PaymentConsumerGroup(),
+			[]string{
+				config.AppConfig().PaymentConsumer.CompletedTopic(),
+				config.AppConfig().PaymentConsumer.FailedTopic(),
+			},
+			logger.Logger(),
+		)
+	}
+
+	return d.paymentConsumer
+}
+
+func (d *diContainer) PaymentCompletedDecoder() kafkaConverter.PaymentCompletedDecoder {
+	if d.paymentCompletedDecoder == nil {
+		d.paymentCompletedDecoder = kafkaDecoder.NewPaymentCompletedDecoder()
+	}
+
+	return d.paymentCompletedDecoder
+}
+
+func (d *diContainer) PaymentFailedDecoder() kafkaConverter.PaymentFailedDecoder {
+	if d.paymentFailedDecoder == nil {
+		d.paymentFailedDecoder = kafkaDecoder.NewPaymentFailedDecoder()
+	}
+
+	return d.paymentFailedDecoder
+}
+
 func (d *diContainer) OrderPaidProducerService() service.OrderPaidProducerService {
 	if d.orderPaidProducerService == nil {
 		d.orderPaidProducerService = orderPaidProducer.NewService(d.OrderPaidProducer())
@@ -210,7 +293,14 @@ func (d *diContainer) PaymentConn(ctx context.Context) *grpcConn.ClientConn {
 		conn, err := grpcConn.NewClient(
 			config.AppConfig().PaymentGRPC.Address(),
 			grpcConn.WithTransportCredentials(insecure.NewCredentials()),
-			grpcConn.WithUnaryInterceptor(tracing.UnaryClientInterceptor("payment-service")),
+			grpcConn.WithChainUnaryInterceptor(
+				tracing.UnaryClientInterceptor("payment-service"),
+				// payment принимает только вызовы с сервисным токеном
+				grpcAuth.ServiceTokenUnaryClientInterceptor(
+					config.AppConfig().ServiceAuth.ServiceName(),
+					config.AppConfig().ServiceAuth.Key(),
+				),
+			),
 		)
 		if err != nil {
 			panic(fmt.Errorf("failed to connect to payment service: %w", err))
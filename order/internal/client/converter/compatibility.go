@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToModelRuleViolations(violations []*inventoryV1.RuleViolation) []model.RuleViolation {
+	result := make([]model.RuleViolation, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, ToModelRuleViolation(violation))
+	}
+	return result
+}
+
+func ToModelRuleViolation(violation *inventoryV1.RuleViolation) model.RuleViolation {
+	ruleUUID, err := uuid.Parse(violation.GetRuleUuid())
+	if err != nil {
+		ruleUUID = uuid.UUID{}
+	}
+
+	partUUIDs := make([]uuid.UUID, 0, len(violation.GetPartUuids()))
+	for _, partUUID := range violation.GetPartUuids() {
+		if parsed, err := uuid.Parse(partUUID); err == nil {
+			partUUIDs = append(partUUIDs, parsed)
+		}
+	}
+
+	return model.RuleViolation{
+		RuleUuid:  ruleUUID,
+		Type:      model.CompatibilityRuleType(violation.GetType()),
+		Message:   violation.GetMessage(),
+		PartUuids: partUUIDs,
+	}
+}
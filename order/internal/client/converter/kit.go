@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func ToModelKits(kits []*inventoryV1.Kit) []model.Kit {
+	result := make([]model.Kit, 0, len(kits))
+	for _, kit := range kits {
+		result = append(result, ToModelKit(kit))
+	}
+	return result
+}
+
+func ToModelKit(kit *inventoryV1.Kit) model.Kit {
+	kitUUID, err := uuid.Parse(kit.GetUuid())
+	if err != nil {
+		kitUUID = uuid.UUID{}
+	}
+
+	components := make([]model.KitComponent, 0, len(kit.GetComponents()))
+	for _, component := range kit.GetComponents() {
+		partUUID, err := uuid.Parse(component.GetPartUuid())
+		if err != nil {
+			partUUID = uuid.UUID{}
+		}
+		components = append(components, model.KitComponent{
+			PartUuid: partUUID,
+			Quantity: component.GetQuantity(),
+		})
+	}
+
+	return model.Kit{
+		Uuid:       kitUUID,
+		Name:       kit.GetName(),
+		Components: components,
+		Price:      kit.GetPrice(),
+	}
+}
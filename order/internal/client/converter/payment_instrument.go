@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func ToModelPaymentInstruments(instruments []*paymentV1.PaymentInstrument) []model.PaymentInstrument {
+	result := make([]model.PaymentInstrument, 0, len(instruments))
+	for _, instrument := range instruments {
+		result = append(result, ToModelPaymentInstrument(instrument))
+	}
+	return result
+}
+
+func ToModelPaymentInstrument(instrument *paymentV1.PaymentInstrument) model.PaymentInstrument {
+	instrumentUUID, err := uuid.Parse(instrument.GetInstrumentUuid())
+	if err != nil {
+		instrumentUUID = uuid.UUID{}
+	}
+	userUUID, err := uuid.Parse(instrument.GetUserUuid())
+	if err != nil {
+		userUUID = uuid.UUID{}
+	}
+
+	return model.PaymentInstrument{
+		UUID:           instrumentUUID,
+		UserUUID:       userUUID,
+		PaymentMethod:  ToModelPaymentMethod(instrument.GetPaymentMethod()),
+		CardLast4:      instrument.GetCardLast4(),
+		SBPPhoneMasked: instrument.GetSbpPhoneMasked(),
+		IsDefault:      instrument.GetIsDefault(),
+		CreatedAt:      instrument.GetCreatedAt().AsTime(),
+	}
+}
+
+// ToProtoPaymentMethod переводит способ оплаты сервиса ("CARD") в enum payment;
+// пустой или неизвестный способ — PAYMENT_METHOD_UNSPECIFIED
+func ToProtoPaymentMethod(paymentMethod string) paymentV1.PaymentMethod {
+	switch paymentMethod {
+	case "CARD":
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CARD
+	case "SBP":
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_SBP
+	case "CREDIT_CARD":
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case "INVESTOR_MONEY":
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
+
+func ToModelPaymentMethod(paymentMethod paymentV1.PaymentMethod) string {
+	switch paymentMethod {
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_CARD:
+		return "CARD"
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_SBP:
+		return "SBP"
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
+		return "CREDIT_CARD"
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
+		return "INVESTOR_MONEY"
+	default:
+		return ""
+	}
+}
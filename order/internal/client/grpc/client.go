@@ -3,13 +3,27 @@ package grpc
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
 )
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error)
+	ValidateConfiguration(ctx context.Context, partUUIDs []uuid.UUID) ([]model.RuleViolation, error)
+	// ListKits возвращает комплекты по UUID. Пустой список вернёт все комплекты, поэтому не передавайте его
+	ListKits(ctx context.Context, kitUUIDs []uuid.UUID) ([]model.Kit, error)
 }
 
 type PaymentClient interface {
-	PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string) (transactionUUID string, err error)
+	// PayOrder возвращает *model.PaymentRejectedError, если payment отклонил оплату,
+	// и ErrPaymentUnavailable, если платёжный провайдер не ответил.
+	// Пустой instrumentUUID — оплата без сохранённого инструмента; если пуст и paymentMethod,
+	// payment берёт инструмент пользователя по умолчанию
+	PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod, instrumentUUID string, amount float64, currency string) (transactionUUID string, err error)
+	// AddPaymentInstrument возвращает ErrInvalidPaymentInstrument, если реквизиты не подходят способу оплаты
+	AddPaymentInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error)
+	ListPaymentInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error)
+	// DeletePaymentInstrument возвращает ErrPaymentInstrumentNotFound, если у пользователя нет такого инструмента
+	DeletePaymentInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error
 }
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	clientConverter "github.com/nkolesnikov999/micro2-OK/order/internal/client/converter"
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ListKits(ctx context.Context, kitUUIDs []uuid.UUID) ([]model.Kit, error) {
+	// Передаем session UUID в gRPC metadata для аутентификации
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	uuids := make([]string, 0, len(kitUUIDs))
+	for _, kitUUID := range kitUUIDs {
+		uuids = append(uuids, kitUUID.String())
+	}
+
+	res, err := c.inventoryClient.ListKits(ctx, &inventoryV1.ListKitsRequest{
+		Uuids: uuids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clientConverter.ToModelKits(res.GetKits()), nil
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	clientConverter "github.com/nkolesnikov999/micro2-OK/order/internal/client/converter"
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+	inventoryV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ValidateConfiguration(ctx context.Context, partUUIDs []uuid.UUID) ([]model.RuleViolation, error) {
+	// Передаем session UUID в gRPC metadata для аутентификации
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	uuids := make([]string, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		uuids = append(uuids, partUUID.String())
+	}
+
+	res, err := c.inventoryClient.ValidateConfiguration(ctx, &inventoryV1.ValidateConfigurationRequest{
+		PartUuids: uuids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return clientConverter.ToModelRuleViolations(res.GetViolations()), nil
+}
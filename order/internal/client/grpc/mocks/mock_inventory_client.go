@@ -8,6 +8,7 @@ package mocks
 import (
 	context "context"
 
+	uuid "github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/nkolesnikov999/micro2-OK/order/internal/model"
@@ -26,6 +27,65 @@ func (_m *InventoryClient) EXPECT() *InventoryClient_Expecter {
 	return &InventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// ListKits provides a mock function with given fields: ctx, kitUUIDs
+func (_m *InventoryClient) ListKits(ctx context.Context, kitUUIDs []uuid.UUID) ([]model.Kit, error) {
+	ret := _m.Called(ctx, kitUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKits")
+	}
+
+	var r0 []model.Kit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]model.Kit, error)); ok {
+		return rf(ctx, kitUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []model.Kit); ok {
+		r0 = rf(ctx, kitUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Kit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, kitUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_ListKits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKits'
+type InventoryClient_ListKits_Call struct {
+	*mock.Call
+}
+
+// ListKits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kitUUIDs []uuid.UUID
+func (_e *InventoryClient_Expecter) ListKits(ctx interface{}, kitUUIDs interface{}) *InventoryClient_ListKits_Call {
+	return &InventoryClient_ListKits_Call{Call: _e.mock.On("ListKits", ctx, kitUUIDs)}
+}
+
+func (_c *InventoryClient_ListKits_Call) Run(run func(ctx context.Context, kitUUIDs []uuid.UUID)) *InventoryClient_ListKits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ListKits_Call) Return(_a0 []model.Kit, _a1 error) *InventoryClient_ListKits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryClient_ListKits_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]model.Kit, error)) *InventoryClient_ListKits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx, filter
 func (_m *InventoryClient) ListParts(ctx context.Context, filter model.PartsFilter) ([]model.Part, error) {
 	ret := _m.Called(ctx, filter)
@@ -85,6 +145,65 @@ func (_c *InventoryClient_ListParts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ValidateConfiguration provides a mock function with given fields: ctx, partUUIDs
+func (_m *InventoryClient) ValidateConfiguration(ctx context.Context, partUUIDs []uuid.UUID) ([]model.RuleViolation, error) {
+	ret := _m.Called(ctx, partUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateConfiguration")
+	}
+
+	var r0 []model.RuleViolation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]model.RuleViolation, error)); ok {
+		return rf(ctx, partUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []model.RuleViolation); ok {
+		r0 = rf(ctx, partUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RuleViolation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, partUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_ValidateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateConfiguration'
+type InventoryClient_ValidateConfiguration_Call struct {
+	*mock.Call
+}
+
+// ValidateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUIDs []uuid.UUID
+func (_e *InventoryClient_Expecter) ValidateConfiguration(ctx interface{}, partUUIDs interface{}) *InventoryClient_ValidateConfiguration_Call {
+	return &InventoryClient_ValidateConfiguration_Call{Call: _e.mock.On("ValidateConfiguration", ctx, partUUIDs)}
+}
+
+func (_c *InventoryClient_ValidateConfiguration_Call) Run(run func(ctx context.Context, partUUIDs []uuid.UUID)) *InventoryClient_ValidateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ValidateConfiguration_Call) Return(_a0 []model.RuleViolation, _a1 error) *InventoryClient_ValidateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryClient_ValidateConfiguration_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]model.RuleViolation, error)) *InventoryClient_ValidateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryClient creates a new instance of InventoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryClient(t interface {
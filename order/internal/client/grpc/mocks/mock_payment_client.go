@@ -8,6 +8,8 @@ package mocks
 import (
 	context "context"
 
+	uuid "github.com/google/uuid"
+	model "github.com/nkolesnikov999/micro2-OK/order/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -24,9 +26,173 @@ func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
 	return &PaymentClient_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod
-func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod)
+// AddPaymentInstrument provides a mock function with given fields: ctx, instrument
+func (_m *PaymentClient) AddPaymentInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPaymentInstrument")
+	}
+
+	var r0 model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentInstrument) (model.PaymentInstrument, error)); ok {
+		return rf(ctx, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentInstrument) model.PaymentInstrument); ok {
+		r0 = rf(ctx, instrument)
+	} else {
+		r0 = ret.Get(0).(model.PaymentInstrument)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PaymentInstrument) error); ok {
+		r1 = rf(ctx, instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_AddPaymentInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPaymentInstrument'
+type PaymentClient_AddPaymentInstrument_Call struct {
+	*mock.Call
+}
+
+// AddPaymentInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instrument model.PaymentInstrument
+func (_e *PaymentClient_Expecter) AddPaymentInstrument(ctx interface{}, instrument interface{}) *PaymentClient_AddPaymentInstrument_Call {
+	return &PaymentClient_AddPaymentInstrument_Call{Call: _e.mock.On("AddPaymentInstrument", ctx, instrument)}
+}
+
+func (_c *PaymentClient_AddPaymentInstrument_Call) Run(run func(ctx context.Context, instrument model.PaymentInstrument)) *PaymentClient_AddPaymentInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PaymentInstrument))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_AddPaymentInstrument_Call) Return(_a0 model.PaymentInstrument, _a1 error) *PaymentClient_AddPaymentInstrument_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentClient_AddPaymentInstrument_Call) RunAndReturn(run func(context.Context, model.PaymentInstrument) (model.PaymentInstrument, error)) *PaymentClient_AddPaymentInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePaymentInstrument provides a mock function with given fields: ctx, userUUID, instrumentUUID
+func (_m *PaymentClient) DeletePaymentInstrument(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, userUUID, instrumentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePaymentInstrument")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userUUID, instrumentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentClient_DeletePaymentInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePaymentInstrument'
+type PaymentClient_DeletePaymentInstrument_Call struct {
+	*mock.Call
+}
+
+// DeletePaymentInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - instrumentUUID uuid.UUID
+func (_e *PaymentClient_Expecter) DeletePaymentInstrument(ctx interface{}, userUUID interface{}, instrumentUUID interface{}) *PaymentClient_DeletePaymentInstrument_Call {
+	return &PaymentClient_DeletePaymentInstrument_Call{Call: _e.mock.On("DeletePaymentInstrument", ctx, userUUID, instrumentUUID)}
+}
+
+func (_c *PaymentClient_DeletePaymentInstrument_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID)) *PaymentClient_DeletePaymentInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_DeletePaymentInstrument_Call) Return(_a0 error) *PaymentClient_DeletePaymentInstrument_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentClient_DeletePaymentInstrument_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *PaymentClient_DeletePaymentInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPaymentInstruments provides a mock function with given fields: ctx, userUUID
+func (_m *PaymentClient) ListPaymentInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPaymentInstruments")
+	}
+
+	var r0 []model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.PaymentInstrument); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PaymentInstrument)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_ListPaymentInstruments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPaymentInstruments'
+type PaymentClient_ListPaymentInstruments_Call struct {
+	*mock.Call
+}
+
+// ListPaymentInstruments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *PaymentClient_Expecter) ListPaymentInstruments(ctx interface{}, userUUID interface{}) *PaymentClient_ListPaymentInstruments_Call {
+	return &PaymentClient_ListPaymentInstruments_Call{Call: _e.mock.On("ListPaymentInstruments", ctx, userUUID)}
+}
+
+func (_c *PaymentClient_ListPaymentInstruments_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *PaymentClient_ListPaymentInstruments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_ListPaymentInstruments_Call) Return(_a0 []model.PaymentInstrument, _a1 error) *PaymentClient_ListPaymentInstruments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentClient_ListPaymentInstruments_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)) *PaymentClient_ListPaymentInstruments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod, instrumentUUID, amount, currency
+func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUID string, paymentMethod string, instrumentUUID string, amount float64, currency string) (string, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID, amount, currency)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -34,17 +200,17 @@ func (_m *PaymentClient) PayOrder(ctx context.Context, orderUUID string, userUUI
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
-		return rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, float64, string) (string, error)); ok {
+		return rf(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID, amount, currency)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
-		r0 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, float64, string) string); ok {
+		r0 = rf(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID, amount, currency)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, orderUUID, userUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, float64, string) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID, amount, currency)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +228,16 @@ type PaymentClient_PayOrder_Call struct {
 //   - orderUUID string
 //   - userUUID string
 //   - paymentMethod string
-func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}) *PaymentClient_PayOrder_Call {
-	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod)}
+//   - instrumentUUID string
+//   - amount float64
+//   - currency string
+func (_e *PaymentClient_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}, instrumentUUID interface{}, amount interface{}, currency interface{}) *PaymentClient_PayOrder_Call {
+	return &PaymentClient_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod, instrumentUUID, amount, currency)}
 }
 
-func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod string)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) Run(run func(ctx context.Context, orderUUID string, userUUID string, paymentMethod string, instrumentUUID string, amount float64, currency string)) *PaymentClient_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(float64), args[6].(string))
 	})
 	return _c
 }
@@ -78,7 +247,7 @@ func (_c *PaymentClient_PayOrder_Call) Return(transactionUUID string, err error)
 	return _c
 }
 
-func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *PaymentClient_PayOrder_Call {
+func (_c *PaymentClient_PayOrder_Call) RunAndReturn(run func(context.Context, string, string, string, string, float64, string) (string, error)) *PaymentClient_PayOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
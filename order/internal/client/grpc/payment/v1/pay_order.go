@@ -2,35 +2,37 @@ package v1
 
 import (
 	"context"
+	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientConverter "github.com/nkolesnikov999/micro2-OK/order/internal/client/converter"
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
 	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
 	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
 )
 
-func (c *client) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod string) (transactionUUID string, err error) {
+func (c *client) PayOrder(ctx context.Context, orderUUID, userUUID, paymentMethod, instrumentUUID string, amount float64, currency string) (transactionUUID string, err error) {
 	// Передаем session UUID в gRPC metadata для аутентификации
 	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
 
-	var methodEnum paymentV1.PaymentMethod
-	switch paymentMethod {
-	case "CARD":
-		methodEnum = paymentV1.PaymentMethod_PAYMENT_METHOD_CARD
-	case "SBP":
-		methodEnum = paymentV1.PaymentMethod_PAYMENT_METHOD_SBP
-	case "CREDIT_CARD":
-		methodEnum = paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
-	case "INVESTOR_MONEY":
-		methodEnum = paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
-	default:
-		methodEnum = paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
-	}
-
 	response, err := c.paymentClient.PayOrder(ctx, &paymentV1.PayOrderRequest{
-		OrderUuid:     orderUUID,
-		UserUuid:      userUUID,
-		PaymentMethod: methodEnum,
+		OrderUuid:      orderUUID,
+		UserUuid:       userUUID,
+		PaymentMethod:  clientConverter.ToProtoPaymentMethod(paymentMethod),
+		Amount:         amount,
+		Currency:       currency,
+		InstrumentUuid: instrumentUUID,
 	})
 	if err != nil {
+		// Отказ по сумме, валюте или инструменту — ошибка запроса, а не сбой payment
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.InvalidArgument, codes.NotFound:
+			return "", &model.PaymentRejectedError{Reason: status.Convert(err).Message()}
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return "", fmt.Errorf("%w: %s", model.ErrPaymentUnavailable, status.Convert(err).Message())
+		}
 		return "", err
 	}
 	return response.GetTransactionUuid(), nil
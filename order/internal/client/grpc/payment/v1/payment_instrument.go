@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientConverter "github.com/nkolesnikov999/micro2-OK/order/internal/client/converter"
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) AddPaymentInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error) {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	res, err := c.paymentClient.AddPaymentInstrument(ctx, &paymentV1.AddPaymentInstrumentRequest{
+		UserUuid:      instrument.UserUUID.String(),
+		PaymentMethod: clientConverter.ToProtoPaymentMethod(instrument.PaymentMethod),
+		CardToken:     instrument.CardToken,
+		CardLast4:     instrument.CardLast4,
+		SbpPhone:      instrument.SBPPhone,
+		IsDefault:     instrument.IsDefault,
+	})
+	if err != nil {
+		return model.PaymentInstrument{}, instrumentError(err)
+	}
+
+	return clientConverter.ToModelPaymentInstrument(res.GetInstrument()), nil
+}
+
+func (c *client) ListPaymentInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	res, err := c.paymentClient.ListPaymentInstruments(ctx, &paymentV1.ListPaymentInstrumentsRequest{
+		UserUuid: userUUID.String(),
+	})
+	if err != nil {
+		return nil, instrumentError(err)
+	}
+
+	return clientConverter.ToModelPaymentInstruments(res.GetInstruments()), nil
+}
+
+func (c *client) DeletePaymentInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	_, err := c.paymentClient.DeletePaymentInstrument(ctx, &paymentV1.DeletePaymentInstrumentRequest{
+		UserUuid:       userUUID.String(),
+		InstrumentUuid: instrumentUUID.String(),
+	})
+	if err != nil {
+		return instrumentError(err)
+	}
+	return nil
+}
+
+// instrumentError переводит статус payment в ошибки модели
+func instrumentError(err error) error {
+	message := status.Convert(err).Message()
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", model.ErrInvalidPaymentInstrument, message)
+	case codes.NotFound:
+		return model.ErrPaymentInstrumentNotFound
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return fmt.Errorf("%w: %s", model.ErrPaymentUnavailable, message)
+	}
+	return err
+}
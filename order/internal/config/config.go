@@ -17,9 +17,11 @@ type config struct {
 	Kafka                  KafkaConfig
 	OrderPaidProducer      OrderPaidProducerConfig
 	OrderAssembledConsumer OrderAssembledConsumerConfig
+	PaymentConsumer        PaymentConsumerConfig
 	InventoryGRPC          InventoryGRPCConfig
 	PaymentGRPC            PaymentGRPCConfig
 	IAMGRPC                IAMGRPCConfig
+	ServiceAuth            ServiceAuthConfig
 	MetricCollector        MetricCollectorConfig
 	Tracing                TracingConfig
 }
@@ -52,6 +54,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	serviceAuthCfg, err := env.NewServiceAuthConfig()
+	if err != nil {
+		return err
+	}
+
 	postgresCfg, err := env.NewPostgresConfig()
 	if err != nil {
 		return err
@@ -72,6 +79,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	paymentConsumerCfg, err := env.NewPaymentConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	metricCollectorCfg, err := env.NewMetricCollectorConfig()
 	if err != nil {
 		return err
@@ -89,9 +101,11 @@ func Load(path ...string) error {
 		InventoryGRPC:          inventoryGRPCCfg,
 		PaymentGRPC:            paymentGRPCCfg,
 		IAMGRPC:                iamGRPCCfg,
+		ServiceAuth:            serviceAuthCfg,
 		Kafka:                  kafkaCfg,
 		OrderPaidProducer:      orderPaidProducerCfg,
 		OrderAssembledConsumer: orderAssembledConsumerCfg,
+		PaymentConsumer:        paymentConsumerCfg,
 		MetricCollector:        metricCollectorCfg,
 		Tracing:                tracingCfg,
 	}
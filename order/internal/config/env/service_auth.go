@@ -0,0 +1,33 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type serviceAuthEnvConfig struct {
+	ServiceName string `env:"SERVICE_AUTH_NAME,required"`
+	Key         string `env:"SERVICE_AUTH_KEY,required"`
+}
+
+type serviceAuthConfig struct {
+	raw serviceAuthEnvConfig
+}
+
+func NewServiceAuthConfig() (*serviceAuthConfig, error) {
+	var raw serviceAuthEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &serviceAuthConfig{raw: raw}, nil
+}
+
+// ServiceName — имя order в сервисных токенах; должно совпадать с именем в ключах payment
+func (cfg *serviceAuthConfig) ServiceName() string {
+	return cfg.raw.ServiceName
+}
+
+// Key — ключ подписи сервисных токенов order
+func (cfg *serviceAuthConfig) Key() []byte {
+	return []byte(cfg.raw.Key)
+}
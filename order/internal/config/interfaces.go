@@ -38,6 +38,11 @@ type IAMGRPCConfig interface {
 	Address() string
 }
 
+type ServiceAuthConfig interface {
+	ServiceName() string
+	Key() []byte
+}
+
 type KafkaConfig interface {
 	Brokers() []string
 }
@@ -53,6 +58,13 @@ type OrderAssembledConsumerConfig interface {
 	Config() *sarama.Config
 }
 
+type PaymentConsumerConfig interface {
+	CompletedTopic() string
+	FailedTopic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
 type MetricCollectorConfig interface {
 	CollectorEndpoint() string
 	CollectorInterval() time.Duration
@@ -0,0 +1,220 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	sarama "github.com/IBM/sarama"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentConsumerConfig is an autogenerated mock type for the PaymentConsumerConfig type
+type PaymentConsumerConfig struct {
+	mock.Mock
+}
+
+type PaymentConsumerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentConsumerConfig) EXPECT() *PaymentConsumerConfig_Expecter {
+	return &PaymentConsumerConfig_Expecter{mock: &_m.Mock}
+}
+
+// CompletedTopic provides a mock function with no fields
+func (_m *PaymentConsumerConfig) CompletedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompletedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PaymentConsumerConfig_CompletedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompletedTopic'
+type PaymentConsumerConfig_CompletedTopic_Call struct {
+	*mock.Call
+}
+
+// CompletedTopic is a helper method to define mock.On call
+func (_e *PaymentConsumerConfig_Expecter) CompletedTopic() *PaymentConsumerConfig_CompletedTopic_Call {
+	return &PaymentConsumerConfig_CompletedTopic_Call{Call: _e.mock.On("CompletedTopic")}
+}
+
+func (_c *PaymentConsumerConfig_CompletedTopic_Call) Run(run func()) *PaymentConsumerConfig_CompletedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_CompletedTopic_Call) Return(_a0 string) *PaymentConsumerConfig_CompletedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_CompletedTopic_Call) RunAndReturn(run func() string) *PaymentConsumerConfig_CompletedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Config provides a mock function with no fields
+func (_m *PaymentConsumerConfig) Config() *sarama.Config {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 *sarama.Config
+	if rf, ok := ret.Get(0).(func() *sarama.Config); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sarama.Config)
+		}
+	}
+
+	return r0
+}
+
+// PaymentConsumerConfig_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type PaymentConsumerConfig_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *PaymentConsumerConfig_Expecter) Config() *PaymentConsumerConfig_Config_Call {
+	return &PaymentConsumerConfig_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *PaymentConsumerConfig_Config_Call) Run(run func()) *PaymentConsumerConfig_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_Config_Call) Return(_a0 *sarama.Config) *PaymentConsumerConfig_Config_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_Config_Call) RunAndReturn(run func() *sarama.Config) *PaymentConsumerConfig_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailedTopic provides a mock function with no fields
+func (_m *PaymentConsumerConfig) FailedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PaymentConsumerConfig_FailedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailedTopic'
+type PaymentConsumerConfig_FailedTopic_Call struct {
+	*mock.Call
+}
+
+// FailedTopic is a helper method to define mock.On call
+func (_e *PaymentConsumerConfig_Expecter) FailedTopic() *PaymentConsumerConfig_FailedTopic_Call {
+	return &PaymentConsumerConfig_FailedTopic_Call{Call: _e.mock.On("FailedTopic")}
+}
+
+func (_c *PaymentConsumerConfig_FailedTopic_Call) Run(run func()) *PaymentConsumerConfig_FailedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_FailedTopic_Call) Return(_a0 string) *PaymentConsumerConfig_FailedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_FailedTopic_Call) RunAndReturn(run func() string) *PaymentConsumerConfig_FailedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupID provides a mock function with no fields
+func (_m *PaymentConsumerConfig) GroupID() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupID")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PaymentConsumerConfig_GroupID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupID'
+type PaymentConsumerConfig_GroupID_Call struct {
+	*mock.Call
+}
+
+// GroupID is a helper method to define mock.On call
+func (_e *PaymentConsumerConfig_Expecter) GroupID() *PaymentConsumerConfig_GroupID_Call {
+	return &PaymentConsumerConfig_GroupID_Call{Call: _e.mock.On("GroupID")}
+}
+
+func (_c *PaymentConsumerConfig_GroupID_Call) Run(run func()) *PaymentConsumerConfig_GroupID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_GroupID_Call) Return(_a0 string) *PaymentConsumerConfig_GroupID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentConsumerConfig_GroupID_Call) RunAndReturn(run func() string) *PaymentConsumerConfig_GroupID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentConsumerConfig creates a new instance of PaymentConsumerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentConsumerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentConsumerConfig {
+	mock := &PaymentConsumerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,127 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ServiceAuthConfig is an autogenerated mock type for the ServiceAuthConfig type
+type ServiceAuthConfig struct {
+	mock.Mock
+}
+
+type ServiceAuthConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAuthConfig) EXPECT() *ServiceAuthConfig_Expecter {
+	return &ServiceAuthConfig_Expecter{mock: &_m.Mock}
+}
+
+// Key provides a mock function with no fields
+func (_m *ServiceAuthConfig) Key() []byte {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Key")
+	}
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	return r0
+}
+
+// ServiceAuthConfig_Key_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Key'
+type ServiceAuthConfig_Key_Call struct {
+	*mock.Call
+}
+
+// Key is a helper method to define mock.On call
+func (_e *ServiceAuthConfig_Expecter) Key() *ServiceAuthConfig_Key_Call {
+	return &ServiceAuthConfig_Key_Call{Call: _e.mock.On("Key")}
+}
+
+func (_c *ServiceAuthConfig_Key_Call) Run(run func()) *ServiceAuthConfig_Key_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ServiceAuthConfig_Key_Call) Return(_a0 []byte) *ServiceAuthConfig_Key_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAuthConfig_Key_Call) RunAndReturn(run func() []byte) *ServiceAuthConfig_Key_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServiceName provides a mock function with no fields
+func (_m *ServiceAuthConfig) ServiceName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ServiceAuthConfig_ServiceName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServiceName'
+type ServiceAuthConfig_ServiceName_Call struct {
+	*mock.Call
+}
+
+// ServiceName is a helper method to define mock.On call
+func (_e *ServiceAuthConfig_Expecter) ServiceName() *ServiceAuthConfig_ServiceName_Call {
+	return &ServiceAuthConfig_ServiceName_Call{Call: _e.mock.On("ServiceName")}
+}
+
+func (_c *ServiceAuthConfig_ServiceName_Call) Run(run func()) *ServiceAuthConfig_ServiceName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ServiceAuthConfig_ServiceName_Call) Return(_a0 string) *ServiceAuthConfig_ServiceName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAuthConfig_ServiceName_Call) RunAndReturn(run func() string) *ServiceAuthConfig_ServiceName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAuthConfig creates a new instance of ServiceAuthConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAuthConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAuthConfig {
+	mock := &ServiceAuthConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
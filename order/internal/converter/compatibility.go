@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	api "github.com/nkolesnikov999/micro2-OK/shared/pkg/openapi/order/v1"
+)
+
+func ToAPIRuleViolations(violations []model.RuleViolation) []api.RuleViolation {
+	result := make([]api.RuleViolation, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, api.RuleViolation{
+			RuleUUID:  v.RuleUuid,
+			Type:      ToAPICompatibilityRuleType(v.Type),
+			Message:   v.Message,
+			PartUuids: v.PartUuids,
+		})
+	}
+	return result
+}
+
+func ToAPICompatibilityRuleType(ruleType model.CompatibilityRuleType) api.CompatibilityRuleType {
+	switch ruleType {
+	case model.CompatibilityRuleTypeRequires:
+		return api.CompatibilityRuleTypeCOMPATIBILITYRULETYPEREQUIRES
+	case model.CompatibilityRuleTypeExcludes:
+		return api.CompatibilityRuleTypeCOMPATIBILITYRULETYPEEXCLUDES
+	case model.CompatibilityRuleTypeMaxPerOrder:
+		return api.CompatibilityRuleTypeCOMPATIBILITYRULETYPEMAXPERORDER
+	case model.CompatibilityRuleTypeCompatibleWith:
+		return api.CompatibilityRuleTypeCOMPATIBILITYRULETYPECOMPATIBLEWITH
+	default:
+		return api.CompatibilityRuleTypeCOMPATIBILITYRULETYPEUNSPECIFIED
+	}
+}
@@ -0,0 +1,33 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	eventsV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/events/v1"
+)
+
+type paymentCompletedDecoder struct{}
+
+func NewPaymentCompletedDecoder() *paymentCompletedDecoder {
+	return &paymentCompletedDecoder{}
+}
+
+func (d *paymentCompletedDecoder) Decode(data []byte) (model.PaymentCompletedEvent, error) {
+	var pb eventsV1.PaymentCompleted
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.PaymentCompletedEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.PaymentCompletedEvent{
+		EventUUID:       pb.EventUuid,
+		OrderUUID:       pb.OrderUuid,
+		UserUUID:        pb.UserUuid,
+		TransactionUUID: pb.TransactionUuid,
+		PaymentMethod:   pb.PaymentMethod,
+		Amount:          pb.Amount,
+		Currency:        pb.Currency,
+	}, nil
+}
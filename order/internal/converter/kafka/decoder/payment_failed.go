@@ -0,0 +1,32 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	eventsV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/events/v1"
+)
+
+type paymentFailedDecoder struct{}
+
+func NewPaymentFailedDecoder() *paymentFailedDecoder {
+	return &paymentFailedDecoder{}
+}
+
+func (d *paymentFailedDecoder) Decode(data []byte) (model.PaymentFailedEvent, error) {
+	var pb eventsV1.PaymentFailed
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return model.PaymentFailedEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return model.PaymentFailedEvent{
+		EventUUID:       pb.EventUuid,
+		OrderUUID:       pb.OrderUuid,
+		UserUUID:        pb.UserUuid,
+		TransactionUUID: pb.TransactionUuid,
+		PaymentMethod:   pb.PaymentMethod,
+		Reason:          pb.Reason,
+	}, nil
+}
@@ -5,3 +5,11 @@ import "github.com/nkolesnikov999/micro2-OK/order/internal/model"
 type OrderAssembledDecoder interface {
 	Decode(data []byte) (model.ShipAssembledEvent, error)
 }
+
+type PaymentCompletedDecoder interface {
+	Decode(data []byte) (model.PaymentCompletedEvent, error)
+}
+
+type PaymentFailedDecoder interface {
+	Decode(data []byte) (model.PaymentFailedEvent, error)
+}
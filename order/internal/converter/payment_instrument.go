@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	api "github.com/nkolesnikov999/micro2-OK/shared/pkg/openapi/order/v1"
+)
+
+func ToAPIPaymentInstruments(instruments []model.PaymentInstrument) []api.PaymentInstrumentDto {
+	result := make([]api.PaymentInstrumentDto, 0, len(instruments))
+	for _, instrument := range instruments {
+		result = append(result, *ToAPIPaymentInstrument(instrument))
+	}
+	return result
+}
+
+func ToAPIPaymentInstrument(instrument model.PaymentInstrument) *api.PaymentInstrumentDto {
+	dto := &api.PaymentInstrumentDto{
+		InstrumentUUID: instrument.UUID,
+		PaymentMethod:  ToAPIPaymentMethod(instrument.PaymentMethod),
+		IsDefault:      instrument.IsDefault,
+		CreatedAt:      instrument.CreatedAt,
+	}
+	if instrument.CardLast4 != "" {
+		dto.CardLast4 = api.NewOptString(instrument.CardLast4)
+	}
+	if instrument.SBPPhoneMasked != "" {
+		dto.SbpPhoneMasked = api.NewOptString(instrument.SBPPhoneMasked)
+	}
+	return dto
+}
+
+// ToAPIPaymentMethod converts service layer payment method ("CARD") to OpenAPI PaymentMethod enum
+func ToAPIPaymentMethod(paymentMethod string) api.PaymentMethod {
+	switch paymentMethod {
+	case "CARD":
+		return api.PaymentMethodPAYMENTMETHODCARD
+	case "SBP":
+		return api.PaymentMethodPAYMENTMETHODSBP
+	case "CREDIT_CARD":
+		return api.PaymentMethodPAYMENTMETHODCREDITCARD
+	case "INVESTOR_MONEY":
+		return api.PaymentMethodPAYMENTMETHODINVESTORMONEY
+	default:
+		return api.PaymentMethodPAYMENTMETHODUNKNOWN
+	}
+}
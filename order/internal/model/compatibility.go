@@ -0,0 +1,21 @@
+package model
+
+import "github.com/google/uuid"
+
+type CompatibilityRuleType int32
+
+const (
+	CompatibilityRuleTypeUnspecified    CompatibilityRuleType = 0
+	CompatibilityRuleTypeRequires       CompatibilityRuleType = 1
+	CompatibilityRuleTypeExcludes       CompatibilityRuleType = 2
+	CompatibilityRuleTypeMaxPerOrder    CompatibilityRuleType = 3
+	CompatibilityRuleTypeCompatibleWith CompatibilityRuleType = 4
+)
+
+// RuleViolation описывает нарушенное правило совместимости деталей
+type RuleViolation struct {
+	RuleUuid  uuid.UUID
+	Type      CompatibilityRuleType
+	Message   string
+	PartUuids []uuid.UUID
+}
@@ -8,14 +8,21 @@ import (
 var (
 	ErrOrderAlreadyExists    = errors.New("order already exists")
 	ErrOrderNotFound         = errors.New("order not found")
-	ErrEmptyPartUUIDs        = errors.New("part_uuids must not be empty")
+	ErrEmptyPartUUIDs        = errors.New("part_uuids and kit_uuids must not both be empty")
 	ErrPartsNotFound         = errors.New("one or more parts not found")
+	ErrKitsNotFound          = errors.New("one or more kits not found")
 	ErrOrderNotPayable       = errors.New("order cannot be paid")
 	ErrCannotCancelPaidOrder = errors.New("order already paid and cannot be cancelled")
+	ErrInvalidConfiguration  = errors.New("parts configuration violates compatibility rules")
+
+	ErrPaymentInstrumentNotFound = errors.New("payment instrument not found")
+	ErrInvalidPaymentInstrument  = errors.New("invalid payment instrument")
 
 	// Service-level failure categories
 	ErrInventoryUnavailable = errors.New("inventory service unavailable")
 	ErrPaymentFailed        = errors.New("payment failed")
+	ErrPaymentRejected      = errors.New("payment rejected")
+	ErrPaymentUnavailable   = errors.New("payment gateway unavailable")
 	ErrOrderCreateFailed    = errors.New("order create failed")
 	ErrOrderUpdateFailed    = errors.New("order update failed")
 	ErrOrderGetFailed       = errors.New("order get failed")
@@ -34,3 +41,42 @@ func (e *PartsNotFoundError) Error() string {
 func (e *PartsNotFoundError) Unwrap() error {
 	return ErrPartsNotFound
 }
+
+// KitsNotFoundError содержит информацию об отсутствующих комплектах
+type KitsNotFoundError struct {
+	MissingUUIDs []string
+}
+
+func (e *KitsNotFoundError) Error() string {
+	return fmt.Sprintf("one or more kits not found: missing UUIDs: %v", e.MissingUUIDs)
+}
+
+func (e *KitsNotFoundError) Unwrap() error {
+	return ErrKitsNotFound
+}
+
+// ConfigurationInvalidError содержит нарушения правил совместимости деталей
+type ConfigurationInvalidError struct {
+	Violations []RuleViolation
+}
+
+func (e *ConfigurationInvalidError) Error() string {
+	return fmt.Sprintf("parts configuration violates compatibility rules: %d violation(s)", len(e.Violations))
+}
+
+func (e *ConfigurationInvalidError) Unwrap() error {
+	return ErrInvalidConfiguration
+}
+
+// PaymentRejectedError содержит причину, по которой payment отклонил оплату
+type PaymentRejectedError struct {
+	Reason string
+}
+
+func (e *PaymentRejectedError) Error() string {
+	return fmt.Sprintf("payment rejected: %s", e.Reason)
+}
+
+func (e *PaymentRejectedError) Unwrap() error {
+	return ErrPaymentRejected
+}
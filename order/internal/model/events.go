@@ -14,3 +14,22 @@ type ShipAssembledEvent struct {
 	UserUUID     string
 	BuildTimeSec int64
 }
+
+type PaymentCompletedEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	TransactionUUID string
+	PaymentMethod   string
+	Amount          float64
+	Currency        string
+}
+
+type PaymentFailedEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	TransactionUUID string
+	PaymentMethod   string
+	Reason          string
+}
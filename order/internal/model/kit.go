@@ -0,0 +1,18 @@
+package model
+
+import "github.com/google/uuid"
+
+// Kit — комплект деталей из inventory. В заказе разворачивается в детали состава
+type Kit struct {
+	Uuid uuid.UUID
+	Name string
+	// Состав комплекта
+	Components []KitComponent
+	// Действующая цена комплекта: ручная или сумма цен деталей
+	Price float64
+}
+
+type KitComponent struct {
+	PartUuid uuid.UUID
+	Quantity int64
+}
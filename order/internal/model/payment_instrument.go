@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentInstrument — сохранённый в payment платёжный инструмент пользователя.
+// CardToken и SBPPhone задаются только при добавлении: payment их не возвращает
+type PaymentInstrument struct {
+	UUID           uuid.UUID
+	UserUUID       uuid.UUID
+	PaymentMethod  string
+	CardToken      string
+	CardLast4      string
+	SBPPhone       string
+	SBPPhoneMasked string
+	IsDefault      bool
+	CreatedAt      time.Time
+}
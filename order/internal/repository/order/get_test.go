@@ -210,3 +210,22 @@ func (s *RepositorySuite) TestGetOrderWithManyPartUUIDs() {
 	s.Equal(testOrder.TotalPrice, result.TotalPrice)
 	s.Equal(testOrder.Status, result.Status)
 }
+
+func (s *RepositorySuite) TestGetOrderRepeatedParts() {
+	// Повторы UUID (например, из состава комплекта) хранятся одной строкой с количеством
+	partUUID := uuid.New()
+	testOrder := model.Order{
+		OrderUUID:  uuid.New(),
+		UserUUID:   uuid.New(),
+		PartUuids:  []uuid.UUID{partUUID, partUUID, partUUID},
+		TotalPrice: 30,
+		Status:     "PENDING_PAYMENT",
+	}
+
+	err := s.repository.CreateOrder(s.ctx, testOrder, model.PartsFilter{Uuids: testOrder.PartUuids}, []model.Part{{Uuid: partUUID}})
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetOrder(s.ctx, testOrder.OrderUUID)
+	s.Require().NoError(err)
+	s.Equal(testOrder.PartUuids, result.PartUuids)
+}
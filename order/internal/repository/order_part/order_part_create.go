@@ -14,8 +14,9 @@ func CreateOrderParts(ctx context.Context, conn *pgx.Conn, orderUUID uuid.UUID,
 
 	query := `INSERT INTO order_parts (order_uuid, part_uuid, quantity) VALUES ($1, $2, $3)`
 
-	for _, partUUID := range partUuids {
-		_, err := conn.Exec(ctx, query, orderUUID, partUUID, 1)
+	uniqueUuids, quantities := countParts(partUuids)
+	for i, partUUID := range uniqueUuids {
+		_, err := conn.Exec(ctx, query, orderUUID, partUUID, quantities[i])
 		if err != nil {
 			return err
 		}
@@ -23,3 +24,22 @@ func CreateOrderParts(ctx context.Context, conn *pgx.Conn, orderUUID uuid.UUID,
 
 	return nil
 }
+
+// countParts сворачивает повторяющиеся UUID в количество, сохраняя порядок первого упоминания
+func countParts(partUuids []uuid.UUID) ([]uuid.UUID, []int32) {
+	index := make(map[uuid.UUID]int, len(partUuids))
+	uniqueUuids := make([]uuid.UUID, 0, len(partUuids))
+	quantities := make([]int32, 0, len(partUuids))
+
+	for _, partUUID := range partUuids {
+		if i, ok := index[partUUID]; ok {
+			quantities[i]++
+			continue
+		}
+		index[partUUID] = len(uniqueUuids)
+		uniqueUuids = append(uniqueUuids, partUUID)
+		quantities = append(quantities, 1)
+	}
+
+	return uniqueUuids, quantities
+}
@@ -7,8 +7,9 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+// ListOrderParts возвращает детали заказа, повторяя UUID по количеству
 func ListOrderParts(ctx context.Context, conn *pgx.Conn, orderUUID uuid.UUID) ([]uuid.UUID, error) {
-	query := `SELECT part_uuid FROM order_parts WHERE order_uuid = $1`
+	query := `SELECT part_uuid, quantity FROM order_parts WHERE order_uuid = $1`
 	rows, err := conn.Query(ctx, query, orderUUID)
 	if err != nil {
 		return nil, err
@@ -17,11 +18,16 @@ func ListOrderParts(ctx context.Context, conn *pgx.Conn, orderUUID uuid.UUID) ([
 
 	partUuids := make([]uuid.UUID, 0)
 	for rows.Next() {
-		var partUUID uuid.UUID
-		if err := rows.Scan(&partUUID); err != nil {
+		var (
+			partUUID uuid.UUID
+			quantity int32
+		)
+		if err := rows.Scan(&partUUID, &quantity); err != nil {
 			return nil, err
 		}
-		partUuids = append(partUuids, partUUID)
+		for range quantity {
+			partUuids = append(partUuids, partUUID)
+		}
 	}
 
 	return partUuids, rows.Err()
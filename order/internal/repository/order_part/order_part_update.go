@@ -39,10 +39,11 @@ func UpdateOrderPartsTx(ctx context.Context, tx pgx.Tx, orderUUID uuid.UUID, par
 	}
 
 	if len(partUuids) > 0 {
+		uniqueUuids, quantities := countParts(partUuids)
 		if _, err := tx.Exec(ctx, `
 INSERT INTO order_parts (order_uuid, part_uuid, quantity)
-SELECT $1::uuid, UNNEST($2::uuid[]), 1
-`, orderUUID, partUuids); err != nil {
+SELECT $1::uuid, UNNEST($2::uuid[]), UNNEST($3::integer[])
+`, orderUUID, uniqueUuids, quantities); err != nil {
 			return fmt.Errorf("insert order_parts: %w", err)
 		}
 	}
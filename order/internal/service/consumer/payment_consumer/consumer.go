@@ -0,0 +1,56 @@
+package paymentconsumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/nkolesnikov999/micro2-OK/order/internal/converter/kafka"
+	"github.com/nkolesnikov999/micro2-OK/order/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/order/internal/service"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+var _ def.ConsumerService = (*service)(nil)
+
+type service struct {
+	paymentConsumer          kafka.Consumer
+	paymentCompletedTopic    string
+	paymentFailedTopic       string
+	paymentCompletedDecoder  kafkaConverter.PaymentCompletedDecoder
+	paymentFailedDecoder     kafkaConverter.PaymentFailedDecoder
+	orderRepository          repository.OrderRepository
+	orderPaidProducerService def.OrderPaidProducerService
+}
+
+func NewService(paymentConsumer kafka.Consumer,
+	paymentCompletedTopic string,
+	paymentFailedTopic string,
+	paymentCompletedDecoder kafkaConverter.PaymentCompletedDecoder,
+	paymentFailedDecoder kafkaConverter.PaymentFailedDecoder,
+	orderRepository repository.OrderRepository,
+	orderPaidProducerService def.OrderPaidProducerService,
+) *service {
+	return &service{
+		paymentConsumer:          paymentConsumer,
+		paymentCompletedTopic:    paymentCompletedTopic,
+		paymentFailedTopic:       paymentFailedTopic,
+		paymentCompletedDecoder:  paymentCompletedDecoder,
+		paymentFailedDecoder:     paymentFailedDecoder,
+		orderRepository:          orderRepository,
+		orderPaidProducerService: orderPaidProducerService,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting payment result consumer service")
+
+	err := s.paymentConsumer.Consume(ctx, s.PaymentHandler)
+	if err != nil {
+		logger.Error(ctx, "Consume from payment topics error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
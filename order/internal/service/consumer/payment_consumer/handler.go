@@ -0,0 +1,172 @@
+package paymentconsumer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	orderMetrics "github.com/nkolesnikov999/micro2-OK/order/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka/consumer"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// PaymentHandler разбирает события об итоге оплаты по топику сообщения
+func (s *service) PaymentHandler(ctx context.Context, msg consumer.Message) error {
+	switch msg.Topic {
+	case s.paymentCompletedTopic:
+		return s.handlePaymentCompleted(ctx, msg)
+	case s.paymentFailedTopic:
+		return s.handlePaymentFailed(ctx, msg)
+	default:
+		logger.Warn(ctx, "Unexpected topic, skipping message", zap.String("topic", msg.Topic))
+		return nil
+	}
+}
+
+func (s *service) handlePaymentCompleted(ctx context.Context, msg consumer.Message) error {
+	event, err := s.paymentCompletedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode PaymentCompleted", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+	)
+
+	order, found, err := s.getOrder(ctx, event.OrderUUID)
+	if err != nil || !found {
+		return err
+	}
+
+	// Повторное событие для уже оплаченного заказа не должно второй раз учитывать выручку и слать OrderPaid
+	if order.Status != "PENDING_PAYMENT" && order.Status != "PAYMENT_PROCESSING" {
+		logger.Info(ctx, "Order is already processed, skipping PaymentCompleted",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.String("status", order.Status))
+		return nil
+	}
+
+	order.Status = "PAID"
+	order.TransactionUUID = event.TransactionUUID
+	order.PaymentMethod = event.PaymentMethod
+	order.UpdatedAt = time.Now()
+
+	err = s.orderRepository.UpdateOrder(ctx, order.OrderUUID, order)
+	if err != nil {
+		logger.Error(ctx, "Failed to update order status to PAID",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.Error(err))
+		return err
+	}
+
+	// Увеличиваем бизнес-метрику выручки на сумму оплаченного заказа.
+	// Метрика OrdersRevenueTotal — монотонно возрастающий счетчик общей выручки.
+	orderMetrics.OrdersRevenueTotal.Add(ctx, order.TotalPrice)
+
+	err = s.orderPaidProducerService.ProduceOrderPaid(ctx, model.OrderPaidEvent{
+		EventUUID:       uuid.New().String(),
+		OrderUUID:       event.OrderUUID,
+		UserUUID:        order.UserUUID.String(),
+		PaymentMethod:   event.PaymentMethod,
+		TransactionUUID: event.TransactionUUID,
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to produce OrderPaid",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.Error(err))
+		return model.ErrOrderProducerFailed
+	}
+
+	logger.Info(ctx, "Order status updated to PAID",
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("transaction_uuid", event.TransactionUUID))
+
+	return nil
+}
+
+func (s *service) handlePaymentFailed(ctx context.Context, msg consumer.Message) error {
+	event, err := s.paymentFailedDecoder.Decode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode PaymentFailed", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Processing message",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+		zap.String("reason", event.Reason),
+	)
+
+	order, found, err := s.getOrder(ctx, event.OrderUUID)
+	if err != nil || !found {
+		return err
+	}
+
+	// Откатываем только незавершённую оплату: PAID заказ уже оплачен другой транзакцией
+	if order.Status != "PAYMENT_PROCESSING" {
+		logger.Info(ctx, "Order is not waiting for payment result, skipping PaymentFailed",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.String("status", order.Status))
+		return nil
+	}
+
+	order.Status = "PENDING_PAYMENT"
+	order.TransactionUUID = ""
+	order.PaymentMethod = ""
+	order.UpdatedAt = time.Now()
+
+	err = s.orderRepository.UpdateOrder(ctx, order.OrderUUID, order)
+	if err != nil {
+		logger.Error(ctx, "Failed to update order status to PENDING_PAYMENT",
+			zap.String("order_uuid", event.OrderUUID),
+			zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "Order returned to PENDING_PAYMENT after failed payment",
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("reason", event.Reason))
+
+	return nil
+}
+
+// getOrder возвращает found=false без ошибки, если заказа нет: такое событие не обработать повтором
+func (s *service) getOrder(ctx context.Context, rawOrderUUID string) (model.Order, bool, error) {
+	orderUUID, err := uuid.Parse(rawOrderUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to parse order UUID",
+			zap.String("order_uuid", rawOrderUUID),
+			zap.Error(err))
+		return model.Order{}, false, err
+	}
+
+	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get order",
+			zap.String("order_uuid", rawOrderUUID),
+			zap.Error(err))
+		if errors.Is(err, model.ErrOrderNotFound) {
+			// Логируем, но не возвращаем ошибку, чтобы не зацикливать обработку
+			logger.Warn(ctx, "Order not found, skipping status update",
+				zap.String("order_uuid", rawOrderUUID))
+			return model.Order{}, false, nil
+		}
+		return model.Order{}, false, err
+	}
+
+	return order, true, nil
+}
@@ -0,0 +1,54 @@
+package instrument
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) AddPaymentInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error) {
+	saved, err := s.paymentClient.AddPaymentInstrument(ctx, instrument)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to add payment instrument",
+			zap.String("userUUID", instrument.UserUUID.String()),
+			zap.String("paymentMethod", instrument.PaymentMethod),
+			zap.Error(err),
+		)
+		return model.PaymentInstrument{}, err
+	}
+
+	return saved, nil
+}
+
+func (s *service) ListPaymentInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	instruments, err := s.paymentClient.ListPaymentInstruments(ctx, userUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list payment instruments",
+			zap.String("userUUID", userUUID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return instruments, nil
+}
+
+func (s *service) DeletePaymentInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error {
+	if err := s.paymentClient.DeletePaymentInstrument(ctx, userUUID, instrumentUUID); err != nil {
+		logger.Error(ctx,
+			"failed to delete payment instrument",
+			zap.String("userUUID", userUUID.String()),
+			zap.String("instrumentUUID", instrumentUUID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package instrument
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+)
+
+func (s *ServiceSuite) TestAddPaymentInstrument() {
+	instrument := model.PaymentInstrument{
+		UserUUID:      uuid.New(),
+		PaymentMethod: "CARD",
+		CardToken:     "tok_visa",
+		CardLast4:     "4242",
+		IsDefault:     true,
+	}
+	saved := model.PaymentInstrument{
+		UUID:          uuid.New(),
+		UserUUID:      instrument.UserUUID,
+		PaymentMethod: "CARD",
+		CardLast4:     "4242",
+		IsDefault:     true,
+	}
+	s.paymentClient.On("AddPaymentInstrument", mock.Anything, instrument).Return(saved, nil)
+
+	result, err := s.service.AddPaymentInstrument(s.ctx, instrument)
+	s.Require().NoError(err)
+	s.Require().Equal(saved, result)
+}
+
+func (s *ServiceSuite) TestAddPaymentInstrumentInvalid() {
+	s.paymentClient.On("AddPaymentInstrument", mock.Anything, mock.Anything).
+		Return(model.PaymentInstrument{}, model.ErrInvalidPaymentInstrument)
+
+	_, err := s.service.AddPaymentInstrument(s.ctx, model.PaymentInstrument{UserUUID: uuid.New(), PaymentMethod: "SBP"})
+	s.Require().ErrorIs(err, model.ErrInvalidPaymentInstrument)
+}
+
+func (s *ServiceSuite) TestListPaymentInstruments() {
+	userUUID := uuid.New()
+	instruments := []model.PaymentInstrument{
+		{UUID: uuid.New(), UserUUID: userUUID, PaymentMethod: "CARD", IsDefault: true},
+		{UUID: uuid.New(), UserUUID: userUUID, PaymentMethod: "SBP"},
+	}
+	s.paymentClient.On("ListPaymentInstruments", mock.Anything, userUUID).Return(instruments, nil)
+
+	result, err := s.service.ListPaymentInstruments(s.ctx, userUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(instruments, result)
+}
+
+func (s *ServiceSuite) TestDeletePaymentInstrumentNotFound() {
+	userUUID, instrumentUUID := uuid.New(), uuid.New()
+	s.paymentClient.On("DeletePaymentInstrument", mock.Anything, userUUID, instrumentUUID).
+		Return(model.ErrPaymentInstrumentNotFound)
+
+	err := s.service.DeletePaymentInstrument(s.ctx, userUUID, instrumentUUID)
+	s.Require().ErrorIs(err, model.ErrPaymentInstrumentNotFound)
+}
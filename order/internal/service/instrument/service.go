@@ -0,0 +1,20 @@
+package instrument
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/order/internal/client/grpc"
+	def "github.com/nkolesnikov999/micro2-OK/order/internal/service"
+)
+
+var _ def.PaymentInstrumentService = (*service)(nil)
+
+// service проксирует управление платёжными инструментами в payment:
+// order — единственная точка входа для пользователя, payment наружу не публикуется
+type service struct {
+	paymentClient grpc.PaymentClient
+}
+
+func NewService(paymentClient grpc.PaymentClient) *service {
+	return &service{
+		paymentClient: paymentClient,
+	}
+}
@@ -74,9 +74,9 @@ func (_c *OrderService_CancelOrder_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// CreateOrder provides a mock function with given fields: ctx, userUUID, partUUIDs
-func (_m *OrderService) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID) (model.Order, error) {
-	ret := _m.Called(ctx, userUUID, partUUIDs)
+// CreateOrder provides a mock function with given fields: ctx, userUUID, partUUIDs, kitUUIDs
+func (_m *OrderService) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID, kitUUIDs []uuid.UUID) (model.Order, error) {
+	ret := _m.Called(ctx, userUUID, partUUIDs, kitUUIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -84,17 +84,17 @@ func (_m *OrderService) CreateOrder(ctx context.Context, userUUID uuid.UUID, par
 
 	var r0 model.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (model.Order, error)); ok {
-		return rf(ctx, userUUID, partUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) (model.Order, error)); ok {
+		return rf(ctx, userUUID, partUUIDs, kitUUIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) model.Order); ok {
-		r0 = rf(ctx, userUUID, partUUIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) model.Order); ok {
+		r0 = rf(ctx, userUUID, partUUIDs, kitUUIDs)
 	} else {
 		r0 = ret.Get(0).(model.Order)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
-		r1 = rf(ctx, userUUID, partUUIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID, partUUIDs, kitUUIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,13 +111,14 @@ type OrderService_CreateOrder_Call struct {
 //   - ctx context.Context
 //   - userUUID uuid.UUID
 //   - partUUIDs []uuid.UUID
-func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, partUUIDs interface{}) *OrderService_CreateOrder_Call {
-	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, partUUIDs)}
+//   - kitUUIDs []uuid.UUID
+func (_e *OrderService_Expecter) CreateOrder(ctx interface{}, userUUID interface{}, partUUIDs interface{}, kitUUIDs interface{}) *OrderService_CreateOrder_Call {
+	return &OrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userUUID, partUUIDs, kitUUIDs)}
 }
 
-func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID, kitUUIDs []uuid.UUID)) *OrderService_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID), args[3].([]uuid.UUID))
 	})
 	return _c
 }
@@ -127,7 +128,7 @@ func (_c *OrderService_CreateOrder_Call) Return(_a0 model.Order, _a1 error) *Ord
 	return _c
 }
 
-func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID) (model.Order, error)) *OrderService_CreateOrder_Call {
+func (_c *OrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, []uuid.UUID, []uuid.UUID) (model.Order, error)) *OrderService_CreateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -189,9 +190,9 @@ func (_c *OrderService_GetOrder_Call) RunAndReturn(run func(context.Context, uui
 	return _c
 }
 
-// PayOrder provides a mock function with given fields: ctx, orderUUID, paymentMethod
-func (_m *OrderService) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, orderUUID, paymentMethod)
+// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod, instrumentUUID
+func (_m *OrderService) PayOrder(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod string, instrumentUUID uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -199,17 +200,17 @@ func (_m *OrderService) PayOrder(ctx context.Context, orderUUID uuid.UUID, payme
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
-		return rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) (string, error)); ok {
+		return rf(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
-		r0 = rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) string); ok {
+		r0 = rf(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, orderUUID, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, paymentMethod, instrumentUUID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -225,14 +226,16 @@ type OrderService_PayOrder_Call struct {
 // PayOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - orderUUID uuid.UUID
+//   - userUUID uuid.UUID
 //   - paymentMethod string
-func (_e *OrderService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, paymentMethod interface{}) *OrderService_PayOrder_Call {
-	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, paymentMethod)}
+//   - instrumentUUID uuid.UUID
+func (_e *OrderService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}, instrumentUUID interface{}) *OrderService_PayOrder_Call {
+	return &OrderService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod, instrumentUUID)}
 }
 
-func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, paymentMethod string)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod string, instrumentUUID uuid.UUID)) *OrderService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(uuid.UUID))
 	})
 	return _c
 }
@@ -242,7 +245,7 @@ func (_c *OrderService_PayOrder_Call) Return(_a0 string, _a1 error) *OrderServic
 	return _c
 }
 
-func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (string, error)) *OrderService_PayOrder_Call {
+func (_c *OrderService_PayOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) (string, error)) *OrderService_PayOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,206 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/order/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PaymentInstrumentService is an autogenerated mock type for the PaymentInstrumentService type
+type PaymentInstrumentService struct {
+	mock.Mock
+}
+
+type PaymentInstrumentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentInstrumentService) EXPECT() *PaymentInstrumentService_Expecter {
+	return &PaymentInstrumentService_Expecter{mock: &_m.Mock}
+}
+
+// AddPaymentInstrument provides a mock function with given fields: ctx, instrument
+func (_m *PaymentInstrumentService) AddPaymentInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPaymentInstrument")
+	}
+
+	var r0 model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentInstrument) (model.PaymentInstrument, error)); ok {
+		return rf(ctx, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentInstrument) model.PaymentInstrument); ok {
+		r0 = rf(ctx, instrument)
+	} else {
+		r0 = ret.Get(0).(model.PaymentInstrument)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PaymentInstrument) error); ok {
+		r1 = rf(ctx, instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentInstrumentService_AddPaymentInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPaymentInstrument'
+type PaymentInstrumentService_AddPaymentInstrument_Call struct {
+	*mock.Call
+}
+
+// AddPaymentInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instrument model.PaymentInstrument
+func (_e *PaymentInstrumentService_Expecter) AddPaymentInstrument(ctx interface{}, instrument interface{}) *PaymentInstrumentService_AddPaymentInstrument_Call {
+	return &PaymentInstrumentService_AddPaymentInstrument_Call{Call: _e.mock.On("AddPaymentInstrument", ctx, instrument)}
+}
+
+func (_c *PaymentInstrumentService_AddPaymentInstrument_Call) Run(run func(ctx context.Context, instrument model.PaymentInstrument)) *PaymentInstrumentService_AddPaymentInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PaymentInstrument))
+	})
+	return _c
+}
+
+func (_c *PaymentInstrumentService_AddPaymentInstrument_Call) Return(_a0 model.PaymentInstrument, _a1 error) *PaymentInstrumentService_AddPaymentInstrument_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentInstrumentService_AddPaymentInstrument_Call) RunAndReturn(run func(context.Context, model.PaymentInstrument) (model.PaymentInstrument, error)) *PaymentInstrumentService_AddPaymentInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePaymentInstrument provides a mock function with given fields: ctx, userUUID, instrumentUUID
+func (_m *PaymentInstrumentService) DeletePaymentInstrument(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, userUUID, instrumentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePaymentInstrument")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userUUID, instrumentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentInstrumentService_DeletePaymentInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePaymentInstrument'
+type PaymentInstrumentService_DeletePaymentInstrument_Call struct {
+	*mock.Call
+}
+
+// DeletePaymentInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - instrumentUUID uuid.UUID
+func (_e *PaymentInstrumentService_Expecter) DeletePaymentInstrument(ctx interface{}, userUUID interface{}, instrumentUUID interface{}) *PaymentInstrumentService_DeletePaymentInstrument_Call {
+	return &PaymentInstrumentService_DeletePaymentInstrument_Call{Call: _e.mock.On("DeletePaymentInstrument", ctx, userUUID, instrumentUUID)}
+}
+
+func (_c *PaymentInstrumentService_DeletePaymentInstrument_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID)) *PaymentInstrumentService_DeletePaymentInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PaymentInstrumentService_DeletePaymentInstrument_Call) Return(_a0 error) *PaymentInstrumentService_DeletePaymentInstrument_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentInstrumentService_DeletePaymentInstrument_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *PaymentInstrumentService_DeletePaymentInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPaymentInstruments provides a mock function with given fields: ctx, userUUID
+func (_m *PaymentInstrumentService) ListPaymentInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPaymentInstruments")
+	}
+
+	var r0 []model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.PaymentInstrument); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PaymentInstrument)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentInstrumentService_ListPaymentInstruments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPaymentInstruments'
+type PaymentInstrumentService_ListPaymentInstruments_Call struct {
+	*mock.Call
+}
+
+// ListPaymentInstruments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *PaymentInstrumentService_Expecter) ListPaymentInstruments(ctx interface{}, userUUID interface{}) *PaymentInstrumentService_ListPaymentInstruments_Call {
+	return &PaymentInstrumentService_ListPaymentInstruments_Call{Call: _e.mock.On("ListPaymentInstruments", ctx, userUUID)}
+}
+
+func (_c *PaymentInstrumentService_ListPaymentInstruments_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *PaymentInstrumentService_ListPaymentInstruments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PaymentInstrumentService_ListPaymentInstruments_Call) Return(_a0 []model.PaymentInstrument, _a1 error) *PaymentInstrumentService_ListPaymentInstruments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentInstrumentService_ListPaymentInstruments_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)) *PaymentInstrumentService_ListPaymentInstruments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentInstrumentService creates a new instance of PaymentInstrumentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentInstrumentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentInstrumentService {
+	mock := &PaymentInstrumentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
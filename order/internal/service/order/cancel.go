@@ -35,6 +35,16 @@ func (s *service) CancelOrder(ctx context.Context, orderUUID uuid.UUID) error {
 		return model.ErrCannotCancelPaidOrder
 	}
 
+	// Итог оплаты ещё не пришёл: отмена могла бы разойтись с уже списанными деньгами
+	if order.Status == "PAYMENT_PROCESSING" {
+		logger.Error(ctx,
+			"cannot cancel order while payment is processing",
+			zap.String("orderUUID", orderUUID.String()),
+			zap.Any("order", order),
+		)
+		return model.ErrCannotCancelPaidOrder
+	}
+
 	if order.Status == "PENDING_PAYMENT" {
 		order.Status = "CANCELLED"
 		order.UpdatedAt = time.Now()
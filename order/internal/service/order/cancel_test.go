@@ -79,6 +79,22 @@ func (s *ServiceSuite) TestCancelOrderAlreadyPaid() {
 	s.ErrorIs(err, model.ErrCannotCancelPaidOrder)
 }
 
+func (s *ServiceSuite) TestCancelOrderPaymentProcessing() {
+	order := model.Order{
+		OrderUUID:     uuid.New(),
+		UserUUID:      uuid.New(),
+		PartUuids:     []uuid.UUID{uuid.New()},
+		TotalPrice:    gofakeit.Price(100, 1000),
+		PaymentMethod: "CARD",
+		Status:        "PAYMENT_PROCESSING", // payment result is not known yet
+	}
+
+	s.orderRepository.On("GetOrder", s.ctx, order.OrderUUID).Return(order, nil)
+
+	err := s.service.CancelOrder(s.ctx, order.OrderUUID)
+	s.ErrorIs(err, model.ErrCannotCancelPaidOrder)
+}
+
 func (s *ServiceSuite) TestCancelOrderAlreadyCancelled() {
 	order := model.Order{
 		OrderUUID:       uuid.New(),
@@ -13,47 +13,77 @@ import (
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 )
 
-func (s *service) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID) (model.Order, error) {
-	if len(partUUIDs) == 0 {
+func (s *service) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs, kitUUIDs []uuid.UUID) (model.Order, error) {
+	if len(partUUIDs) == 0 && len(kitUUIDs) == 0 {
 		logger.Error(ctx,
-			"empty part UUIDs",
+			"empty part and kit UUIDs",
 			zap.String("userUUID", userUUID.String()),
 			zap.Any("partUUIDs", partUUIDs),
+			zap.Any("kitUUIDs", kitUUIDs),
 		)
 		return model.Order{}, model.ErrEmptyPartUUIDs
 	}
 
-	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: partUUIDs})
+	kits, err := s.loadKits(ctx, kitUUIDs)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to load kits",
+			zap.String("userUUID", userUUID.String()),
+			zap.Any("kitUUIDs", kitUUIDs),
+			zap.Error(err),
+		)
+		return model.Order{}, err
+	}
+
+	orderPartUUIDs := expandKits(partUUIDs, kitUUIDs, kits)
+
+	parts, err := s.inventoryClient.ListParts(ctx, model.PartsFilter{Uuids: orderPartUUIDs})
 	if err != nil {
 		logger.Error(ctx,
 			"failed to list parts from inventory",
 			zap.String("userUUID", userUUID.String()),
-			zap.Any("partUUIDs", partUUIDs),
+			zap.Any("partUUIDs", orderPartUUIDs),
 			zap.Error(err),
 		)
 		return model.Order{}, model.ErrInventoryUnavailable
 	}
-	var total float64
-	for _, p := range parts {
-		total += p.Price
+
+	// Проверяем только найденные детали: об отсутствующих сообщит репозиторий при сохранении заказа
+	violations, err := s.inventoryClient.ValidateConfiguration(ctx, foundPartUUIDs(orderPartUUIDs, parts))
+	if err != nil {
+		logger.Error(ctx,
+			"failed to validate parts configuration",
+			zap.String("userUUID", userUUID.String()),
+			zap.Any("partUUIDs", orderPartUUIDs),
+			zap.Error(err),
+		)
+		return model.Order{}, model.ErrInventoryUnavailable
+	}
+	if len(violations) > 0 {
+		logger.Debug(ctx,
+			"parts configuration violates compatibility rules",
+			zap.String("userUUID", userUUID.String()),
+			zap.Any("violations", violations),
+		)
+		return model.Order{}, &model.ConfigurationInvalidError{Violations: violations}
 	}
 
 	now := time.Now()
 	order := model.Order{
 		OrderUUID:  uuid.New(),
 		UserUUID:   userUUID,
-		PartUuids:  partUUIDs,
-		TotalPrice: total,
+		PartUuids:  orderPartUUIDs,
+		TotalPrice: totalPrice(partUUIDs, parts, kitUUIDs, kits),
 		Status:     "PENDING_PAYMENT",
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
 
-	if err := s.orderRepository.CreateOrder(ctx, order, model.PartsFilter{Uuids: partUUIDs}, parts); err != nil {
+	if err := s.orderRepository.CreateOrder(ctx, order, model.PartsFilter{Uuids: orderPartUUIDs}, parts); err != nil {
 		logger.Error(ctx,
 			"failed to create order",
 			zap.Any("order", order),
-			zap.Any("partUUIDs", partUUIDs),
+			zap.Any("partUUIDs", orderPartUUIDs),
 			zap.Any("parts", parts),
 			zap.Error(err),
 		)
@@ -77,3 +107,87 @@ func (s *service) CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs
 
 	return order, nil
 }
+
+// loadKits загружает комплекты из inventory. Если какого-то нет, возвращает KitsNotFoundError
+func (s *service) loadKits(ctx context.Context, kitUUIDs []uuid.UUID) (map[uuid.UUID]model.Kit, error) {
+	if len(kitUUIDs) == 0 {
+		return nil, nil
+	}
+
+	kits, err := s.inventoryClient.ListKits(ctx, kitUUIDs)
+	if err != nil {
+		return nil, model.ErrInventoryUnavailable
+	}
+
+	kitsByUUID := make(map[uuid.UUID]model.Kit, len(kits))
+	for _, kit := range kits {
+		kitsByUUID[kit.Uuid] = kit
+	}
+
+	var missingUUIDs []string
+	for _, kitUUID := range kitUUIDs {
+		if _, ok := kitsByUUID[kitUUID]; !ok {
+			missingUUIDs = append(missingUUIDs, kitUUID.String())
+		}
+	}
+	if len(missingUUIDs) > 0 {
+		return nil, &model.KitsNotFoundError{MissingUUIDs: missingUUIDs}
+	}
+
+	return kitsByUUID, nil
+}
+
+// expandKits дописывает к деталям заказа состав каждого комплекта.
+// Деталь повторяется столько раз, сколько её единиц в комплекте
+func expandKits(partUUIDs, kitUUIDs []uuid.UUID, kits map[uuid.UUID]model.Kit) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(partUUIDs))
+	result = append(result, partUUIDs...)
+
+	for _, kitUUID := range kitUUIDs {
+		for _, component := range kits[kitUUID].Components {
+			for range component.Quantity {
+				result = append(result, component.PartUuid)
+			}
+		}
+	}
+
+	return result
+}
+
+// totalPrice считает стоимость заказа: найденные детали из запроса по их цене
+// и каждый комплект по его цене, а не по ценам деталей состава
+func totalPrice(partUUIDs []uuid.UUID, parts []model.Part, kitUUIDs []uuid.UUID, kits map[uuid.UUID]model.Kit) float64 {
+	requested := make(map[uuid.UUID]struct{}, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		requested[partUUID] = struct{}{}
+	}
+
+	var total float64
+	for _, p := range parts {
+		if _, ok := requested[p.Uuid]; ok {
+			total += p.Price
+		}
+	}
+
+	for _, kitUUID := range kitUUIDs {
+		total += kits[kitUUID].Price
+	}
+
+	return total
+}
+
+// foundPartUUIDs оставляет из запроса только детали, которые вернул inventory, сохраняя повторы
+func foundPartUUIDs(partUUIDs []uuid.UUID, parts []model.Part) []uuid.UUID {
+	found := make(map[uuid.UUID]struct{}, len(parts))
+	for _, p := range parts {
+		found[p.Uuid] = struct{}{}
+	}
+
+	result := make([]uuid.UUID, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		if _, ok := found[partUUID]; ok {
+			result = append(result, partUUID)
+		}
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package order
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateOrderExpandsKits() {
+	userUUID := uuid.New()
+	partUUID, engineUUID, wingUUID := uuid.New(), uuid.New(), uuid.New()
+	kit := model.Kit{
+		Uuid: uuid.New(),
+		Components: []model.KitComponent{
+			{PartUuid: engineUUID, Quantity: 1},
+			{PartUuid: wingUUID, Quantity: 2},
+		},
+		Price: 500.0,
+	}
+	expanded := []uuid.UUID{partUUID, engineUUID, wingUUID, wingUUID}
+	parts := []model.Part{
+		{Uuid: partUUID, Price: 50.0},
+		{Uuid: engineUUID, Price: 400.0},
+		{Uuid: wingUUID, Price: 100.0},
+	}
+
+	s.inventoryClient.On("ListKits", s.ctx, []uuid.UUID{kit.Uuid}).Return([]model.Kit{kit}, nil)
+	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: expanded}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, expanded).Return(nil, nil)
+	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
+		return order.TotalPrice == 550.0 && len(order.PartUuids) == len(expanded)
+	}), model.PartsFilter{Uuids: expanded}, parts).Return(nil)
+
+	order, err := s.service.CreateOrder(s.ctx, userUUID, []uuid.UUID{partUUID}, []uuid.UUID{kit.Uuid})
+	s.Require().NoError(err)
+	s.Require().Equal(550.0, order.TotalPrice)
+	s.Require().Equal(expanded, order.PartUuids)
+}
+
+func (s *ServiceSuite) TestCreateOrderRepeatedKit() {
+	userUUID := uuid.New()
+	partUUID := uuid.New()
+	kit := model.Kit{
+		Uuid:       uuid.New(),
+		Components: []model.KitComponent{{PartUuid: partUUID, Quantity: 1}},
+		Price:      30.0,
+	}
+	expanded := []uuid.UUID{partUUID, partUUID}
+
+	s.inventoryClient.On("ListKits", s.ctx, []uuid.UUID{kit.Uuid, kit.Uuid}).Return([]model.Kit{kit}, nil)
+	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: expanded}).
+		Return([]model.Part{{Uuid: partUUID, Price: 40.0}}, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, expanded).Return(nil, nil)
+	s.orderRepository.On("CreateOrder", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	order, err := s.service.CreateOrder(s.ctx, userUUID, nil, []uuid.UUID{kit.Uuid, kit.Uuid})
+	s.Require().NoError(err)
+	s.Require().Equal(60.0, order.TotalPrice)
+	s.Require().Equal(expanded, order.PartUuids)
+}
+
+func (s *ServiceSuite) TestCreateOrderKitsNotFound() {
+	userUUID := uuid.New()
+	found, missing := uuid.New(), uuid.New()
+
+	s.inventoryClient.On("ListKits", s.ctx, []uuid.UUID{found, missing}).
+		Return([]model.Kit{{Uuid: found, Components: []model.KitComponent{{PartUuid: uuid.New(), Quantity: 1}}}}, nil)
+
+	order, err := s.service.CreateOrder(s.ctx, userUUID, nil, []uuid.UUID{found, missing})
+	s.Require().ErrorIs(err, model.ErrKitsNotFound)
+
+	var notFound *model.KitsNotFoundError
+	s.Require().ErrorAs(err, &notFound)
+	s.Require().Equal([]string{missing.String()}, notFound.MissingUUIDs)
+	s.Require().Empty(order)
+	s.inventoryClient.AssertNotCalled(s.T(), "ListParts", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreateOrderListKitsError() {
+	kitUUID := uuid.New()
+	s.inventoryClient.On("ListKits", s.ctx, []uuid.UUID{kitUUID}).Return(nil, model.ErrInventoryUnavailable)
+
+	order, err := s.service.CreateOrder(s.ctx, uuid.New(), nil, []uuid.UUID{kitUUID})
+	s.Require().ErrorIs(err, model.ErrInventoryUnavailable)
+	s.Require().Empty(order)
+}
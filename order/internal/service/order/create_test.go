@@ -23,6 +23,7 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -31,7 +32,7 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(userUUID, order.UserUUID)
 	s.Equal(partUUIDs, order.PartUuids)
@@ -44,7 +45,7 @@ func (s *ServiceSuite) TestCreateOrderEmptyPartUUIDs() {
 	userUUID := uuid.New()
 	partUUIDs := []uuid.UUID{}
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrEmptyPartUUIDs)
 	s.Empty(order)
@@ -54,7 +55,7 @@ func (s *ServiceSuite) TestCreateOrderNilPartUUIDs() {
 	userUUID := uuid.New()
 	partUUIDs := []uuid.UUID(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrEmptyPartUUIDs)
 	s.Empty(order)
@@ -67,7 +68,7 @@ func (s *ServiceSuite) TestCreateOrderInventoryUnavailable() {
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return([]model.Part{}, inventoryErr)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInventoryUnavailable)
 	s.Empty(order)
@@ -85,10 +86,11 @@ func (s *ServiceSuite) TestCreateOrderPartsNotFound() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 
 	s.orderRepository.On("CreateOrder", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(model.ErrOrderCreateFailed)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderCreateFailed)
 	s.Empty(order)
@@ -106,10 +108,11 @@ func (s *ServiceSuite) TestCreateOrderMultiplePartsNotFound() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 
 	s.orderRepository.On("CreateOrder", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(model.ErrOrderCreateFailed)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderCreateFailed)
 	s.Empty(order)
@@ -121,10 +124,11 @@ func (s *ServiceSuite) TestCreateOrderAllPartsNotFound() {
 	parts := []model.Part{} // no parts found
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 
 	s.orderRepository.On("CreateOrder", s.ctx, mock.Anything, mock.Anything, mock.Anything).Return(model.ErrOrderCreateFailed)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderCreateFailed)
 	s.Empty(order)
@@ -142,6 +146,7 @@ func (s *ServiceSuite) TestCreateOrderRepositoryError() {
 	repoErr := gofakeit.Error()
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -150,7 +155,7 @@ func (s *ServiceSuite) TestCreateOrderRepositoryError() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(repoErr)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderCreateFailed)
 	s.Empty(order)
@@ -167,6 +172,7 @@ func (s *ServiceSuite) TestCreateOrderAlreadyExists() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -175,7 +181,7 @@ func (s *ServiceSuite) TestCreateOrderAlreadyExists() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(model.ErrOrderAlreadyExists)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderAlreadyExists)
 	s.Empty(order)
@@ -192,6 +198,7 @@ func (s *ServiceSuite) TestCreateOrderWithZeroPrice() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -200,7 +207,7 @@ func (s *ServiceSuite) TestCreateOrderWithZeroPrice() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(0.0, order.TotalPrice)
 }
@@ -216,6 +223,7 @@ func (s *ServiceSuite) TestCreateOrderWithNegativePrice() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -224,7 +232,7 @@ func (s *ServiceSuite) TestCreateOrderWithNegativePrice() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(-100.0, order.TotalPrice)
 }
@@ -240,6 +248,7 @@ func (s *ServiceSuite) TestCreateOrderWithVeryHighPrice() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -248,7 +257,7 @@ func (s *ServiceSuite) TestCreateOrderWithVeryHighPrice() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(999999.99, order.TotalPrice)
 }
@@ -270,6 +279,7 @@ func (s *ServiceSuite) TestCreateOrderWithManyParts() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -278,7 +288,7 @@ func (s *ServiceSuite) TestCreateOrderWithManyParts() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(userUUID, order.UserUUID)
 	s.Equal(partUUIDs, order.PartUuids)
@@ -297,6 +307,7 @@ func (s *ServiceSuite) TestCreateOrderWithSameUserAndOrderUUID() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == sharedUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -305,7 +316,7 @@ func (s *ServiceSuite) TestCreateOrderWithSameUserAndOrderUUID() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, sharedUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, sharedUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(sharedUUID, order.UserUUID)
 	s.NotEqual(sharedUUID, order.OrderUUID) // OrderUUID should be different
@@ -324,6 +335,7 @@ func (s *ServiceSuite) TestCreateOrderWithDuplicatePartUUIDs() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -332,7 +344,7 @@ func (s *ServiceSuite) TestCreateOrderWithDuplicatePartUUIDs() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(100.0, order.TotalPrice)
 }
@@ -356,6 +368,7 @@ func (s *ServiceSuite) TestCreateOrderWithMixedPrices() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -364,7 +377,7 @@ func (s *ServiceSuite) TestCreateOrderWithMixedPrices() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err)
 	s.Equal(25.0, order.TotalPrice)
 }
@@ -380,6 +393,7 @@ func (s *ServiceSuite) TestCreateOrderGeneratesUniqueOrderUUID() {
 	}
 
 	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, mock.Anything).Return(nil, nil)
 	s.orderRepository.On("CreateOrder", s.ctx, mock.MatchedBy(func(order model.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUuids) == len(partUUIDs) &&
@@ -388,11 +402,66 @@ func (s *ServiceSuite) TestCreateOrderGeneratesUniqueOrderUUID() {
 			order.OrderUUID != uuid.Nil
 	}), mock.Anything, mock.Anything).Return(nil)
 
-	order1, err1 := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order1, err1 := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err1)
 
-	order2, err2 := s.service.CreateOrder(s.ctx, userUUID, partUUIDs)
+	order2, err2 := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
 	s.NoError(err2)
 
 	s.NotEqual(order1.OrderUUID, order2.OrderUUID)
 }
+
+func (s *ServiceSuite) TestCreateOrderConfigurationViolations() {
+	userUUID := uuid.New()
+	partUUIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	parts := []model.Part{
+		{Uuid: partUUIDs[0], Price: 100.0},
+		{Uuid: partUUIDs[1], Price: 200.0},
+	}
+	violations := []model.RuleViolation{{
+		RuleUuid:  uuid.New(),
+		Type:      model.CompatibilityRuleTypeExcludes,
+		Message:   gofakeit.Sentence(),
+		PartUuids: partUUIDs,
+	}}
+
+	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, partUUIDs).Return(violations, nil)
+
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
+	s.ErrorIs(err, model.ErrInvalidConfiguration)
+	var invalid *model.ConfigurationInvalidError
+	s.Require().ErrorAs(err, &invalid)
+	s.Equal(violations, invalid.Violations)
+	s.Empty(order)
+	s.orderRepository.AssertNotCalled(s.T(), "CreateOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreateOrderValidateConfigurationError() {
+	userUUID := uuid.New()
+	partUUIDs := []uuid.UUID{uuid.New()}
+	parts := []model.Part{{Uuid: partUUIDs[0], Price: 100.0}}
+
+	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, partUUIDs).Return(nil, gofakeit.Error())
+
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
+	s.ErrorIs(err, model.ErrInventoryUnavailable)
+	s.Empty(order)
+}
+
+func (s *ServiceSuite) TestCreateOrderValidatesOnlyFoundParts() {
+	userUUID := uuid.New()
+	partUUIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	partUUIDs[2] = partUUIDs[0]
+	parts := []model.Part{{Uuid: partUUIDs[0], Price: 100.0}}
+
+	s.inventoryClient.On("ListParts", s.ctx, model.PartsFilter{Uuids: partUUIDs}).Return(parts, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, []uuid.UUID{partUUIDs[0], partUUIDs[0]}).Return(nil, nil)
+	s.orderRepository.On("CreateOrder", s.ctx, mock.Anything, mock.Anything, mock.Anything).
+		Return(&model.PartsNotFoundError{MissingUUIDs: []string{partUUIDs[1].String()}})
+
+	order, err := s.service.CreateOrder(s.ctx, userUUID, partUUIDs, nil)
+	s.ErrorIs(err, model.ErrPartsNotFound)
+	s.Empty(order)
+}
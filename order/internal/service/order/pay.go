@@ -11,17 +11,20 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
-	orderMetrics "github.com/nkolesnikov999/micro2-OK/order/internal/metrics"
 	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
 )
 
-func (s *service) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod string) (string, error) {
+// orderCurrency — валюта цен заказов: цены деталей в inventory хранятся в рублях
+const orderCurrency = "RUB"
+
+func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod string, instrumentUUID uuid.UUID) (string, error) {
 	ctx, span := tracing.StartSpan(ctx, "order.call_pay_order",
 		trace.WithAttributes(
 			attribute.String("order.uuid", orderUUID.String()),
 			attribute.String("payment.method", paymentMethod),
+			attribute.String("payment.instrument_uuid", instrumentUUID.String()),
 		),
 	)
 	defer span.End()
@@ -51,7 +54,20 @@ func (s *service) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMeth
 	}
 	dbSpan.End()
 
-	if order.Status == "PAID" || order.Status == "CANCELLED" || order.Status == "ASSEMBLED" {
+	// Чужой заказ неотличим от несуществующего: payment списывает деньги владельца заказа
+	if order.UserUUID != userUUID {
+		span.SetStatus(codes.Error, "order belongs to another user")
+		logger.Warn(ctx,
+			"attempt to pay another user's order",
+			zap.String("orderUUID", orderUUID.String()),
+			zap.String("userUUID", userUUID.String()),
+		)
+		return "", model.ErrOrderNotFound
+	}
+
+	// Оплачивать можно заказ, ожидающий оплаты. В PAYMENT_PROCESSING итог прошлой попытки
+	// неизвестен: повторный вызов безопасен, payment продолжит ту же транзакцию заказа
+	if order.Status != "PENDING_PAYMENT" && order.Status != "PAYMENT_PROCESSING" {
 		span.SetAttributes(
 			attribute.String("order.status", order.Status),
 			attribute.String("payment.method", paymentMethod),
@@ -62,11 +78,19 @@ func (s *service) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMeth
 			"order is not payable",
 			zap.String("paymentMethod", paymentMethod),
 			zap.String("orderUUID", orderUUID.String()),
+			zap.String("status", order.Status),
 			zap.Error(model.ErrOrderNotPayable),
 		)
 		return "", model.ErrOrderNotPayable
 	}
 
+	if order.Status == "PENDING_PAYMENT" {
+		if err := s.markPaymentProcessing(ctx, &order, paymentMethod); err != nil {
+			span.RecordError(err)
+			return "", err
+		}
+	}
+
 	// Создаем спан для вызова paymentClient
 	ctx, clientSpan := tracing.StartSpan(ctx, "grpc.payment.pay_order",
 		trace.WithAttributes(
@@ -76,11 +100,20 @@ func (s *service) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMeth
 			attribute.String("operation.name", "pay_order"),
 		),
 	)
+	// Инструмент проверяется в payment по владельцу заказа; способ оплаты с инструментом
+	// можно не указывать — заказ получит его из события PaymentCompleted
+	var instrument string
+	if instrumentUUID != uuid.Nil {
+		instrument = instrumentUUID.String()
+	}
 	txUUID, err := s.paymentClient.PayOrder(
 		ctx,
 		order.OrderUUID.String(),
 		order.UserUUID.String(),
 		paymentMethod,
+		instrument,
+		order.TotalPrice,
+		orderCurrency,
 	)
 	if err != nil {
 		clientSpan.RecordError(err)
@@ -92,63 +125,89 @@ func (s *service) PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMeth
 			zap.Any("order", order),
 			zap.Error(err),
 		)
+		// Итог неизвестен: деньги могли списаться, поэтому заказ остаётся в PAYMENT_PROCESSING
+		// и его нельзя отменить, пока итог не придёт событием или повторным PayOrder
+		if errors.Is(err, model.ErrPaymentUnavailable) {
+			return "", err
+		}
+		s.revertPaymentProcessing(ctx, orderUUID)
+		if errors.Is(err, model.ErrPaymentRejected) {
+			return "", err
+		}
 		return "", model.ErrPaymentFailed
 	}
 	clientSpan.End()
 
-	order.Status = "PAID"
-	order.TransactionUUID = txUUID
+	// Заказ станет PAID по событию PaymentCompleted, см. payment_consumer
+	span.SetAttributes(
+		attribute.String("order.status", order.Status),
+		attribute.String("payment.method", paymentMethod),
+		attribute.String("order.uuid", orderUUID.String()),
+		attribute.String("transactionUUID", txUUID),
+	)
+
+	logger.Debug(ctx,
+		"order payment initiated",
+		zap.String("paymentMethod", paymentMethod),
+		zap.Any("order", order),
+		zap.String("transactionUUID", txUUID),
+	)
+
+	return txUUID, nil
+}
+
+// markPaymentProcessing переводит заказ в PAYMENT_PROCESSING до вызова payment: событие
+// PaymentCompleted может прийти раньше ответа, и consumer не должен увидеть устаревший статус
+func (s *service) markPaymentProcessing(ctx context.Context, order *model.Order, paymentMethod string) error {
+	order.Status = "PAYMENT_PROCESSING"
 	order.PaymentMethod = paymentMethod
 	order.UpdatedAt = time.Now()
 
 	// Создаем спан для запроса к БД UpdateOrder
 	ctx, updateSpan := tracing.StartSpan(ctx, "db.update_order",
 		trace.WithAttributes(
-			attribute.String("order.uuid", orderUUID.String()),
+			attribute.String("order.uuid", order.OrderUUID.String()),
 			attribute.String("order.status", order.Status),
 			attribute.String("operation.name", "pay_order"),
 		),
 	)
-	if err := s.orderRepository.UpdateOrder(ctx, orderUUID, order); err != nil {
+	defer updateSpan.End()
+
+	if err := s.orderRepository.UpdateOrder(ctx, order.OrderUUID, *order); err != nil {
 		updateSpan.RecordError(err)
-		updateSpan.End()
-		span.RecordError(err)
 		if errors.Is(err, model.ErrOrderNotFound) {
-			return "", model.ErrOrderNotFound
+			return model.ErrOrderNotFound
 		}
-		return "", model.ErrOrderUpdateFailed
-	}
-	updateSpan.End()
-
-	// Увеличиваем бизнес-метрику выручки на сумму оплаченного заказа.
-	// Метрика OrdersRevenueTotal — монотонно возрастающий счетчик общей выручки.
-	orderMetrics.OrdersRevenueTotal.Add(ctx, order.TotalPrice)
-
-	err = s.orderPaidProducerService.ProduceOrderPaid(ctx, model.OrderPaidEvent{
-		EventUUID:       uuid.New().String(),
-		OrderUUID:       orderUUID.String(),
-		UserUUID:        order.UserUUID.String(),
-		PaymentMethod:   paymentMethod,
-		TransactionUUID: txUUID,
-	})
-	if err != nil {
-		span.RecordError(err)
-		return "", model.ErrOrderProducerFailed
+		return model.ErrOrderUpdateFailed
 	}
 
-	span.SetAttributes(
-		attribute.String("order.status", order.Status),
-		attribute.String("payment.method", paymentMethod),
-		attribute.String("order.uuid", orderUUID.String()),
-		attribute.String("transactionUUID", txUUID),
-	)
+	return nil
+}
 
-	logger.Debug(ctx,
-		"order paid successfully",
-		zap.String("paymentMethod", paymentMethod),
-		zap.Any("order", order),
-		zap.String("transactionUUID", txUUID),
-	)
+// revertPaymentProcessing возвращает заказ в PENDING_PAYMENT после явного отказа payment.
+// Заказ перечитывается: если consumer уже применил итог оплаты, статус не трогаем
+func (s *service) revertPaymentProcessing(ctx context.Context, orderUUID uuid.UUID) {
+	order, err := s.orderRepository.GetOrder(ctx, orderUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get order for payment revert",
+			zap.String("orderUUID", orderUUID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+	if order.Status != "PAYMENT_PROCESSING" {
+		return
+	}
 
-	return txUUID, nil
+	order.Status = "PENDING_PAYMENT"
+	order.PaymentMethod = ""
+	order.UpdatedAt = time.Now()
+	if err := s.orderRepository.UpdateOrder(ctx, orderUUID, order); err != nil {
+		logger.Error(ctx,
+			"failed to revert order to PENDING_PAYMENT",
+			zap.String("orderUUID", orderUUID.String()),
+			zap.Error(err),
+		)
+	}
 }
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"fmt"
+
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -8,20 +10,39 @@ import (
 	"github.com/nkolesnikov999/micro2-OK/order/internal/model"
 )
 
-// Helper function to create a matcher for paid order
-func (s *ServiceSuite) createPaidOrderMatcher(originalOrder model.Order, transactionUUID, paymentMethod string) interface{} {
+// Helper function to create a matcher for order waiting for payment result
+func (s *ServiceSuite) createProcessingOrderMatcher(originalOrder model.Order, paymentMethod string) interface{} {
 	return mock.MatchedBy(func(updatedOrder model.Order) bool {
-		return updatedOrder.Status == "PAID" &&
+		return updatedOrder.Status == "PAYMENT_PROCESSING" &&
 			updatedOrder.OrderUUID == originalOrder.OrderUUID &&
 			updatedOrder.UserUUID == originalOrder.UserUUID &&
 			updatedOrder.TotalPrice == originalOrder.TotalPrice &&
-			updatedOrder.TransactionUUID == transactionUUID &&
+			updatedOrder.TransactionUUID == "" &&
 			updatedOrder.PaymentMethod == paymentMethod &&
 			len(updatedOrder.PartUuids) == len(originalOrder.PartUuids) &&
 			!updatedOrder.UpdatedAt.IsZero() // Проверяем, что UpdatedAt установлен
 	})
 }
 
+// Helper function to create a matcher for order returned to PENDING_PAYMENT
+func (s *ServiceSuite) createRevertedOrderMatcher(originalOrder model.Order) interface{} {
+	return mock.MatchedBy(func(updatedOrder model.Order) bool {
+		return updatedOrder.Status == "PENDING_PAYMENT" &&
+			updatedOrder.OrderUUID == originalOrder.OrderUUID &&
+			updatedOrder.PaymentMethod == ""
+	})
+}
+
+// expectPaymentRevert настраивает повторное чтение заказа и его возврат в PENDING_PAYMENT
+func (s *ServiceSuite) expectPaymentRevert(order model.Order, paymentMethod string) {
+	processing := order
+	processing.Status = "PAYMENT_PROCESSING"
+	processing.PaymentMethod = paymentMethod
+
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(processing, nil).Once()
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createRevertedOrderMatcher(order)).Return(nil).Once()
+}
+
 func (s *ServiceSuite) TestPayOrderSuccess() {
 	order := model.Order{
 		OrderUUID:       uuid.New(),
@@ -36,10 +57,10 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -50,7 +71,7 @@ func (s *ServiceSuite) TestPayOrderNotFound() {
 
 	s.orderRepository.On("GetOrder", mock.Anything, orderUUID).Return(model.Order{}, model.ErrOrderNotFound)
 
-	res, err := s.service.PayOrder(s.ctx, orderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, orderUUID, uuid.New(), paymentMethod, uuid.Nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderNotFound)
 	s.Empty(res)
@@ -63,7 +84,7 @@ func (s *ServiceSuite) TestPayOrderGetFailed() {
 
 	s.orderRepository.On("GetOrder", mock.Anything, orderUUID).Return(model.Order{}, repoErr)
 
-	res, err := s.service.PayOrder(s.ctx, orderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, orderUUID, uuid.New(), paymentMethod, uuid.Nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderGetFailed)
 	s.Empty(res)
@@ -83,7 +104,7 @@ func (s *ServiceSuite) TestPayOrderAlreadyPaid() {
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderNotPayable)
 	s.Empty(res)
@@ -103,7 +124,7 @@ func (s *ServiceSuite) TestPayOrderCancelled() {
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderNotPayable)
 	s.Empty(res)
@@ -122,15 +143,64 @@ func (s *ServiceSuite) TestPayOrderPaymentFailed() {
 	paymentMethod := "CARD"
 	paymentErr := gofakeit.Error()
 
-	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return("", paymentErr)
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil).Once()
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return("", paymentErr)
+	s.expectPaymentRevert(order, paymentMethod)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrPaymentFailed)
 	s.Empty(res)
 }
 
+func (s *ServiceSuite) TestPayOrderPaymentRejected() {
+	order := model.Order{
+		OrderUUID:  uuid.New(),
+		UserUUID:   uuid.New(),
+		PartUuids:  []uuid.UUID{uuid.New()},
+		TotalPrice: 700_000,
+		Status:     "PENDING_PAYMENT",
+	}
+	paymentMethod := "SBP"
+	rejected := &model.PaymentRejectedError{Reason: "amount 700000.00 RUB for SBP must be between 1.00 and 600000.00"}
+
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil).Once()
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return("", rejected)
+	s.expectPaymentRevert(order, paymentMethod)
+
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
+	s.ErrorIs(err, model.ErrPaymentRejected)
+	s.ErrorAs(err, &rejected)
+	s.Empty(res)
+}
+
+func (s *ServiceSuite) TestPayOrderPaymentUnavailable() {
+	order := model.Order{
+		OrderUUID:  uuid.New(),
+		UserUUID:   uuid.New(),
+		PartUuids:  []uuid.UUID{uuid.New()},
+		TotalPrice: gofakeit.Price(100, 1000),
+		Status:     "PENDING_PAYMENT",
+	}
+	paymentMethod := "CARD"
+
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil).Once()
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").
+		Return("", fmt.Errorf("%w: psp timeout", model.ErrPaymentUnavailable))
+
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
+	s.ErrorIs(err, model.ErrPaymentUnavailable)
+	s.Empty(res)
+
+	// Итог неизвестен: заказ не возвращается в PENDING_PAYMENT, иначе его можно отменить
+	// до того, как придёт успешный webhook
+	s.orderRepository.AssertNumberOfCalls(s.T(), "GetOrder", 1)
+	s.orderRepository.AssertNumberOfCalls(s.T(), "UpdateOrder", 1)
+}
+
 func (s *ServiceSuite) TestPayOrderUpdateFailed() {
 	order := model.Order{
 		OrderUUID:       uuid.New(),
@@ -142,14 +212,12 @@ func (s *ServiceSuite) TestPayOrderUpdateFailed() {
 		Status:          "PENDING_PAYMENT",
 	}
 	paymentMethod := "CARD"
-	transactionUUID := uuid.New().String()
 	updateErr := gofakeit.Error()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(updateErr)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(updateErr)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderUpdateFailed)
 	s.Empty(res)
@@ -166,13 +234,11 @@ func (s *ServiceSuite) TestPayOrderUpdateNotFound() {
 		Status:          "PENDING_PAYMENT",
 	}
 	paymentMethod := "CARD"
-	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(model.ErrOrderNotFound)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(model.ErrOrderNotFound)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.Error(err)
 	s.ErrorIs(err, model.ErrOrderNotFound)
 	s.Empty(res)
@@ -194,10 +260,10 @@ func (s *ServiceSuite) TestPayOrderWithDifferentPaymentMethods() {
 		transactionUUID := uuid.New().String()
 
 		s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-		s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), method).Return(transactionUUID, nil)
-		s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, method)).Return(nil)
+		s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), method, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+		s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, method)).Return(nil)
 
-		res, err := s.service.PayOrder(s.ctx, order.OrderUUID, method)
+		res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, method, uuid.Nil)
 		s.NoError(err)
 		s.Equal(transactionUUID, res)
 	}
@@ -217,10 +283,10 @@ func (s *ServiceSuite) TestPayOrderWithEmptyPaymentMethod() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -239,10 +305,10 @@ func (s *ServiceSuite) TestPayOrderWithZeroPrice() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -261,10 +327,10 @@ func (s *ServiceSuite) TestPayOrderWithNegativePrice() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -283,10 +349,10 @@ func (s *ServiceSuite) TestPayOrderWithVeryHighPrice() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -305,10 +371,10 @@ func (s *ServiceSuite) TestPayOrderWithEmptyPartUUIDs() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -327,10 +393,10 @@ func (s *ServiceSuite) TestPayOrderWithNilPartUUIDs() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -354,10 +420,10 @@ func (s *ServiceSuite) TestPayOrderWithManyParts() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -377,10 +443,10 @@ func (s *ServiceSuite) TestPayOrderWithSameUserAndOrderUUID() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
@@ -399,10 +465,120 @@ func (s *ServiceSuite) TestPayOrderUpdatesOrderStatus() {
 	transactionUUID := uuid.New().String()
 
 	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
-	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod).Return(transactionUUID, nil)
-	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createPaidOrderMatcher(order, transactionUUID, paymentMethod)).Return(nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil)
 
-	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, paymentMethod)
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
 	s.NoError(err)
 	s.Equal(transactionUUID, res)
 }
+
+func (s *ServiceSuite) TestPayOrderPaymentProcessing() {
+	order := model.Order{
+		OrderUUID:     uuid.New(),
+		UserUUID:      uuid.New(),
+		PartUuids:     []uuid.UUID{uuid.New()},
+		TotalPrice:    gofakeit.Price(100, 1000),
+		PaymentMethod: "CARD",
+		Status:        "PAYMENT_PROCESSING", // outcome of the previous attempt is unknown
+	}
+	transactionUUID := uuid.New().String()
+
+	// Повтор уходит в payment, который продолжит ту же транзакцию; статус заказа не меняется
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), "SBP", "", order.TotalPrice, "RUB").
+		Return(transactionUUID, nil)
+
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, "SBP", uuid.Nil)
+	s.NoError(err)
+	s.Equal(transactionUUID, res)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderRetryAfterPaymentUnavailable() {
+	order := model.Order{
+		OrderUUID:     uuid.New(),
+		UserUUID:      uuid.New(),
+		PartUuids:     []uuid.UUID{uuid.New()},
+		TotalPrice:    gofakeit.Price(100, 1000),
+		PaymentMethod: "CARD",
+		Status:        "PAYMENT_PROCESSING",
+	}
+
+	// Повторный сбой тоже оставляет заказ в PAYMENT_PROCESSING
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), "CARD", "", order.TotalPrice, "RUB").
+		Return("", model.ErrPaymentUnavailable)
+
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, "CARD", uuid.Nil)
+	s.ErrorIs(err, model.ErrPaymentUnavailable)
+	s.Empty(res)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrder", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderPaymentFailedAfterOrderPaid() {
+	order := model.Order{
+		OrderUUID:  uuid.New(),
+		UserUUID:   uuid.New(),
+		PartUuids:  []uuid.UUID{uuid.New()},
+		TotalPrice: gofakeit.Price(100, 1000),
+		Status:     "PENDING_PAYMENT",
+	}
+	paymentMethod := "CARD"
+	paid := order
+	paid.Status = "PAID"
+	paid.PaymentMethod = paymentMethod
+	paid.TransactionUUID = uuid.New().String()
+
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil).Once()
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, s.createProcessingOrderMatcher(order, paymentMethod)).Return(nil).Once()
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), paymentMethod, "", order.TotalPrice, "RUB").
+		Return("", gofakeit.Error())
+	// Consumer успел применить PaymentCompleted: заказ не возвращается в PENDING_PAYMENT
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(paid, nil).Once()
+
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, paymentMethod, uuid.Nil)
+	s.ErrorIs(err, model.ErrPaymentFailed)
+	s.Empty(res)
+	s.orderRepository.AssertNumberOfCalls(s.T(), "UpdateOrder", 1)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInstrument() {
+	order := model.Order{
+		OrderUUID:  uuid.New(),
+		UserUUID:   uuid.New(),
+		PartUuids:  []uuid.UUID{uuid.New()},
+		TotalPrice: gofakeit.Price(100, 1000),
+		Status:     "PENDING_PAYMENT",
+	}
+	instrumentUUID := uuid.New()
+	transactionUUID := uuid.New().String()
+
+	// Метод не указан: payment определит его по инструменту
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
+	s.paymentClient.On("PayOrder", mock.Anything, order.OrderUUID.String(), order.UserUUID.String(), "", instrumentUUID.String(), order.TotalPrice, "RUB").Return(transactionUUID, nil)
+	s.orderRepository.On("UpdateOrder", mock.Anything, order.OrderUUID, mock.Anything).Return(nil)
+
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, order.UserUUID, "", instrumentUUID)
+	s.NoError(err)
+	s.Equal(transactionUUID, res)
+}
+
+func (s *ServiceSuite) TestPayOrderOfAnotherUser() {
+	order := model.Order{
+		OrderUUID:  uuid.New(),
+		UserUUID:   uuid.New(),
+		PartUuids:  []uuid.UUID{uuid.New()},
+		TotalPrice: gofakeit.Price(100, 1000),
+		Status:     "PENDING_PAYMENT",
+	}
+
+	// Чужой заказ не переводится в обработку и не уходит в payment
+	s.orderRepository.On("GetOrder", mock.Anything, order.OrderUUID).Return(order, nil)
+
+	res, err := s.service.PayOrder(s.ctx, order.OrderUUID, uuid.New(), "", uuid.Nil)
+	s.ErrorIs(err, model.ErrOrderNotFound)
+	s.Empty(res)
+	s.orderRepository.AssertNotCalled(s.T(), "UpdateOrder", mock.Anything, mock.Anything, mock.Anything)
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -9,8 +9,7 @@ import (
 var _ def.OrderService = (*service)(nil)
 
 type service struct {
-	orderRepository          repository.OrderRepository
-	orderPaidProducerService def.OrderPaidProducerService
+	orderRepository repository.OrderRepository
 
 	inventoryClient grpc.InventoryClient
 	paymentClient   grpc.PaymentClient
@@ -18,14 +17,12 @@ type service struct {
 
 func NewService(
 	orderRepository repository.OrderRepository,
-	orderPaidProducerService def.OrderPaidProducerService,
 	inventoryClient grpc.InventoryClient,
 	paymentClient grpc.PaymentClient,
 ) *service {
 	return &service{
-		orderRepository:          orderRepository,
-		orderPaidProducerService: orderPaidProducerService,
-		inventoryClient:          inventoryClient,
-		paymentClient:            paymentClient,
+		orderRepository: orderRepository,
+		inventoryClient: inventoryClient,
+		paymentClient:   paymentClient,
 	}
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/metric"
@@ -12,7 +11,6 @@ import (
 	grpc "github.com/nkolesnikov999/micro2-OK/order/internal/client/grpc/mocks"
 	orderMetrics "github.com/nkolesnikov999/micro2-OK/order/internal/metrics"
 	repoMocks "github.com/nkolesnikov999/micro2-OK/order/internal/repository/mocks"
-	svcMocks "github.com/nkolesnikov999/micro2-OK/order/internal/service/mocks"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 )
 
@@ -21,10 +19,9 @@ type ServiceSuite struct {
 
 	ctx context.Context
 
-	orderRepository      *repoMocks.OrderRepository
-	orderProducerService *svcMocks.OrderPaidProducerService
-	paymentClient        *grpc.PaymentClient
-	inventoryClient      *grpc.InventoryClient
+	orderRepository *repoMocks.OrderRepository
+	paymentClient   *grpc.PaymentClient
+	inventoryClient *grpc.InventoryClient
 
 	service *service
 }
@@ -42,19 +39,11 @@ func (s *ServiceSuite) SetupTest() {
 	s.ctx = context.Background()
 
 	s.orderRepository = repoMocks.NewOrderRepository(s.T())
-	s.orderProducerService = svcMocks.NewOrderPaidProducerService(s.T())
 	s.paymentClient = grpc.NewPaymentClient(s.T())
 	s.inventoryClient = grpc.NewInventoryClient(s.T())
 
-	// By default, allow producing OrderPaidRecorded without error
-	s.orderProducerService.
-		On("ProduceOrderPaid", mock.Anything, mock.Anything).
-		Return(nil).
-		Maybe()
-
 	s.service = NewService(
 		s.orderRepository,
-		s.orderProducerService,
 		s.inventoryClient,
 		s.paymentClient,
 	)
@@ -10,19 +10,35 @@ import (
 
 type OrderService interface {
 	// CreateOrder validates parts via Inventory, calculates total, and persists the order.
+	// Kits are expanded into their component parts; each kit is charged at its own price.
 	// Returns the created domain order.
-	CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs []uuid.UUID) (model.Order, error)
+	CreateOrder(ctx context.Context, userUUID uuid.UUID, partUUIDs, kitUUIDs []uuid.UUID) (model.Order, error)
 
 	// GetOrder returns the domain order by its UUID.
 	GetOrder(ctx context.Context, orderUUID uuid.UUID) (model.Order, error)
 
 	// PayOrder processes payment for the order and returns the transaction UUID.
-	PayOrder(ctx context.Context, orderUUID uuid.UUID, paymentMethod string) (string, error)
+	// The order must belong to userUUID, otherwise ErrOrderNotFound is returned.
+	// A non-nil instrumentUUID pays with the user's saved instrument; with neither
+	// paymentMethod nor instrumentUUID payment uses the user's default instrument.
+	PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod string, instrumentUUID uuid.UUID) (string, error)
 
 	// CancelOrder cancels the order if not paid.
 	CancelOrder(ctx context.Context, orderUUID uuid.UUID) error
 }
 
+// PaymentInstrumentService manages the user's saved payment instruments stored in payment.
+type PaymentInstrumentService interface {
+	// AddPaymentInstrument returns ErrInvalidPaymentInstrument if the details do not match the payment method.
+	AddPaymentInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error)
+
+	// ListPaymentInstruments returns the user's instruments, the default one first.
+	ListPaymentInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error)
+
+	// DeletePaymentInstrument returns ErrPaymentInstrumentNotFound if the user has no such instrument.
+	DeletePaymentInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error
+}
+
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
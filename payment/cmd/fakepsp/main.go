@@ -0,0 +1,70 @@
+// Fake PSP — локальная заглушка платёжного провайдера для разработки и тестов.
+// Поведение выбирается FAKE_PSP_SCENARIO или копейками суммы (см. fakepsp.ScenarioForAmount).
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/fakepsp"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type config struct {
+	Address       string        `env:"FAKE_PSP_ADDRESS" envDefault:"localhost:8091"`
+	Scenario      string        `env:"FAKE_PSP_SCENARIO"`
+	TimeoutDelay  time.Duration `env:"FAKE_PSP_TIMEOUT_DELAY" envDefault:"30s"`
+	WebhookURL    string        `env:"FAKE_PSP_WEBHOOK_URL"`
+	WebhookDelay  time.Duration `env:"FAKE_PSP_WEBHOOK_DELAY" envDefault:"3s"`
+	WebhookSecret string        `env:"FAKE_PSP_WEBHOOK_SECRET" envDefault:"fake-psp-webhook-secret"`
+}
+
+func main() {
+	var cfg config
+	if err := env.Parse(&cfg); err != nil {
+		panic(fmt.Errorf("failed to load config: %w", err))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	if err := logger.Init(ctx, "info", false, false, "", "fake-psp"); err != nil {
+		panic(fmt.Errorf("failed to init logger: %w", err))
+	}
+
+	psp := fakepsp.NewServer(fakepsp.Config{
+		Scenario:      fakepsp.Scenario(cfg.Scenario),
+		TimeoutDelay:  cfg.TimeoutDelay,
+		WebhookURL:    cfg.WebhookURL,
+		WebhookDelay:  cfg.WebhookDelay,
+		WebhookSecret: cfg.WebhookSecret,
+	})
+
+	server := &http.Server{
+		Addr:              cfg.Address,
+		Handler:           psp.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer shutdownCancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info(ctx, "🚀 Fake PSP listening", zap.String("address", cfg.Address), zap.String("scenario", cfg.Scenario))
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error(ctx, "❌ Fake PSP stopped", zap.Error(err))
+	}
+
+	psp.Wait()
+}
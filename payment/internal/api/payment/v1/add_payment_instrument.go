@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) AddPaymentInstrument(ctx context.Context, req *paymentV1.AddPaymentInstrumentRequest) (*paymentV1.AddPaymentInstrumentResponse, error) {
+	userUUID, err := uuid.Parse(req.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	}
+
+	method := converter.ToModelPaymentMethod(req.GetPaymentMethod())
+	if method == model.PaymentMethodUnspecified {
+		return nil, status.Error(codes.InvalidArgument, "payment_method must be specified")
+	}
+
+	instrument, err := a.instrumentService.AddInstrument(ctx, model.PaymentInstrument{
+		UserUuid:      userUUID,
+		PaymentMethod: method,
+		CardToken:     req.GetCardToken(),
+		CardLast4:     req.GetCardLast4(),
+		SBPPhone:      req.GetSbpPhone(),
+		IsDefault:     req.GetIsDefault(),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidInstrument) || errors.Is(err, model.ErrInvalidPaymentMethod) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.AddPaymentInstrumentResponse{Instrument: converter.ToProtoInstrument(instrument)}, nil
+}
@@ -8,11 +8,13 @@ import (
 type api struct {
 	paymentV1.UnimplementedPaymentServiceServer
 
-	paymentService service.PaymentService
+	paymentService    service.PaymentService
+	instrumentService service.InstrumentService
 }
 
-func NewAPI(paymentService service.PaymentService) *api {
+func NewAPI(paymentService service.PaymentService, instrumentService service.InstrumentService) *api {
 	return &api{
-		paymentService: paymentService,
+		paymentService:    paymentService,
+		instrumentService: instrumentService,
 	}
 }
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) DeletePaymentInstrument(ctx context.Context, req *paymentV1.DeletePaymentInstrumentRequest) (*paymentV1.DeletePaymentInstrumentResponse, error) {
+	userUUID, err := uuid.Parse(req.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	}
+
+	instrumentUUID, err := uuid.Parse(req.GetInstrumentUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instrument_uuid format: %v", err)
+	}
+
+	err = a.instrumentService.DeleteInstrument(ctx, userUUID, instrumentUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrInstrumentNotFound) {
+			return nil, status.Error(codes.NotFound, "payment instrument not found")
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.DeletePaymentInstrumentResponse{}, nil
+}
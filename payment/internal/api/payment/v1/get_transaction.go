@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) GetTransaction(ctx context.Context, req *paymentV1.GetTransactionRequest) (*paymentV1.GetTransactionResponse, error) {
+	transactionUUID, err := uuid.Parse(req.GetTransactionUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction_uuid format: %v", err)
+	}
+
+	transaction, err := a.paymentService.GetTransaction(ctx, transactionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, "transaction not found")
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.GetTransactionResponse{Transaction: converter.ToProtoTransaction(transaction)}, nil
+}
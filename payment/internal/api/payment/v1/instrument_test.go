@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestAddPaymentInstrumentSuccess() {
+	var (
+		userUUID = uuid.New()
+		req      = &paymentV1.AddPaymentInstrumentRequest{
+			UserUuid:      userUUID.String(),
+			PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+			CardToken:     "tok_" + gofakeit.UUID(),
+			CardLast4:     "4242",
+			IsDefault:     true,
+		}
+		saved = model.PaymentInstrument{
+			Uuid:          uuid.New(),
+			UserUuid:      userUUID,
+			PaymentMethod: model.PaymentMethodCard,
+			CardToken:     req.CardToken,
+			CardLast4:     "4242",
+			IsDefault:     true,
+			CreatedAt:     time.Now(),
+		}
+	)
+
+	s.instrumentService.On("AddInstrument", s.ctx, mock.MatchedBy(func(i model.PaymentInstrument) bool {
+		return i.UserUuid == userUUID && i.PaymentMethod == model.PaymentMethodCard && i.CardToken == req.CardToken && i.IsDefault
+	})).Return(saved, nil)
+
+	res, err := s.api.AddPaymentInstrument(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(saved.Uuid.String(), res.Instrument.InstrumentUuid)
+	s.Require().Equal("4242", res.Instrument.CardLast4)
+	s.Require().True(res.Instrument.IsDefault)
+}
+
+func (s *APISuite) TestAddPaymentInstrumentInvalid() {
+	req := &paymentV1.AddPaymentInstrumentRequest{
+		UserUuid:      gofakeit.UUID(),
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+		SbpPhone:      "123",
+	}
+
+	s.instrumentService.On("AddInstrument", s.ctx, mock.Anything).Return(model.PaymentInstrument{}, model.ErrInvalidInstrument)
+
+	res, err := s.api.AddPaymentInstrument(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestAddPaymentInstrumentUnspecifiedMethod() {
+	req := &paymentV1.AddPaymentInstrumentRequest{
+		UserUuid: gofakeit.UUID(),
+	}
+
+	res, err := s.api.AddPaymentInstrument(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestListPaymentInstrumentsMasksPhone() {
+	userUUID := uuid.New()
+	instruments := []model.PaymentInstrument{
+		{
+			Uuid:          uuid.New(),
+			UserUuid:      userUUID,
+			PaymentMethod: model.PaymentMethodSBP,
+			SBPPhone:      "+79991234567",
+			CreatedAt:     time.Now(),
+		},
+	}
+
+	s.instrumentService.On("ListInstruments", s.ctx, userUUID).Return(instruments, nil)
+
+	res, err := s.api.ListPaymentInstruments(s.ctx, &paymentV1.ListPaymentInstrumentsRequest{UserUuid: userUUID.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Instruments, 1)
+	s.Require().Equal(paymentV1.PaymentMethod_PAYMENT_METHOD_SBP, res.Instruments[0].PaymentMethod)
+	s.Require().NotContains(res.Instruments[0].SbpPhoneMasked, "1234")
+}
+
+func (s *APISuite) TestListPaymentInstrumentsInternalError() {
+	userUUID := uuid.New()
+
+	s.instrumentService.On("ListInstruments", s.ctx, userUUID).Return(nil, errors.New("db down"))
+
+	res, err := s.api.ListPaymentInstruments(s.ctx, &paymentV1.ListPaymentInstrumentsRequest{UserUuid: userUUID.String()})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.Internal, status.Code(err))
+}
+
+func (s *APISuite) TestDeletePaymentInstrumentSuccess() {
+	userUUID, instrumentUUID := uuid.New(), uuid.New()
+
+	s.instrumentService.On("DeleteInstrument", s.ctx, userUUID, instrumentUUID).Return(nil)
+
+	res, err := s.api.DeletePaymentInstrument(s.ctx, &paymentV1.DeletePaymentInstrumentRequest{
+		UserUuid:       userUUID.String(),
+		InstrumentUuid: instrumentUUID.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res)
+}
+
+func (s *APISuite) TestDeletePaymentInstrumentNotFound() {
+	userUUID, instrumentUUID := uuid.New(), uuid.New()
+
+	s.instrumentService.On("DeleteInstrument", s.ctx, userUUID, instrumentUUID).Return(model.ErrInstrumentNotFound)
+
+	res, err := s.api.DeletePaymentInstrument(s.ctx, &paymentV1.DeletePaymentInstrumentRequest{
+		UserUuid:       userUUID.String(),
+		InstrumentUuid: instrumentUUID.String(),
+	})
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/converter"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) ListPaymentInstruments(ctx context.Context, req *paymentV1.ListPaymentInstrumentsRequest) (*paymentV1.ListPaymentInstrumentsResponse, error) {
+	userUUID, err := uuid.Parse(req.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	}
+
+	instruments, err := a.instrumentService.ListInstruments(ctx, userUUID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.ListPaymentInstrumentsResponse{Instruments: converter.ToProtoInstruments(instruments)}, nil
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) ListTransactions(ctx context.Context, req *paymentV1.ListTransactionsRequest) (*paymentV1.ListTransactionsResponse, error) {
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	filter := model.TransactionsFilter{
+		Limit:  int(req.GetLimit()),
+		Offset: int(req.GetOffset()),
+	}
+
+	if req.GetOrderUuid() != "" {
+		orderUUID, err := uuid.Parse(req.GetOrderUuid())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order_uuid format: %v", err)
+		}
+		filter.OrderUuid = orderUUID
+	}
+
+	if req.GetUserUuid() != "" {
+		userUUID, err := uuid.Parse(req.GetUserUuid())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+		}
+		filter.UserUuid = userUUID
+	}
+
+	transactions, err := a.paymentService.ListTransactions(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.ListTransactionsResponse{Transactions: converter.ToProtoTransactions(transactions)}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/converter"
 	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
@@ -19,34 +20,76 @@ func (a *api) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*pa
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	if _, err := uuid.Parse(req.GetOrderUuid()); err != nil {
+	orderUUID, err := uuid.Parse(req.GetOrderUuid())
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid order_uuid format: %v", err)
 	}
 
+	// Пустой user_uuid допустим: транзакция сохраняется с нулевым UUID
+	var userUUID uuid.UUID
+	if req.GetUserUuid() != "" {
+		userUUID, err = uuid.Parse(req.GetUserUuid())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+		}
+	}
+
+	// Сохранённый инструмент задаёт способ оплаты сам
+	var instrumentUUID uuid.UUID
+	if req.GetInstrumentUuid() != "" {
+		instrumentUUID, err = uuid.Parse(req.GetInstrumentUuid())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid instrument_uuid format: %v", err)
+		}
+		if userUUID == uuid.Nil {
+			return nil, status.Error(codes.InvalidArgument, "user_uuid is required to pay with instrument")
+		}
+	}
+
+	// Без способа оплаты и инструмента платим инструментом пользователя по умолчанию
 	pm := req.GetPaymentMethod()
-	if pm == paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED {
+	useDefault := pm == paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED && instrumentUUID == uuid.Nil
+	if useDefault && userUUID == uuid.Nil {
 		return nil, status.Error(codes.InvalidArgument, "payment_method must be specified")
 	}
 
-	var method model.PaymentMethod
-	switch pm {
-	case paymentV1.PaymentMethod_PAYMENT_METHOD_CARD:
-		method = model.PaymentMethodCard
-	case paymentV1.PaymentMethod_PAYMENT_METHOD_SBP:
-		method = model.PaymentMethodSBP
-	case paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
-		method = model.PaymentMethodCreditCard
-	case paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
-		method = model.PaymentMethodInvestorMoney
-	default:
+	method := converter.ToModelPaymentMethod(pm)
+	if pm != paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED && method == model.PaymentMethodUnspecified {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid payment_method: %v", pm)
 	}
 
-	txn, err := a.paymentService.PayOrder(ctx, string(method))
+	var txn string
+	if instrumentUUID != uuid.Nil || useDefault {
+		txn, err = a.paymentService.PayOrderWithInstrument(ctx, orderUUID, userUUID, instrumentUUID, string(method), req.GetAmount(), req.GetCurrency())
+	} else {
+		txn, err = a.paymentService.PayOrder(ctx, orderUUID, userUUID, string(method), req.GetAmount(), req.GetCurrency())
+	}
 	if err != nil {
-		if errors.Is(err, model.ErrInvalidPaymentMethod) {
+		var limitErr *model.AmountLimitError
+		if errors.As(err, &limitErr) {
+			return nil, amountLimitStatus(limitErr)
+		}
+		if errors.Is(err, model.ErrInstrumentNotFound) {
+			return nil, status.Error(codes.NotFound, "payment instrument not found")
+		}
+		if errors.Is(err, model.ErrNoDefaultInstrument) {
+			return nil, status.Error(codes.FailedPrecondition, "payment_method or instrument_uuid must be specified: no default payment instrument")
+		}
+		if errors.Is(err, model.ErrInvalidPaymentMethod) ||
+			errors.Is(err, model.ErrInstrumentMethodMismatch) ||
+			errors.Is(err, model.ErrInvalidAmount) ||
+			errors.Is(err, model.ErrUnsupportedCurrency) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, model.ErrPaymentConflict) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, model.ErrPaymentDeclined) || errors.Is(err, model.ErrFraudDenied) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, model.ErrProviderUnavailable) {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
@@ -59,3 +102,20 @@ func (a *api) PayOrder(ctx context.Context, req *paymentV1.PayOrderRequest) (*pa
 
 	return &paymentV1.PayOrderResponse{TransactionUuid: txn}, nil
 }
+
+// amountLimitStatus возвращает FailedPrecondition с деталями нарушенного лимита,
+// чтобы клиент мог отличить отказ по лимиту от прочих ошибок
+func amountLimitStatus(limitErr *model.AmountLimitError) error {
+	st := status.New(codes.FailedPrecondition, limitErr.Error())
+	detailed, err := st.WithDetails(&paymentV1.AmountLimitViolation{
+		PaymentMethod: converter.ToProtoPaymentMethod(limitErr.PaymentMethod),
+		Amount:        limitErr.Amount,
+		MinAmount:     limitErr.Limit.Min,
+		MaxAmount:     limitErr.Limit.Max,
+		Currency:      limitErr.Currency,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -22,7 +23,7 @@ func (s *APISuite) TestPayOrderSuccess() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "CARD").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CARD", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -42,7 +43,7 @@ func (s *APISuite) TestPayOrderWithSBP() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "SBP").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "SBP", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -62,7 +63,7 @@ func (s *APISuite) TestPayOrderWithCreditCard() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "CREDIT_CARD").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CREDIT_CARD", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -82,7 +83,7 @@ func (s *APISuite) TestPayOrderWithInvestorMoney() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "INVESTOR_MONEY").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "INVESTOR_MONEY", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -131,10 +132,9 @@ func (s *APISuite) TestPayOrderInvalidOrderUUID() {
 func (s *APISuite) TestPayOrderUnspecifiedPaymentMethod() {
 	var (
 		orderUUID = gofakeit.UUID()
-		userUUID  = gofakeit.UUID()
-		req       = &paymentV1.PayOrderRequest{
+		// Без пользователя нельзя взять его инструмент по умолчанию
+		req = &paymentV1.PayOrderRequest{
 			OrderUuid:     orderUUID,
-			UserUuid:      userUUID,
 			PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED,
 		}
 	)
@@ -181,7 +181,7 @@ func (s *APISuite) TestPayOrderServiceInvalidPaymentMethod() {
 		}
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "CARD").Return("", model.ErrInvalidPaymentMethod)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CARD", mock.Anything, mock.Anything).Return("", model.ErrInvalidPaymentMethod)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().Error(err)
@@ -193,6 +193,26 @@ func (s *APISuite) TestPayOrderServiceInvalidPaymentMethod() {
 	s.Require().Contains(st.Message(), "invalid payment method")
 }
 
+func (s *APISuite) TestPayOrderFraudDenied() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid:     gofakeit.UUID(),
+		UserUuid:      gofakeit.UUID(),
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD,
+	}
+
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CREDIT_CARD", mock.Anything, mock.Anything).
+		Return("", &model.FraudDeniedError{Reason: "velocity 10 attempts in 1m0s"})
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.FailedPrecondition, st.Code())
+	s.Require().Contains(st.Message(), "velocity 10 attempts")
+}
+
 func (s *APISuite) TestPayOrderServiceError() {
 	var (
 		orderUUID = gofakeit.UUID()
@@ -205,7 +225,7 @@ func (s *APISuite) TestPayOrderServiceError() {
 		serviceErr = gofakeit.Error()
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "CARD").Return("", serviceErr)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CARD", mock.Anything, mock.Anything).Return("", serviceErr)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().Error(err)
@@ -229,7 +249,7 @@ func (s *APISuite) TestPayOrderEmptyUserUUID() {
 	)
 
 	// Empty user UUID should not cause validation error in API layer
-	s.paymentService.On("PayOrder", s.ctx, "CARD").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, uuid.MustParse(orderUUID), uuid.Nil, "CARD", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -249,7 +269,7 @@ func (s *APISuite) TestPayOrderWithValidUUIDs() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "SBP").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "SBP", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -269,7 +289,7 @@ func (s *APISuite) TestPayOrderWithLongTransactionUUID() {
 		expectedTransactionUUID = gofakeit.UUID() + gofakeit.UUID() // long UUID
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "CREDIT_CARD").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CREDIT_CARD", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
@@ -288,10 +308,106 @@ func (s *APISuite) TestPayOrderWithSameOrderAndUserUUID() {
 		expectedTransactionUUID = gofakeit.UUID()
 	)
 
-	s.paymentService.On("PayOrder", s.ctx, "INVESTOR_MONEY").Return(expectedTransactionUUID, nil)
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "INVESTOR_MONEY", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
 
 	res, err := s.api.PayOrder(s.ctx, req)
 	s.Require().NoError(err)
 	s.Require().NotNil(res)
 	s.Require().Equal(expectedTransactionUUID, res.TransactionUuid)
 }
+
+func (s *APISuite) TestPayOrderWithInstrument() {
+	var (
+		orderUUID      = uuid.New()
+		userUUID       = uuid.New()
+		instrumentUUID = uuid.New()
+		req            = &paymentV1.PayOrderRequest{
+			OrderUuid:      orderUUID.String(),
+			UserUuid:       userUUID.String(),
+			InstrumentUuid: instrumentUUID.String(),
+		}
+		expectedTransactionUUID = gofakeit.UUID()
+	)
+
+	s.paymentService.On("PayOrderWithInstrument", s.ctx, orderUUID, userUUID, instrumentUUID, "", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTransactionUUID, res.TransactionUuid)
+}
+
+func (s *APISuite) TestPayOrderWithDefaultInstrument() {
+	var (
+		orderUUID = uuid.New()
+		userUUID  = uuid.New()
+		req       = &paymentV1.PayOrderRequest{
+			OrderUuid: orderUUID.String(),
+			UserUuid:  userUUID.String(),
+		}
+		expectedTransactionUUID = gofakeit.UUID()
+	)
+
+	s.paymentService.On("PayOrderWithInstrument", s.ctx, orderUUID, userUUID, uuid.Nil, "", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTransactionUUID, res.TransactionUuid)
+}
+
+func (s *APISuite) TestPayOrderWithoutDefaultInstrument() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid: gofakeit.UUID(),
+		UserUuid:  gofakeit.UUID(),
+	}
+
+	s.paymentService.On("PayOrderWithInstrument", s.ctx, mock.Anything, mock.Anything, uuid.Nil, "", mock.Anything, mock.Anything).Return("", model.ErrNoDefaultInstrument)
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func (s *APISuite) TestPayOrderWithInstrumentNotFound() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid:      gofakeit.UUID(),
+		UserUuid:       gofakeit.UUID(),
+		InstrumentUuid: gofakeit.UUID(),
+		PaymentMethod:  paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+	}
+
+	s.paymentService.On("PayOrderWithInstrument", s.ctx, mock.Anything, mock.Anything, mock.Anything, "CARD", mock.Anything, mock.Anything).Return("", model.ErrInstrumentNotFound)
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *APISuite) TestPayOrderWithInstrumentMethodMismatch() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid:      gofakeit.UUID(),
+		UserUuid:       gofakeit.UUID(),
+		InstrumentUuid: gofakeit.UUID(),
+		PaymentMethod:  paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+	}
+
+	s.paymentService.On("PayOrderWithInstrument", s.ctx, mock.Anything, mock.Anything, mock.Anything, "SBP", mock.Anything, mock.Anything).Return("", model.ErrInstrumentMethodMismatch)
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestPayOrderWithInstrumentInvalidUUID() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid:      gofakeit.UUID(),
+		UserUuid:       gofakeit.UUID(),
+		InstrumentUuid: "invalid-uuid",
+	}
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -14,7 +14,8 @@ type APISuite struct {
 
 	ctx context.Context
 
-	paymentService *mocks.PaymentService
+	paymentService    *mocks.PaymentService
+	instrumentService *mocks.InstrumentService
 
 	api *api
 }
@@ -23,9 +24,11 @@ func (s *APISuite) SetupTest() {
 	s.ctx = context.Background()
 
 	s.paymentService = mocks.NewPaymentService(s.T())
+	s.instrumentService = mocks.NewInstrumentService(s.T())
 
 	s.api = NewAPI(
 		s.paymentService,
+		s.instrumentService,
 	)
 }
 
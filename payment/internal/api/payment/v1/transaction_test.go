@@ -0,0 +1,240 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) TestPayOrderInvalidUserUUID() {
+	req := &paymentV1.PayOrderRequest{
+		OrderUuid:     uuid.New().String(),
+		UserUuid:      "not-a-uuid",
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+	}
+
+	res, err := s.api.PayOrder(s.ctx, req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.InvalidArgument, st.Code())
+	s.Require().Contains(st.Message(), "invalid user_uuid")
+}
+
+func (s *APISuite) TestPayOrderPassesUUIDs() {
+	var (
+		orderUUID               = uuid.New()
+		userUUID                = uuid.New()
+		expectedTransactionUUID = gofakeit.UUID()
+	)
+
+	s.paymentService.On("PayOrder", s.ctx, orderUUID, userUUID, "SBP", mock.Anything, mock.Anything).Return(expectedTransactionUUID, nil)
+
+	res, err := s.api.PayOrder(s.ctx, &paymentV1.PayOrderRequest{
+		OrderUuid:     orderUUID.String(),
+		UserUuid:      userUUID.String(),
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTransactionUUID, res.GetTransactionUuid())
+}
+
+func (s *APISuite) TestGetTransactionSuccess() {
+	now := time.Now()
+	transaction := model.Transaction{
+		Uuid:          uuid.New(),
+		OrderUuid:     uuid.New(),
+		UserUuid:      uuid.New(),
+		Amount:        150.5,
+		PaymentMethod: model.PaymentMethodCard,
+		Status:        model.TransactionStatusSucceeded,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	s.paymentService.On("GetTransaction", s.ctx, transaction.Uuid).Return(transaction, nil)
+
+	res, err := s.api.GetTransaction(s.ctx, &paymentV1.GetTransactionRequest{TransactionUuid: transaction.Uuid.String()})
+	s.Require().NoError(err)
+
+	got := res.GetTransaction()
+	s.Require().Equal(transaction.Uuid.String(), got.GetTransactionUuid())
+	s.Require().Equal(transaction.OrderUuid.String(), got.GetOrderUuid())
+	s.Require().Equal(transaction.UserUuid.String(), got.GetUserUuid())
+	s.Require().Equal(transaction.Amount, got.GetAmount())
+	s.Require().Equal(paymentV1.PaymentMethod_PAYMENT_METHOD_CARD, got.GetPaymentMethod())
+	s.Require().Equal(paymentV1.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED, got.GetStatus())
+	s.Require().True(now.Equal(got.GetCreatedAt().AsTime()))
+}
+
+func (s *APISuite) TestGetTransactionInvalidUUID() {
+	res, err := s.api.GetTransaction(s.ctx, &paymentV1.GetTransactionRequest{TransactionUuid: "bad"})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *APISuite) TestGetTransactionNotFound() {
+	transactionUUID := uuid.New()
+	s.paymentService.On("GetTransaction", s.ctx, transactionUUID).Return(model.Transaction{}, model.ErrTransactionNotFound)
+
+	res, err := s.api.GetTransaction(s.ctx, &paymentV1.GetTransactionRequest{TransactionUuid: transactionUUID.String()})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *APISuite) TestGetTransactionInternalError() {
+	transactionUUID := uuid.New()
+	s.paymentService.On("GetTransaction", s.ctx, transactionUUID).Return(model.Transaction{}, gofakeit.Error())
+
+	res, err := s.api.GetTransaction(s.ctx, &paymentV1.GetTransactionRequest{TransactionUuid: transactionUUID.String()})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.Internal, status.Code(err))
+}
+
+func (s *APISuite) TestListTransactionsSuccess() {
+	orderUUID := uuid.New()
+	filter := model.TransactionsFilter{OrderUuid: orderUUID, Limit: 10, Offset: 5}
+	transactions := []model.Transaction{
+		{Uuid: uuid.New(), OrderUuid: orderUUID, Status: model.TransactionStatusSucceeded},
+		{Uuid: uuid.New(), OrderUuid: orderUUID, Status: model.TransactionStatusSucceeded},
+	}
+
+	s.paymentService.On("ListTransactions", s.ctx, filter).Return(transactions, nil)
+
+	res, err := s.api.ListTransactions(s.ctx, &paymentV1.ListTransactionsRequest{
+		OrderUuid: orderUUID.String(),
+		Limit:     10,
+		Offset:    5,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.GetTransactions(), 2)
+	s.Require().Equal(transactions[0].Uuid.String(), res.GetTransactions()[0].GetTransactionUuid())
+}
+
+func (s *APISuite) TestListTransactionsInvalidArguments() {
+	cases := []*paymentV1.ListTransactionsRequest{
+		{Limit: -1},
+		{Offset: -1},
+		{OrderUuid: "bad"},
+		{UserUuid: "bad"},
+	}
+
+	for _, req := range cases {
+		res, err := s.api.ListTransactions(s.ctx, req)
+		s.Require().Nil(res)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (s *APISuite) TestListTransactionsInternalError() {
+	s.paymentService.On("ListTransactions", s.ctx, model.TransactionsFilter{}).Return(nil, gofakeit.Error())
+
+	res, err := s.api.ListTransactions(s.ctx, &paymentV1.ListTransactionsRequest{})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.Internal, status.Code(err))
+}
+
+func (s *APISuite) TestPayOrderConflict() {
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CARD", mock.Anything, mock.Anything).Return("", model.ErrPaymentConflict)
+
+	res, err := s.api.PayOrder(s.ctx, &paymentV1.PayOrderRequest{
+		OrderUuid:     uuid.New().String(),
+		UserUuid:      uuid.New().String(),
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+	})
+	s.Require().Nil(res)
+	s.Require().Equal(codes.AlreadyExists, status.Code(err))
+}
+
+func (s *APISuite) TestPayOrderPassesAmountAndCurrency() {
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	expectedTransactionUUID := gofakeit.UUID()
+
+	s.paymentService.On("PayOrder", s.ctx, orderUUID, userUUID, "CARD", 2500.75, "RUB").Return(expectedTransactionUUID, nil)
+
+	res, err := s.api.PayOrder(s.ctx, &paymentV1.PayOrderRequest{
+		OrderUuid:     orderUUID.String(),
+		UserUuid:      userUUID.String(),
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+		Amount:        2500.75,
+		Currency:      "RUB",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTransactionUUID, res.GetTransactionUuid())
+}
+
+func (s *APISuite) TestPayOrderAmountLimitViolation() {
+	limitErr := &model.AmountLimitError{
+		PaymentMethod: model.PaymentMethodSBP,
+		Amount:        700_000,
+		Currency:      "RUB",
+		Limit:         model.AmountLimit{Min: 1, Max: 600_000},
+	}
+	s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "SBP", 700_000.0, "").Return("", limitErr)
+
+	res, err := s.api.PayOrder(s.ctx, &paymentV1.PayOrderRequest{
+		OrderUuid:     uuid.New().String(),
+		UserUuid:      uuid.New().String(),
+		PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_SBP,
+		Amount:        700_000,
+	})
+	s.Require().Nil(res)
+
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.FailedPrecondition, st.Code())
+	s.Require().Len(st.Details(), 1)
+
+	violation, ok := st.Details()[0].(*paymentV1.AmountLimitViolation)
+	s.Require().True(ok)
+	s.Require().Equal(paymentV1.PaymentMethod_PAYMENT_METHOD_SBP, violation.GetPaymentMethod())
+	s.Require().Equal(700_000.0, violation.GetAmount())
+	s.Require().Equal(1.0, violation.GetMinAmount())
+	s.Require().Equal(600_000.0, violation.GetMaxAmount())
+	s.Require().Equal("RUB", violation.GetCurrency())
+}
+
+func (s *APISuite) TestPayOrderInvalidAmountOrCurrency() {
+	for _, serviceErr := range []error{model.ErrInvalidAmount, model.ErrUnsupportedCurrency} {
+		s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CARD", mock.Anything, mock.Anything).Return("", serviceErr).Once()
+
+		res, err := s.api.PayOrder(s.ctx, &paymentV1.PayOrderRequest{
+			OrderUuid:     uuid.New().String(),
+			PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+		})
+		s.Require().Nil(res)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (s *APISuite) TestPayOrderProviderErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: &model.PaymentDeclinedError{Reason: "insufficient_funds"}, code: codes.FailedPrecondition},
+		{err: model.ErrProviderUnavailable, code: codes.Unavailable},
+		{err: model.ErrProviderNotConfigured, code: codes.Internal},
+	}
+
+	for _, tc := range cases {
+		s.paymentService.On("PayOrder", s.ctx, mock.Anything, mock.Anything, "CARD", mock.Anything, mock.Anything).Return("", tc.err).Once()
+
+		res, err := s.api.PayOrder(s.ctx, &paymentV1.PayOrderRequest{
+			OrderUuid:     uuid.New().String(),
+			PaymentMethod: paymentV1.PaymentMethod_PAYMENT_METHOD_CARD,
+			Amount:        100,
+		})
+		s.Require().Nil(res)
+		s.Require().Equal(tc.code, status.Code(err), tc.err.Error())
+	}
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/service"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+type api struct {
+	paymentV1.UnimplementedWalletServiceServer
+
+	walletService service.WalletService
+}
+
+func NewAPI(walletService service.WalletService) *api {
+	return &api{
+		walletService: walletService,
+	}
+}
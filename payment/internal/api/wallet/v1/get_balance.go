@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) GetBalance(ctx context.Context, req *paymentV1.GetBalanceRequest) (*paymentV1.GetBalanceResponse, error) {
+	userUUID, err := uuid.Parse(req.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	}
+
+	account, err := a.walletService.GetBalance(ctx, userUUID, req.GetCurrency())
+	if err != nil {
+		if errors.Is(err, model.ErrUnsupportedCurrency) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.GetBalanceResponse{
+		UserUuid: userUUID.String(),
+		Balance:  account.Balance,
+		Currency: account.Key.Currency,
+	}, nil
+}
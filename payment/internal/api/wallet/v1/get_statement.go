@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/converter"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) GetStatement(ctx context.Context, req *paymentV1.GetStatementRequest) (*paymentV1.GetStatementResponse, error) {
+	if req.GetLimit() < 0 || req.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	userUUID, err := uuid.Parse(req.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	}
+
+	statement, err := a.walletService.GetStatement(ctx, model.StatementFilter{
+		UserUuid: userUUID,
+		Currency: req.GetCurrency(),
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrUnsupportedCurrency) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.GetStatementResponse{
+		Balance:  statement.Balance,
+		Currency: statement.Currency,
+		Lines:    converter.ToProtoStatementLines(statement.Lines),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/service/mocks"
+)
+
+type APISuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	walletService *mocks.WalletService
+
+	api *api
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.walletService = mocks.NewWalletService(s.T())
+
+	s.api = NewAPI(
+		s.walletService,
+	)
+}
+
+func (s *APISuite) TearDownTest() {
+}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
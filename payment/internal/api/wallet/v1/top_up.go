@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (a *api) TopUp(ctx context.Context, req *paymentV1.TopUpRequest) (*paymentV1.TopUpResponse, error) {
+	userUUID, err := uuid.Parse(req.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_uuid format: %v", err)
+	}
+
+	entry, account, err := a.walletService.TopUp(ctx, model.TopUp{
+		UserUuid:       userUUID,
+		Amount:         req.GetAmount(),
+		Currency:       req.GetCurrency(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidAmount) || errors.Is(err, model.ErrUnsupportedCurrency) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, model.ErrEntryConflict) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &paymentV1.TopUpResponse{
+		EntryUuid: entry.Uuid.String(),
+		Balance:   account.Balance,
+		Currency:  account.Key.Currency,
+	}, nil
+}
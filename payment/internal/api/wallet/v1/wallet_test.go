@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func (s *APISuite) requireCode(err error, code codes.Code) {
+	s.Require().Error(err)
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(code, st.Code())
+}
+
+func (s *APISuite) TestGetBalance() {
+	userUUID := uuid.New()
+	account := model.Account{Key: model.UserAccount(userUUID, "RUB"), Balance: 320.5}
+	s.walletService.On("GetBalance", s.ctx, userUUID, "").Return(account, nil)
+
+	res, err := s.api.GetBalance(s.ctx, &paymentV1.GetBalanceRequest{UserUuid: userUUID.String()})
+	s.Require().NoError(err)
+	s.Equal(userUUID.String(), res.GetUserUuid())
+	s.Equal(320.5, res.GetBalance())
+	s.Equal("RUB", res.GetCurrency())
+}
+
+func (s *APISuite) TestGetBalanceInvalidUserUUID() {
+	_, err := s.api.GetBalance(s.ctx, &paymentV1.GetBalanceRequest{UserUuid: "not-a-uuid"})
+	s.requireCode(err, codes.InvalidArgument)
+}
+
+func (s *APISuite) TestTopUp() {
+	userUUID := uuid.New()
+	entry := model.JournalEntry{Uuid: uuid.New(), Kind: model.EntryKindTopUp, Amount: 1000}
+	account := model.Account{Key: model.UserAccount(userUUID, "RUB"), Balance: 1000}
+	s.walletService.On("TopUp", s.ctx, model.TopUp{
+		UserUuid:       userUUID,
+		Amount:         1000,
+		IdempotencyKey: "key-1",
+	}).Return(entry, account, nil)
+
+	res, err := s.api.TopUp(s.ctx, &paymentV1.TopUpRequest{
+		UserUuid:       userUUID.String(),
+		Amount:         1000,
+		IdempotencyKey: "key-1",
+	})
+	s.Require().NoError(err)
+	s.Equal(entry.Uuid.String(), res.GetEntryUuid())
+	s.Equal(1000.0, res.GetBalance())
+}
+
+func (s *APISuite) TestTopUpErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{model.ErrInvalidAmount, codes.InvalidArgument},
+		{fmt.Errorf("%w: USD", model.ErrUnsupportedCurrency), codes.InvalidArgument},
+		{model.ErrEntryConflict, codes.AlreadyExists},
+		{fmt.Errorf("db is down"), codes.Internal},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.err.Error(), func() {
+			s.walletService.On("TopUp", s.ctx, mock.Anything).
+				Return(model.JournalEntry{}, model.Account{}, tc.err).Once()
+
+			_, err := s.api.TopUp(s.ctx, &paymentV1.TopUpRequest{UserUuid: uuid.NewString(), Amount: 1})
+			s.requireCode(err, tc.code)
+		})
+	}
+}
+
+func (s *APISuite) TestGetStatement() {
+	userUUID := uuid.New()
+	now := time.Now()
+	statement := model.Statement{
+		Balance:  700,
+		Currency: "RUB",
+		Lines: []model.StatementLine{
+			{EntryUuid: uuid.New(), Kind: model.EntryKindPayment, Reference: uuid.NewString(), Amount: -300, BalanceAfter: 700, CreatedAt: now},
+			{EntryUuid: uuid.New(), Kind: model.EntryKindTopUp, Reference: "key-1", Amount: 1000, BalanceAfter: 1000, CreatedAt: now},
+		},
+	}
+	s.walletService.On("GetStatement", s.ctx, model.StatementFilter{
+		UserUuid: userUUID,
+		Limit:    10,
+		Offset:   5,
+	}).Return(statement, nil)
+
+	res, err := s.api.GetStatement(s.ctx, &paymentV1.GetStatementRequest{
+		UserUuid: userUUID.String(),
+		Limit:    10,
+		Offset:   5,
+	})
+	s.Require().NoError(err)
+	s.Equal(700.0, res.GetBalance())
+	s.Require().Len(res.GetLines(), 2)
+	s.Equal(paymentV1.EntryKind_ENTRY_KIND_PAYMENT, res.GetLines()[0].GetKind())
+	s.Equal(-300.0, res.GetLines()[0].GetAmount())
+	s.Equal(paymentV1.EntryKind_ENTRY_KIND_TOP_UP, res.GetLines()[1].GetKind())
+	s.Equal("key-1", res.GetLines()[1].GetReference())
+}
+
+func (s *APISuite) TestGetStatementNegativeLimit() {
+	_, err := s.api.GetStatement(s.ctx, &paymentV1.GetStatementRequest{UserUuid: uuid.NewString(), Limit: -1})
+	s.requireCode(err, codes.InvalidArgument)
+}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/service"
+)
+
+// api принимает webhook платёжного провайдера с итогом отложенного списания
+type api struct {
+	paymentService service.PaymentService
+
+	secret    []byte
+	tolerance time.Duration
+}
+
+func NewAPI(paymentService service.PaymentService, secret []byte, tolerance time.Duration) *api {
+	return &api{
+		paymentService: paymentService,
+		secret:         secret,
+		tolerance:      tolerance,
+	}
+}
@@ -0,0 +1,16 @@
+package v1
+
+// paymentWebhook — тело уведомления PSP; reference — UUID транзакции
+type paymentWebhook struct {
+	ID            string `json:"id"`
+	Reference     string `json:"reference"`
+	OrderUUID     string `json:"order_uuid"`
+	Status        string `json:"status"`
+	DeclineReason string `json:"decline_reason,omitempty"`
+}
+
+const (
+	statusSucceeded = "succeeded"
+	statusDeclined  = "declined"
+	statusPending   = "pending"
+)
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/webhook"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const maxBodySize = 1 << 20
+
+// ServeHTTP обрабатывает POST webhook. Ответ 5xx просит PSP повторить доставку
+func (a *api) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	err = webhook.Verify(a.secret,
+		r.Header.Get(webhook.TimestampHeader),
+		r.Header.Get(webhook.SignatureHeader),
+		body, time.Now(), a.tolerance,
+	)
+	if err != nil {
+		logger.Warn(ctx, "rejected payment webhook", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var payload paymentWebhook
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid webhook body", http.StatusBadRequest)
+		return
+	}
+
+	transactionUUID, err := uuid.Parse(payload.Reference)
+	if err != nil {
+		http.Error(w, "invalid reference", http.StatusBadRequest)
+		return
+	}
+
+	var status model.ChargeStatus
+	switch payload.Status {
+	case statusSucceeded:
+		status = model.ChargeStatusSucceeded
+	case statusDeclined:
+		status = model.ChargeStatusDeclined
+	case statusPending:
+		status = model.ChargeStatusPending
+	default:
+		http.Error(w, "unknown status", http.StatusBadRequest)
+		return
+	}
+
+	err = a.paymentService.ConfirmPayment(ctx, transactionUUID, model.ChargeResult{
+		ProviderReference: payload.ID,
+		Status:            status,
+		DeclineReason:     payload.DeclineReason,
+	})
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+	case errors.Is(err, model.ErrTransactionNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, model.ErrTransactionFinalized):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func webhookBody(reference, status, reason string) []byte {
+	return fmt.Appendf(nil, `{"id":"psp_1","reference":%q,"order_uuid":%q,"status":%q,"decline_reason":%q}`,
+		reference, uuid.NewString(), status, reason)
+}
+
+func (s *APISuite) TestWebhookSucceeded() {
+	transactionUUID := uuid.New()
+	s.paymentService.On("ConfirmPayment", mock.Anything, transactionUUID, model.ChargeResult{
+		ProviderReference: "psp_1",
+		Status:            model.ChargeStatusSucceeded,
+	}).Return(nil)
+
+	rec := s.send(testSecret, webhookBody(transactionUUID.String(), "succeeded", ""))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *APISuite) TestWebhookDeclined() {
+	transactionUUID := uuid.New()
+	s.paymentService.On("ConfirmPayment", mock.Anything, transactionUUID, model.ChargeResult{
+		ProviderReference: "psp_1",
+		Status:            model.ChargeStatusDeclined,
+		DeclineReason:     "insufficient_funds",
+	}).Return(nil)
+
+	rec := s.send(testSecret, webhookBody(transactionUUID.String(), "declined", "insufficient_funds"))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *APISuite) TestWebhookInvalidSignature() {
+	rec := s.send("other-secret", webhookBody(uuid.NewString(), "succeeded", ""))
+	s.Require().Equal(http.StatusUnauthorized, rec.Code)
+	s.paymentService.AssertNotCalled(s.T(), "ConfirmPayment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *APISuite) TestWebhookInvalidBody() {
+	cases := [][]byte{
+		[]byte("not json"),
+		webhookBody("not-a-uuid", "succeeded", ""),
+		webhookBody(uuid.NewString(), "refunded", ""),
+	}
+
+	for _, body := range cases {
+		rec := s.send(testSecret, body)
+		s.Require().Equal(http.StatusBadRequest, rec.Code, string(body))
+	}
+	s.paymentService.AssertNotCalled(s.T(), "ConfirmPayment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *APISuite) TestWebhookServiceErrors() {
+	cases := []struct {
+		err  error
+		code int
+	}{
+		{err: model.ErrTransactionNotFound, code: http.StatusNotFound},
+		{err: fmt.Errorf("%w: FAILED", model.ErrTransactionFinalized), code: http.StatusConflict},
+		// PSP повторит доставку
+		{err: gofakeit.Error(), code: http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		transactionUUID := uuid.New()
+		s.paymentService.On("ConfirmPayment", mock.Anything, transactionUUID, mock.Anything).Return(tc.err).Once()
+
+		rec := s.send(testSecret, webhookBody(transactionUUID.String(), "succeeded", ""))
+		s.Require().Equal(tc.code, rec.Code, tc.err.Error())
+	}
+}
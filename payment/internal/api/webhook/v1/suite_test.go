@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/service/mocks"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/webhook"
+)
+
+const testSecret = "test-webhook-secret"
+
+type APISuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	paymentService *mocks.PaymentService
+
+	api *api
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.paymentService = mocks.NewPaymentService(s.T())
+
+	s.api = NewAPI(
+		s.paymentService,
+		[]byte(testSecret),
+		time.Minute,
+	)
+}
+
+func (s *APISuite) TearDownTest() {
+}
+
+// send отправляет webhook, подписанный секретом secret
+func (s *APISuite) send(secret string, body []byte) *httptest.ResponseRecorder {
+	now := time.Now()
+	req := httptest.NewRequestWithContext(s.ctx, http.MethodPost, "/api/v1/payments/webhook", bytes.NewReader(body))
+	req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign([]byte(secret), now, body))
+
+	rec := httptest.NewRecorder()
+	s.api.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	grpcMiddleware "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+var testServiceKeys = map[string][]byte{
+	orderService:      []byte("order-key"),
+	backofficeService: []byte("backoffice-key"),
+}
+
+// signedContext подписывает вызов так же, как клиентский interceptor сервиса-отправителя
+func signedContext(t *testing.T, service, fullMethod string) context.Context {
+	t.Helper()
+
+	var md metadata.MD
+	err := grpcMiddleware.ServiceTokenUnaryClientInterceptor(service, testServiceKeys[service])(
+		context.Background(), fullMethod, nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestServiceAllowList(t *testing.T) {
+	interceptor := grpcMiddleware.NewServiceAuthInterceptor(testServiceKeys, time.Minute, serviceAllowList)
+
+	tests := []struct {
+		name     string
+		service  string
+		method   string
+		wantCode codes.Code
+	}{
+		{name: "order pays", service: orderService, method: paymentV1.PaymentService_PayOrder_FullMethodName, wantCode: codes.OK},
+		{name: "backoffice cannot pay", service: backofficeService, method: paymentV1.PaymentService_PayOrder_FullMethodName, wantCode: codes.PermissionDenied},
+		{name: "order cannot top up", service: orderService, method: paymentV1.WalletService_TopUp_FullMethodName, wantCode: codes.PermissionDenied},
+		{name: "order cannot read wallet", service: orderService, method: paymentV1.WalletService_GetBalance_FullMethodName, wantCode: codes.PermissionDenied},
+		{name: "backoffice tops up", service: backofficeService, method: paymentV1.WalletService_TopUp_FullMethodName, wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			_, err := interceptor.Unary()(
+				signedContext(t, tt.service, tt.method),
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(context.Context, any) (any, error) {
+					called = true
+					return nil, nil
+				},
+			)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantCode == codes.OK, called)
+		})
+	}
+}
+
+func TestTopUpWithoutServiceTokenRejected(t *testing.T) {
+	interceptor := grpcMiddleware.NewServiceAuthInterceptor(testServiceKeys, time.Minute, serviceAllowList)
+
+	_, err := interceptor.Unary()(
+		context.Background(),
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: paymentV1.WalletService_TopUp_FullMethodName},
+		func(context.Context, any) (any, error) {
+			t.Fatal("handler must not run")
+			return nil, nil
+		},
+	)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -4,24 +4,51 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/nkolesnikov999/micro2-OK/payment/internal/config"
+	paymentMetrics "github.com/nkolesnikov999/micro2-OK/payment/internal/metrics"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/grpc/health"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/metrics"
+	grpcMiddleware "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
 	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
 )
 
+// Имена сервисов-клиентов, которым выдаются ключи SERVICE_AUTH_KEYS
+const (
+	orderService = "order-service"
+	// backofficeService — административные инструменты (пополнение кошельков)
+	backofficeService = "backoffice"
+)
+
+// serviceAllowList — какие сервисы могут вызывать RPC payment
+var serviceAllowList = grpcMiddleware.ServiceAllowList{
+	paymentV1.PaymentService_PayOrder_FullMethodName:                {orderService},
+	paymentV1.PaymentService_GetTransaction_FullMethodName:          {orderService, backofficeService},
+	paymentV1.PaymentService_ListTransactions_FullMethodName:        {orderService, backofficeService},
+	paymentV1.PaymentService_AddPaymentInstrument_FullMethodName:    {orderService, backofficeService},
+	paymentV1.PaymentService_ListPaymentInstruments_FullMethodName:  {orderService, backofficeService},
+	paymentV1.PaymentService_DeletePaymentInstrument_FullMethodName: {orderService, backofficeService},
+	"/" + paymentV1.WalletService_ServiceDesc.ServiceName + "/":     {backofficeService},
+	// Пополнение создаёт деньги из ничего: только backoffice, даже если префикс кошелька расширят
+	paymentV1.WalletService_TopUp_FullMethodName: {backofficeService},
+}
+
 type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
 	listener    net.Listener
+	httpServer  *http.Server
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -36,7 +63,38 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	return a.runGRPCServer(ctx)
+	// Канал для ошибок от компонентов
+	errCh := make(chan error, 2)
+
+	// Контекст для остановки всех горутин
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// gRPC сервер
+	go func() {
+		if err := a.runGRPCServer(ctx); err != nil {
+			errCh <- errors.Errorf("grpc server crashed: %v", err)
+		}
+	}()
+
+	// HTTP сервер для webhook платёжного провайдера
+	go func() {
+		if err := a.runHTTPServer(ctx); err != nil {
+			errCh <- errors.Errorf("http server crashed: %v", err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		logger.Info(ctx, "Shutdown signal received")
+	case err := <-errCh:
+		logger.Error(ctx, "Component crashed, shutting down", zap.Error(err))
+		cancel()
+		<-ctx.Done()
+		return err
+	}
+
+	return nil
 }
 
 func (a *App) initDeps(ctx context.Context) error {
@@ -45,8 +103,10 @@ func (a *App) initDeps(ctx context.Context) error {
 		a.initLogger,
 		a.initTracing,
 		a.initCloser,
+		a.initMetrics,
 		a.initListener,
 		a.initGRPCServer,
+		a.initHTTPServer,
 	}
 
 	for _, f := range inits {
@@ -91,6 +151,22 @@ func (a *App) initCloser(_ context.Context) error {
 	return nil
 }
 
+func (a *App) initMetrics(ctx context.Context) error {
+	err := metrics.InitProvider(ctx, config.AppConfig().MetricCollector)
+	if err != nil {
+		return err
+	}
+
+	err = paymentMetrics.InitMetrics(config.AppConfig().MetricCollector.ServiceName())
+	if err != nil {
+		return err
+	}
+
+	closer.AddNamed("metrics provider", metrics.Shutdown)
+
+	return nil
+}
+
 func (a *App) initListener(_ context.Context) error {
 	listener, err := net.Listen("tcp", config.AppConfig().GRPC.Address())
 	if err != nil {
@@ -111,9 +187,23 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
+	// Health check и reflection доступны без токена: их вызывают probe и grpcurl
+	serviceAuth := grpcMiddleware.NewServiceAuthInterceptor(
+		config.AppConfig().ServiceAuth.Keys(),
+		config.AppConfig().ServiceAuth.Tolerance(),
+		serviceAllowList,
+		"/"+grpc_health_v1.Health_ServiceDesc.ServiceName+"/",
+		"/grpc.reflection.v1.ServerReflection/",
+		"/grpc.reflection.v1alpha.ServerReflection/",
+	)
+
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
-		grpc.UnaryInterceptor(tracing.UnaryServerInterceptor(config.AppConfig().Tracing.ServiceName())),
+		grpc.ChainUnaryInterceptor(
+			tracing.UnaryServerInterceptor(config.AppConfig().Tracing.ServiceName()),
+			serviceAuth.Unary(),
+		),
+		grpc.StreamInterceptor(serviceAuth.Stream()),
 	)
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
@@ -125,6 +215,24 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 	health.RegisterService(a.grpcServer)
 
 	paymentV1.RegisterPaymentServiceServer(a.grpcServer, a.diContainer.PaymentV1API(ctx))
+	paymentV1.RegisterWalletServiceServer(a.grpcServer, a.diContainer.WalletV1API(ctx))
+
+	return nil
+}
+
+func (a *App) initHTTPServer(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle("POST /api/v1/payments/webhook", a.diContainer.WebhookV1API(ctx))
+
+	a.httpServer = &http.Server{
+		Addr:              config.AppConfig().HTTP.Address(),
+		Handler:           tracing.HTTPHandlerMiddleware(config.AppConfig().Tracing.ServiceName())(mux),
+		ReadHeaderTimeout: config.AppConfig().HTTP.ReadTimeout(),
+	}
+
+	closer.AddNamed("HTTP server", func(ctx context.Context) error {
+		return a.httpServer.Shutdown(ctx)
+	})
 
 	return nil
 }
@@ -139,3 +247,14 @@ func (a *App) runGRPCServer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runHTTPServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🚀 HTTP webhook server listening on %s", config.AppConfig().HTTP.Address()))
+
+	err := a.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
@@ -2,17 +2,90 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/sarama"
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	grpcConn "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	paymentV1API "github.com/nkolesnikov999/micro2-OK/payment/internal/api/payment/v1"
+	walletV1API "github.com/nkolesnikov999/micro2-OK/payment/internal/api/wallet/v1"
+	webhookV1API "github.com/nkolesnikov999/micro2-OK/payment/internal/api/webhook/v1"
+	grpcClient "github.com/nkolesnikov999/micro2-OK/payment/internal/client/grpc"
+	iamV1Client "github.com/nkolesnikov999/micro2-OK/payment/internal/client/grpc/iam/v1"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/config"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/encryption"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/provider"
+	localProvider "github.com/nkolesnikov999/micro2-OK/payment/internal/provider/local"
+	pspProvider "github.com/nkolesnikov999/micro2-OK/payment/internal/provider/psp"
+	providerRouter "github.com/nkolesnikov999/micro2-OK/payment/internal/provider/router"
+	walletProvider "github.com/nkolesnikov999/micro2-OK/payment/internal/provider/wallet"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+	instrumentRepository "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/instrument"
+	transactionRepository "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/transaction"
+	velocityRepository "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/velocity"
+	walletRepository "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/wallet"
 	"github.com/nkolesnikov999/micro2-OK/payment/internal/service"
+	fraudService "github.com/nkolesnikov999/micro2-OK/payment/internal/service/fraud"
+	instrumentService "github.com/nkolesnikov999/micro2-OK/payment/internal/service/instrument"
 	paymentService "github.com/nkolesnikov999/micro2-OK/payment/internal/service/payment"
+	paymentProducer "github.com/nkolesnikov999/micro2-OK/payment/internal/service/producer/payment_producer"
+	walletService "github.com/nkolesnikov999/micro2-OK/payment/internal/service/wallet"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/cache"
+	redisClient "github.com/nkolesnikov999/micro2-OK/platform/pkg/cache/redis"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/closer"
+	wrappedKafka "github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka"
+	wrappedKafkaProducer "github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka/producer"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/migrator"
 	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+	userV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/user/v1"
+)
+
+// Имена провайдеров в переменных PROVIDER_*
+const (
+	providerLocal  = "local"
+	providerPSP    = "psp"
+	providerWallet = "wallet"
 )
 
 type diContainer struct {
 	paymentV1API paymentV1.PaymentServiceServer
+	walletV1API  paymentV1.WalletServiceServer
+	webhookV1API http.Handler
+
+	paymentService         service.PaymentService
+	paymentProducerService service.PaymentProducerService
+	walletService          service.WalletService
+	fraudService           service.FraudService
+	instrumentService      service.InstrumentService
+
+	transactionRepository repository.TransactionRepository
+	walletRepository      repository.WalletRepository
+	velocityRepository    repository.VelocityRepository
+	instrumentRepository  repository.InstrumentRepository
+
+	instrumentCipher *encryption.Cipher
+
+	paymentProvider provider.Provider
 
-	paymentService service.PaymentService
+	iamClient grpcClient.IAMClient
+	iamConn   *grpcConn.ClientConn
+
+	postgresPool *pgxpool.Pool
+
+	redisPool   *redigo.Pool
+	redisClient cache.RedisClient
+
+	syncProducer             sarama.SyncProducer
+	paymentCompletedProducer wrappedKafka.Producer
+	paymentFailedProducer    wrappedKafka.Producer
 }
 
 func NewDiContainer() *diContainer {
@@ -21,16 +94,293 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) PaymentV1API(ctx context.Context) paymentV1.PaymentServiceServer {
 	if d.paymentV1API == nil {
-		d.paymentV1API = paymentV1API.NewAPI(d.PaymentService(ctx))
+		d.paymentV1API = paymentV1API.NewAPI(d.PaymentService(ctx), d.InstrumentService(ctx))
 	}
 
 	return d.paymentV1API
 }
 
+func (d *diContainer) WalletV1API(ctx context.Context) paymentV1.WalletServiceServer {
+	if d.walletV1API == nil {
+		d.walletV1API = walletV1API.NewAPI(d.WalletService(ctx))
+	}
+
+	return d.walletV1API
+}
+
+func (d *diContainer) WebhookV1API(ctx context.Context) http.Handler {
+	if d.webhookV1API == nil {
+		d.webhookV1API = webhookV1API.NewAPI(
+			d.PaymentService(ctx),
+			config.AppConfig().Webhook.Secret(),
+			config.AppConfig().Webhook.Tolerance(),
+		)
+	}
+
+	return d.webhookV1API
+}
+
 func (d *diContainer) PaymentService(ctx context.Context) service.PaymentService {
 	if d.paymentService == nil {
-		d.paymentService = paymentService.NewService()
+		d.paymentService = paymentService.NewService(
+			d.TransactionRepository(ctx),
+			d.InstrumentRepository(ctx),
+			d.PaymentProvider(ctx),
+			d.PaymentProducerService(),
+			d.FraudService(ctx),
+			config.AppConfig().Limits.Currency(),
+			config.AppConfig().Limits.Limits(),
+		)
 	}
 
 	return d.paymentService
 }
+
+func (d *diContainer) WalletService(ctx context.Context) service.WalletService {
+	if d.walletService == nil {
+		d.walletService = walletService.NewService(
+			d.WalletRepository(ctx),
+			config.AppConfig().Limits.Currency(),
+		)
+	}
+
+	return d.walletService
+}
+
+func (d *diContainer) InstrumentService(ctx context.Context) service.InstrumentService {
+	if d.instrumentService == nil {
+		d.instrumentService = instrumentService.NewService(d.InstrumentRepository(ctx))
+	}
+
+	return d.instrumentService
+}
+
+func (d *diContainer) FraudService(ctx context.Context) service.FraudService {
+	if d.fraudService == nil {
+		d.fraudService = fraudService.NewService(
+			d.VelocityRepository(ctx),
+			d.IAMClient(ctx),
+			config.AppConfig().Fraud.Rules(),
+		)
+	}
+
+	return d.fraudService
+}
+
+func (d *diContainer) PaymentProducerService() service.PaymentProducerService {
+	if d.paymentProducerService == nil {
+		d.paymentProducerService = paymentProducer.NewService(
+			d.PaymentCompletedProducer(),
+			d.PaymentFailedProducer(),
+		)
+	}
+
+	return d.paymentProducerService
+}
+
+// PaymentProvider собирает маршрутизатор провайдеров по способам оплаты из конфига
+func (d *diContainer) PaymentProvider(ctx context.Context) provider.Provider {
+	if d.paymentProvider == nil {
+		providers := map[string]provider.Provider{
+			providerLocal: localProvider.NewProvider(),
+			providerPSP: pspProvider.NewProvider(
+				config.AppConfig().Provider.PSPURL(),
+				config.AppConfig().Provider.PSPTimeout(),
+			),
+			providerWallet: walletProvider.NewProvider(d.WalletRepository(ctx)),
+		}
+
+		routes := make(map[model.PaymentMethod]provider.Provider)
+		for method, name := range config.AppConfig().Provider.Routes() {
+			p, ok := providers[name]
+			if !ok {
+				panic(fmt.Errorf("unknown payment provider %q for %s", name, method))
+			}
+			routes[method] = p
+		}
+
+		d.paymentProvider = providerRouter.NewRouter(routes)
+	}
+
+	return d.paymentProvider
+}
+
+func (d *diContainer) TransactionRepository(ctx context.Context) repository.TransactionRepository {
+	if d.transactionRepository == nil {
+		d.transactionRepository = transactionRepository.NewRepository(d.PostgresPool(ctx))
+	}
+
+	return d.transactionRepository
+}
+
+func (d *diContainer) WalletRepository(ctx context.Context) repository.WalletRepository {
+	if d.walletRepository == nil {
+		d.walletRepository = walletRepository.NewRepository(d.PostgresPool(ctx))
+	}
+
+	return d.walletRepository
+}
+
+func (d *diContainer) InstrumentRepository(ctx context.Context) repository.InstrumentRepository {
+	if d.instrumentRepository == nil {
+		d.instrumentRepository = instrumentRepository.NewRepository(d.PostgresPool(ctx), d.InstrumentCipher())
+	}
+
+	return d.instrumentRepository
+}
+
+func (d *diContainer) InstrumentCipher() *encryption.Cipher {
+	if d.instrumentCipher == nil {
+		c, err := encryption.NewCipher(config.AppConfig().Encryption.Key())
+		if err != nil {
+			panic(fmt.Errorf("failed to create instrument cipher: %w", err))
+		}
+
+		d.instrumentCipher = c
+	}
+
+	return d.instrumentCipher
+}
+
+func (d *diContainer) VelocityRepository(ctx context.Context) repository.VelocityRepository {
+	if d.velocityRepository == nil {
+		d.velocityRepository = velocityRepository.NewRepository(d.RedisClient(ctx))
+	}
+
+	return d.velocityRepository
+}
+
+func (d *diContainer) IAMConn(ctx context.Context) *grpcConn.ClientConn {
+	if d.iamConn == nil {
+		conn, err := grpcConn.NewClient(
+			config.AppConfig().IAMGRPC.Address(),
+			grpcConn.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			panic(fmt.Errorf("failed to connect to IAM service: %w", err))
+		}
+
+		closer.AddNamed("IAM gRPC connection", func(ctx context.Context) error {
+			return conn.Close()
+		})
+
+		d.iamConn = conn
+	}
+
+	return d.iamConn
+}
+
+func (d *diContainer) IAMClient(ctx context.Context) grpcClient.IAMClient {
+	if d.iamClient == nil {
+		d.iamClient = iamV1Client.NewClient(userV1.NewUserServiceClient(d.IAMConn(ctx)))
+	}
+
+	return d.iamClient
+}
+
+func (d *diContainer) PostgresPool(ctx context.Context) *pgxpool.Pool {
+	if d.postgresPool == nil {
+		pool, err := pgxpool.New(ctx, config.AppConfig().Postgres.URI())
+		if err != nil {
+			panic(fmt.Errorf("failed to connect to PostgreSQL: %w", err))
+		}
+
+		err = pool.Ping(ctx)
+		if err != nil {
+			panic(fmt.Errorf("failed to ping PostgreSQL: %w", err))
+		}
+
+		migrationsDir := config.AppConfig().Postgres.MigrationsDir()
+		migratorRunner := migrator.NewMigrator(stdlib.OpenDBFromPool(pool), migrationsDir)
+		err = migratorRunner.Up()
+		if err != nil {
+			panic(fmt.Errorf("failed to run migrations: %w", err))
+		}
+
+		closer.AddNamed("PostgreSQL pool", func(ctx context.Context) error {
+			pool.Close()
+			return nil
+		})
+
+		d.postgresPool = pool
+	}
+
+	return d.postgresPool
+}
+
+func (d *diContainer) RedisPool() *redigo.Pool {
+	if d.redisPool == nil {
+		redisCfg := config.AppConfig().Redis
+		d.redisPool = &redigo.Pool{
+			MaxIdle:     redisCfg.MaxIdle(),
+			IdleTimeout: redisCfg.IdleTimeout(),
+			Dial: func() (redigo.Conn, error) {
+				return redigo.Dial("tcp", redisCfg.Address())
+			},
+			TestOnBorrow: func(c redigo.Conn, t time.Time) error {
+				_, err := c.Do("PING")
+				return err
+			},
+		}
+
+		closer.AddNamed("Redis pool", func(ctx context.Context) error {
+			return d.redisPool.Close()
+		})
+	}
+
+	return d.redisPool
+}
+
+func (d *diContainer) RedisClient(ctx context.Context) cache.RedisClient {
+	if d.redisClient == nil {
+		redisCfg := config.AppConfig().Redis
+		d.redisClient = redisClient.NewClient(
+			d.RedisPool(),
+			logger.Logger(),
+			redisCfg.ConnectionTimeout(),
+		)
+	}
+
+	return d.redisClient
+}
+
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().PaymentProducer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create sync producer: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.syncProducer = p
+	}
+
+	return d.syncProducer
+}
+
+func (d *diContainer) PaymentCompletedProducer() wrappedKafka.Producer {
+	if d.paymentCompletedProducer == nil {
+		d.paymentCompletedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PaymentProducer.PaymentCompletedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.paymentCompletedProducer
+}
+
+func (d *diContainer) PaymentFailedProducer() wrappedKafka.Producer {
+	if d.paymentFailedProducer == nil {
+		d.paymentFailedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PaymentProducer.PaymentFailedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.paymentFailedProducer
+}
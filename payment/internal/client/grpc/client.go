@@ -0,0 +1,14 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+type IAMClient interface {
+	// GetUser возвращает ErrUserNotFound, если пользователя нет в IAM
+	GetUser(ctx context.Context, userUUID uuid.UUID) (model.User, error)
+}
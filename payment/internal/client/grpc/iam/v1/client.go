@@ -0,0 +1,18 @@
+package v1
+
+import (
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/client/grpc"
+	userV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/user/v1"
+)
+
+var _ def.IAMClient = (*client)(nil)
+
+type client struct {
+	userClient userV1.UserServiceClient
+}
+
+func NewClient(userClient userV1.UserServiceClient) *client {
+	return &client{
+		userClient: userClient,
+	}
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	userV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/user/v1"
+)
+
+func (c *client) GetUser(ctx context.Context, userUUID uuid.UUID) (model.User, error) {
+	response, err := c.userClient.GetUser(ctx, &userV1.GetUserRequest{
+		UserUuid: userUUID.String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.User{}, fmt.Errorf("%w: %s", model.ErrUserNotFound, userUUID)
+		}
+		return model.User{}, err
+	}
+
+	return model.User{
+		Uuid:      userUUID,
+		CreatedAt: response.GetUser().GetCreatedAt().AsTime(),
+	}, nil
+}
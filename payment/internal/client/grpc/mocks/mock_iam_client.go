@@ -0,0 +1,98 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	uuid "github.com/google/uuid"
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IAMClient is an autogenerated mock type for the IAMClient type
+type IAMClient struct {
+	mock.Mock
+}
+
+type IAMClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IAMClient) EXPECT() *IAMClient_Expecter {
+	return &IAMClient_Expecter{mock: &_m.Mock}
+}
+
+// GetUser provides a mock function with given fields: ctx, userUUID
+func (_m *IAMClient) GetUser(ctx context.Context, userUUID uuid.UUID) (model.User, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.User, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.User); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IAMClient_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type IAMClient_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *IAMClient_Expecter) GetUser(ctx interface{}, userUUID interface{}) *IAMClient_GetUser_Call {
+	return &IAMClient_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userUUID)}
+}
+
+func (_c *IAMClient_GetUser_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *IAMClient_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *IAMClient_GetUser_Call) Return(_a0 model.User, _a1 error) *IAMClient_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IAMClient_GetUser_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.User, error)) *IAMClient_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIAMClient creates a new instance of IAMClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIAMClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IAMClient {
+	mock := &IAMClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
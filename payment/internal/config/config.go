@@ -11,9 +11,22 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger  LoggerConfig
-	GRPC    GRPCConfig
-	Tracing TracingConfig
+	Logger          LoggerConfig
+	GRPC            GRPCConfig
+	HTTP            HTTPConfig
+	Tracing         TracingConfig
+	Postgres        PostgresConfig
+	Limits          PaymentLimitsConfig
+	Provider        ProviderConfig
+	Webhook         WebhookConfig
+	Kafka           KafkaConfig
+	PaymentProducer PaymentProducerConfig
+	ServiceAuth     ServiceAuthConfig
+	Encryption      InstrumentEncryptionConfig
+	Redis           RedisConfig
+	IAMGRPC         IAMGRPCConfig
+	Fraud           FraudConfig
+	MetricCollector MetricCollectorConfig
 }
 
 func Load(path ...string) error {
@@ -32,15 +45,93 @@ func Load(path ...string) error {
 		return err
 	}
 
+	httpCfg, err := env.NewHTTPConfig()
+	if err != nil {
+		return err
+	}
+
 	tracingCfg, err := env.NewTracingConfig()
 	if err != nil {
 		return err
 	}
 
+	postgresCfg, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
+	limitsCfg, err := env.NewPaymentLimitsConfig()
+	if err != nil {
+		return err
+	}
+
+	providerCfg, err := env.NewProviderConfig()
+	if err != nil {
+		return err
+	}
+
+	webhookCfg, err := env.NewWebhookConfig()
+	if err != nil {
+		return err
+	}
+
+	kafkaCfg, err := env.NewKafkaConfig()
+	if err != nil {
+		return err
+	}
+
+	paymentProducerCfg, err := env.NewPaymentProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	serviceAuthCfg, err := env.NewServiceAuthConfig()
+	if err != nil {
+		return err
+	}
+
+	encryptionCfg, err := env.NewInstrumentEncryptionConfig()
+	if err != nil {
+		return err
+	}
+
+	redisCfg, err := env.NewRedisConfig()
+	if err != nil {
+		return err
+	}
+
+	iamGRPCCfg, err := env.NewIAMGRPCConfig()
+	if err != nil {
+		return err
+	}
+
+	fraudCfg, err := env.NewFraudConfig()
+	if err != nil {
+		return err
+	}
+
+	metricCollectorCfg, err := env.NewMetricCollectorConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Logger:  loggerCfg,
-		GRPC:    grpcCfg,
-		Tracing: tracingCfg,
+		Logger:          loggerCfg,
+		GRPC:            grpcCfg,
+		HTTP:            httpCfg,
+		Tracing:         tracingCfg,
+		Postgres:        postgresCfg,
+		Limits:          limitsCfg,
+		Provider:        providerCfg,
+		Webhook:         webhookCfg,
+		Kafka:           kafkaCfg,
+		PaymentProducer: paymentProducerCfg,
+		ServiceAuth:     serviceAuthCfg,
+		Encryption:      encryptionCfg,
+		Redis:           redisCfg,
+		IAMGRPC:         iamGRPCCfg,
+		Fraud:           fraudCfg,
+		MetricCollector: metricCollectorCfg,
 	}
 
 	return nil
@@ -0,0 +1,54 @@
+package env
+
+import (
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+type fraudEnvConfig struct {
+	VelocityWindow      time.Duration `env:"FRAUD_VELOCITY_WINDOW,required"`
+	VelocityReviewLimit int64         `env:"FRAUD_VELOCITY_REVIEW_LIMIT,required"`
+	VelocityDenyLimit   int64         `env:"FRAUD_VELOCITY_DENY_LIMIT,required"`
+	AmountReview        float64       `env:"FRAUD_AMOUNT_REVIEW,required"`
+	AmountDeny          float64       `env:"FRAUD_AMOUNT_DENY,required"`
+	NewAccountAge       time.Duration `env:"FRAUD_NEW_ACCOUNT_AGE,required"`
+	HighRiskMethods     []string      `env:"FRAUD_HIGH_RISK_METHODS" envSeparator:","`
+}
+
+type fraudConfig struct {
+	raw fraudEnvConfig
+}
+
+func NewFraudConfig() (*fraudConfig, error) {
+	var raw fraudEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &fraudConfig{raw: raw}, nil
+}
+
+// Rules возвращает правила антифрода; нулевой порог отключает правило
+func (cfg *fraudConfig) Rules() model.FraudRules {
+	methods := make([]model.PaymentMethod, 0, len(cfg.raw.HighRiskMethods))
+	for _, method := range cfg.raw.HighRiskMethods {
+		method = strings.ToUpper(strings.TrimSpace(method))
+		if method != "" {
+			methods = append(methods, model.PaymentMethod(method))
+		}
+	}
+
+	return model.FraudRules{
+		VelocityWindow:  cfg.raw.VelocityWindow,
+		VelocityReview:  cfg.raw.VelocityReviewLimit,
+		VelocityDeny:    cfg.raw.VelocityDenyLimit,
+		AmountReview:    cfg.raw.AmountReview,
+		AmountDeny:      cfg.raw.AmountDeny,
+		NewAccountAge:   cfg.raw.NewAccountAge,
+		HighRiskMethods: methods,
+	}
+}
@@ -0,0 +1,40 @@
+package env
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/encryption"
+)
+
+type instrumentEncryptionEnvConfig struct {
+	Key string `env:"INSTRUMENT_ENCRYPTION_KEY,required"`
+}
+
+type instrumentEncryptionConfig struct {
+	key []byte
+}
+
+func NewInstrumentEncryptionConfig() (*instrumentEncryptionConfig, error) {
+	var raw instrumentEncryptionEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(raw.Key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid INSTRUMENT_ENCRYPTION_KEY: %w", err)
+	}
+	if len(key) != encryption.KeySize {
+		return nil, fmt.Errorf("invalid INSTRUMENT_ENCRYPTION_KEY: %w", encryption.ErrInvalidKey)
+	}
+
+	return &instrumentEncryptionConfig{key: key}, nil
+}
+
+// Key — ключ AES-256 для шифрования реквизитов платёжных инструментов
+func (cfg *instrumentEncryptionConfig) Key() []byte {
+	return cfg.key
+}
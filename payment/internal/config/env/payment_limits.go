@@ -0,0 +1,47 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+type paymentLimitsEnvConfig struct {
+	Currency               string  `env:"PAYMENT_CURRENCY,required"`
+	CardMinAmount          float64 `env:"CARD_MIN_AMOUNT,required"`
+	CardMaxAmount          float64 `env:"CARD_MAX_AMOUNT,required"`
+	SBPMinAmount           float64 `env:"SBP_MIN_AMOUNT,required"`
+	SBPMaxAmount           float64 `env:"SBP_MAX_AMOUNT,required"`
+	CreditCardMinAmount    float64 `env:"CREDIT_CARD_MIN_AMOUNT,required"`
+	CreditCardMaxAmount    float64 `env:"CREDIT_CARD_MAX_AMOUNT,required"`
+	InvestorMoneyMinAmount float64 `env:"INVESTOR_MONEY_MIN_AMOUNT,required"`
+	InvestorMoneyMaxAmount float64 `env:"INVESTOR_MONEY_MAX_AMOUNT,required"`
+}
+
+type paymentLimitsConfig struct {
+	raw paymentLimitsEnvConfig
+}
+
+func NewPaymentLimitsConfig() (*paymentLimitsConfig, error) {
+	var raw paymentLimitsEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentLimitsConfig{raw: raw}, nil
+}
+
+// Currency возвращает код валюты, в которой принимаются оплаты
+func (cfg *paymentLimitsConfig) Currency() string {
+	return cfg.raw.Currency
+}
+
+// Limits возвращает лимиты сумм по способам оплаты; максимум 0 — без ограничения
+func (cfg *paymentLimitsConfig) Limits() map[model.PaymentMethod]model.AmountLimit {
+	return map[model.PaymentMethod]model.AmountLimit{
+		model.PaymentMethodCard:          {Min: cfg.raw.CardMinAmount, Max: cfg.raw.CardMaxAmount},
+		model.PaymentMethodSBP:           {Min: cfg.raw.SBPMinAmount, Max: cfg.raw.SBPMaxAmount},
+		model.PaymentMethodCreditCard:    {Min: cfg.raw.CreditCardMinAmount, Max: cfg.raw.CreditCardMaxAmount},
+		model.PaymentMethodInvestorMoney: {Min: cfg.raw.InvestorMoneyMinAmount, Max: cfg.raw.InvestorMoneyMaxAmount},
+	}
+}
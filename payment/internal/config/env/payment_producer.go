@@ -0,0 +1,41 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentProducerEnvConfig struct {
+	PaymentCompletedTopicName string `env:"PAYMENT_COMPLETED_TOPIC_NAME,required"`
+	PaymentFailedTopicName    string `env:"PAYMENT_FAILED_TOPIC_NAME,required"`
+}
+
+type paymentProducerConfig struct {
+	raw paymentProducerEnvConfig
+}
+
+func NewPaymentProducerConfig() (*paymentProducerConfig, error) {
+	var raw paymentProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentProducerConfig{raw: raw}, nil
+}
+
+func (cfg *paymentProducerConfig) PaymentCompletedTopic() string {
+	return cfg.raw.PaymentCompletedTopicName
+}
+
+func (cfg *paymentProducerConfig) PaymentFailedTopic() string {
+	return cfg.raw.PaymentFailedTopicName
+}
+
+// Config возвращает конфигурацию для sarama producer
+func (cfg *paymentProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
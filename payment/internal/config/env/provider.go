@@ -0,0 +1,49 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+type providerEnvConfig struct {
+	Card          string        `env:"PROVIDER_CARD,required"`
+	SBP           string        `env:"PROVIDER_SBP,required"`
+	CreditCard    string        `env:"PROVIDER_CREDIT_CARD,required"`
+	InvestorMoney string        `env:"PROVIDER_INVESTOR_MONEY,required"`
+	PSPURL        string        `env:"PSP_URL,required"`
+	PSPTimeout    time.Duration `env:"PSP_TIMEOUT,required"`
+}
+
+type providerConfig struct {
+	raw providerEnvConfig
+}
+
+func NewProviderConfig() (*providerConfig, error) {
+	var raw providerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &providerConfig{raw: raw}, nil
+}
+
+// Routes возвращает имя провайдера (local, psp или wallet) для каждого способа оплаты
+func (cfg *providerConfig) Routes() map[model.PaymentMethod]string {
+	return map[model.PaymentMethod]string{
+		model.PaymentMethodCard:          cfg.raw.Card,
+		model.PaymentMethodSBP:           cfg.raw.SBP,
+		model.PaymentMethodCreditCard:    cfg.raw.CreditCard,
+		model.PaymentMethodInvestorMoney: cfg.raw.InvestorMoney,
+	}
+}
+
+func (cfg *providerConfig) PSPURL() string {
+	return cfg.raw.PSPURL
+}
+
+func (cfg *providerConfig) PSPTimeout() time.Duration {
+	return cfg.raw.PSPTimeout
+}
@@ -0,0 +1,40 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type serviceAuthEnvConfig struct {
+	// Keys — ключи подписи сервисов-клиентов в формате "service:key,service:key"
+	Keys      map[string]string `env:"SERVICE_AUTH_KEYS,required"`
+	Tolerance time.Duration     `env:"SERVICE_AUTH_TOLERANCE,required"`
+}
+
+type serviceAuthConfig struct {
+	raw serviceAuthEnvConfig
+}
+
+func NewServiceAuthConfig() (*serviceAuthConfig, error) {
+	var raw serviceAuthEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &serviceAuthConfig{raw: raw}, nil
+}
+
+// Keys возвращает ключи подписи по именам сервисов, которым разрешено обращаться к payment
+func (cfg *serviceAuthConfig) Keys() map[string][]byte {
+	keys := make(map[string][]byte, len(cfg.raw.Keys))
+	for service, key := range cfg.raw.Keys {
+		keys[service] = []byte(key)
+	}
+	return keys
+}
+
+// Tolerance — допустимое расхождение времени токена с текущим (защита от повторов)
+func (cfg *serviceAuthConfig) Tolerance() time.Duration {
+	return cfg.raw.Tolerance
+}
@@ -0,0 +1,35 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type webhookEnvConfig struct {
+	Secret    string        `env:"PSP_WEBHOOK_SECRET,required"`
+	Tolerance time.Duration `env:"PSP_WEBHOOK_TOLERANCE,required"`
+}
+
+type webhookConfig struct {
+	raw webhookEnvConfig
+}
+
+func NewWebhookConfig() (*webhookConfig, error) {
+	var raw webhookEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &webhookConfig{raw: raw}, nil
+}
+
+// Secret — общий с PSP ключ подписи webhook
+func (cfg *webhookConfig) Secret() []byte {
+	return []byte(cfg.raw.Secret)
+}
+
+// Tolerance — допустимое расхождение времени подписи с текущим (защита от повторов)
+func (cfg *webhookConfig) Tolerance() time.Duration {
+	return cfg.raw.Tolerance
+}
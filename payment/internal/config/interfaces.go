@@ -1,5 +1,13 @@
 package config
 
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
@@ -12,9 +20,77 @@ type GRPCConfig interface {
 	Address() string
 }
 
+type HTTPConfig interface {
+	Address() string
+	ReadTimeout() time.Duration
+	ShutdownTimeout() time.Duration
+}
+
 type TracingConfig interface {
 	CollectorEndpoint() string
 	ServiceName() string
 	Environment() string
 	ServiceVersion() string
 }
+
+type PostgresConfig interface {
+	URI() string
+	DatabaseName() string
+	MigrationsDir() string
+}
+
+type PaymentLimitsConfig interface {
+	Currency() string
+	Limits() map[model.PaymentMethod]model.AmountLimit
+}
+
+type ProviderConfig interface {
+	Routes() map[model.PaymentMethod]string
+	PSPURL() string
+	PSPTimeout() time.Duration
+}
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+type PaymentProducerConfig interface {
+	PaymentCompletedTopic() string
+	PaymentFailedTopic() string
+	Config() *sarama.Config
+}
+
+type WebhookConfig interface {
+	Secret() []byte
+	Tolerance() time.Duration
+}
+
+type ServiceAuthConfig interface {
+	Keys() map[string][]byte
+	Tolerance() time.Duration
+}
+
+type InstrumentEncryptionConfig interface {
+	Key() []byte
+}
+
+type RedisConfig interface {
+	Address() string
+	ConnectionTimeout() time.Duration
+	MaxIdle() int
+	IdleTimeout() time.Duration
+}
+
+type IAMGRPCConfig interface {
+	Address() string
+}
+
+type FraudConfig interface {
+	Rules() model.FraudRules
+}
+
+type MetricCollectorConfig interface {
+	CollectorEndpoint() string
+	CollectorInterval() time.Duration
+	ServiceName() string
+}
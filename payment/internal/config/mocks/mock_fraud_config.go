@@ -0,0 +1,83 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FraudConfig is an autogenerated mock type for the FraudConfig type
+type FraudConfig struct {
+	mock.Mock
+}
+
+type FraudConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FraudConfig) EXPECT() *FraudConfig_Expecter {
+	return &FraudConfig_Expecter{mock: &_m.Mock}
+}
+
+// Rules provides a mock function with no fields
+func (_m *FraudConfig) Rules() model.FraudRules {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rules")
+	}
+
+	var r0 model.FraudRules
+	if rf, ok := ret.Get(0).(func() model.FraudRules); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.FraudRules)
+	}
+
+	return r0
+}
+
+// FraudConfig_Rules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rules'
+type FraudConfig_Rules_Call struct {
+	*mock.Call
+}
+
+// Rules is a helper method to define mock.On call
+func (_e *FraudConfig_Expecter) Rules() *FraudConfig_Rules_Call {
+	return &FraudConfig_Rules_Call{Call: _e.mock.On("Rules")}
+}
+
+func (_c *FraudConfig_Rules_Call) Run(run func()) *FraudConfig_Rules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FraudConfig_Rules_Call) Return(_a0 model.FraudRules) *FraudConfig_Rules_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FraudConfig_Rules_Call) RunAndReturn(run func() model.FraudRules) *FraudConfig_Rules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFraudConfig creates a new instance of FraudConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFraudConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FraudConfig {
+	mock := &FraudConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
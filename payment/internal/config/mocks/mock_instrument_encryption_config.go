@@ -0,0 +1,82 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// InstrumentEncryptionConfig is an autogenerated mock type for the InstrumentEncryptionConfig type
+type InstrumentEncryptionConfig struct {
+	mock.Mock
+}
+
+type InstrumentEncryptionConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstrumentEncryptionConfig) EXPECT() *InstrumentEncryptionConfig_Expecter {
+	return &InstrumentEncryptionConfig_Expecter{mock: &_m.Mock}
+}
+
+// Key provides a mock function with no fields
+func (_m *InstrumentEncryptionConfig) Key() []byte {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Key")
+	}
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	return r0
+}
+
+// InstrumentEncryptionConfig_Key_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Key'
+type InstrumentEncryptionConfig_Key_Call struct {
+	*mock.Call
+}
+
+// Key is a helper method to define mock.On call
+func (_e *InstrumentEncryptionConfig_Expecter) Key() *InstrumentEncryptionConfig_Key_Call {
+	return &InstrumentEncryptionConfig_Key_Call{Call: _e.mock.On("Key")}
+}
+
+func (_c *InstrumentEncryptionConfig_Key_Call) Run(run func()) *InstrumentEncryptionConfig_Key_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentEncryptionConfig_Key_Call) Return(_a0 []byte) *InstrumentEncryptionConfig_Key_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentEncryptionConfig_Key_Call) RunAndReturn(run func() []byte) *InstrumentEncryptionConfig_Key_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstrumentEncryptionConfig creates a new instance of InstrumentEncryptionConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstrumentEncryptionConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstrumentEncryptionConfig {
+	mock := &InstrumentEncryptionConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,130 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentLimitsConfig is an autogenerated mock type for the PaymentLimitsConfig type
+type PaymentLimitsConfig struct {
+	mock.Mock
+}
+
+type PaymentLimitsConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentLimitsConfig) EXPECT() *PaymentLimitsConfig_Expecter {
+	return &PaymentLimitsConfig_Expecter{mock: &_m.Mock}
+}
+
+// Currency provides a mock function with no fields
+func (_m *PaymentLimitsConfig) Currency() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Currency")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PaymentLimitsConfig_Currency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Currency'
+type PaymentLimitsConfig_Currency_Call struct {
+	*mock.Call
+}
+
+// Currency is a helper method to define mock.On call
+func (_e *PaymentLimitsConfig_Expecter) Currency() *PaymentLimitsConfig_Currency_Call {
+	return &PaymentLimitsConfig_Currency_Call{Call: _e.mock.On("Currency")}
+}
+
+func (_c *PaymentLimitsConfig_Currency_Call) Run(run func()) *PaymentLimitsConfig_Currency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentLimitsConfig_Currency_Call) Return(_a0 string) *PaymentLimitsConfig_Currency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentLimitsConfig_Currency_Call) RunAndReturn(run func() string) *PaymentLimitsConfig_Currency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Limits provides a mock function with no fields
+func (_m *PaymentLimitsConfig) Limits() map[model.PaymentMethod]model.AmountLimit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Limits")
+	}
+
+	var r0 map[model.PaymentMethod]model.AmountLimit
+	if rf, ok := ret.Get(0).(func() map[model.PaymentMethod]model.AmountLimit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[model.PaymentMethod]model.AmountLimit)
+		}
+	}
+
+	return r0
+}
+
+// PaymentLimitsConfig_Limits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Limits'
+type PaymentLimitsConfig_Limits_Call struct {
+	*mock.Call
+}
+
+// Limits is a helper method to define mock.On call
+func (_e *PaymentLimitsConfig_Expecter) Limits() *PaymentLimitsConfig_Limits_Call {
+	return &PaymentLimitsConfig_Limits_Call{Call: _e.mock.On("Limits")}
+}
+
+func (_c *PaymentLimitsConfig_Limits_Call) Run(run func()) *PaymentLimitsConfig_Limits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentLimitsConfig_Limits_Call) Return(_a0 map[model.PaymentMethod]model.AmountLimit) *PaymentLimitsConfig_Limits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentLimitsConfig_Limits_Call) RunAndReturn(run func() map[model.PaymentMethod]model.AmountLimit) *PaymentLimitsConfig_Limits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentLimitsConfig creates a new instance of PaymentLimitsConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentLimitsConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentLimitsConfig {
+	mock := &PaymentLimitsConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
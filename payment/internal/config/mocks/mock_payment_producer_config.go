@@ -0,0 +1,175 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	sarama "github.com/IBM/sarama"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentProducerConfig is an autogenerated mock type for the PaymentProducerConfig type
+type PaymentProducerConfig struct {
+	mock.Mock
+}
+
+type PaymentProducerConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentProducerConfig) EXPECT() *PaymentProducerConfig_Expecter {
+	return &PaymentProducerConfig_Expecter{mock: &_m.Mock}
+}
+
+// Config provides a mock function with no fields
+func (_m *PaymentProducerConfig) Config() *sarama.Config {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 *sarama.Config
+	if rf, ok := ret.Get(0).(func() *sarama.Config); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sarama.Config)
+		}
+	}
+
+	return r0
+}
+
+// PaymentProducerConfig_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type PaymentProducerConfig_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *PaymentProducerConfig_Expecter) Config() *PaymentProducerConfig_Config_Call {
+	return &PaymentProducerConfig_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *PaymentProducerConfig_Config_Call) Run(run func()) *PaymentProducerConfig_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentProducerConfig_Config_Call) Return(_a0 *sarama.Config) *PaymentProducerConfig_Config_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentProducerConfig_Config_Call) RunAndReturn(run func() *sarama.Config) *PaymentProducerConfig_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PaymentCompletedTopic provides a mock function with no fields
+func (_m *PaymentProducerConfig) PaymentCompletedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentCompletedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PaymentProducerConfig_PaymentCompletedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaymentCompletedTopic'
+type PaymentProducerConfig_PaymentCompletedTopic_Call struct {
+	*mock.Call
+}
+
+// PaymentCompletedTopic is a helper method to define mock.On call
+func (_e *PaymentProducerConfig_Expecter) PaymentCompletedTopic() *PaymentProducerConfig_PaymentCompletedTopic_Call {
+	return &PaymentProducerConfig_PaymentCompletedTopic_Call{Call: _e.mock.On("PaymentCompletedTopic")}
+}
+
+func (_c *PaymentProducerConfig_PaymentCompletedTopic_Call) Run(run func()) *PaymentProducerConfig_PaymentCompletedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentProducerConfig_PaymentCompletedTopic_Call) Return(_a0 string) *PaymentProducerConfig_PaymentCompletedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentProducerConfig_PaymentCompletedTopic_Call) RunAndReturn(run func() string) *PaymentProducerConfig_PaymentCompletedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PaymentFailedTopic provides a mock function with no fields
+func (_m *PaymentProducerConfig) PaymentFailedTopic() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentFailedTopic")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// PaymentProducerConfig_PaymentFailedTopic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaymentFailedTopic'
+type PaymentProducerConfig_PaymentFailedTopic_Call struct {
+	*mock.Call
+}
+
+// PaymentFailedTopic is a helper method to define mock.On call
+func (_e *PaymentProducerConfig_Expecter) PaymentFailedTopic() *PaymentProducerConfig_PaymentFailedTopic_Call {
+	return &PaymentProducerConfig_PaymentFailedTopic_Call{Call: _e.mock.On("PaymentFailedTopic")}
+}
+
+func (_c *PaymentProducerConfig_PaymentFailedTopic_Call) Run(run func()) *PaymentProducerConfig_PaymentFailedTopic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentProducerConfig_PaymentFailedTopic_Call) Return(_a0 string) *PaymentProducerConfig_PaymentFailedTopic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentProducerConfig_PaymentFailedTopic_Call) RunAndReturn(run func() string) *PaymentProducerConfig_PaymentFailedTopic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentProducerConfig creates a new instance of PaymentProducerConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentProducerConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentProducerConfig {
+	mock := &PaymentProducerConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,177 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProviderConfig is an autogenerated mock type for the ProviderConfig type
+type ProviderConfig struct {
+	mock.Mock
+}
+
+type ProviderConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProviderConfig) EXPECT() *ProviderConfig_Expecter {
+	return &ProviderConfig_Expecter{mock: &_m.Mock}
+}
+
+// PSPTimeout provides a mock function with no fields
+func (_m *ProviderConfig) PSPTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PSPTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// ProviderConfig_PSPTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PSPTimeout'
+type ProviderConfig_PSPTimeout_Call struct {
+	*mock.Call
+}
+
+// PSPTimeout is a helper method to define mock.On call
+func (_e *ProviderConfig_Expecter) PSPTimeout() *ProviderConfig_PSPTimeout_Call {
+	return &ProviderConfig_PSPTimeout_Call{Call: _e.mock.On("PSPTimeout")}
+}
+
+func (_c *ProviderConfig_PSPTimeout_Call) Run(run func()) *ProviderConfig_PSPTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ProviderConfig_PSPTimeout_Call) Return(_a0 time.Duration) *ProviderConfig_PSPTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProviderConfig_PSPTimeout_Call) RunAndReturn(run func() time.Duration) *ProviderConfig_PSPTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PSPURL provides a mock function with no fields
+func (_m *ProviderConfig) PSPURL() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PSPURL")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ProviderConfig_PSPURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PSPURL'
+type ProviderConfig_PSPURL_Call struct {
+	*mock.Call
+}
+
+// PSPURL is a helper method to define mock.On call
+func (_e *ProviderConfig_Expecter) PSPURL() *ProviderConfig_PSPURL_Call {
+	return &ProviderConfig_PSPURL_Call{Call: _e.mock.On("PSPURL")}
+}
+
+func (_c *ProviderConfig_PSPURL_Call) Run(run func()) *ProviderConfig_PSPURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ProviderConfig_PSPURL_Call) Return(_a0 string) *ProviderConfig_PSPURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProviderConfig_PSPURL_Call) RunAndReturn(run func() string) *ProviderConfig_PSPURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Routes provides a mock function with no fields
+func (_m *ProviderConfig) Routes() map[model.PaymentMethod]string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Routes")
+	}
+
+	var r0 map[model.PaymentMethod]string
+	if rf, ok := ret.Get(0).(func() map[model.PaymentMethod]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[model.PaymentMethod]string)
+		}
+	}
+
+	return r0
+}
+
+// ProviderConfig_Routes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Routes'
+type ProviderConfig_Routes_Call struct {
+	*mock.Call
+}
+
+// Routes is a helper method to define mock.On call
+func (_e *ProviderConfig_Expecter) Routes() *ProviderConfig_Routes_Call {
+	return &ProviderConfig_Routes_Call{Call: _e.mock.On("Routes")}
+}
+
+func (_c *ProviderConfig_Routes_Call) Run(run func()) *ProviderConfig_Routes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ProviderConfig_Routes_Call) Return(_a0 map[model.PaymentMethod]string) *ProviderConfig_Routes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProviderConfig_Routes_Call) RunAndReturn(run func() map[model.PaymentMethod]string) *ProviderConfig_Routes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProviderConfig creates a new instance of ProviderConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProviderConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProviderConfig {
+	mock := &ProviderConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,131 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceAuthConfig is an autogenerated mock type for the ServiceAuthConfig type
+type ServiceAuthConfig struct {
+	mock.Mock
+}
+
+type ServiceAuthConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAuthConfig) EXPECT() *ServiceAuthConfig_Expecter {
+	return &ServiceAuthConfig_Expecter{mock: &_m.Mock}
+}
+
+// Keys provides a mock function with no fields
+func (_m *ServiceAuthConfig) Keys() map[string][]byte {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 map[string][]byte
+	if rf, ok := ret.Get(0).(func() map[string][]byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	return r0
+}
+
+// ServiceAuthConfig_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
+type ServiceAuthConfig_Keys_Call struct {
+	*mock.Call
+}
+
+// Keys is a helper method to define mock.On call
+func (_e *ServiceAuthConfig_Expecter) Keys() *ServiceAuthConfig_Keys_Call {
+	return &ServiceAuthConfig_Keys_Call{Call: _e.mock.On("Keys")}
+}
+
+func (_c *ServiceAuthConfig_Keys_Call) Run(run func()) *ServiceAuthConfig_Keys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ServiceAuthConfig_Keys_Call) Return(_a0 map[string][]byte) *ServiceAuthConfig_Keys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAuthConfig_Keys_Call) RunAndReturn(run func() map[string][]byte) *ServiceAuthConfig_Keys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tolerance provides a mock function with no fields
+func (_m *ServiceAuthConfig) Tolerance() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tolerance")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// ServiceAuthConfig_Tolerance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tolerance'
+type ServiceAuthConfig_Tolerance_Call struct {
+	*mock.Call
+}
+
+// Tolerance is a helper method to define mock.On call
+func (_e *ServiceAuthConfig_Expecter) Tolerance() *ServiceAuthConfig_Tolerance_Call {
+	return &ServiceAuthConfig_Tolerance_Call{Call: _e.mock.On("Tolerance")}
+}
+
+func (_c *ServiceAuthConfig_Tolerance_Call) Run(run func()) *ServiceAuthConfig_Tolerance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ServiceAuthConfig_Tolerance_Call) Return(_a0 time.Duration) *ServiceAuthConfig_Tolerance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAuthConfig_Tolerance_Call) RunAndReturn(run func() time.Duration) *ServiceAuthConfig_Tolerance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAuthConfig creates a new instance of ServiceAuthConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAuthConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAuthConfig {
+	mock := &ServiceAuthConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
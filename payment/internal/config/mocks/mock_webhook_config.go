@@ -0,0 +1,131 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookConfig is an autogenerated mock type for the WebhookConfig type
+type WebhookConfig struct {
+	mock.Mock
+}
+
+type WebhookConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookConfig) EXPECT() *WebhookConfig_Expecter {
+	return &WebhookConfig_Expecter{mock: &_m.Mock}
+}
+
+// Secret provides a mock function with no fields
+func (_m *WebhookConfig) Secret() []byte {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Secret")
+	}
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	return r0
+}
+
+// WebhookConfig_Secret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Secret'
+type WebhookConfig_Secret_Call struct {
+	*mock.Call
+}
+
+// Secret is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) Secret() *WebhookConfig_Secret_Call {
+	return &WebhookConfig_Secret_Call{Call: _e.mock.On("Secret")}
+}
+
+func (_c *WebhookConfig_Secret_Call) Run(run func()) *WebhookConfig_Secret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_Secret_Call) Return(_a0 []byte) *WebhookConfig_Secret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_Secret_Call) RunAndReturn(run func() []byte) *WebhookConfig_Secret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tolerance provides a mock function with no fields
+func (_m *WebhookConfig) Tolerance() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tolerance")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// WebhookConfig_Tolerance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tolerance'
+type WebhookConfig_Tolerance_Call struct {
+	*mock.Call
+}
+
+// Tolerance is a helper method to define mock.On call
+func (_e *WebhookConfig_Expecter) Tolerance() *WebhookConfig_Tolerance_Call {
+	return &WebhookConfig_Tolerance_Call{Call: _e.mock.On("Tolerance")}
+}
+
+func (_c *WebhookConfig_Tolerance_Call) Run(run func()) *WebhookConfig_Tolerance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebhookConfig_Tolerance_Call) Return(_a0 time.Duration) *WebhookConfig_Tolerance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookConfig_Tolerance_Call) RunAndReturn(run func() time.Duration) *WebhookConfig_Tolerance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookConfig creates a new instance of WebhookConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookConfig {
+	mock := &WebhookConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
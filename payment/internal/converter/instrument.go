@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+// ToProtoInstrument не раскрывает токен карты и телефон СБП целиком
+func ToProtoInstrument(instrument model.PaymentInstrument) *paymentV1.PaymentInstrument {
+	return &paymentV1.PaymentInstrument{
+		InstrumentUuid: instrument.Uuid.String(),
+		UserUuid:       instrument.UserUuid.String(),
+		PaymentMethod:  ToProtoPaymentMethod(instrument.PaymentMethod),
+		CardLast4:      instrument.CardLast4,
+		SbpPhoneMasked: instrument.MaskedSBPPhone(),
+		IsDefault:      instrument.IsDefault,
+		CreatedAt:      timestamppb.New(instrument.CreatedAt),
+	}
+}
+
+func ToProtoInstruments(instruments []model.PaymentInstrument) []*paymentV1.PaymentInstrument {
+	result := make([]*paymentV1.PaymentInstrument, 0, len(instruments))
+	for _, instrument := range instruments {
+		result = append(result, ToProtoInstrument(instrument))
+	}
+	return result
+}
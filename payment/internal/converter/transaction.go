@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func ToProtoTransaction(transaction model.Transaction) *paymentV1.Transaction {
+	return &paymentV1.Transaction{
+		TransactionUuid: transaction.Uuid.String(),
+		OrderUuid:       transaction.OrderUuid.String(),
+		UserUuid:        transaction.UserUuid.String(),
+		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+		PaymentMethod:   ToProtoPaymentMethod(transaction.PaymentMethod),
+		Status:          ToProtoTransactionStatus(transaction.Status),
+		FraudDecision:   ToProtoFraudDecision(transaction.FraudDecision),
+		FraudReason:     transaction.FraudReason,
+		InstrumentUuid:  instrumentUUID(transaction.InstrumentUuid),
+		CreatedAt:       timestamppb.New(transaction.CreatedAt),
+		UpdatedAt:       timestamppb.New(transaction.UpdatedAt),
+	}
+}
+
+func ToProtoTransactions(transactions []model.Transaction) []*paymentV1.Transaction {
+	result := make([]*paymentV1.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		result = append(result, ToProtoTransaction(transaction))
+	}
+	return result
+}
+
+func ToProtoPaymentMethod(method model.PaymentMethod) paymentV1.PaymentMethod {
+	switch method {
+	case model.PaymentMethodCard:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CARD
+	case model.PaymentMethodSBP:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_SBP
+	case model.PaymentMethodCreditCard:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD
+	case model.PaymentMethodInvestorMoney:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY
+	default:
+		return paymentV1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+	}
+}
+
+func ToModelPaymentMethod(method paymentV1.PaymentMethod) model.PaymentMethod {
+	switch method {
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_CARD:
+		return model.PaymentMethodCard
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_SBP:
+		return model.PaymentMethodSBP
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_CREDIT_CARD:
+		return model.PaymentMethodCreditCard
+	case paymentV1.PaymentMethod_PAYMENT_METHOD_INVESTOR_MONEY:
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
+
+func ToProtoTransactionStatus(status model.TransactionStatus) paymentV1.TransactionStatus {
+	switch status {
+	case model.TransactionStatusSucceeded:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_SUCCEEDED
+	case model.TransactionStatusPending:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_PENDING
+	case model.TransactionStatusFailed:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_FAILED
+	default:
+		return paymentV1.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
+
+func ToProtoFraudDecision(decision model.FraudDecision) paymentV1.FraudDecision {
+	switch decision {
+	case model.FraudDecisionAllow:
+		return paymentV1.FraudDecision_FRAUD_DECISION_ALLOW
+	case model.FraudDecisionReview:
+		return paymentV1.FraudDecision_FRAUD_DECISION_REVIEW
+	case model.FraudDecisionDeny:
+		return paymentV1.FraudDecision_FRAUD_DECISION_DENY
+	default:
+		return paymentV1.FraudDecision_FRAUD_DECISION_UNSPECIFIED
+	}
+}
+
+// instrumentUUID возвращает пустую строку для оплаты без инструмента
+func instrumentUUID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
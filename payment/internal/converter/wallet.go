@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	paymentV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1"
+)
+
+func ToProtoStatementLines(lines []model.StatementLine) []*paymentV1.StatementLine {
+	result := make([]*paymentV1.StatementLine, 0, len(lines))
+	for _, line := range lines {
+		result = append(result, &paymentV1.StatementLine{
+			EntryUuid:    line.EntryUuid.String(),
+			Kind:         ToProtoEntryKind(line.Kind),
+			Amount:       line.Amount,
+			BalanceAfter: line.BalanceAfter,
+			Reference:    line.Reference,
+			CreatedAt:    timestamppb.New(line.CreatedAt),
+		})
+	}
+	return result
+}
+
+func ToProtoEntryKind(kind model.EntryKind) paymentV1.EntryKind {
+	switch kind {
+	case model.EntryKindTopUp:
+		return paymentV1.EntryKind_ENTRY_KIND_TOP_UP
+	case model.EntryKindPayment:
+		return paymentV1.EntryKind_ENTRY_KIND_PAYMENT
+	default:
+		return paymentV1.EntryKind_ENTRY_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,80 @@
+package encryption
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CipherSuite struct {
+	suite.Suite
+
+	key    []byte
+	cipher *Cipher
+}
+
+func (s *CipherSuite) SetupTest() {
+	s.key = bytes.Repeat([]byte{7}, KeySize)
+
+	var err error
+	s.cipher, err = NewCipher(s.key)
+	s.Require().NoError(err)
+}
+
+func (s *CipherSuite) TestRoundTrip() {
+	ciphertext, err := s.cipher.Encrypt("+79991234567")
+	s.Require().NoError(err)
+	s.Require().NotContains(ciphertext, "9991234567")
+
+	plaintext, err := s.cipher.Decrypt(ciphertext)
+	s.Require().NoError(err)
+	s.Require().Equal("+79991234567", plaintext)
+}
+
+func (s *CipherSuite) TestRandomNonce() {
+	first, err := s.cipher.Encrypt("tok_123")
+	s.Require().NoError(err)
+	second, err := s.cipher.Encrypt("tok_123")
+	s.Require().NoError(err)
+
+	s.Require().NotEqual(first, second)
+}
+
+func (s *CipherSuite) TestEmpty() {
+	ciphertext, err := s.cipher.Encrypt("")
+	s.Require().NoError(err)
+	s.Require().Empty(ciphertext)
+
+	plaintext, err := s.cipher.Decrypt("")
+	s.Require().NoError(err)
+	s.Require().Empty(plaintext)
+}
+
+func (s *CipherSuite) TestWrongKey() {
+	ciphertext, err := s.cipher.Encrypt("tok_123")
+	s.Require().NoError(err)
+
+	other, err := NewCipher(bytes.Repeat([]byte{8}, KeySize))
+	s.Require().NoError(err)
+
+	_, err = other.Decrypt(ciphertext)
+	s.Require().ErrorIs(err, ErrInvalidCiphertext)
+}
+
+func (s *CipherSuite) TestTampered() {
+	_, err := s.cipher.Decrypt("bm90LWEtY2lwaGVydGV4dA==")
+	s.Require().ErrorIs(err, ErrInvalidCiphertext)
+
+	_, err = s.cipher.Decrypt("%%%")
+	s.Require().ErrorIs(err, ErrInvalidCiphertext)
+}
+
+func (s *CipherSuite) TestInvalidKey() {
+	_, err := NewCipher([]byte("short"))
+	s.Require().ErrorIs(err, ErrInvalidKey)
+}
+
+func TestCipher(t *testing.T) {
+	suite.Run(t, new(CipherSuite))
+}
@@ -0,0 +1,106 @@
+package fakepsp
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type chargeRequest struct {
+	Reference     string  `json:"reference"`
+	OrderUUID     string  `json:"order_uuid"`
+	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
+	PaymentMethod string  `json:"payment_method"`
+}
+
+type chargeResponse struct {
+	ID            string `json:"id"`
+	Reference     string `json:"reference"`
+	Status        string `json:"status"`
+	DeclineReason string `json:"decline_reason,omitempty"`
+}
+
+const declineReason = "insufficient_funds"
+
+func (s *Server) handleCharge(w http.ResponseWriter, r *http.Request) {
+	var req chargeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if key == "" {
+		key = req.Reference
+	}
+
+	if res, ok := s.storedCharge(key); ok {
+		writeJSON(w, res)
+		return
+	}
+
+	scenario := s.scenario(req.Amount)
+	logger.Info(r.Context(), "fake psp charge",
+		zap.String("reference", req.Reference),
+		zap.Float64("amount", req.Amount),
+		zap.String("scenario", string(scenario)),
+	)
+
+	res := chargeResponse{
+		ID:        "psp_" + uuid.NewString(),
+		Reference: req.Reference,
+	}
+
+	switch scenario {
+	case ScenarioTimeout:
+		select {
+		case <-time.After(s.cfg.TimeoutDelay):
+		case <-r.Context().Done():
+		}
+		// Итог не сохраняется: повтор снова зависнет
+		w.WriteHeader(http.StatusGatewayTimeout)
+		return
+	case ScenarioDecline:
+		res.Status = "declined"
+		res.DeclineReason = declineReason
+	case ScenarioWebhook, ScenarioWebhookDecline:
+		res.Status = "pending"
+		final := webhookPayload{ID: res.ID, Reference: req.Reference, OrderUUID: req.OrderUUID, Status: "succeeded"}
+		if scenario == ScenarioWebhookDecline {
+			final.Status = "declined"
+			final.DeclineReason = declineReason
+		}
+		s.scheduleWebhook(final)
+	default:
+		res.Status = "succeeded"
+	}
+
+	s.storeCharge(key, res)
+	writeJSON(w, res)
+}
+
+func (s *Server) storedCharge(key string) (chargeResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, ok := s.charges[key]
+	return res, ok
+}
+
+func (s *Server) storeCharge(key string, res chargeResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.charges[key] = res
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
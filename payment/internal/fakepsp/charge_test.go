@@ -0,0 +1,94 @@
+package fakepsp
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *ServerSuite) TestScenarioForAmount() {
+	cases := map[float64]Scenario{
+		1500:       ScenarioSuccess,
+		1500.13:    ScenarioDecline,
+		0.42:       ScenarioTimeout,
+		99.77:      ScenarioWebhook,
+		10_000.66:  ScenarioWebhookDecline,
+		1500.1:     ScenarioSuccess,
+		123_456.99: ScenarioSuccess,
+	}
+
+	for amount, want := range cases {
+		s.Require().Equal(want, ScenarioForAmount(amount), "amount %.2f", amount)
+	}
+}
+
+func (s *ServerSuite) TestChargeByAmount() {
+	_, server := s.newServer("")
+
+	code, res := s.charge(server.URL, uuid.NewString(), chargeRequest{Reference: "r1", Amount: 100})
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Equal("succeeded", res.Status)
+	s.Require().Equal("r1", res.Reference)
+
+	code, res = s.charge(server.URL, uuid.NewString(), chargeRequest{Reference: "r2", Amount: 100.13})
+	s.Require().Equal(http.StatusOK, code)
+	s.Require().Equal("declined", res.Status)
+	s.Require().Equal(declineReason, res.DeclineReason)
+}
+
+func (s *ServerSuite) TestChargeScenarioOverridesAmount() {
+	_, server := s.newServer(ScenarioDecline)
+
+	_, res := s.charge(server.URL, uuid.NewString(), chargeRequest{Amount: 100})
+	s.Require().Equal("declined", res.Status)
+}
+
+func (s *ServerSuite) TestChargeTimeout() {
+	_, server := s.newServer(ScenarioTimeout)
+
+	started := time.Now()
+	code, _ := s.charge(server.URL, uuid.NewString(), chargeRequest{Amount: 100})
+	s.Require().Equal(http.StatusGatewayTimeout, code)
+	s.Require().GreaterOrEqual(time.Since(started), 50*time.Millisecond)
+}
+
+func (s *ServerSuite) TestChargeIdempotencyKey() {
+	_, server := s.newServer("")
+	key := uuid.NewString()
+
+	_, first := s.charge(server.URL, key, chargeRequest{Reference: "r1", Amount: 100})
+	_, second := s.charge(server.URL, key, chargeRequest{Reference: "r2", Amount: 100.13})
+	s.Require().Equal(first, second)
+}
+
+func (s *ServerSuite) TestDelayedWebhook() {
+	psp, server := s.newServer("")
+	orderUUID := uuid.NewString()
+
+	_, res := s.charge(server.URL, orderUUID, chargeRequest{Reference: "r1", OrderUUID: orderUUID, Amount: 100.77})
+	s.Require().Equal("pending", res.Status)
+
+	psp.Wait()
+	select {
+	case payload := <-s.webhooks:
+		s.Require().Equal(res.ID, payload.ID)
+		s.Require().Equal("r1", payload.Reference)
+		s.Require().Equal(orderUUID, payload.OrderUUID)
+		s.Require().Equal("succeeded", payload.Status)
+	default:
+		s.Fail("webhook was not delivered")
+	}
+}
+
+func (s *ServerSuite) TestDelayedWebhookDecline() {
+	psp, server := s.newServer(ScenarioWebhookDecline)
+
+	_, res := s.charge(server.URL, uuid.NewString(), chargeRequest{Reference: "r1", Amount: 100})
+	s.Require().Equal("pending", res.Status)
+
+	psp.Wait()
+	payload := <-s.webhooks
+	s.Require().Equal("declined", payload.Status)
+	s.Require().Equal(declineReason, payload.DeclineReason)
+}
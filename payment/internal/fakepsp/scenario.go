@@ -0,0 +1,38 @@
+package fakepsp
+
+import "math"
+
+// Scenario — поведение fake PSP при списании
+type Scenario string
+
+const (
+	// ScenarioSuccess — списание сразу успешно
+	ScenarioSuccess Scenario = "success"
+	// ScenarioDecline — банк отказывает в списании
+	ScenarioDecline Scenario = "decline"
+	// ScenarioTimeout — PSP не отвечает TimeoutDelay, затем возвращает 504
+	ScenarioTimeout Scenario = "timeout"
+	// ScenarioWebhook — списание в обработке, успех приходит webhook'ом через WebhookDelay
+	ScenarioWebhook Scenario = "webhook"
+	// ScenarioWebhookDecline — списание в обработке, отказ приходит webhook'ом
+	ScenarioWebhookDecline Scenario = "webhook_decline"
+)
+
+// ScenarioForAmount выбирает сценарий по копейкам суммы, как тестовые
+// карты у настоящих PSP: .13 — отказ, .42 — таймаут, .77 — успех webhook'ом,
+// .66 — отказ webhook'ом, остальное — успех
+func ScenarioForAmount(amount float64) Scenario {
+	kopecks := int64(math.Round(amount*100)) % 100
+	switch kopecks {
+	case 13:
+		return ScenarioDecline
+	case 42:
+		return ScenarioTimeout
+	case 77:
+		return ScenarioWebhook
+	case 66:
+		return ScenarioWebhookDecline
+	default:
+		return ScenarioSuccess
+	}
+}
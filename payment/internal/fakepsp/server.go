@@ -0,0 +1,60 @@
+package fakepsp
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Config — настройки fake PSP
+type Config struct {
+	// Scenario задаёт поведение для всех списаний; пусто — выбор по сумме (ScenarioForAmount)
+	Scenario Scenario
+	// TimeoutDelay — сколько PSP молчит в сценарии timeout
+	TimeoutDelay time.Duration
+	// WebhookURL — куда отправлять итог отложенных списаний; пусто — не отправлять
+	WebhookURL string
+	// WebhookDelay — задержка перед отправкой webhook
+	WebhookDelay time.Duration
+	// WebhookSecret — ключ подписи webhook, общий с payment-сервисом
+	WebhookSecret string
+}
+
+// Server — локальная заглушка платёжного провайдера с детерминированным поведением
+type Server struct {
+	cfg           Config
+	webhookClient *http.Client
+
+	mu sync.Mutex
+	// charges хранит ответы по Idempotency-Key, чтобы повтор не списывал снова
+	charges map[string]chargeResponse
+
+	webhooks sync.WaitGroup
+}
+
+func NewServer(cfg Config) *Server {
+	return &Server{
+		cfg:           cfg,
+		webhookClient: &http.Client{Timeout: 5 * time.Second},
+		charges:       make(map[string]chargeResponse),
+	}
+}
+
+// Handler возвращает HTTP API fake PSP
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/charges", s.handleCharge)
+	return mux
+}
+
+// Wait дожидается отправки всех запланированных webhook
+func (s *Server) Wait() {
+	s.webhooks.Wait()
+}
+
+func (s *Server) scenario(amount float64) Scenario {
+	if s.cfg.Scenario != "" {
+		return s.cfg.Scenario
+	}
+	return ScenarioForAmount(amount)
+}
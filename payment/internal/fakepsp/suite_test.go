@@ -0,0 +1,103 @@
+package fakepsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/webhook"
+)
+
+const testWebhookSecret = "test-webhook-secret"
+
+type ServerSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	webhooks chan webhookPayload
+	receiver *httptest.Server
+}
+
+func (s *ServerSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.webhooks = make(chan webhookPayload, 10)
+	// Получатель принимает только webhook с корректной подписью
+	s.receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		err = webhook.Verify([]byte(testWebhookSecret),
+			r.Header.Get(webhook.TimestampHeader),
+			r.Header.Get(webhook.SignatureHeader),
+			body, time.Now(), time.Minute,
+		)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var payload webhookPayload
+		if err := json.Unmarshal(body, &payload); err == nil {
+			s.webhooks <- payload
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func (s *ServerSuite) TearDownTest() {
+	s.receiver.Close()
+}
+
+// newServer запускает fake PSP с webhook'ами в s.receiver
+func (s *ServerSuite) newServer(scenario Scenario) (*Server, *httptest.Server) {
+	psp := NewServer(Config{
+		Scenario:      scenario,
+		TimeoutDelay:  50 * time.Millisecond,
+		WebhookURL:    s.receiver.URL,
+		WebhookDelay:  10 * time.Millisecond,
+		WebhookSecret: testWebhookSecret,
+	})
+	server := httptest.NewServer(psp.Handler())
+	s.T().Cleanup(func() {
+		server.Close()
+		psp.Wait()
+	})
+	return psp, server
+}
+
+// charge отправляет списание и возвращает код ответа и тело
+func (s *ServerSuite) charge(url, key string, req chargeRequest) (int, chargeResponse) {
+	body, err := json.Marshal(req)
+	s.Require().NoError(err)
+
+	httpReq, err := http.NewRequestWithContext(s.ctx, http.MethodPost, url+"/v1/charges", bytes.NewReader(body))
+	s.Require().NoError(err)
+	httpReq.Header.Set("Idempotency-Key", key)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	s.Require().NoError(err)
+	defer func() { _ = resp.Body.Close() }()
+
+	var res chargeResponse
+	raw, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	if len(raw) > 0 {
+		s.Require().NoError(json.Unmarshal(raw, &res))
+	}
+	return resp.StatusCode, res
+}
+
+func TestServerIntegration(t *testing.T) {
+	suite.Run(t, new(ServerSuite))
+}
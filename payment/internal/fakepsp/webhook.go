@@ -0,0 +1,75 @@
+package fakepsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/webhook"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// webhookPayload — итог отложенного списания
+type webhookPayload struct {
+	ID            string `json:"id"`
+	Reference     string `json:"reference"`
+	OrderUUID     string `json:"order_uuid"`
+	Status        string `json:"status"`
+	DeclineReason string `json:"decline_reason,omitempty"`
+}
+
+func (s *Server) scheduleWebhook(payload webhookPayload) {
+	if s.cfg.WebhookURL == "" {
+		logger.Warn(context.Background(), "fake psp webhook url is not set, skipping webhook",
+			zap.String("reference", payload.Reference),
+		)
+		return
+	}
+
+	s.webhooks.Add(1)
+	go func() {
+		defer s.webhooks.Done()
+
+		time.Sleep(s.cfg.WebhookDelay)
+		s.sendWebhook(context.Background(), payload)
+	}()
+}
+
+func (s *Server) sendWebhook(ctx context.Context, payload webhookPayload) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal webhook", zap.Error(err))
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		logger.Error(ctx, "failed to build webhook request", zap.Error(err))
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	now := time.Now()
+	req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign([]byte(s.cfg.WebhookSecret), now, body))
+
+	resp, err := s.webhookClient.Do(req)
+	if err != nil {
+		logger.Error(ctx, "failed to send webhook",
+			zap.String("reference", payload.Reference),
+			zap.Error(err),
+		)
+		return
+	}
+	_ = resp.Body.Close()
+
+	logger.Info(ctx, "fake psp webhook sent",
+		zap.String("reference", payload.Reference),
+		zap.String("status", payload.Status),
+		zap.Int("responseStatus", resp.StatusCode),
+	)
+}
@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// FraudDecisionsTotal - COUNTER для подсчета решений антифрода
+// Тип: Int64Counter (монотонно возрастающий)
+// Использование: доля отклонённых и отправленных на проверку оплат
+// Лейблы: decision (ALLOW, REVIEW, DENY), method (способ оплаты)
+var FraudDecisionsTotal metric.Int64Counter
+
+// InitMetrics инициализирует все метрики payment сервиса
+// Должна быть вызвана один раз при старте приложения после инициализации OpenTelemetry провайдера
+func InitMetrics(serviceName string) error {
+	meter := otel.Meter(serviceName)
+	var err error
+
+	FraudDecisionsTotal, err = meter.Int64Counter(
+		serviceName+"_fraud_decisions_total",
+		metric.WithDescription("Total number of fraud screening decisions"),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
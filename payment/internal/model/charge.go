@@ -0,0 +1,34 @@
+package model
+
+import "github.com/google/uuid"
+
+// ChargeStatus — итог списания у платёжного провайдера
+type ChargeStatus string
+
+const (
+	ChargeStatusSucceeded ChargeStatus = "SUCCEEDED"
+	ChargeStatusDeclined  ChargeStatus = "DECLINED"
+	// ChargeStatusPending — провайдер сообщит итог позже через webhook
+	ChargeStatusPending ChargeStatus = "PENDING"
+)
+
+// Charge — запрос на списание у платёжного провайдера
+type Charge struct {
+	TransactionUuid uuid.UUID
+	OrderUuid       uuid.UUID
+	UserUuid        uuid.UUID
+	PaymentMethod   PaymentMethod
+	Amount          float64
+	Currency        string
+	// CardToken и SBPPhone — реквизиты сохранённого инструмента; пусто — оплата без инструмента
+	CardToken string
+	SBPPhone  string
+}
+
+// ChargeResult — ответ провайдера на списание
+type ChargeResult struct {
+	// ProviderReference — идентификатор операции на стороне провайдера
+	ProviderReference string
+	Status            ChargeStatus
+	DeclineReason     string
+}
@@ -1,5 +1,96 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
-var ErrInvalidPaymentMethod = errors.New("invalid payment method")
+var (
+	ErrInvalidPaymentMethod = errors.New("invalid payment method")
+	ErrTransactionNotFound  = errors.New("transaction not found")
+	// ErrTransactionAlreadyExists — транзакция для заказа уже сохранена
+	ErrTransactionAlreadyExists = errors.New("transaction for order already exists")
+	// ErrPaymentConflict — заказ уже оплачен с другими параметрами (пользователь или сумма)
+	ErrPaymentConflict = errors.New("order already paid with different parameters")
+	// ErrInvalidAmount — сумма не положительна или содержит доли копейки
+	ErrInvalidAmount = errors.New("amount must be positive with at most 2 decimals")
+	// ErrUnsupportedCurrency — валюта не поддерживается сервисом
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	// ErrAmountOutOfLimits — сумма не укладывается в лимиты способа оплаты
+	ErrAmountOutOfLimits = errors.New("amount is out of payment method limits")
+	// ErrPaymentDeclined — провайдер отказал в списании
+	ErrPaymentDeclined = errors.New("payment declined")
+	// ErrProviderUnavailable — провайдер не ответил или вернул ошибку сервера
+	ErrProviderUnavailable = errors.New("payment provider unavailable")
+	// ErrTransactionNotPending — транзакция уже получила итоговый статус
+	ErrTransactionNotPending = errors.New("transaction is not pending")
+	// ErrTransactionFinalized — итог от провайдера противоречит сохранённому статусу транзакции
+	ErrTransactionFinalized = errors.New("transaction already finalized with another status")
+	// ErrProviderNotConfigured — для способа оплаты не настроен провайдер
+	ErrProviderNotConfigured = errors.New("payment provider not configured")
+	// ErrInsufficientFunds — на кошельке недостаточно средств для списания
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrAccountNotFound — счёт ещё не открыт: по нему не было операций
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrEntryConflict — операция с этим ключом идемпотентности уже проведена на другую сумму
+	ErrEntryConflict = errors.New("entry with this reference already posted with another amount")
+	// ErrFraudDenied — антифрод отклонил оплату
+	ErrFraudDenied = errors.New("payment denied by fraud screening")
+	// ErrUserNotFound — пользователь не найден в IAM
+	ErrUserNotFound = errors.New("user not found")
+	// ErrInstrumentNotFound — инструмента нет или он принадлежит другому пользователю
+	ErrInstrumentNotFound = errors.New("payment instrument not found")
+	// ErrNoDefaultInstrument — способ оплаты не указан, а инструмента по умолчанию у пользователя нет
+	ErrNoDefaultInstrument = errors.New("no default payment instrument")
+	// ErrInvalidInstrument — данные инструмента не подходят его способу оплаты
+	ErrInvalidInstrument = errors.New("invalid payment instrument")
+	// ErrInstrumentMethodMismatch — способ оплаты в запросе не совпадает со способом инструмента
+	ErrInstrumentMethodMismatch = errors.New("payment method does not match instrument")
+)
+
+// PaymentDeclinedError содержит причину отказа провайдера
+type PaymentDeclinedError struct {
+	Reason string
+}
+
+func (e *PaymentDeclinedError) Error() string {
+	return fmt.Sprintf("payment declined: %s", e.Reason)
+}
+
+func (e *PaymentDeclinedError) Unwrap() error {
+	return ErrPaymentDeclined
+}
+
+// AmountLimitError содержит лимит способа оплаты, который нарушила сумма
+type AmountLimitError struct {
+	PaymentMethod PaymentMethod
+	Amount        float64
+	Currency      string
+	Limit         AmountLimit
+}
+
+func (e *AmountLimitError) Error() string {
+	if e.Limit.Max == 0 {
+		return fmt.Sprintf("amount %.2f %s for %s must be at least %.2f",
+			e.Amount, e.Currency, e.PaymentMethod, e.Limit.Min)
+	}
+	return fmt.Sprintf("amount %.2f %s for %s must be between %.2f and %.2f",
+		e.Amount, e.Currency, e.PaymentMethod, e.Limit.Min, e.Limit.Max)
+}
+
+func (e *AmountLimitError) Unwrap() error {
+	return ErrAmountOutOfLimits
+}
+
+// FraudDeniedError содержит причину отказа антифрода
+type FraudDeniedError struct {
+	Reason string
+}
+
+func (e *FraudDeniedError) Error() string {
+	return "payment denied by fraud screening: " + e.Reason
+}
+
+func (e *FraudDeniedError) Unwrap() error {
+	return ErrFraudDenied
+}
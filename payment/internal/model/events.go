@@ -0,0 +1,20 @@
+package model
+
+type PaymentCompletedEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	TransactionUUID string
+	PaymentMethod   string
+	Amount          float64
+	Currency        string
+}
+
+type PaymentFailedEvent struct {
+	EventUUID       string
+	OrderUUID       string
+	UserUUID        string
+	TransactionUUID string
+	PaymentMethod   string
+	Reason          string
+}
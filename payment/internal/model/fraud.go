@@ -0,0 +1,77 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FraudDecision — итог антифрод-проверки оплаты
+type FraudDecision string
+
+const (
+	// FraudDecisionUnspecified — транзакция создана до появления антифрода
+	FraudDecisionUnspecified FraudDecision = ""
+	FraudDecisionAllow       FraudDecision = "ALLOW"
+	// FraudDecisionReview — оплата проводится, но транзакция помечается для ручной проверки
+	FraudDecisionReview FraudDecision = "REVIEW"
+	// FraudDecisionDeny — оплата отклоняется без обращения к провайдеру
+	FraudDecisionDeny FraudDecision = "DENY"
+)
+
+// severity упорядочивает решения: итог проверки — самое строгое из решений правил
+func (d FraudDecision) severity() int {
+	switch d {
+	case FraudDecisionDeny:
+		return 2
+	case FraudDecisionReview:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// FraudCheck — данные оплаты для антифрод-проверки
+type FraudCheck struct {
+	UserUuid      uuid.UUID
+	PaymentMethod PaymentMethod
+	Amount        float64
+	Currency      string
+}
+
+// FraudVerdict — решение антифрода с причинами от сработавших правил
+type FraudVerdict struct {
+	Decision FraudDecision
+	Reasons  []string
+}
+
+// Escalate учитывает решение очередного правила
+func (v *FraudVerdict) Escalate(decision FraudDecision, reason string) {
+	if decision.severity() > v.Decision.severity() {
+		v.Decision = decision
+	}
+	v.Reasons = append(v.Reasons, reason)
+}
+
+// Reason возвращает причины одной строкой для сохранения в транзакции
+func (v FraudVerdict) Reason() string {
+	return strings.Join(v.Reasons, "; ")
+}
+
+// FraudRules — настройки правил антифрода. Нулевой порог отключает правило
+type FraudRules struct {
+	// VelocityWindow — окно подсчёта попыток оплаты пользователя
+	VelocityWindow time.Duration
+	// VelocityReview и VelocityDeny — число попыток в окне, начиная с которого
+	// оплата уходит на проверку или отклоняется
+	VelocityReview int64
+	VelocityDeny   int64
+	// AmountReview и AmountDeny — пороги суммы оплаты
+	AmountReview float64
+	AmountDeny   float64
+	// NewAccountAge — аккаунт моложе этого возраста считается новым
+	NewAccountAge time.Duration
+	// HighRiskMethods — способы оплаты, для которых решение REVIEW ужесточается до DENY
+	HighRiskMethods []PaymentMethod
+}
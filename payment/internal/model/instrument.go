@@ -0,0 +1,31 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentInstrument — сохранённый платёжный инструмент пользователя.
+// CardToken и SBPPhone хранятся в БД зашифрованными
+type PaymentInstrument struct {
+	Uuid          uuid.UUID
+	UserUuid      uuid.UUID
+	PaymentMethod PaymentMethod
+	// CardToken — токен карты от платёжного провайдера (CARD, CREDIT_CARD)
+	CardToken string
+	CardLast4 string
+	// SBPPhone — телефон СБП в формате +79991234567 (SBP)
+	SBPPhone  string
+	IsDefault bool
+	CreatedAt time.Time
+}
+
+// MaskedSBPPhone возвращает телефон СБП, в котором видны код страны и последние 4 цифры
+func (i PaymentInstrument) MaskedSBPPhone() string {
+	if len(i.SBPPhone) <= 6 {
+		return i.SBPPhone
+	}
+	return i.SBPPhone[:2] + strings.Repeat("*", len(i.SBPPhone)-6) + i.SBPPhone[len(i.SBPPhone)-4:]
+}
@@ -1,5 +1,7 @@
 package model
 
+import "math"
+
 // PaymentMethod represents supported payment methods in a type-safe way.
 type PaymentMethod string
 
@@ -10,3 +12,23 @@ const (
 	PaymentMethodCreditCard    PaymentMethod = "CREDIT_CARD"
 	PaymentMethodInvestorMoney PaymentMethod = "INVESTOR_MONEY"
 )
+
+// AmountLimit — допустимый диапазон суммы для способа оплаты. Max == 0 — без верхней границы
+type AmountLimit struct {
+	Min float64
+	Max float64
+}
+
+// Allows сообщает, укладывается ли сумма в лимит
+func (l AmountLimit) Allows(amount float64) bool {
+	if amount < l.Min {
+		return false
+	}
+	return l.Max == 0 || amount <= l.Max
+}
+
+// ToCents переводит сумму в копейки: в БД суммы хранятся как DECIMAL,
+// поэтому сравнивать float64 из БД и из запроса напрямую нельзя
+func ToCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransactionStatus — состояние транзакции оплаты
+type TransactionStatus string
+
+const (
+	TransactionStatusSucceeded TransactionStatus = "SUCCEEDED"
+	// TransactionStatusPending — списание ждёт подтверждения провайдера (webhook)
+	TransactionStatusPending TransactionStatus = "PENDING"
+	// TransactionStatusFailed — провайдер или антифрод отказал в списании
+	TransactionStatusFailed TransactionStatus = "FAILED"
+)
+
+// Transaction — сохранённая транзакция оплаты заказа
+type Transaction struct {
+	Uuid          uuid.UUID
+	OrderUuid     uuid.UUID
+	UserUuid      uuid.UUID
+	Amount        float64
+	Currency      string
+	PaymentMethod PaymentMethod
+	Status        TransactionStatus
+	// FraudDecision и FraudReason — итог антифрод-проверки при создании транзакции
+	FraudDecision FraudDecision
+	FraudReason   string
+	// InstrumentUuid — сохранённый инструмент оплаты; uuid.Nil — оплата без инструмента
+	InstrumentUuid uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TransactionsFilter — фильтр списка транзакций. Нулевые UUID не фильтруют
+type TransactionsFilter struct {
+	OrderUuid uuid.UUID
+	UserUuid  uuid.UUID
+	Limit     int
+	Offset    int
+}
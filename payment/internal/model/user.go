@@ -0,0 +1,13 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User — данные пользователя из IAM, нужные антифроду
+type User struct {
+	Uuid      uuid.UUID
+	CreatedAt time.Time
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountKind — вид счёта в журнале двойной записи
+type AccountKind string
+
+const (
+	// AccountKindUser — кошелёк пользователя; остаток не может быть отрицательным
+	AccountKindUser AccountKind = "USER"
+	// AccountKindFunding — системный источник пополнений; его остаток равен
+	// минус сумме всех пополнений
+	AccountKindFunding AccountKind = "FUNDING"
+	// AccountKindRevenue — системный счёт, на который зачисляются оплаты заказов
+	AccountKindRevenue AccountKind = "REVENUE"
+)
+
+// EntryKind — вид операции в журнале
+type EntryKind string
+
+const (
+	EntryKindTopUp   EntryKind = "TOP_UP"
+	EntryKindPayment EntryKind = "PAYMENT"
+)
+
+// AccountKey однозначно определяет счёт. У системных счетов OwnerUuid — uuid.Nil
+type AccountKey struct {
+	Kind      AccountKind
+	OwnerUuid uuid.UUID
+	Currency  string
+}
+
+// UserAccount возвращает ключ кошелька пользователя
+func UserAccount(userUUID uuid.UUID, currency string) AccountKey {
+	return AccountKey{Kind: AccountKindUser, OwnerUuid: userUUID, Currency: currency}
+}
+
+// SystemAccount возвращает ключ системного счёта
+func SystemAccount(kind AccountKind, currency string) AccountKey {
+	return AccountKey{Kind: kind, Currency: currency}
+}
+
+// Account — счёт журнала с текущим остатком
+type Account struct {
+	Uuid      uuid.UUID
+	Key       AccountKey
+	Balance   float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Transfer — проводка: сумма списывается со счёта From и зачисляется на счёт To.
+// Пара (Kind, Reference) уникальна и служит ключом идемпотентности
+type Transfer struct {
+	Kind      EntryKind
+	Reference string
+	From      AccountKey
+	To        AccountKey
+	Amount    float64
+}
+
+// JournalEntry — проведённая операция журнала
+type JournalEntry struct {
+	Uuid      uuid.UUID
+	Kind      EntryKind
+	Reference string
+	Amount    float64
+	CreatedAt time.Time
+}
+
+// TopUp — запрос на пополнение кошелька
+type TopUp struct {
+	UserUuid       uuid.UUID
+	Amount         float64
+	Currency       string
+	IdempotencyKey string
+}
+
+// StatementLine — движение по счёту. Amount > 0 — зачисление, Amount < 0 — списание
+type StatementLine struct {
+	EntryUuid    uuid.UUID
+	Kind         EntryKind
+	Reference    string
+	Amount       float64
+	BalanceAfter float64
+	CreatedAt    time.Time
+}
+
+// StatementFilter — параметры выписки по кошельку пользователя
+type StatementFilter struct {
+	UserUuid uuid.UUID
+	Currency string
+	Limit    int
+	Offset   int
+}
+
+// Statement — выписка: текущий остаток и движения, новые первыми
+type Statement struct {
+	Balance  float64
+	Currency string
+	Lines    []StatementLine
+}
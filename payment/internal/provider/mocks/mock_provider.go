@@ -0,0 +1,97 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Provider is an autogenerated mock type for the Provider type
+type Provider struct {
+	mock.Mock
+}
+
+type Provider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Provider) EXPECT() *Provider_Expecter {
+	return &Provider_Expecter{mock: &_m.Mock}
+}
+
+// Charge provides a mock function with given fields: ctx, charge
+func (_m *Provider) Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	ret := _m.Called(ctx, charge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Charge")
+	}
+
+	var r0 model.ChargeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) (model.ChargeResult, error)); ok {
+		return rf(ctx, charge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Charge) model.ChargeResult); ok {
+		r0 = rf(ctx, charge)
+	} else {
+		r0 = ret.Get(0).(model.ChargeResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Charge) error); ok {
+		r1 = rf(ctx, charge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_Charge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Charge'
+type Provider_Charge_Call struct {
+	*mock.Call
+}
+
+// Charge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - charge model.Charge
+func (_e *Provider_Expecter) Charge(ctx interface{}, charge interface{}) *Provider_Charge_Call {
+	return &Provider_Charge_Call{Call: _e.mock.On("Charge", ctx, charge)}
+}
+
+func (_c *Provider_Charge_Call) Run(run func(ctx context.Context, charge model.Charge)) *Provider_Charge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Charge))
+	})
+	return _c
+}
+
+func (_c *Provider_Charge_Call) Return(_a0 model.ChargeResult, _a1 error) *Provider_Charge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_Charge_Call) RunAndReturn(run func(context.Context, model.Charge) (model.ChargeResult, error)) *Provider_Charge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProvider creates a new instance of Provider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Provider {
+	mock := &Provider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
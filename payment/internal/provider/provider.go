@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+// Provider проводит списание у платёжного провайдера.
+// Отказ банка — это ChargeStatusDeclined без ошибки; ошибка означает,
+// что итог списания неизвестен (ErrProviderUnavailable и т.п.)
+type Provider interface {
+	Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error)
+}
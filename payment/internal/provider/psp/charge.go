@@ -0,0 +1,76 @@
+package psp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (p *provider) Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	body, err := json.Marshal(chargeRequest{
+		Reference:     charge.TransactionUuid.String(),
+		OrderUUID:     charge.OrderUuid.String(),
+		Amount:        charge.Amount,
+		Currency:      charge.Currency,
+		PaymentMethod: string(charge.PaymentMethod),
+		CardToken:     charge.CardToken,
+		Phone:         charge.SBPPhone,
+	})
+	if err != nil {
+		return model.ChargeResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/v1/charges", bytes.NewReader(body))
+	if err != nil {
+		return model.ChargeResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// Ключ — транзакция: повтор запроса не спишет повторно, а новая попытка
+	// после неуспешной транзакции заказа получает своё списание
+	req.Header.Set("Idempotency-Key", charge.TransactionUuid.String())
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		// Таймаут и сетевые ошибки: итог списания неизвестен
+		return model.ChargeResult{}, fmt.Errorf("%w: %v", model.ErrProviderUnavailable, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return model.ChargeResult{}, fmt.Errorf("%w: psp responded %d", model.ErrProviderUnavailable, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return model.ChargeResult{}, fmt.Errorf("psp responded %d", resp.StatusCode)
+	}
+
+	var res chargeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return model.ChargeResult{}, fmt.Errorf("decode psp response: %w", err)
+	}
+
+	return toChargeResult(res)
+}
+
+func toChargeResult(res chargeResponse) (model.ChargeResult, error) {
+	result := model.ChargeResult{
+		ProviderReference: res.ID,
+		DeclineReason:     res.DeclineReason,
+	}
+
+	switch res.Status {
+	case statusSucceeded:
+		result.Status = model.ChargeStatusSucceeded
+	case statusDeclined:
+		result.Status = model.ChargeStatusDeclined
+	case statusPending:
+		result.Status = model.ChargeStatusPending
+	default:
+		return model.ChargeResult{}, fmt.Errorf("unknown psp charge status %q", res.Status)
+	}
+
+	return result, nil
+}
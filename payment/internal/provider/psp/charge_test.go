@@ -0,0 +1,101 @@
+package psp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func newCharge(amount float64) model.Charge {
+	return model.Charge{
+		TransactionUuid: uuid.New(),
+		OrderUuid:       uuid.New(),
+		UserUuid:        uuid.New(),
+		PaymentMethod:   model.PaymentMethodCard,
+		Amount:          amount,
+		Currency:        "RUB",
+	}
+}
+
+func (s *ProviderSuite) TestChargeSucceeded() {
+	result, err := s.provider.Charge(s.ctx, newCharge(1500))
+	s.Require().NoError(err)
+	s.Require().Equal(model.ChargeStatusSucceeded, result.Status)
+	s.Require().NotEmpty(result.ProviderReference)
+}
+
+func (s *ProviderSuite) TestChargeDeclined() {
+	result, err := s.provider.Charge(s.ctx, newCharge(1500.13))
+	s.Require().NoError(err)
+	s.Require().Equal(model.ChargeStatusDeclined, result.Status)
+	s.Require().Equal("insufficient_funds", result.DeclineReason)
+}
+
+func (s *ProviderSuite) TestChargePending() {
+	result, err := s.provider.Charge(s.ctx, newCharge(1500.77))
+	s.Require().NoError(err)
+	s.Require().Equal(model.ChargeStatusPending, result.Status)
+}
+
+func (s *ProviderSuite) TestChargeTimeout() {
+	_, err := s.provider.Charge(s.ctx, newCharge(1500.42))
+	s.Require().ErrorIs(err, model.ErrProviderUnavailable)
+}
+
+func (s *ProviderSuite) TestChargeIdempotentByTransaction() {
+	charge := newCharge(1500)
+	first, err := s.provider.Charge(s.ctx, charge)
+	s.Require().NoError(err)
+
+	// Повтор той же транзакции не создаёт новое списание
+	second, err := s.provider.Charge(s.ctx, charge)
+	s.Require().NoError(err)
+	s.Require().Equal(first.ProviderReference, second.ProviderReference)
+
+	// Новая попытка оплаты заказа — новое списание
+	charge.TransactionUuid = uuid.New()
+	third, err := s.provider.Charge(s.ctx, charge)
+	s.Require().NoError(err)
+	s.Require().NotEqual(first.ProviderReference, third.ProviderReference)
+}
+
+func (s *ProviderSuite) TestChargeServerError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewProvider(server.URL, 0).Charge(s.ctx, newCharge(1500))
+	s.Require().ErrorIs(err, model.ErrProviderUnavailable)
+}
+
+func (s *ProviderSuite) TestChargeSendsInstrumentDetails() {
+	var received chargeRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		_, _ = w.Write([]byte(`{"id":"psp_1","status":"succeeded"}`))
+	}))
+	defer server.Close()
+
+	charge := newCharge(1500)
+	charge.CardToken = "tok_123"
+	_, err := NewProvider(server.URL, 0).Charge(s.ctx, charge)
+	s.Require().NoError(err)
+	s.Require().Equal("tok_123", received.CardToken)
+	s.Require().Empty(received.Phone)
+}
+
+func (s *ProviderSuite) TestChargeUnknownStatus() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"psp_1","status":"refunded"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewProvider(server.URL, 0).Charge(s.ctx, newCharge(1500))
+	s.Require().Error(err)
+	s.Require().NotErrorIs(err, model.ErrProviderUnavailable)
+}
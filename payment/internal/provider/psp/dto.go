@@ -0,0 +1,27 @@
+package psp
+
+// chargeRequest — тело POST /v1/charges
+type chargeRequest struct {
+	Reference     string  `json:"reference"`
+	OrderUUID     string  `json:"order_uuid"`
+	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
+	PaymentMethod string  `json:"payment_method"`
+	// CardToken и Phone — реквизиты сохранённого инструмента; без инструмента PSP запрашивает их сам
+	CardToken string `json:"card_token,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+}
+
+// chargeResponse — ответ PSP на списание
+type chargeResponse struct {
+	ID            string `json:"id"`
+	Reference     string `json:"reference"`
+	Status        string `json:"status"`
+	DeclineReason string `json:"decline_reason,omitempty"`
+}
+
+const (
+	statusSucceeded = "succeeded"
+	statusDeclined  = "declined"
+	statusPending   = "pending"
+)
@@ -0,0 +1,24 @@
+package psp
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/provider"
+)
+
+var _ def.Provider = (*provider)(nil)
+
+// provider списывает деньги через HTTP API внешнего PSP
+type provider struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+func NewProvider(baseURL string, timeout time.Duration) *provider {
+	return &provider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
@@ -0,0 +1,41 @@
+package psp
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/fakepsp"
+)
+
+type ProviderSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	psp    *fakepsp.Server
+	server *httptest.Server
+
+	provider *provider
+}
+
+func (s *ProviderSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.psp = fakepsp.NewServer(fakepsp.Config{TimeoutDelay: time.Second})
+	s.server = httptest.NewServer(s.psp.Handler())
+
+	s.provider = NewProvider(s.server.URL, 200*time.Millisecond)
+}
+
+func (s *ProviderSuite) TearDownTest() {
+	s.server.Close()
+	s.psp.Wait()
+}
+
+func TestProviderIntegration(t *testing.T) {
+	suite.Run(t, new(ProviderSuite))
+}
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/provider"
+)
+
+var _ def.Provider = (*router)(nil)
+
+// router выбирает провайдера по способу оплаты
+type router struct {
+	routes map[model.PaymentMethod]def.Provider
+}
+
+func NewRouter(routes map[model.PaymentMethod]def.Provider) *router {
+	return &router{
+		routes: routes,
+	}
+}
+
+func (r *router) Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	provider, ok := r.routes[charge.PaymentMethod]
+	if !ok {
+		return model.ChargeResult{}, fmt.Errorf("%w: %s", model.ErrProviderNotConfigured, charge.PaymentMethod)
+	}
+
+	return provider.Charge(ctx, charge)
+}
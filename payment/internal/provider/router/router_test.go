@@ -0,0 +1,57 @@
+package router
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/provider"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/provider/mocks"
+)
+
+type RouterSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	card *mocks.Provider
+	sbp  *mocks.Provider
+
+	router *router
+}
+
+func (s *RouterSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.card = mocks.NewProvider(s.T())
+	s.sbp = mocks.NewProvider(s.T())
+
+	s.router = NewRouter(map[model.PaymentMethod]def.Provider{
+		model.PaymentMethodCard: s.card,
+		model.PaymentMethodSBP:  s.sbp,
+	})
+}
+
+func (s *RouterSuite) TestChargeRoutesByMethod() {
+	charge := model.Charge{TransactionUuid: uuid.New(), PaymentMethod: model.PaymentMethodSBP, Amount: 100}
+	expected := model.ChargeResult{ProviderReference: "sbp-1", Status: model.ChargeStatusSucceeded}
+	s.sbp.On("Charge", s.ctx, charge).Return(expected, nil)
+
+	result, err := s.router.Charge(s.ctx, charge)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, result)
+	s.card.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+}
+
+func (s *RouterSuite) TestChargeUnknownMethod() {
+	_, err := s.router.Charge(s.ctx, model.Charge{PaymentMethod: model.PaymentMethodInvestorMoney})
+	s.Require().ErrorIs(err, model.ErrProviderNotConfigured)
+}
+
+func TestRouterIntegration(t *testing.T) {
+	suite.Run(t, new(RouterSuite))
+}
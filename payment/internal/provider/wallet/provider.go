@@ -0,0 +1,49 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/provider"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+)
+
+var _ def.Provider = (*provider)(nil)
+
+// provider списывает оплату с кошелька пользователя (INVESTOR_MONEY)
+// на системный счёт выручки. Нехватка средств — отказ, а не ошибка
+type provider struct {
+	walletRepository repository.WalletRepository
+}
+
+func NewProvider(walletRepository repository.WalletRepository) *provider {
+	return &provider{
+		walletRepository: walletRepository,
+	}
+}
+
+func (p *provider) Charge(ctx context.Context, charge model.Charge) (model.ChargeResult, error) {
+	// UUID транзакции — ключ операции: повторное списание той же транзакции не проводится
+	entry, err := p.walletRepository.Transfer(ctx, model.Transfer{
+		Kind:      model.EntryKindPayment,
+		Reference: charge.TransactionUuid.String(),
+		From:      model.UserAccount(charge.UserUuid, charge.Currency),
+		To:        model.SystemAccount(model.AccountKindRevenue, charge.Currency),
+		Amount:    charge.Amount,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInsufficientFunds) {
+			return model.ChargeResult{
+				Status:        model.ChargeStatusDeclined,
+				DeclineReason: model.ErrInsufficientFunds.Error(),
+			}, nil
+		}
+		return model.ChargeResult{}, err
+	}
+
+	return model.ChargeResult{
+		ProviderReference: "wallet-" + entry.Uuid.String(),
+		Status:            model.ChargeStatusSucceeded,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+)
+
+type ProviderSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	walletRepository *mocks.WalletRepository
+
+	provider *provider
+	charge   model.Charge
+	transfer model.Transfer
+}
+
+func (s *ProviderSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.walletRepository = mocks.NewWalletRepository(s.T())
+	s.provider = NewProvider(s.walletRepository)
+
+	s.charge = model.Charge{
+		TransactionUuid: uuid.New(),
+		OrderUuid:       uuid.New(),
+		UserUuid:        uuid.New(),
+		PaymentMethod:   model.PaymentMethodInvestorMoney,
+		Amount:          1500,
+		Currency:        "RUB",
+	}
+	s.transfer = model.Transfer{
+		Kind:      model.EntryKindPayment,
+		Reference: s.charge.TransactionUuid.String(),
+		From:      model.UserAccount(s.charge.UserUuid, "RUB"),
+		To:        model.SystemAccount(model.AccountKindRevenue, "RUB"),
+		Amount:    1500,
+	}
+}
+
+func (s *ProviderSuite) TestChargeDebitsWallet() {
+	entry := model.JournalEntry{Uuid: uuid.New(), Kind: model.EntryKindPayment, Amount: 1500}
+	s.walletRepository.On("Transfer", s.ctx, s.transfer).Return(entry, nil)
+
+	result, err := s.provider.Charge(s.ctx, s.charge)
+	s.Require().NoError(err)
+	s.Equal(model.ChargeStatusSucceeded, result.Status)
+	s.Equal("wallet-"+entry.Uuid.String(), result.ProviderReference)
+}
+
+func (s *ProviderSuite) TestChargeInsufficientFunds() {
+	s.walletRepository.On("Transfer", s.ctx, s.transfer).Return(model.JournalEntry{}, model.ErrInsufficientFunds)
+
+	result, err := s.provider.Charge(s.ctx, s.charge)
+	s.Require().NoError(err)
+	s.Equal(model.ChargeStatusDeclined, result.Status)
+	s.Equal("insufficient funds", result.DeclineReason)
+}
+
+func (s *ProviderSuite) TestChargeRepositoryError() {
+	repoErr := errors.New("connection reset")
+	s.walletRepository.On("Transfer", s.ctx, s.transfer).Return(model.JournalEntry{}, repoErr)
+
+	_, err := s.provider.Charge(s.ctx, s.charge)
+	s.Require().ErrorIs(err, repoErr)
+}
+
+func TestProvider(t *testing.T) {
+	suite.Run(t, new(ProviderSuite))
+}
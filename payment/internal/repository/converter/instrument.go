@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+// ToRepoInstrument принимает уже зашифрованные токен карты и телефон СБП
+func ToRepoInstrument(instrument model.PaymentInstrument, cardTokenEncrypted, sbpPhoneEncrypted string) repoModel.PaymentInstrument {
+	return repoModel.PaymentInstrument{
+		InstrumentUUID:     instrument.Uuid,
+		UserUUID:           instrument.UserUuid,
+		PaymentMethod:      string(instrument.PaymentMethod),
+		CardTokenEncrypted: cardTokenEncrypted,
+		CardLast4:          instrument.CardLast4,
+		SBPPhoneEncrypted:  sbpPhoneEncrypted,
+		IsDefault:          instrument.IsDefault,
+		CreatedAt:          instrument.CreatedAt,
+	}
+}
+
+// ToModelInstrument принимает расшифрованные токен карты и телефон СБП
+func ToModelInstrument(instrument repoModel.PaymentInstrument, cardToken, sbpPhone string) model.PaymentInstrument {
+	return model.PaymentInstrument{
+		Uuid:          instrument.InstrumentUUID,
+		UserUuid:      instrument.UserUUID,
+		PaymentMethod: model.PaymentMethod(instrument.PaymentMethod),
+		CardToken:     cardToken,
+		CardLast4:     instrument.CardLast4,
+		SBPPhone:      sbpPhone,
+		IsDefault:     instrument.IsDefault,
+		CreatedAt:     instrument.CreatedAt,
+	}
+}
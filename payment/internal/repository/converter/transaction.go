@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+func ToRepoTransaction(transaction model.Transaction) repoModel.Transaction {
+	var instrumentUUID *uuid.UUID
+	if transaction.InstrumentUuid != uuid.Nil {
+		instrumentUUID = &transaction.InstrumentUuid
+	}
+
+	return repoModel.Transaction{
+		TransactionUUID: transaction.Uuid,
+		OrderUUID:       transaction.OrderUuid,
+		UserUUID:        transaction.UserUuid,
+		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+		PaymentMethod:   string(transaction.PaymentMethod),
+		Status:          string(transaction.Status),
+		FraudDecision:   string(transaction.FraudDecision),
+		FraudReason:     transaction.FraudReason,
+		InstrumentUUID:  instrumentUUID,
+		CreatedAt:       transaction.CreatedAt,
+		UpdatedAt:       transaction.UpdatedAt,
+	}
+}
+
+func ToModelTransaction(transaction repoModel.Transaction) model.Transaction {
+	var instrumentUUID uuid.UUID
+	if transaction.InstrumentUUID != nil {
+		instrumentUUID = *transaction.InstrumentUUID
+	}
+
+	return model.Transaction{
+		Uuid:           transaction.TransactionUUID,
+		OrderUuid:      transaction.OrderUUID,
+		UserUuid:       transaction.UserUUID,
+		Amount:         transaction.Amount,
+		Currency:       transaction.Currency,
+		PaymentMethod:  model.PaymentMethod(transaction.PaymentMethod),
+		Status:         model.TransactionStatus(transaction.Status),
+		FraudDecision:  model.FraudDecision(transaction.FraudDecision),
+		FraudReason:    transaction.FraudReason,
+		InstrumentUuid: instrumentUUID,
+		CreatedAt:      transaction.CreatedAt,
+		UpdatedAt:      transaction.UpdatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+func ToModelAccount(account repoModel.Account) model.Account {
+	return model.Account{
+		Uuid: account.AccountUUID,
+		Key: model.AccountKey{
+			Kind:      model.AccountKind(account.Kind),
+			OwnerUuid: account.OwnerUUID,
+			Currency:  account.Currency,
+		},
+		Balance:   account.Balance,
+		CreatedAt: account.CreatedAt,
+		UpdatedAt: account.UpdatedAt,
+	}
+}
+
+func ToModelJournalEntry(entry repoModel.JournalEntry) model.JournalEntry {
+	return model.JournalEntry{
+		Uuid:      entry.EntryUUID,
+		Kind:      model.EntryKind(entry.Kind),
+		Reference: entry.Reference,
+		Amount:    entry.Amount,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+func ToModelStatementLine(line repoModel.StatementLine) model.StatementLine {
+	return model.StatementLine{
+		EntryUuid:    line.EntryUUID,
+		Kind:         model.EntryKind(line.Kind),
+		Reference:    line.Reference,
+		Amount:       line.Amount,
+		BalanceAfter: line.BalanceAfter,
+		CreatedAt:    line.CreatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package instrument
+
+import (
+	"context"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (r *repository) CreateInstrument(ctx context.Context, instrument model.PaymentInstrument) error {
+	repoInstrument, err := r.encrypt(instrument)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if repoInstrument.IsDefault {
+		_, err = tx.Exec(ctx, `
+			UPDATE payment_instruments SET is_default = FALSE
+			WHERE user_uuid = $1 AND is_default`,
+			repoInstrument.UserUUID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO payment_instruments (instrument_uuid, user_uuid, payment_method, card_token_encrypted,
+		                                 card_last4, sbp_phone_encrypted, is_default, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		repoInstrument.InstrumentUUID,
+		repoInstrument.UserUUID,
+		repoInstrument.PaymentMethod,
+		repoInstrument.CardTokenEncrypted,
+		repoInstrument.CardLast4,
+		repoInstrument.SBPPhoneEncrypted,
+		repoInstrument.IsDefault,
+		repoInstrument.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
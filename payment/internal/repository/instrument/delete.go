@@ -0,0 +1,25 @@
+package instrument
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (r *repository) DeleteInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, `
+		DELETE FROM payment_instruments
+		WHERE instrument_uuid = $1 AND user_uuid = $2`,
+		instrumentUUID, userUUID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrInstrumentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package instrument
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+const selectInstruments = `
+	SELECT instrument_uuid, user_uuid, payment_method, card_token_encrypted,
+	       card_last4, sbp_phone_encrypted, is_default, created_at
+	FROM payment_instruments`
+
+func (r *repository) GetInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) (model.PaymentInstrument, error) {
+	// Чужой инструмент неотличим от отсутствующего
+	rows, err := r.pool.Query(ctx, selectInstruments+`
+		WHERE instrument_uuid = $1 AND user_uuid = $2`,
+		instrumentUUID, userUUID,
+	)
+	if err != nil {
+		return model.PaymentInstrument{}, err
+	}
+	defer rows.Close()
+
+	return r.collectInstrument(rows)
+}
+
+func (r *repository) GetDefaultInstrument(ctx context.Context, userUUID uuid.UUID) (model.PaymentInstrument, error) {
+	// Признак по умолчанию снимается с прежнего инструмента при создании нового, поэтому строка одна
+	rows, err := r.pool.Query(ctx, selectInstruments+`
+		WHERE user_uuid = $1 AND is_default`,
+		userUUID,
+	)
+	if err != nil {
+		return model.PaymentInstrument{}, err
+	}
+	defer rows.Close()
+
+	return r.collectInstrument(rows)
+}
+
+// collectInstrument читает единственный инструмент выборки и расшифровывает реквизиты
+func (r *repository) collectInstrument(rows pgx.Rows) (model.PaymentInstrument, error) {
+	repoInstrument, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[repoModel.PaymentInstrument])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PaymentInstrument{}, model.ErrInstrumentNotFound
+		}
+		return model.PaymentInstrument{}, err
+	}
+
+	return r.decrypt(repoInstrument)
+}
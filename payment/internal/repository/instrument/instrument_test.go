@@ -0,0 +1,128 @@
+package instrument
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func newCardInstrument(userUUID uuid.UUID, isDefault bool, createdAt time.Time) model.PaymentInstrument {
+	return model.PaymentInstrument{
+		Uuid:          uuid.New(),
+		UserUuid:      userUUID,
+		PaymentMethod: model.PaymentMethodCard,
+		CardToken:     "tok_" + uuid.NewString(),
+		CardLast4:     "4242",
+		IsDefault:     isDefault,
+		CreatedAt:     createdAt,
+	}
+}
+
+func (s *RepositorySuite) TestCreateAndGetInstrument() {
+	instrument := model.PaymentInstrument{
+		Uuid:          uuid.New(),
+		UserUuid:      uuid.New(),
+		PaymentMethod: model.PaymentMethodSBP,
+		SBPPhone:      "+79991234567",
+		IsDefault:     true,
+		CreatedAt:     time.Now(),
+	}
+	s.Require().NoError(s.repository.CreateInstrument(s.ctx, instrument))
+
+	result, err := s.repository.GetInstrument(s.ctx, instrument.UserUuid, instrument.Uuid)
+	s.Require().NoError(err)
+	s.Equal(instrument.Uuid, result.Uuid)
+	s.Equal(model.PaymentMethodSBP, result.PaymentMethod)
+	s.Equal("+79991234567", result.SBPPhone)
+	s.Empty(result.CardToken)
+	s.True(result.IsDefault)
+	s.WithinDuration(instrument.CreatedAt, result.CreatedAt, time.Millisecond)
+}
+
+func (s *RepositorySuite) TestSensitiveFieldsEncryptedAtRest() {
+	instrument := newCardInstrument(uuid.New(), false, time.Now())
+	s.Require().NoError(s.repository.CreateInstrument(s.ctx, instrument))
+
+	var cardToken string
+	err := s.pool.QueryRow(s.ctx,
+		"SELECT card_token_encrypted FROM payment_instruments WHERE instrument_uuid = $1",
+		instrument.Uuid,
+	).Scan(&cardToken)
+	s.Require().NoError(err)
+	s.NotEmpty(cardToken)
+	s.NotContains(cardToken, instrument.CardToken)
+
+	result, err := s.repository.GetInstrument(s.ctx, instrument.UserUuid, instrument.Uuid)
+	s.Require().NoError(err)
+	s.Equal(instrument.CardToken, result.CardToken)
+}
+
+func (s *RepositorySuite) TestGetInstrumentOfAnotherUser() {
+	instrument := newCardInstrument(uuid.New(), false, time.Now())
+	s.Require().NoError(s.repository.CreateInstrument(s.ctx, instrument))
+
+	_, err := s.repository.GetInstrument(s.ctx, uuid.New(), instrument.Uuid)
+	s.Require().ErrorIs(err, model.ErrInstrumentNotFound)
+}
+
+func (s *RepositorySuite) TestListInstrumentsDefaultFirst() {
+	userUUID := uuid.New()
+	now := time.Now()
+
+	first := newCardInstrument(userUUID, true, now.Add(-2*time.Hour))
+	second := newCardInstrument(userUUID, false, now.Add(-time.Hour))
+	// Новый инструмент по умолчанию снимает признак с прежнего
+	third := newCardInstrument(userUUID, true, now)
+	for _, instrument := range []model.PaymentInstrument{first, second, third} {
+		s.Require().NoError(s.repository.CreateInstrument(s.ctx, instrument))
+	}
+	s.Require().NoError(s.repository.CreateInstrument(s.ctx, newCardInstrument(uuid.New(), true, now)))
+
+	instruments, err := s.repository.ListInstruments(s.ctx, userUUID)
+	s.Require().NoError(err)
+	s.Require().Len(instruments, 3)
+	s.Equal(third.Uuid, instruments[0].Uuid)
+	s.True(instruments[0].IsDefault)
+	s.Equal(second.Uuid, instruments[1].Uuid)
+	s.Equal(first.Uuid, instruments[2].Uuid)
+	s.False(instruments[2].IsDefault)
+}
+
+func (s *RepositorySuite) TestGetDefaultInstrument() {
+	userUUID := uuid.New()
+
+	_, err := s.repository.GetDefaultInstrument(s.ctx, userUUID)
+	s.Require().ErrorIs(err, model.ErrInstrumentNotFound)
+
+	other := newCardInstrument(userUUID, false, time.Now())
+	s.Require().NoError(s.repository.CreateInstrument(s.ctx, other))
+	_, err = s.repository.GetDefaultInstrument(s.ctx, userUUID)
+	s.Require().ErrorIs(err, model.ErrInstrumentNotFound)
+
+	instrument := newCardInstrument(userUUID, true, time.Now())
+	s.Require().NoError(s.repository.CreateInstrument(s.ctx, instrument))
+
+	result, err := s.repository.GetDefaultInstrument(s.ctx, userUUID)
+	s.Require().NoError(err)
+	s.Equal(instrument.Uuid, result.Uuid)
+	s.Equal(instrument.CardToken, result.CardToken)
+}
+
+func (s *RepositorySuite) TestDeleteInstrument() {
+	instrument := newCardInstrument(uuid.New(), false, time.Now())
+	s.Require().NoError(s.repository.CreateInstrument(s.ctx, instrument))
+
+	// Чужой пользователь не может удалить инструмент
+	err := s.repository.DeleteInstrument(s.ctx, uuid.New(), instrument.Uuid)
+	s.Require().ErrorIs(err, model.ErrInstrumentNotFound)
+
+	s.Require().NoError(s.repository.DeleteInstrument(s.ctx, instrument.UserUuid, instrument.Uuid))
+
+	_, err = s.repository.GetInstrument(s.ctx, instrument.UserUuid, instrument.Uuid)
+	s.Require().ErrorIs(err, model.ErrInstrumentNotFound)
+
+	err = s.repository.DeleteInstrument(s.ctx, instrument.UserUuid, instrument.Uuid)
+	s.Require().ErrorIs(err, model.ErrInstrumentNotFound)
+}
@@ -0,0 +1,39 @@
+package instrument
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+func (r *repository) ListInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	rows, err := r.pool.Query(ctx, selectInstruments+`
+		WHERE user_uuid = $1
+		ORDER BY is_default DESC, created_at DESC, instrument_uuid`,
+		userUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoInstruments, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.PaymentInstrument])
+	if err != nil {
+		return nil, err
+	}
+
+	instruments := make([]model.PaymentInstrument, 0, len(repoInstruments))
+	for _, repoInstrument := range repoInstruments {
+		instrument, err := r.decrypt(repoInstrument)
+		if err != nil {
+			return nil, err
+		}
+		instruments = append(instruments, instrument)
+	}
+
+	return instruments, nil
+}
@@ -0,0 +1,57 @@
+package instrument
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/encryption"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+var _ def.InstrumentRepository = (*repository)(nil)
+
+type repository struct {
+	pool   *pgxpool.Pool
+	cipher *encryption.Cipher
+}
+
+func NewRepository(pool *pgxpool.Pool, cipher *encryption.Cipher) *repository {
+	return &repository{
+		pool:   pool,
+		cipher: cipher,
+	}
+}
+
+// encrypt шифрует чувствительные поля инструмента для записи в БД
+func (r *repository) encrypt(instrument model.PaymentInstrument) (repoModel.PaymentInstrument, error) {
+	cardToken, err := r.cipher.Encrypt(instrument.CardToken)
+	if err != nil {
+		return repoModel.PaymentInstrument{}, fmt.Errorf("failed to encrypt card token: %w", err)
+	}
+
+	sbpPhone, err := r.cipher.Encrypt(instrument.SBPPhone)
+	if err != nil {
+		return repoModel.PaymentInstrument{}, fmt.Errorf("failed to encrypt sbp phone: %w", err)
+	}
+
+	return repoConverter.ToRepoInstrument(instrument, cardToken, sbpPhone), nil
+}
+
+// decrypt восстанавливает чувствительные поля инструмента, прочитанного из БД
+func (r *repository) decrypt(instrument repoModel.PaymentInstrument) (model.PaymentInstrument, error) {
+	cardToken, err := r.cipher.Decrypt(instrument.CardTokenEncrypted)
+	if err != nil {
+		return model.PaymentInstrument{}, fmt.Errorf("failed to decrypt card token of %s: %w", instrument.InstrumentUUID, err)
+	}
+
+	sbpPhone, err := r.cipher.Decrypt(instrument.SBPPhoneEncrypted)
+	if err != nil {
+		return model.PaymentInstrument{}, fmt.Errorf("failed to decrypt sbp phone of %s: %w", instrument.InstrumentUUID, err)
+	}
+
+	return repoConverter.ToModelInstrument(instrument, cardToken, sbpPhone), nil
+}
@@ -0,0 +1,311 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// InstrumentRepository is an autogenerated mock type for the InstrumentRepository type
+type InstrumentRepository struct {
+	mock.Mock
+}
+
+type InstrumentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstrumentRepository) EXPECT() *InstrumentRepository_Expecter {
+	return &InstrumentRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateInstrument provides a mock function with given fields: ctx, instrument
+func (_m *InstrumentRepository) CreateInstrument(ctx context.Context, instrument model.PaymentInstrument) error {
+	ret := _m.Called(ctx, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstrument")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentInstrument) error); ok {
+		r0 = rf(ctx, instrument)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstrumentRepository_CreateInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInstrument'
+type InstrumentRepository_CreateInstrument_Call struct {
+	*mock.Call
+}
+
+// CreateInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instrument model.PaymentInstrument
+func (_e *InstrumentRepository_Expecter) CreateInstrument(ctx interface{}, instrument interface{}) *InstrumentRepository_CreateInstrument_Call {
+	return &InstrumentRepository_CreateInstrument_Call{Call: _e.mock.On("CreateInstrument", ctx, instrument)}
+}
+
+func (_c *InstrumentRepository_CreateInstrument_Call) Run(run func(ctx context.Context, instrument model.PaymentInstrument)) *InstrumentRepository_CreateInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PaymentInstrument))
+	})
+	return _c
+}
+
+func (_c *InstrumentRepository_CreateInstrument_Call) Return(_a0 error) *InstrumentRepository_CreateInstrument_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentRepository_CreateInstrument_Call) RunAndReturn(run func(context.Context, model.PaymentInstrument) error) *InstrumentRepository_CreateInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInstrument provides a mock function with given fields: ctx, userUUID, instrumentUUID
+func (_m *InstrumentRepository) DeleteInstrument(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, userUUID, instrumentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInstrument")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userUUID, instrumentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstrumentRepository_DeleteInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInstrument'
+type InstrumentRepository_DeleteInstrument_Call struct {
+	*mock.Call
+}
+
+// DeleteInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - instrumentUUID uuid.UUID
+func (_e *InstrumentRepository_Expecter) DeleteInstrument(ctx interface{}, userUUID interface{}, instrumentUUID interface{}) *InstrumentRepository_DeleteInstrument_Call {
+	return &InstrumentRepository_DeleteInstrument_Call{Call: _e.mock.On("DeleteInstrument", ctx, userUUID, instrumentUUID)}
+}
+
+func (_c *InstrumentRepository_DeleteInstrument_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID)) *InstrumentRepository_DeleteInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InstrumentRepository_DeleteInstrument_Call) Return(_a0 error) *InstrumentRepository_DeleteInstrument_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentRepository_DeleteInstrument_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *InstrumentRepository_DeleteInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultInstrument provides a mock function with given fields: ctx, userUUID
+func (_m *InstrumentRepository) GetDefaultInstrument(ctx context.Context, userUUID uuid.UUID) (model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultInstrument")
+	}
+
+	var r0 model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.PaymentInstrument, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.PaymentInstrument); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(model.PaymentInstrument)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentRepository_GetDefaultInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultInstrument'
+type InstrumentRepository_GetDefaultInstrument_Call struct {
+	*mock.Call
+}
+
+// GetDefaultInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *InstrumentRepository_Expecter) GetDefaultInstrument(ctx interface{}, userUUID interface{}) *InstrumentRepository_GetDefaultInstrument_Call {
+	return &InstrumentRepository_GetDefaultInstrument_Call{Call: _e.mock.On("GetDefaultInstrument", ctx, userUUID)}
+}
+
+func (_c *InstrumentRepository_GetDefaultInstrument_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *InstrumentRepository_GetDefaultInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InstrumentRepository_GetDefaultInstrument_Call) Return(_a0 model.PaymentInstrument, _a1 error) *InstrumentRepository_GetDefaultInstrument_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentRepository_GetDefaultInstrument_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.PaymentInstrument, error)) *InstrumentRepository_GetDefaultInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInstrument provides a mock function with given fields: ctx, userUUID, instrumentUUID
+func (_m *InstrumentRepository) GetInstrument(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID) (model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, userUUID, instrumentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstrument")
+	}
+
+	var r0 model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (model.PaymentInstrument, error)); ok {
+		return rf(ctx, userUUID, instrumentUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) model.PaymentInstrument); ok {
+		r0 = rf(ctx, userUUID, instrumentUUID)
+	} else {
+		r0 = ret.Get(0).(model.PaymentInstrument)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID, instrumentUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentRepository_GetInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInstrument'
+type InstrumentRepository_GetInstrument_Call struct {
+	*mock.Call
+}
+
+// GetInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - instrumentUUID uuid.UUID
+func (_e *InstrumentRepository_Expecter) GetInstrument(ctx interface{}, userUUID interface{}, instrumentUUID interface{}) *InstrumentRepository_GetInstrument_Call {
+	return &InstrumentRepository_GetInstrument_Call{Call: _e.mock.On("GetInstrument", ctx, userUUID, instrumentUUID)}
+}
+
+func (_c *InstrumentRepository_GetInstrument_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID)) *InstrumentRepository_GetInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InstrumentRepository_GetInstrument_Call) Return(_a0 model.PaymentInstrument, _a1 error) *InstrumentRepository_GetInstrument_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentRepository_GetInstrument_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (model.PaymentInstrument, error)) *InstrumentRepository_GetInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstruments provides a mock function with given fields: ctx, userUUID
+func (_m *InstrumentRepository) ListInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstruments")
+	}
+
+	var r0 []model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.PaymentInstrument); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PaymentInstrument)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentRepository_ListInstruments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstruments'
+type InstrumentRepository_ListInstruments_Call struct {
+	*mock.Call
+}
+
+// ListInstruments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *InstrumentRepository_Expecter) ListInstruments(ctx interface{}, userUUID interface{}) *InstrumentRepository_ListInstruments_Call {
+	return &InstrumentRepository_ListInstruments_Call{Call: _e.mock.On("ListInstruments", ctx, userUUID)}
+}
+
+func (_c *InstrumentRepository_ListInstruments_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *InstrumentRepository_ListInstruments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InstrumentRepository_ListInstruments_Call) Return(_a0 []model.PaymentInstrument, _a1 error) *InstrumentRepository_ListInstruments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentRepository_ListInstruments_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)) *InstrumentRepository_ListInstruments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstrumentRepository creates a new instance of InstrumentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstrumentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstrumentRepository {
+	mock := &InstrumentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
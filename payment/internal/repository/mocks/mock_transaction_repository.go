@@ -0,0 +1,310 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// TransactionRepository is an autogenerated mock type for the TransactionRepository type
+type TransactionRepository struct {
+	mock.Mock
+}
+
+type TransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactionRepository) EXPECT() *TransactionRepository_Expecter {
+	return &TransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateTransaction provides a mock function with given fields: ctx, transaction
+func (_m *TransactionRepository) CreateTransaction(ctx context.Context, transaction model.Transaction) error {
+	ret := _m.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Transaction) error); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionRepository_CreateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransaction'
+type TransactionRepository_CreateTransaction_Call struct {
+	*mock.Call
+}
+
+// CreateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction model.Transaction
+func (_e *TransactionRepository_Expecter) CreateTransaction(ctx interface{}, transaction interface{}) *TransactionRepository_CreateTransaction_Call {
+	return &TransactionRepository_CreateTransaction_Call{Call: _e.mock.On("CreateTransaction", ctx, transaction)}
+}
+
+func (_c *TransactionRepository_CreateTransaction_Call) Run(run func(ctx context.Context, transaction model.Transaction)) *TransactionRepository_CreateTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Transaction))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_CreateTransaction_Call) Return(_a0 error) *TransactionRepository_CreateTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionRepository_CreateTransaction_Call) RunAndReturn(run func(context.Context, model.Transaction) error) *TransactionRepository_CreateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransaction provides a mock function with given fields: ctx, _a1
+func (_m *TransactionRepository) GetTransaction(ctx context.Context, _a1 uuid.UUID) (model.Transaction, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Transaction, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Transaction); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type TransactionRepository_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 uuid.UUID
+func (_e *TransactionRepository_Expecter) GetTransaction(ctx interface{}, _a1 interface{}) *TransactionRepository_GetTransaction_Call {
+	return &TransactionRepository_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, _a1)}
+}
+
+func (_c *TransactionRepository_GetTransaction_Call) Run(run func(ctx context.Context, _a1 uuid.UUID)) *TransactionRepository_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_GetTransaction_Call) Return(_a0 model.Transaction, _a1 error) *TransactionRepository_GetTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_GetTransaction_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.Transaction, error)) *TransactionRepository_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransactionByOrder provides a mock function with given fields: ctx, orderUUID
+func (_m *TransactionRepository) GetTransactionByOrder(ctx context.Context, orderUUID uuid.UUID) (model.Transaction, error) {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionByOrder")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Transaction, error)); ok {
+		return rf(ctx, orderUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Transaction); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orderUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_GetTransactionByOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactionByOrder'
+type TransactionRepository_GetTransactionByOrder_Call struct {
+	*mock.Call
+}
+
+// GetTransactionByOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+func (_e *TransactionRepository_Expecter) GetTransactionByOrder(ctx interface{}, orderUUID interface{}) *TransactionRepository_GetTransactionByOrder_Call {
+	return &TransactionRepository_GetTransactionByOrder_Call{Call: _e.mock.On("GetTransactionByOrder", ctx, orderUUID)}
+}
+
+func (_c *TransactionRepository_GetTransactionByOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID)) *TransactionRepository_GetTransactionByOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_GetTransactionByOrder_Call) Return(_a0 model.Transaction, _a1 error) *TransactionRepository_GetTransactionByOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_GetTransactionByOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.Transaction, error)) *TransactionRepository_GetTransactionByOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTransactions provides a mock function with given fields: ctx, filter
+func (_m *TransactionRepository) ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 []model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter) ([]model.Transaction, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter) []model.Transaction); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TransactionsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_ListTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransactions'
+type TransactionRepository_ListTransactions_Call struct {
+	*mock.Call
+}
+
+// ListTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.TransactionsFilter
+func (_e *TransactionRepository_Expecter) ListTransactions(ctx interface{}, filter interface{}) *TransactionRepository_ListTransactions_Call {
+	return &TransactionRepository_ListTransactions_Call{Call: _e.mock.On("ListTransactions", ctx, filter)}
+}
+
+func (_c *TransactionRepository_ListTransactions_Call) Run(run func(ctx context.Context, filter model.TransactionsFilter)) *TransactionRepository_ListTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TransactionsFilter))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ListTransactions_Call) Return(_a0 []model.Transaction, _a1 error) *TransactionRepository_ListTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_ListTransactions_Call) RunAndReturn(run func(context.Context, model.TransactionsFilter) ([]model.Transaction, error)) *TransactionRepository_ListTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTransactionStatus provides a mock function with given fields: ctx, transactionUUID, status
+func (_m *TransactionRepository) UpdateTransactionStatus(ctx context.Context, transactionUUID uuid.UUID, status model.TransactionStatus) error {
+	ret := _m.Called(ctx, transactionUUID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransactionStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.TransactionStatus) error); ok {
+		r0 = rf(ctx, transactionUUID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionRepository_UpdateTransactionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransactionStatus'
+type TransactionRepository_UpdateTransactionStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateTransactionStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+//   - status model.TransactionStatus
+func (_e *TransactionRepository_Expecter) UpdateTransactionStatus(ctx interface{}, transactionUUID interface{}, status interface{}) *TransactionRepository_UpdateTransactionStatus_Call {
+	return &TransactionRepository_UpdateTransactionStatus_Call{Call: _e.mock.On("UpdateTransactionStatus", ctx, transactionUUID, status)}
+}
+
+func (_c *TransactionRepository_UpdateTransactionStatus_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID, status model.TransactionStatus)) *TransactionRepository_UpdateTransactionStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.TransactionStatus))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_UpdateTransactionStatus_Call) Return(_a0 error) *TransactionRepository_UpdateTransactionStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionRepository_UpdateTransactionStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.TransactionStatus) error) *TransactionRepository_UpdateTransactionStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactionRepository creates a new instance of TransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactionRepository {
+	mock := &TransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// VelocityRepository is an autogenerated mock type for the VelocityRepository type
+type VelocityRepository struct {
+	mock.Mock
+}
+
+type VelocityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VelocityRepository) EXPECT() *VelocityRepository_Expecter {
+	return &VelocityRepository_Expecter{mock: &_m.Mock}
+}
+
+// IncrementAttempts provides a mock function with given fields: ctx, userUUID, window
+func (_m *VelocityRepository) IncrementAttempts(ctx context.Context, userUUID uuid.UUID, window time.Duration) (int64, error) {
+	ret := _m.Called(ctx, userUUID, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementAttempts")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) (int64, error)); ok {
+		return rf(ctx, userUUID, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) int64); ok {
+		r0 = rf(ctx, userUUID, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration) error); ok {
+		r1 = rf(ctx, userUUID, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VelocityRepository_IncrementAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementAttempts'
+type VelocityRepository_IncrementAttempts_Call struct {
+	*mock.Call
+}
+
+// IncrementAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - window time.Duration
+func (_e *VelocityRepository_Expecter) IncrementAttempts(ctx interface{}, userUUID interface{}, window interface{}) *VelocityRepository_IncrementAttempts_Call {
+	return &VelocityRepository_IncrementAttempts_Call{Call: _e.mock.On("IncrementAttempts", ctx, userUUID, window)}
+}
+
+func (_c *VelocityRepository_IncrementAttempts_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, window time.Duration)) *VelocityRepository_IncrementAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *VelocityRepository_IncrementAttempts_Call) Return(_a0 int64, _a1 error) *VelocityRepository_IncrementAttempts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VelocityRepository_IncrementAttempts_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Duration) (int64, error)) *VelocityRepository_IncrementAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVelocityRepository creates a new instance of VelocityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVelocityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VelocityRepository {
+	mock := &VelocityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
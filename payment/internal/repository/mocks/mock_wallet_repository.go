@@ -0,0 +1,213 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WalletRepository is an autogenerated mock type for the WalletRepository type
+type WalletRepository struct {
+	mock.Mock
+}
+
+type WalletRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WalletRepository) EXPECT() *WalletRepository_Expecter {
+	return &WalletRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAccount provides a mock function with given fields: ctx, key
+func (_m *WalletRepository) GetAccount(ctx context.Context, key model.AccountKey) (model.Account, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccount")
+	}
+
+	var r0 model.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccountKey) (model.Account, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.AccountKey) model.Account); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(model.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.AccountKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WalletRepository_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
+type WalletRepository_GetAccount_Call struct {
+	*mock.Call
+}
+
+// GetAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key model.AccountKey
+func (_e *WalletRepository_Expecter) GetAccount(ctx interface{}, key interface{}) *WalletRepository_GetAccount_Call {
+	return &WalletRepository_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, key)}
+}
+
+func (_c *WalletRepository_GetAccount_Call) Run(run func(ctx context.Context, key model.AccountKey)) *WalletRepository_GetAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.AccountKey))
+	})
+	return _c
+}
+
+func (_c *WalletRepository_GetAccount_Call) Return(_a0 model.Account, _a1 error) *WalletRepository_GetAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WalletRepository_GetAccount_Call) RunAndReturn(run func(context.Context, model.AccountKey) (model.Account, error)) *WalletRepository_GetAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStatement provides a mock function with given fields: ctx, filter
+func (_m *WalletRepository) ListStatement(ctx context.Context, filter model.StatementFilter) ([]model.StatementLine, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatement")
+	}
+
+	var r0 []model.StatementLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StatementFilter) ([]model.StatementLine, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.StatementFilter) []model.StatementLine); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.StatementLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.StatementFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WalletRepository_ListStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatement'
+type WalletRepository_ListStatement_Call struct {
+	*mock.Call
+}
+
+// ListStatement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.StatementFilter
+func (_e *WalletRepository_Expecter) ListStatement(ctx interface{}, filter interface{}) *WalletRepository_ListStatement_Call {
+	return &WalletRepository_ListStatement_Call{Call: _e.mock.On("ListStatement", ctx, filter)}
+}
+
+func (_c *WalletRepository_ListStatement_Call) Run(run func(ctx context.Context, filter model.StatementFilter)) *WalletRepository_ListStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.StatementFilter))
+	})
+	return _c
+}
+
+func (_c *WalletRepository_ListStatement_Call) Return(_a0 []model.StatementLine, _a1 error) *WalletRepository_ListStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WalletRepository_ListStatement_Call) RunAndReturn(run func(context.Context, model.StatementFilter) ([]model.StatementLine, error)) *WalletRepository_ListStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transfer provides a mock function with given fields: ctx, transfer
+func (_m *WalletRepository) Transfer(ctx context.Context, transfer model.Transfer) (model.JournalEntry, error) {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transfer")
+	}
+
+	var r0 model.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Transfer) (model.JournalEntry, error)); ok {
+		return rf(ctx, transfer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Transfer) model.JournalEntry); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		r0 = ret.Get(0).(model.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Transfer) error); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WalletRepository_Transfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transfer'
+type WalletRepository_Transfer_Call struct {
+	*mock.Call
+}
+
+// Transfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer model.Transfer
+func (_e *WalletRepository_Expecter) Transfer(ctx interface{}, transfer interface{}) *WalletRepository_Transfer_Call {
+	return &WalletRepository_Transfer_Call{Call: _e.mock.On("Transfer", ctx, transfer)}
+}
+
+func (_c *WalletRepository_Transfer_Call) Run(run func(ctx context.Context, transfer model.Transfer)) *WalletRepository_Transfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Transfer))
+	})
+	return _c
+}
+
+func (_c *WalletRepository_Transfer_Call) Return(_a0 model.JournalEntry, _a1 error) *WalletRepository_Transfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WalletRepository_Transfer_Call) RunAndReturn(run func(context.Context, model.Transfer) (model.JournalEntry, error)) *WalletRepository_Transfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWalletRepository creates a new instance of WalletRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWalletRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WalletRepository {
+	mock := &WalletRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
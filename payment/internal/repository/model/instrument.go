@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentInstrument struct {
+	InstrumentUUID     uuid.UUID `db:"instrument_uuid"`
+	UserUUID           uuid.UUID `db:"user_uuid"`
+	PaymentMethod      string    `db:"payment_method"`
+	CardTokenEncrypted string    `db:"card_token_encrypted"`
+	CardLast4          string    `db:"card_last4"`
+	SBPPhoneEncrypted  string    `db:"sbp_phone_encrypted"`
+	IsDefault          bool      `db:"is_default"`
+	CreatedAt          time.Time `db:"created_at"`
+}
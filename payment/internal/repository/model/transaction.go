@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Transaction struct {
+	TransactionUUID uuid.UUID `db:"transaction_uuid"`
+	OrderUUID       uuid.UUID `db:"order_uuid"`
+	UserUUID        uuid.UUID `db:"user_uuid"`
+	Amount          float64   `db:"amount"`
+	Currency        string    `db:"currency"`
+	PaymentMethod   string    `db:"payment_method"`
+	Status          string    `db:"status"`
+	FraudDecision   string    `db:"fraud_decision"`
+	FraudReason     string    `db:"fraud_reason"`
+	// InstrumentUUID — NULL для оплаты без сохранённого инструмента
+	InstrumentUUID *uuid.UUID `db:"instrument_uuid"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Account struct {
+	AccountUUID uuid.UUID `db:"account_uuid"`
+	Kind        string    `db:"kind"`
+	OwnerUUID   uuid.UUID `db:"owner_uuid"`
+	Currency    string    `db:"currency"`
+	Balance     float64   `db:"balance"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type JournalEntry struct {
+	EntryUUID uuid.UUID `db:"entry_uuid"`
+	Kind      string    `db:"kind"`
+	Reference string    `db:"reference"`
+	Amount    float64   `db:"amount"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type StatementLine struct {
+	EntryUUID    uuid.UUID `db:"entry_uuid"`
+	Kind         string    `db:"kind"`
+	Reference    string    `db:"reference"`
+	Amount       float64   `db:"amount"`
+	BalanceAfter float64   `db:"balance_after"`
+	CreatedAt    time.Time `db:"created_at"`
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+type TransactionRepository interface {
+	// CreateTransaction возвращает ErrTransactionAlreadyExists, если транзакция для заказа уже есть
+	CreateTransaction(ctx context.Context, transaction model.Transaction) error
+	GetTransaction(ctx context.Context, uuid uuid.UUID) (model.Transaction, error)
+	// GetTransactionByOrder возвращает действующую (не FAILED) транзакцию заказа
+	GetTransactionByOrder(ctx context.Context, orderUUID uuid.UUID) (model.Transaction, error)
+	// UpdateTransactionStatus переводит транзакцию из PENDING в итоговый статус.
+	// Возвращает ErrTransactionNotPending, если транзакция уже не в PENDING
+	UpdateTransactionStatus(ctx context.Context, transactionUUID uuid.UUID, status model.TransactionStatus) error
+	// ListTransactions возвращает транзакции по фильтру, новые первыми
+	ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error)
+}
+
+type WalletRepository interface {
+	// Transfer атомарно проводит операцию между двумя счетами, открывая их при необходимости.
+	// Возвращает ErrInsufficientFunds, если кошелёк пользователя ушёл бы в минус.
+	// Повтор с теми же Kind и Reference не проводит операцию второй раз и возвращает
+	// уже сохранённую; если сумма отличается — ErrEntryConflict
+	Transfer(ctx context.Context, transfer model.Transfer) (model.JournalEntry, error)
+	// GetAccount возвращает ErrAccountNotFound, если по счёту ещё не было операций
+	GetAccount(ctx context.Context, key model.AccountKey) (model.Account, error)
+	// ListStatement возвращает движения по кошельку пользователя, новые первыми
+	ListStatement(ctx context.Context, filter model.StatementFilter) ([]model.StatementLine, error)
+}
+
+// InstrumentRepository хранит платёжные инструменты; токен карты и телефон СБП шифруются при записи
+type InstrumentRepository interface {
+	// CreateInstrument сохраняет инструмент. Инструмент по умолчанию снимает этот признак с прежнего
+	CreateInstrument(ctx context.Context, instrument model.PaymentInstrument) error
+	// GetInstrument возвращает ErrInstrumentNotFound, если у пользователя нет такого инструмента
+	GetInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) (model.PaymentInstrument, error)
+	// GetDefaultInstrument возвращает ErrInstrumentNotFound, если у пользователя нет инструмента по умолчанию
+	GetDefaultInstrument(ctx context.Context, userUUID uuid.UUID) (model.PaymentInstrument, error)
+	// ListInstruments возвращает инструменты пользователя: по умолчанию первым, остальные новые первыми
+	ListInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error)
+	// DeleteInstrument возвращает ErrInstrumentNotFound, если у пользователя нет такого инструмента
+	DeleteInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error
+}
+
+type VelocityRepository interface {
+	// IncrementAttempts учитывает попытку оплаты пользователя и возвращает число попыток
+	// в текущем окне. Окно начинается с первой попытки и длится window
+	IncrementAttempts(ctx context.Context, userUUID uuid.UUID, window time.Duration) (int64, error)
+}
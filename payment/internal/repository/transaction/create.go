@@ -0,0 +1,46 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/converter"
+)
+
+func (r *repository) CreateTransaction(ctx context.Context, transaction model.Transaction) error {
+	query := `
+		INSERT INTO transactions (transaction_uuid, order_uuid, user_uuid, amount, currency,
+		                          payment_method, status, fraud_decision, fraud_reason, instrument_uuid,
+		                          created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	repoTransaction := repoConverter.ToRepoTransaction(transaction)
+
+	_, err := r.pool.Exec(ctx, query,
+		repoTransaction.TransactionUUID,
+		repoTransaction.OrderUUID,
+		repoTransaction.UserUUID,
+		repoTransaction.Amount,
+		repoTransaction.Currency,
+		repoTransaction.PaymentMethod,
+		repoTransaction.Status,
+		repoTransaction.FraudDecision,
+		repoTransaction.FraudReason,
+		repoTransaction.InstrumentUUID,
+		repoTransaction.CreatedAt,
+		repoTransaction.UpdatedAt,
+	)
+	if err != nil {
+		// Нарушение уникальности order_uuid — заказ уже оплачен
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return model.ErrTransactionAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package transaction
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestCreateAndGetTransaction() {
+	transaction := newTransaction(uuid.New(), uuid.New(), time.Now())
+	transaction.Amount = 1234.56
+	transaction.FraudDecision = model.FraudDecisionReview
+	transaction.FraudReason = "new account 1h0m0s old"
+	transaction.InstrumentUuid = uuid.New()
+
+	err := s.repository.CreateTransaction(s.ctx, transaction)
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetTransaction(s.ctx, transaction.Uuid)
+	s.Require().NoError(err)
+	s.Equal(transaction.Uuid, result.Uuid)
+	s.Equal(transaction.OrderUuid, result.OrderUuid)
+	s.Equal(transaction.UserUuid, result.UserUuid)
+	s.Equal(transaction.Amount, result.Amount)
+	s.Equal(transaction.Currency, result.Currency)
+	s.Equal(model.PaymentMethodCard, result.PaymentMethod)
+	s.Equal(model.TransactionStatusSucceeded, result.Status)
+	s.Equal(model.FraudDecisionReview, result.FraudDecision)
+	s.Equal(transaction.FraudReason, result.FraudReason)
+	s.Equal(transaction.InstrumentUuid, result.InstrumentUuid)
+	s.WithinDuration(transaction.CreatedAt, result.CreatedAt, time.Millisecond)
+}
+
+func (s *RepositorySuite) TestGetTransactionNotFound() {
+	result, err := s.repository.GetTransaction(s.ctx, uuid.New())
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+	s.Equal(model.Transaction{}, result)
+}
+
+func newTransaction(orderUUID, userUUID uuid.UUID, createdAt time.Time) model.Transaction {
+	return model.Transaction{
+		Uuid:          uuid.New(),
+		OrderUuid:     orderUUID,
+		UserUuid:      userUUID,
+		Amount:        100,
+		Currency:      "RUB",
+		PaymentMethod: model.PaymentMethodCard,
+		Status:        model.TransactionStatusSucceeded,
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+	}
+}
@@ -0,0 +1,48 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+func (r *repository) GetTransaction(ctx context.Context, id uuid.UUID) (model.Transaction, error) {
+	return r.getTransaction(ctx, "transaction_uuid = $1", id)
+}
+
+func (r *repository) GetTransactionByOrder(ctx context.Context, orderUUID uuid.UUID) (model.Transaction, error) {
+	// Неуспешных попыток у заказа может быть несколько, действующая транзакция — одна
+	return r.getTransaction(ctx, "order_uuid = $1 AND status <> 'FAILED'", orderUUID)
+}
+
+// getTransaction ищет одну транзакцию по условию с единственным параметром
+func (r *repository) getTransaction(ctx context.Context, condition string, id uuid.UUID) (model.Transaction, error) {
+	query := `
+		SELECT transaction_uuid, order_uuid, user_uuid, amount, currency,
+		       payment_method, status, fraud_decision, fraud_reason, instrument_uuid,
+		       created_at, updated_at
+		FROM transactions
+		WHERE ` + condition
+
+	rows, err := r.pool.Query(ctx, query, id)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+	defer rows.Close()
+
+	repoTransaction, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[repoModel.Transaction])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Transaction{}, model.ErrTransactionNotFound
+		}
+		return model.Transaction{}, err
+	}
+
+	return repoConverter.ToModelTransaction(repoTransaction), nil
+}
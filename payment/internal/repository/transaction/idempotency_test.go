@@ -0,0 +1,55 @@
+package transaction
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestCreateTransactionDuplicateOrder() {
+	orderUUID := uuid.New()
+	first := newTransaction(orderUUID, uuid.New(), time.Now())
+	s.Require().NoError(s.repository.CreateTransaction(s.ctx, first))
+
+	second := newTransaction(orderUUID, uuid.New(), time.Now())
+	err := s.repository.CreateTransaction(s.ctx, second)
+	s.Require().ErrorIs(err, model.ErrTransactionAlreadyExists)
+
+	result, err := s.repository.GetTransactionByOrder(s.ctx, orderUUID)
+	s.Require().NoError(err)
+	s.Equal(first.Uuid, result.Uuid)
+}
+
+func (s *RepositorySuite) TestGetTransactionByOrderNotFound() {
+	_, err := s.repository.GetTransactionByOrder(s.ctx, uuid.New())
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+}
+
+func (s *RepositorySuite) TestCreateTransactionConcurrentDuplicates() {
+	const callers = 10
+	orderUUID := uuid.New()
+	errs := make([]error, callers)
+
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.repository.CreateTransaction(s.ctx, newTransaction(orderUUID, uuid.New(), time.Now()))
+		}()
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		s.Require().ErrorIs(err, model.ErrTransactionAlreadyExists)
+	}
+	s.Equal(1, created)
+}
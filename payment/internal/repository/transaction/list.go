@@ -0,0 +1,59 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+func (r *repository) ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	if filter.OrderUuid != uuid.Nil {
+		args = append(args, filter.OrderUuid)
+		conditions = append(conditions, fmt.Sprintf("order_uuid = $%d", len(args)))
+	}
+	if filter.UserUuid != uuid.Nil {
+		args = append(args, filter.UserUuid)
+		conditions = append(conditions, fmt.Sprintf("user_uuid = $%d", len(args)))
+	}
+
+	query := `
+		SELECT transaction_uuid, order_uuid, user_uuid, amount, currency,
+		       payment_method, status, fraud_decision, fraud_reason, instrument_uuid,
+		       created_at, updated_at
+		FROM transactions`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, transaction_uuid LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoTransactions, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.Transaction])
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]model.Transaction, 0, len(repoTransactions))
+	for _, repoTransaction := range repoTransactions {
+		transactions = append(transactions, repoConverter.ToModelTransaction(repoTransaction))
+	}
+
+	return transactions, nil
+}
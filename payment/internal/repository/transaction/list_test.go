@@ -0,0 +1,50 @@
+package transaction
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestListTransactionsFilters() {
+	userUUID := uuid.New()
+	orderUUID := uuid.New()
+	now := time.Now()
+
+	older := newTransaction(orderUUID, userUUID, now.Add(-time.Hour))
+	newer := newTransaction(uuid.New(), userUUID, now)
+	other := newTransaction(uuid.New(), uuid.New(), now)
+	for _, transaction := range []model.Transaction{older, newer, other} {
+		s.Require().NoError(s.repository.CreateTransaction(s.ctx, transaction))
+	}
+
+	byUser, err := s.repository.ListTransactions(s.ctx, model.TransactionsFilter{UserUuid: userUUID, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(byUser, 2)
+	s.Equal(newer.Uuid, byUser[0].Uuid)
+	s.Equal(older.Uuid, byUser[1].Uuid)
+
+	byOrder, err := s.repository.ListTransactions(s.ctx, model.TransactionsFilter{OrderUuid: orderUUID, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(byOrder, 1)
+	s.Equal(older.Uuid, byOrder[0].Uuid)
+
+	all, err := s.repository.ListTransactions(s.ctx, model.TransactionsFilter{Limit: 10})
+	s.Require().NoError(err)
+	s.Len(all, 3)
+}
+
+func (s *RepositorySuite) TestListTransactionsPagination() {
+	userUUID := uuid.New()
+	now := time.Now()
+	for i := range 3 {
+		transaction := newTransaction(uuid.New(), userUUID, now.Add(-time.Duration(i)*time.Minute))
+		s.Require().NoError(s.repository.CreateTransaction(s.ctx, transaction))
+	}
+
+	page, err := s.repository.ListTransactions(s.ctx, model.TransactionsFilter{UserUuid: userUUID, Limit: 2, Offset: 2})
+	s.Require().NoError(err)
+	s.Len(page, 1)
+}
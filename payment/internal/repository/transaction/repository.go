@@ -0,0 +1,20 @@
+package transaction
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+)
+
+var _ def.TransactionRepository = (*repository)(nil)
+
+// Пул, а не одно соединение: gRPC-запросы на оплату обрабатываются параллельно
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+	}
+}
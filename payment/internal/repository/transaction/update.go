@@ -0,0 +1,45 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (r *repository) UpdateTransactionStatus(ctx context.Context, transactionUUID uuid.UUID, status model.TransactionStatus) error {
+	// Условие по статусу не даёт двум подтверждениям одновременно завершить транзакцию
+	query := `
+		UPDATE transactions
+		SET status = $2, updated_at = $3
+		WHERE transaction_uuid = $1 AND status = $4
+		RETURNING transaction_uuid`
+
+	var updated uuid.UUID
+	err := r.pool.QueryRow(ctx, query,
+		transactionUUID,
+		string(status),
+		time.Now(),
+		string(model.TransactionStatusPending),
+	).Scan(&updated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r.notPendingError(ctx, transactionUUID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// notPendingError различает отсутствующую транзакцию и уже завершённую
+func (r *repository) notPendingError(ctx context.Context, transactionUUID uuid.UUID) error {
+	if _, err := r.GetTransaction(ctx, transactionUUID); err != nil {
+		return err
+	}
+	return model.ErrTransactionNotPending
+}
@@ -0,0 +1,47 @@
+package transaction
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestUpdateTransactionStatus() {
+	transaction := newTransaction(uuid.New(), uuid.New(), time.Now())
+	transaction.Status = model.TransactionStatusPending
+	s.Require().NoError(s.repository.CreateTransaction(s.ctx, transaction))
+
+	err := s.repository.UpdateTransactionStatus(s.ctx, transaction.Uuid, model.TransactionStatusSucceeded)
+	s.Require().NoError(err)
+
+	result, err := s.repository.GetTransaction(s.ctx, transaction.Uuid)
+	s.Require().NoError(err)
+	s.Equal(model.TransactionStatusSucceeded, result.Status)
+	s.True(result.UpdatedAt.After(transaction.UpdatedAt))
+
+	// Завершённую транзакцию повторно не меняем
+	err = s.repository.UpdateTransactionStatus(s.ctx, transaction.Uuid, model.TransactionStatusFailed)
+	s.Require().ErrorIs(err, model.ErrTransactionNotPending)
+}
+
+func (s *RepositorySuite) TestUpdateTransactionStatusNotFound() {
+	err := s.repository.UpdateTransactionStatus(s.ctx, uuid.New(), model.TransactionStatusSucceeded)
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+}
+
+func (s *RepositorySuite) TestCreateTransactionAfterFailedAttempt() {
+	orderUUID := uuid.New()
+	failed := newTransaction(orderUUID, uuid.New(), time.Now())
+	failed.Status = model.TransactionStatusPending
+	s.Require().NoError(s.repository.CreateTransaction(s.ctx, failed))
+	s.Require().NoError(s.repository.UpdateTransactionStatus(s.ctx, failed.Uuid, model.TransactionStatusFailed))
+
+	retry := newTransaction(orderUUID, failed.UserUuid, time.Now())
+	s.Require().NoError(s.repository.CreateTransaction(s.ctx, retry))
+
+	result, err := s.repository.GetTransactionByOrder(s.ctx, orderUUID)
+	s.Require().NoError(err)
+	s.Equal(retry.Uuid, result.Uuid)
+}
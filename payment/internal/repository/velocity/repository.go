@@ -0,0 +1,30 @@
+package velocity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/cache"
+)
+
+const attemptsKey = "payment:fraud:velocity:%s"
+
+var _ def.VelocityRepository = (*repository)(nil)
+
+type repository struct {
+	cache cache.RedisClient
+}
+
+func NewRepository(cache cache.RedisClient) *repository {
+	return &repository{
+		cache: cache,
+	}
+}
+
+func (r *repository) IncrementAttempts(ctx context.Context, userUUID uuid.UUID, window time.Duration) (int64, error) {
+	return r.cache.IncrWithTTL(ctx, fmt.Sprintf(attemptsKey, userUUID), window)
+}
@@ -0,0 +1,35 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+func (r *repository) GetAccount(ctx context.Context, key model.AccountKey) (model.Account, error) {
+	query := `
+		SELECT account_uuid, kind, owner_uuid, currency, balance, created_at, updated_at
+		FROM wallet_accounts
+		WHERE kind = $1 AND owner_uuid = $2 AND currency = $3`
+
+	rows, err := r.pool.Query(ctx, query, string(key.Kind), key.OwnerUuid, key.Currency)
+	if err != nil {
+		return model.Account{}, err
+	}
+	defer rows.Close()
+
+	repoAccount, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[repoModel.Account])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Account{}, model.ErrAccountNotFound
+		}
+		return model.Account{}, err
+	}
+
+	return repoConverter.ToModelAccount(repoAccount), nil
+}
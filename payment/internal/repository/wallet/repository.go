@@ -0,0 +1,19 @@
+package wallet
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+)
+
+var _ def.WalletRepository = (*repository)(nil)
+
+type repository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRepository(pool *pgxpool.Pool) *repository {
+	return &repository{
+		pool: pool,
+	}
+}
@@ -0,0 +1,46 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+func (r *repository) ListStatement(ctx context.Context, filter model.StatementFilter) ([]model.StatementLine, error) {
+	query := `
+		SELECT p.entry_uuid, e.kind, e.reference, p.amount, p.balance_after, p.created_at
+		FROM journal_postings p
+		JOIN journal_entries e ON e.entry_uuid = p.entry_uuid
+		JOIN wallet_accounts a ON a.account_uuid = p.account_uuid
+		WHERE a.kind = $1 AND a.owner_uuid = $2 AND a.currency = $3
+		ORDER BY p.posting_id DESC
+		LIMIT $4 OFFSET $5`
+
+	rows, err := r.pool.Query(ctx, query,
+		string(model.AccountKindUser),
+		filter.UserUuid,
+		filter.Currency,
+		filter.Limit,
+		filter.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoLines, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.StatementLine])
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]model.StatementLine, 0, len(repoLines))
+	for _, repoLine := range repoLines {
+		lines = append(lines, repoConverter.ToModelStatementLine(repoLine))
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *RepositorySuite) TestListStatement() {
+	userUUID := uuid.New()
+	topUp := s.topUp(userUUID, 1000)
+	payment := s.payment(userUUID, 300)
+	_, err := s.repository.Transfer(s.ctx, payment)
+	s.Require().NoError(err)
+	// Операции другого пользователя в выписку не попадают
+	s.topUp(uuid.New(), 50)
+
+	lines, err := s.repository.ListStatement(s.ctx, model.StatementFilter{
+		UserUuid: userUUID,
+		Currency: "RUB",
+		Limit:    10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(lines, 2)
+
+	s.Equal(model.EntryKindPayment, lines[0].Kind)
+	s.Equal(payment.Reference, lines[0].Reference)
+	s.Equal(-300.0, lines[0].Amount)
+	s.Equal(700.0, lines[0].BalanceAfter)
+
+	s.Equal(topUp.Uuid, lines[1].EntryUuid)
+	s.Equal(model.EntryKindTopUp, lines[1].Kind)
+	s.Equal(1000.0, lines[1].Amount)
+	s.Equal(1000.0, lines[1].BalanceAfter)
+}
+
+func (s *RepositorySuite) TestListStatementPagination() {
+	userUUID := uuid.New()
+	for range 3 {
+		s.topUp(userUUID, 10)
+	}
+
+	lines, err := s.repository.ListStatement(s.ctx, model.StatementFilter{
+		UserUuid: userUUID,
+		Currency: "RUB",
+		Limit:    2,
+		Offset:   2,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(lines, 1)
+	s.Equal(10.0, lines[0].BalanceAfter)
+}
@@ -0,0 +1,163 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	repoConverter "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/converter"
+	repoModel "github.com/nkolesnikov999/micro2-OK/payment/internal/repository/model"
+)
+
+// checkViolation — код ошибки Postgres при нарушении CHECK: кошелёк ушёл бы в минус
+const checkViolation = "23514"
+
+func (r *repository) Transfer(ctx context.Context, transfer model.Transfer) (model.JournalEntry, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.JournalEntry{}, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	now := time.Now()
+	entry := model.JournalEntry{
+		Uuid:      uuid.New(),
+		Kind:      transfer.Kind,
+		Reference: transfer.Reference,
+		Amount:    transfer.Amount,
+		CreatedAt: now,
+	}
+
+	// Ключ (kind, reference) не даёт провести операцию дважды: параллельный повтор
+	// дождётся фиксации первой вставки и ничего не вставит
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO journal_entries (entry_uuid, kind, reference, amount, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (kind, reference) DO NOTHING`,
+		entry.Uuid, string(entry.Kind), entry.Reference, entry.Amount, entry.CreatedAt,
+	)
+	if err != nil {
+		return model.JournalEntry{}, err
+	}
+	if tag.RowsAffected() == 0 {
+		return r.existingEntry(ctx, transfer)
+	}
+
+	accounts, err := lockAccounts(ctx, tx, now, transfer.From, transfer.To)
+	if err != nil {
+		return model.JournalEntry{}, err
+	}
+
+	if err := post(ctx, tx, entry, accounts[transfer.From], -transfer.Amount); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == checkViolation {
+			return model.JournalEntry{}, model.ErrInsufficientFunds
+		}
+		return model.JournalEntry{}, err
+	}
+	if err := post(ctx, tx, entry, accounts[transfer.To], transfer.Amount); err != nil {
+		return model.JournalEntry{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return model.JournalEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// existingEntry возвращает ранее проведённую операцию с тем же ключом
+func (r *repository) existingEntry(ctx context.Context, transfer model.Transfer) (model.JournalEntry, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT entry_uuid, kind, reference, amount, created_at
+		FROM journal_entries
+		WHERE kind = $1 AND reference = $2`,
+		string(transfer.Kind), transfer.Reference,
+	)
+	if err != nil {
+		return model.JournalEntry{}, err
+	}
+	defer rows.Close()
+
+	repoEntry, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[repoModel.JournalEntry])
+	if err != nil {
+		return model.JournalEntry{}, err
+	}
+
+	entry := repoConverter.ToModelJournalEntry(repoEntry)
+	if model.ToCents(entry.Amount) != model.ToCents(transfer.Amount) {
+		return model.JournalEntry{}, model.ErrEntryConflict
+	}
+
+	return entry, nil
+}
+
+// lockAccounts открывает недостающие счета и блокирует оба в порядке account_uuid,
+// чтобы встречные операции не взаимоблокировались
+func lockAccounts(ctx context.Context, tx pgx.Tx, now time.Time, keys ...model.AccountKey) (map[model.AccountKey]uuid.UUID, error) {
+	for _, key := range keys {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO wallet_accounts (account_uuid, kind, owner_uuid, currency, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $5)
+			ON CONFLICT (kind, owner_uuid, currency) DO NOTHING`,
+			uuid.New(), string(key.Kind), key.OwnerUuid, key.Currency, now,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT account_uuid, kind, owner_uuid, currency, balance, created_at, updated_at
+		FROM wallet_accounts
+		WHERE (kind, owner_uuid, currency) IN (($1, $2, $3), ($4, $5, $6))
+		ORDER BY account_uuid
+		FOR UPDATE`,
+		string(keys[0].Kind), keys[0].OwnerUuid, keys[0].Currency,
+		string(keys[1].Kind), keys[1].OwnerUuid, keys[1].Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repoAccounts, err := pgx.CollectRows(rows, pgx.RowToStructByName[repoModel.Account])
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[model.AccountKey]uuid.UUID, len(repoAccounts))
+	for _, repoAccount := range repoAccounts {
+		account := repoConverter.ToModelAccount(repoAccount)
+		accounts[account.Key] = account.Uuid
+	}
+
+	return accounts, nil
+}
+
+// post меняет остаток счёта на amount и записывает проводку с остатком после неё
+func post(ctx context.Context, tx pgx.Tx, entry model.JournalEntry, accountUUID uuid.UUID, amount float64) error {
+	var balanceAfter float64
+	err := tx.QueryRow(ctx, `
+		UPDATE wallet_accounts
+		SET balance = balance + $2, updated_at = $3
+		WHERE account_uuid = $1
+		RETURNING balance`,
+		accountUUID, amount, entry.CreatedAt,
+	).Scan(&balanceAfter)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO journal_postings (entry_uuid, account_uuid, amount, balance_after, created_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		entry.Uuid, accountUUID, amount, balanceAfter, entry.CreatedAt,
+	)
+	return err
+}
@@ -0,0 +1,142 @@
+package wallet
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *RepositorySuite) topUp(userUUID uuid.UUID, amount float64) model.JournalEntry {
+	entry, err := s.repository.Transfer(s.ctx, model.Transfer{
+		Kind:      model.EntryKindTopUp,
+		Reference: uuid.NewString(),
+		From:      model.SystemAccount(model.AccountKindFunding, "RUB"),
+		To:        model.UserAccount(userUUID, "RUB"),
+		Amount:    amount,
+	})
+	s.Require().NoError(err)
+	return entry
+}
+
+func (s *RepositorySuite) payment(userUUID uuid.UUID, amount float64) model.Transfer {
+	return model.Transfer{
+		Kind:      model.EntryKindPayment,
+		Reference: uuid.NewString(),
+		From:      model.UserAccount(userUUID, "RUB"),
+		To:        model.SystemAccount(model.AccountKindRevenue, "RUB"),
+		Amount:    amount,
+	}
+}
+
+func (s *RepositorySuite) balance(key model.AccountKey) float64 {
+	account, err := s.repository.GetAccount(s.ctx, key)
+	s.Require().NoError(err)
+	return account.Balance
+}
+
+func (s *RepositorySuite) TestTransferTopUpAndPayment() {
+	userUUID := uuid.New()
+	s.topUp(userUUID, 1000)
+
+	_, err := s.repository.Transfer(s.ctx, s.payment(userUUID, 250.5))
+	s.Require().NoError(err)
+
+	s.Equal(749.5, s.balance(model.UserAccount(userUUID, "RUB")))
+	s.Equal(250.5, s.balance(model.SystemAccount(model.AccountKindRevenue, "RUB")))
+	// Двойная запись: сумма остатков всех счетов равна нулю
+	s.Equal(-1000.0, s.balance(model.SystemAccount(model.AccountKindFunding, "RUB")))
+}
+
+func (s *RepositorySuite) TestTransferInsufficientFunds() {
+	userUUID := uuid.New()
+	s.topUp(userUUID, 100)
+	transfer := s.payment(userUUID, 100.01)
+
+	_, err := s.repository.Transfer(s.ctx, transfer)
+	s.Require().ErrorIs(err, model.ErrInsufficientFunds)
+
+	s.Equal(100.0, s.balance(model.UserAccount(userUUID, "RUB")))
+
+	// Откат не оставляет операцию: после пополнения тот же платёж проходит
+	s.topUp(userUUID, 1)
+	_, err = s.repository.Transfer(s.ctx, transfer)
+	s.Require().NoError(err)
+}
+
+func (s *RepositorySuite) TestTransferEmptyWallet() {
+	_, err := s.repository.Transfer(s.ctx, s.payment(uuid.New(), 1))
+	s.Require().ErrorIs(err, model.ErrInsufficientFunds)
+}
+
+func (s *RepositorySuite) TestTransferIdempotent() {
+	userUUID := uuid.New()
+	s.topUp(userUUID, 500)
+	transfer := s.payment(userUUID, 200)
+
+	first, err := s.repository.Transfer(s.ctx, transfer)
+	s.Require().NoError(err)
+	second, err := s.repository.Transfer(s.ctx, transfer)
+	s.Require().NoError(err)
+
+	s.Equal(first.Uuid, second.Uuid)
+	s.Equal(300.0, s.balance(model.UserAccount(userUUID, "RUB")))
+
+	transfer.Amount = 100
+	_, err = s.repository.Transfer(s.ctx, transfer)
+	s.Require().ErrorIs(err, model.ErrEntryConflict)
+}
+
+func (s *RepositorySuite) TestTransferIdempotentRoundedAmount() {
+	userUUID := uuid.New()
+	// БД хранит 10.00; повтор с той же исходной суммой — не конфликт
+	transfer := model.Transfer{
+		Kind:      model.EntryKindTopUp,
+		Reference: uuid.NewString(),
+		From:      model.SystemAccount(model.AccountKindFunding, "RUB"),
+		To:        model.UserAccount(userUUID, "RUB"),
+		Amount:    10.004,
+	}
+
+	first, err := s.repository.Transfer(s.ctx, transfer)
+	s.Require().NoError(err)
+	second, err := s.repository.Transfer(s.ctx, transfer)
+	s.Require().NoError(err)
+
+	s.Equal(first.Uuid, second.Uuid)
+	s.Equal(10.0, s.balance(model.UserAccount(userUUID, "RUB")))
+}
+
+func (s *RepositorySuite) TestTransferConcurrentDebits() {
+	userUUID := uuid.New()
+	s.topUp(userUUID, 100)
+
+	const attempts = 5
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.repository.Transfer(s.ctx, s.payment(userUUID, 40))
+			if err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.Equal(2, succeeded)
+	s.Equal(20.0, s.balance(model.UserAccount(userUUID, "RUB")))
+}
+
+func (s *RepositorySuite) TestGetAccountNotFound() {
+	_, err := s.repository.GetAccount(s.ctx, model.UserAccount(uuid.New(), "RUB"))
+	s.Require().ErrorIs(err, model.ErrAccountNotFound)
+}
@@ -0,0 +1,131 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
+)
+
+func (s *service) Screen(ctx context.Context, check model.FraudCheck) model.FraudVerdict {
+	ctx, span := tracing.StartSpan(ctx, "payment.fraud_screen",
+		trace.WithAttributes(
+			attribute.String("payment.method", string(check.PaymentMethod)),
+			attribute.String("user.uuid", check.UserUuid.String()),
+		),
+	)
+	defer span.End()
+
+	var verdict model.FraudVerdict
+	s.checkVelocity(ctx, check, &verdict)
+	s.checkAmount(check, &verdict)
+	s.checkAccountAge(ctx, check, &verdict)
+	s.checkMethodRisk(check, &verdict)
+
+	if verdict.Decision == model.FraudDecisionUnspecified {
+		verdict.Decision = model.FraudDecisionAllow
+	}
+
+	span.SetAttributes(attribute.String("fraud.decision", string(verdict.Decision)))
+	metrics.FraudDecisionsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("decision", string(verdict.Decision)),
+		attribute.String("method", string(check.PaymentMethod)),
+	))
+
+	if verdict.Decision != model.FraudDecisionAllow {
+		logger.Info(ctx,
+			"payment flagged by fraud screening",
+			zap.String("userUUID", check.UserUuid.String()),
+			zap.String("paymentMethod", string(check.PaymentMethod)),
+			zap.String("decision", string(verdict.Decision)),
+			zap.String("reason", verdict.Reason()),
+		)
+	}
+
+	return verdict
+}
+
+// checkVelocity ограничивает число попыток оплаты пользователя в окне.
+// Попытка учитывается до остальных правил, поэтому отклонённые оплаты тоже идут в счёт
+func (s *service) checkVelocity(ctx context.Context, check model.FraudCheck, verdict *model.FraudVerdict) {
+	if s.rules.VelocityWindow <= 0 || (s.rules.VelocityReview <= 0 && s.rules.VelocityDeny <= 0) {
+		return
+	}
+
+	attempts, err := s.velocityRepository.IncrementAttempts(ctx, check.UserUuid, s.rules.VelocityWindow)
+	if err != nil {
+		// Без Redis скорость неизвестна: оплату не блокируем, но отправляем на проверку
+		logger.Error(ctx,
+			"failed to count payment attempts",
+			zap.String("userUUID", check.UserUuid.String()),
+			zap.Error(err),
+		)
+		verdict.Escalate(model.FraudDecisionReview, "velocity unknown")
+		return
+	}
+
+	switch {
+	case s.rules.VelocityDeny > 0 && attempts >= s.rules.VelocityDeny:
+		verdict.Escalate(model.FraudDecisionDeny,
+			fmt.Sprintf("velocity %d attempts in %s", attempts, s.rules.VelocityWindow))
+	case s.rules.VelocityReview > 0 && attempts >= s.rules.VelocityReview:
+		verdict.Escalate(model.FraudDecisionReview,
+			fmt.Sprintf("velocity %d attempts in %s", attempts, s.rules.VelocityWindow))
+	}
+}
+
+func (s *service) checkAmount(check model.FraudCheck, verdict *model.FraudVerdict) {
+	switch {
+	case s.rules.AmountDeny > 0 && check.Amount >= s.rules.AmountDeny:
+		verdict.Escalate(model.FraudDecisionDeny,
+			fmt.Sprintf("amount %.2f %s over %.2f", check.Amount, check.Currency, s.rules.AmountDeny))
+	case s.rules.AmountReview > 0 && check.Amount >= s.rules.AmountReview:
+		verdict.Escalate(model.FraudDecisionReview,
+			fmt.Sprintf("amount %.2f %s over %.2f", check.Amount, check.Currency, s.rules.AmountReview))
+	}
+}
+
+// checkAccountAge отправляет на проверку оплаты с только что созданных аккаунтов
+func (s *service) checkAccountAge(ctx context.Context, check model.FraudCheck, verdict *model.FraudVerdict) {
+	if s.rules.NewAccountAge <= 0 {
+		return
+	}
+
+	user, err := s.iamClient.GetUser(ctx, check.UserUuid)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get user for fraud screening",
+			zap.String("userUUID", check.UserUuid.String()),
+			zap.Error(err),
+		)
+		verdict.Escalate(model.FraudDecisionReview, "account age unknown")
+		return
+	}
+
+	age := time.Since(user.CreatedAt)
+	if age < s.rules.NewAccountAge {
+		verdict.Escalate(model.FraudDecisionReview,
+			fmt.Sprintf("new account %s old", age.Truncate(time.Second)))
+	}
+}
+
+// checkMethodRisk ужесточает REVIEW до DENY для рискованных способов оплаты:
+// по ним списание трудно отменить, поэтому сомнительную оплату не проводим
+func (s *service) checkMethodRisk(check model.FraudCheck, verdict *model.FraudVerdict) {
+	if verdict.Decision != model.FraudDecisionReview || !slices.Contains(s.rules.HighRiskMethods, check.PaymentMethod) {
+		return
+	}
+
+	verdict.Escalate(model.FraudDecisionDeny,
+		fmt.Sprintf("high-risk method %s", check.PaymentMethod))
+}
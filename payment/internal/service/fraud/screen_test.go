@@ -0,0 +1,125 @@
+package fraud
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *ServiceSuite) check(method model.PaymentMethod, amount float64) model.FraudCheck {
+	return model.FraudCheck{
+		UserUuid:      uuid.New(),
+		PaymentMethod: method,
+		Amount:        amount,
+		Currency:      "RUB",
+	}
+}
+
+func (s *ServiceSuite) expectAttempts(attempts int64) {
+	s.velocityRepository.On("IncrementAttempts", mock.Anything, mock.Anything, s.rules.VelocityWindow).
+		Return(attempts, nil)
+}
+
+func (s *ServiceSuite) expectAccountAge(age time.Duration) {
+	s.iamClient.On("GetUser", mock.Anything, mock.Anything).
+		Return(model.User{CreatedAt: time.Now().Add(-age)}, nil)
+}
+
+func (s *ServiceSuite) TestScreenAllow() {
+	s.expectAttempts(1)
+	s.expectAccountAge(30 * 24 * time.Hour)
+
+	verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodCard, 1500))
+	s.Require().Equal(model.FraudDecisionAllow, verdict.Decision)
+	s.Require().Empty(verdict.Reason())
+}
+
+func (s *ServiceSuite) TestScreenVelocity() {
+	tests := []struct {
+		attempts int64
+		decision model.FraudDecision
+	}{
+		{attempts: 2, decision: model.FraudDecisionAllow},
+		{attempts: 3, decision: model.FraudDecisionReview},
+		{attempts: 5, decision: model.FraudDecisionDeny},
+	}
+
+	for _, tt := range tests {
+		s.SetupTest()
+		s.expectAttempts(tt.attempts)
+		s.expectAccountAge(30 * 24 * time.Hour)
+
+		verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodCard, 1500))
+		s.Require().Equal(tt.decision, verdict.Decision, "attempts %d", tt.attempts)
+	}
+}
+
+func (s *ServiceSuite) TestScreenAmount() {
+	tests := []struct {
+		amount   float64
+		decision model.FraudDecision
+	}{
+		{amount: 99_999, decision: model.FraudDecisionAllow},
+		{amount: 100_000, decision: model.FraudDecisionReview},
+		{amount: 500_000, decision: model.FraudDecisionDeny},
+	}
+
+	for _, tt := range tests {
+		s.SetupTest()
+		s.expectAttempts(1)
+		s.expectAccountAge(30 * 24 * time.Hour)
+
+		verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodSBP, tt.amount))
+		s.Require().Equal(tt.decision, verdict.Decision, "amount %.2f", tt.amount)
+	}
+}
+
+func (s *ServiceSuite) TestScreenNewAccount() {
+	s.expectAttempts(1)
+	s.expectAccountAge(time.Hour)
+
+	verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodCard, 1500))
+	s.Require().Equal(model.FraudDecisionReview, verdict.Decision)
+	s.Require().Contains(verdict.Reason(), "new account")
+}
+
+func (s *ServiceSuite) TestScreenHighRiskMethodEscalatesReview() {
+	s.expectAttempts(3)
+	s.expectAccountAge(30 * 24 * time.Hour)
+
+	verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodCreditCard, 1500))
+	s.Require().Equal(model.FraudDecisionDeny, verdict.Decision)
+	s.Require().Contains(verdict.Reason(), "velocity 3 attempts")
+	s.Require().Contains(verdict.Reason(), "high-risk method CREDIT_CARD")
+}
+
+func (s *ServiceSuite) TestScreenHighRiskMethodAlone() {
+	s.expectAttempts(1)
+	s.expectAccountAge(30 * 24 * time.Hour)
+
+	// Без других сигналов рискованный способ оплаты сам по себе не блокируется
+	verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodCreditCard, 1500))
+	s.Require().Equal(model.FraudDecisionAllow, verdict.Decision)
+}
+
+func (s *ServiceSuite) TestScreenSourcesUnavailable() {
+	s.velocityRepository.On("IncrementAttempts", mock.Anything, mock.Anything, mock.Anything).
+		Return(int64(0), gofakeit.Error())
+	s.iamClient.On("GetUser", mock.Anything, mock.Anything).
+		Return(model.User{}, gofakeit.Error())
+
+	verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodCard, 1500))
+	s.Require().Equal(model.FraudDecisionReview, verdict.Decision)
+	s.Require().Equal("velocity unknown; account age unknown", verdict.Reason())
+}
+
+func (s *ServiceSuite) TestScreenRulesDisabled() {
+	s.service = NewService(s.velocityRepository, s.iamClient, model.FraudRules{})
+
+	verdict := s.service.Screen(s.ctx, s.check(model.PaymentMethodCreditCard, 1_000_000))
+	s.Require().Equal(model.FraudDecisionAllow, verdict.Decision)
+}
@@ -0,0 +1,29 @@
+package fraud
+
+import (
+	grpcClient "github.com/nkolesnikov999/micro2-OK/payment/internal/client/grpc"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/service"
+)
+
+var _ def.FraudService = (*service)(nil)
+
+type service struct {
+	velocityRepository repository.VelocityRepository
+	iamClient          grpcClient.IAMClient
+
+	rules model.FraudRules
+}
+
+func NewService(
+	velocityRepository repository.VelocityRepository,
+	iamClient grpcClient.IAMClient,
+	rules model.FraudRules,
+) *service {
+	return &service{
+		velocityRepository: velocityRepository,
+		iamClient:          iamClient,
+		rules:              rules,
+	}
+}
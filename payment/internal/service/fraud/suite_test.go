@@ -0,0 +1,55 @@
+package fraud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	clientMocks "github.com/nkolesnikov999/micro2-OK/payment/internal/client/grpc/mocks"
+	paymentMetrics "github.com/nkolesnikov999/micro2-OK/payment/internal/metrics"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	velocityRepository *mocks.VelocityRepository
+	iamClient          *clientMocks.IAMClient
+
+	rules   model.FraudRules
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+	_ = paymentMetrics.InitMetrics("payment-service-test")
+
+	s.ctx = context.Background()
+
+	s.velocityRepository = mocks.NewVelocityRepository(s.T())
+	s.iamClient = clientMocks.NewIAMClient(s.T())
+
+	s.rules = model.FraudRules{
+		VelocityWindow:  time.Minute,
+		VelocityReview:  3,
+		VelocityDeny:    5,
+		AmountReview:    100_000,
+		AmountDeny:      500_000,
+		NewAccountAge:   24 * time.Hour,
+		HighRiskMethods: []model.PaymentMethod{model.PaymentMethodCreditCard},
+	}
+	s.service = NewService(s.velocityRepository, s.iamClient, s.rules)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
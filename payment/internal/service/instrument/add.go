@@ -0,0 +1,82 @@
+package instrument
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+var (
+	cardLast4Pattern = regexp.MustCompile(`^[0-9]{4}$`)
+	// sbpPhonePattern — номер в формате E.164
+	sbpPhonePattern = regexp.MustCompile(`^\+[1-9][0-9]{10,14}$`)
+)
+
+func (s *service) AddInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error) {
+	instrument.PaymentMethod = model.PaymentMethod(strings.ToUpper(strings.TrimSpace(string(instrument.PaymentMethod))))
+	instrument.CardToken = strings.TrimSpace(instrument.CardToken)
+	instrument.CardLast4 = strings.TrimSpace(instrument.CardLast4)
+	instrument.SBPPhone = strings.TrimSpace(instrument.SBPPhone)
+
+	if err := validate(instrument); err != nil {
+		return model.PaymentInstrument{}, err
+	}
+
+	instrument.Uuid = uuid.New()
+	instrument.CreatedAt = time.Now()
+
+	if err := s.instrumentRepository.CreateInstrument(ctx, instrument); err != nil {
+		logger.Error(ctx,
+			"failed to save payment instrument",
+			zap.String("userUUID", instrument.UserUuid.String()),
+			zap.Error(err),
+		)
+		return model.PaymentInstrument{}, err
+	}
+
+	return instrument, nil
+}
+
+// validate проверяет, что у инструмента есть реквизиты его способа оплаты и нет лишних
+func validate(instrument model.PaymentInstrument) error {
+	if instrument.UserUuid == uuid.Nil {
+		return fmt.Errorf("%w: user_uuid is required", model.ErrInvalidInstrument)
+	}
+
+	switch instrument.PaymentMethod {
+	case model.PaymentMethodCard, model.PaymentMethodCreditCard:
+		if instrument.CardToken == "" {
+			return fmt.Errorf("%w: card_token is required", model.ErrInvalidInstrument)
+		}
+		if !cardLast4Pattern.MatchString(instrument.CardLast4) {
+			return fmt.Errorf("%w: card_last4 must be 4 digits", model.ErrInvalidInstrument)
+		}
+		if instrument.SBPPhone != "" {
+			return fmt.Errorf("%w: sbp_phone is not allowed for %s", model.ErrInvalidInstrument, instrument.PaymentMethod)
+		}
+	case model.PaymentMethodSBP:
+		if !sbpPhonePattern.MatchString(instrument.SBPPhone) {
+			return fmt.Errorf("%w: sbp_phone must be in +79991234567 format", model.ErrInvalidInstrument)
+		}
+		if instrument.CardToken != "" || instrument.CardLast4 != "" {
+			return fmt.Errorf("%w: card fields are not allowed for SBP", model.ErrInvalidInstrument)
+		}
+	case model.PaymentMethodInvestorMoney:
+		// Кошелёк определяется пользователем: инструмент лишь запоминает способ оплаты
+		if instrument.CardToken != "" || instrument.CardLast4 != "" || instrument.SBPPhone != "" {
+			return fmt.Errorf("%w: no details are allowed for INVESTOR_MONEY", model.ErrInvalidInstrument)
+		}
+	default:
+		return model.ErrInvalidPaymentMethod
+	}
+
+	return nil
+}
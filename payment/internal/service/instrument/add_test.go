@@ -0,0 +1,82 @@
+package instrument
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestAddCardInstrument() {
+	userUUID := uuid.New()
+	s.instrumentRepository.On("CreateInstrument", s.ctx, mock.MatchedBy(func(i model.PaymentInstrument) bool {
+		return i.Uuid != uuid.Nil &&
+			i.UserUuid == userUUID &&
+			i.PaymentMethod == model.PaymentMethodCard &&
+			i.CardToken == "tok_visa" &&
+			!i.CreatedAt.IsZero()
+	})).Return(nil)
+
+	instrument, err := s.service.AddInstrument(s.ctx, model.PaymentInstrument{
+		UserUuid:      userUUID,
+		PaymentMethod: "card",
+		CardToken:     " tok_visa ",
+		CardLast4:     "4242",
+	})
+	s.Require().NoError(err)
+	s.Require().NotEqual(uuid.Nil, instrument.Uuid)
+	s.Require().Equal(model.PaymentMethodCard, instrument.PaymentMethod)
+}
+
+func (s *ServiceSuite) TestAddSBPInstrument() {
+	s.instrumentRepository.On("CreateInstrument", s.ctx, mock.Anything).Return(nil)
+
+	instrument, err := s.service.AddInstrument(s.ctx, model.PaymentInstrument{
+		UserUuid:      uuid.New(),
+		PaymentMethod: model.PaymentMethodSBP,
+		SBPPhone:      "+79991234567",
+		IsDefault:     true,
+	})
+	s.Require().NoError(err)
+	s.Require().True(instrument.IsDefault)
+}
+
+func (s *ServiceSuite) TestAddInstrumentInvalid() {
+	userUUID := uuid.New()
+	cases := map[string]model.PaymentInstrument{
+		"no user":          {PaymentMethod: model.PaymentMethodInvestorMoney},
+		"card no token":    {UserUuid: userUUID, PaymentMethod: model.PaymentMethodCard, CardLast4: "4242"},
+		"card bad last4":   {UserUuid: userUUID, PaymentMethod: model.PaymentMethodCreditCard, CardToken: "tok", CardLast4: "42a2"},
+		"card with phone":  {UserUuid: userUUID, PaymentMethod: model.PaymentMethodCard, CardToken: "tok", CardLast4: "4242", SBPPhone: "+79991234567"},
+		"sbp bad phone":    {UserUuid: userUUID, PaymentMethod: model.PaymentMethodSBP, SBPPhone: "89991234567"},
+		"sbp with card":    {UserUuid: userUUID, PaymentMethod: model.PaymentMethodSBP, SBPPhone: "+79991234567", CardToken: "tok"},
+		"wallet with card": {UserUuid: userUUID, PaymentMethod: model.PaymentMethodInvestorMoney, CardLast4: "4242"},
+	}
+
+	for name, instrument := range cases {
+		_, err := s.service.AddInstrument(s.ctx, instrument)
+		s.Require().ErrorIs(err, model.ErrInvalidInstrument, name)
+	}
+	s.instrumentRepository.AssertNotCalled(s.T(), "CreateInstrument", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestAddInstrumentUnknownMethod() {
+	_, err := s.service.AddInstrument(s.ctx, model.PaymentInstrument{
+		UserUuid:      uuid.New(),
+		PaymentMethod: "CASH",
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidPaymentMethod)
+}
+
+func (s *ServiceSuite) TestAddInstrumentRepositoryError() {
+	repoErr := errors.New("db down")
+	s.instrumentRepository.On("CreateInstrument", s.ctx, mock.Anything).Return(repoErr)
+
+	_, err := s.service.AddInstrument(s.ctx, model.PaymentInstrument{
+		UserUuid:      uuid.New(),
+		PaymentMethod: model.PaymentMethodInvestorMoney,
+	})
+	s.Require().ErrorIs(err, repoErr)
+}
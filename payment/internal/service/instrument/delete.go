@@ -0,0 +1,11 @@
+package instrument
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func (s *service) DeleteInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error {
+	return s.instrumentRepository.DeleteInstrument(ctx, userUUID, instrumentUUID)
+}
@@ -0,0 +1,13 @@
+package instrument
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *service) ListInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	return s.instrumentRepository.ListInstruments(ctx, userUUID)
+}
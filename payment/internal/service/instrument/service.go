@@ -0,0 +1,18 @@
+package instrument
+
+import (
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/service"
+)
+
+var _ def.InstrumentService = (*service)(nil)
+
+type service struct {
+	instrumentRepository repository.InstrumentRepository
+}
+
+func NewService(instrumentRepository repository.InstrumentRepository) *service {
+	return &service{
+		instrumentRepository: instrumentRepository,
+	}
+}
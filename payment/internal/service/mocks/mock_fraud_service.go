@@ -0,0 +1,87 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FraudService is an autogenerated mock type for the FraudService type
+type FraudService struct {
+	mock.Mock
+}
+
+type FraudService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FraudService) EXPECT() *FraudService_Expecter {
+	return &FraudService_Expecter{mock: &_m.Mock}
+}
+
+// Screen provides a mock function with given fields: ctx, check
+func (_m *FraudService) Screen(ctx context.Context, check model.FraudCheck) model.FraudVerdict {
+	ret := _m.Called(ctx, check)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Screen")
+	}
+
+	var r0 model.FraudVerdict
+	if rf, ok := ret.Get(0).(func(context.Context, model.FraudCheck) model.FraudVerdict); ok {
+		r0 = rf(ctx, check)
+	} else {
+		r0 = ret.Get(0).(model.FraudVerdict)
+	}
+
+	return r0
+}
+
+// FraudService_Screen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Screen'
+type FraudService_Screen_Call struct {
+	*mock.Call
+}
+
+// Screen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - check model.FraudCheck
+func (_e *FraudService_Expecter) Screen(ctx interface{}, check interface{}) *FraudService_Screen_Call {
+	return &FraudService_Screen_Call{Call: _e.mock.On("Screen", ctx, check)}
+}
+
+func (_c *FraudService_Screen_Call) Run(run func(ctx context.Context, check model.FraudCheck)) *FraudService_Screen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.FraudCheck))
+	})
+	return _c
+}
+
+func (_c *FraudService_Screen_Call) Return(_a0 model.FraudVerdict) *FraudService_Screen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FraudService_Screen_Call) RunAndReturn(run func(context.Context, model.FraudCheck) model.FraudVerdict) *FraudService_Screen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFraudService creates a new instance of FraudService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFraudService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FraudService {
+	mock := &FraudService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
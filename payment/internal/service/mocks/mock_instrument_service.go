@@ -0,0 +1,206 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// InstrumentService is an autogenerated mock type for the InstrumentService type
+type InstrumentService struct {
+	mock.Mock
+}
+
+type InstrumentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstrumentService) EXPECT() *InstrumentService_Expecter {
+	return &InstrumentService_Expecter{mock: &_m.Mock}
+}
+
+// AddInstrument provides a mock function with given fields: ctx, instrument
+func (_m *InstrumentService) AddInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddInstrument")
+	}
+
+	var r0 model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentInstrument) (model.PaymentInstrument, error)); ok {
+		return rf(ctx, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentInstrument) model.PaymentInstrument); ok {
+		r0 = rf(ctx, instrument)
+	} else {
+		r0 = ret.Get(0).(model.PaymentInstrument)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.PaymentInstrument) error); ok {
+		r1 = rf(ctx, instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentService_AddInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddInstrument'
+type InstrumentService_AddInstrument_Call struct {
+	*mock.Call
+}
+
+// AddInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - instrument model.PaymentInstrument
+func (_e *InstrumentService_Expecter) AddInstrument(ctx interface{}, instrument interface{}) *InstrumentService_AddInstrument_Call {
+	return &InstrumentService_AddInstrument_Call{Call: _e.mock.On("AddInstrument", ctx, instrument)}
+}
+
+func (_c *InstrumentService_AddInstrument_Call) Run(run func(ctx context.Context, instrument model.PaymentInstrument)) *InstrumentService_AddInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PaymentInstrument))
+	})
+	return _c
+}
+
+func (_c *InstrumentService_AddInstrument_Call) Return(_a0 model.PaymentInstrument, _a1 error) *InstrumentService_AddInstrument_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentService_AddInstrument_Call) RunAndReturn(run func(context.Context, model.PaymentInstrument) (model.PaymentInstrument, error)) *InstrumentService_AddInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInstrument provides a mock function with given fields: ctx, userUUID, instrumentUUID
+func (_m *InstrumentService) DeleteInstrument(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, userUUID, instrumentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInstrument")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userUUID, instrumentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstrumentService_DeleteInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInstrument'
+type InstrumentService_DeleteInstrument_Call struct {
+	*mock.Call
+}
+
+// DeleteInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - instrumentUUID uuid.UUID
+func (_e *InstrumentService_Expecter) DeleteInstrument(ctx interface{}, userUUID interface{}, instrumentUUID interface{}) *InstrumentService_DeleteInstrument_Call {
+	return &InstrumentService_DeleteInstrument_Call{Call: _e.mock.On("DeleteInstrument", ctx, userUUID, instrumentUUID)}
+}
+
+func (_c *InstrumentService_DeleteInstrument_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, instrumentUUID uuid.UUID)) *InstrumentService_DeleteInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InstrumentService_DeleteInstrument_Call) Return(_a0 error) *InstrumentService_DeleteInstrument_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentService_DeleteInstrument_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *InstrumentService_DeleteInstrument_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInstruments provides a mock function with given fields: ctx, userUUID
+func (_m *InstrumentService) ListInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInstruments")
+	}
+
+	var r0 []model.PaymentInstrument
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []model.PaymentInstrument); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PaymentInstrument)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentService_ListInstruments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInstruments'
+type InstrumentService_ListInstruments_Call struct {
+	*mock.Call
+}
+
+// ListInstruments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+func (_e *InstrumentService_Expecter) ListInstruments(ctx interface{}, userUUID interface{}) *InstrumentService_ListInstruments_Call {
+	return &InstrumentService_ListInstruments_Call{Call: _e.mock.On("ListInstruments", ctx, userUUID)}
+}
+
+func (_c *InstrumentService_ListInstruments_Call) Run(run func(ctx context.Context, userUUID uuid.UUID)) *InstrumentService_ListInstruments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *InstrumentService_ListInstruments_Call) Return(_a0 []model.PaymentInstrument, _a1 error) *InstrumentService_ListInstruments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentService_ListInstruments_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]model.PaymentInstrument, error)) *InstrumentService_ListInstruments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstrumentService creates a new instance of InstrumentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstrumentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstrumentService {
+	mock := &InstrumentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,134 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentProducerService is an autogenerated mock type for the PaymentProducerService type
+type PaymentProducerService struct {
+	mock.Mock
+}
+
+type PaymentProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentProducerService) EXPECT() *PaymentProducerService_Expecter {
+	return &PaymentProducerService_Expecter{mock: &_m.Mock}
+}
+
+// ProducePaymentCompleted provides a mock function with given fields: ctx, event
+func (_m *PaymentProducerService) ProducePaymentCompleted(ctx context.Context, event model.PaymentCompletedEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProducePaymentCompleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentCompletedEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentProducerService_ProducePaymentCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProducePaymentCompleted'
+type PaymentProducerService_ProducePaymentCompleted_Call struct {
+	*mock.Call
+}
+
+// ProducePaymentCompleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.PaymentCompletedEvent
+func (_e *PaymentProducerService_Expecter) ProducePaymentCompleted(ctx interface{}, event interface{}) *PaymentProducerService_ProducePaymentCompleted_Call {
+	return &PaymentProducerService_ProducePaymentCompleted_Call{Call: _e.mock.On("ProducePaymentCompleted", ctx, event)}
+}
+
+func (_c *PaymentProducerService_ProducePaymentCompleted_Call) Run(run func(ctx context.Context, event model.PaymentCompletedEvent)) *PaymentProducerService_ProducePaymentCompleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PaymentCompletedEvent))
+	})
+	return _c
+}
+
+func (_c *PaymentProducerService_ProducePaymentCompleted_Call) Return(_a0 error) *PaymentProducerService_ProducePaymentCompleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentProducerService_ProducePaymentCompleted_Call) RunAndReturn(run func(context.Context, model.PaymentCompletedEvent) error) *PaymentProducerService_ProducePaymentCompleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProducePaymentFailed provides a mock function with given fields: ctx, event
+func (_m *PaymentProducerService) ProducePaymentFailed(ctx context.Context, event model.PaymentFailedEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProducePaymentFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PaymentFailedEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentProducerService_ProducePaymentFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProducePaymentFailed'
+type PaymentProducerService_ProducePaymentFailed_Call struct {
+	*mock.Call
+}
+
+// ProducePaymentFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event model.PaymentFailedEvent
+func (_e *PaymentProducerService_Expecter) ProducePaymentFailed(ctx interface{}, event interface{}) *PaymentProducerService_ProducePaymentFailed_Call {
+	return &PaymentProducerService_ProducePaymentFailed_Call{Call: _e.mock.On("ProducePaymentFailed", ctx, event)}
+}
+
+func (_c *PaymentProducerService_ProducePaymentFailed_Call) Run(run func(ctx context.Context, event model.PaymentFailedEvent)) *PaymentProducerService_ProducePaymentFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.PaymentFailedEvent))
+	})
+	return _c
+}
+
+func (_c *PaymentProducerService_ProducePaymentFailed_Call) Return(_a0 error) *PaymentProducerService_ProducePaymentFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentProducerService_ProducePaymentFailed_Call) RunAndReturn(run func(context.Context, model.PaymentFailedEvent) error) *PaymentProducerService_ProducePaymentFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentProducerService creates a new instance of PaymentProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentProducerService {
+	mock := &PaymentProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,7 +8,10 @@ package mocks
 import (
 	context "context"
 
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // PaymentService is an autogenerated mock type for the PaymentService type
@@ -24,9 +27,173 @@ func (_m *PaymentService) EXPECT() *PaymentService_Expecter {
 	return &PaymentService_Expecter{mock: &_m.Mock}
 }
 
-// PayOrder provides a mock function with given fields: ctx, paymentMethod
-func (_m *PaymentService) PayOrder(ctx context.Context, paymentMethod string) (string, error) {
-	ret := _m.Called(ctx, paymentMethod)
+// ConfirmPayment provides a mock function with given fields: ctx, transactionUUID, result
+func (_m *PaymentService) ConfirmPayment(ctx context.Context, transactionUUID uuid.UUID, result model.ChargeResult) error {
+	ret := _m.Called(ctx, transactionUUID, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmPayment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.ChargeResult) error); ok {
+		r0 = rf(ctx, transactionUUID, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentService_ConfirmPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmPayment'
+type PaymentService_ConfirmPayment_Call struct {
+	*mock.Call
+}
+
+// ConfirmPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+//   - result model.ChargeResult
+func (_e *PaymentService_Expecter) ConfirmPayment(ctx interface{}, transactionUUID interface{}, result interface{}) *PaymentService_ConfirmPayment_Call {
+	return &PaymentService_ConfirmPayment_Call{Call: _e.mock.On("ConfirmPayment", ctx, transactionUUID, result)}
+}
+
+func (_c *PaymentService_ConfirmPayment_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID, result model.ChargeResult)) *PaymentService_ConfirmPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.ChargeResult))
+	})
+	return _c
+}
+
+func (_c *PaymentService_ConfirmPayment_Call) Return(_a0 error) *PaymentService_ConfirmPayment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentService_ConfirmPayment_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.ChargeResult) error) *PaymentService_ConfirmPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransaction provides a mock function with given fields: ctx, transactionUUID
+func (_m *PaymentService) GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(model.Transaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type PaymentService_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID uuid.UUID
+func (_e *PaymentService_Expecter) GetTransaction(ctx interface{}, transactionUUID interface{}) *PaymentService_GetTransaction_Call {
+	return &PaymentService_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, transactionUUID)}
+}
+
+func (_c *PaymentService_GetTransaction_Call) Run(run func(ctx context.Context, transactionUUID uuid.UUID)) *PaymentService_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PaymentService_GetTransaction_Call) Return(_a0 model.Transaction, _a1 error) *PaymentService_GetTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_GetTransaction_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.Transaction, error)) *PaymentService_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTransactions provides a mock function with given fields: ctx, filter
+func (_m *PaymentService) ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTransactions")
+	}
+
+	var r0 []model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter) ([]model.Transaction, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TransactionsFilter) []model.Transaction); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TransactionsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_ListTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTransactions'
+type PaymentService_ListTransactions_Call struct {
+	*mock.Call
+}
+
+// ListTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.TransactionsFilter
+func (_e *PaymentService_Expecter) ListTransactions(ctx interface{}, filter interface{}) *PaymentService_ListTransactions_Call {
+	return &PaymentService_ListTransactions_Call{Call: _e.mock.On("ListTransactions", ctx, filter)}
+}
+
+func (_c *PaymentService_ListTransactions_Call) Run(run func(ctx context.Context, filter model.TransactionsFilter)) *PaymentService_ListTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TransactionsFilter))
+	})
+	return _c
+}
+
+func (_c *PaymentService_ListTransactions_Call) Return(_a0 []model.Transaction, _a1 error) *PaymentService_ListTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_ListTransactions_Call) RunAndReturn(run func(context.Context, model.TransactionsFilter) ([]model.Transaction, error)) *PaymentService_ListTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayOrder provides a mock function with given fields: ctx, orderUUID, userUUID, paymentMethod, amount, currency
+func (_m *PaymentService) PayOrder(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod string, amount float64, currency string) (string, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, paymentMethod, amount, currency)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayOrder")
@@ -34,17 +201,17 @@ func (_m *PaymentService) PayOrder(ctx context.Context, paymentMethod string) (s
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, float64, string) (string, error)); ok {
+		return rf(ctx, orderUUID, userUUID, paymentMethod, amount, currency)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, paymentMethod)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, float64, string) string); ok {
+		r0 = rf(ctx, orderUUID, userUUID, paymentMethod, amount, currency)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, paymentMethod)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string, float64, string) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, paymentMethod, amount, currency)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,14 +226,18 @@ type PaymentService_PayOrder_Call struct {
 
 // PayOrder is a helper method to define mock.On call
 //   - ctx context.Context
+//   - orderUUID uuid.UUID
+//   - userUUID uuid.UUID
 //   - paymentMethod string
-func (_e *PaymentService_Expecter) PayOrder(ctx interface{}, paymentMethod interface{}) *PaymentService_PayOrder_Call {
-	return &PaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, paymentMethod)}
+//   - amount float64
+//   - currency string
+func (_e *PaymentService_Expecter) PayOrder(ctx interface{}, orderUUID interface{}, userUUID interface{}, paymentMethod interface{}, amount interface{}, currency interface{}) *PaymentService_PayOrder_Call {
+	return &PaymentService_PayOrder_Call{Call: _e.mock.On("PayOrder", ctx, orderUUID, userUUID, paymentMethod, amount, currency)}
 }
 
-func (_c *PaymentService_PayOrder_Call) Run(run func(ctx context.Context, paymentMethod string)) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, paymentMethod string, amount float64, currency string)) *PaymentService_PayOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(float64), args[5].(string))
 	})
 	return _c
 }
@@ -76,7 +247,69 @@ func (_c *PaymentService_PayOrder_Call) Return(transactionUUID string, err error
 	return _c
 }
 
-func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, string) (string, error)) *PaymentService_PayOrder_Call {
+func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, float64, string) (string, error)) *PaymentService_PayOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PayOrderWithInstrument provides a mock function with given fields: ctx, orderUUID, userUUID, instrumentUUID, paymentMethod, amount, currency
+func (_m *PaymentService) PayOrderWithInstrument(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, instrumentUUID uuid.UUID, paymentMethod string, amount float64, currency string) (string, error) {
+	ret := _m.Called(ctx, orderUUID, userUUID, instrumentUUID, paymentMethod, amount, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayOrderWithInstrument")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, float64, string) (string, error)); ok {
+		return rf(ctx, orderUUID, userUUID, instrumentUUID, paymentMethod, amount, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, float64, string) string); ok {
+		r0 = rf(ctx, orderUUID, userUUID, instrumentUUID, paymentMethod, amount, currency)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, float64, string) error); ok {
+		r1 = rf(ctx, orderUUID, userUUID, instrumentUUID, paymentMethod, amount, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_PayOrderWithInstrument_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PayOrderWithInstrument'
+type PaymentService_PayOrderWithInstrument_Call struct {
+	*mock.Call
+}
+
+// PayOrderWithInstrument is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID uuid.UUID
+//   - userUUID uuid.UUID
+//   - instrumentUUID uuid.UUID
+//   - paymentMethod string
+//   - amount float64
+//   - currency string
+func (_e *PaymentService_Expecter) PayOrderWithInstrument(ctx interface{}, orderUUID interface{}, userUUID interface{}, instrumentUUID interface{}, paymentMethod interface{}, amount interface{}, currency interface{}) *PaymentService_PayOrderWithInstrument_Call {
+	return &PaymentService_PayOrderWithInstrument_Call{Call: _e.mock.On("PayOrderWithInstrument", ctx, orderUUID, userUUID, instrumentUUID, paymentMethod, amount, currency)}
+}
+
+func (_c *PaymentService_PayOrderWithInstrument_Call) Run(run func(ctx context.Context, orderUUID uuid.UUID, userUUID uuid.UUID, instrumentUUID uuid.UUID, paymentMethod string, amount float64, currency string)) *PaymentService_PayOrderWithInstrument_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string), args[5].(float64), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentService_PayOrderWithInstrument_Call) Return(transactionUUID string, err error) *PaymentService_PayOrderWithInstrument_Call {
+	_c.Call.Return(transactionUUID, err)
+	return _c
+}
+
+func (_c *PaymentService_PayOrderWithInstrument_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, float64, string) (string, error)) *PaymentService_PayOrderWithInstrument_Call {
 	_c.Call.Return(run)
 	return _c
 }
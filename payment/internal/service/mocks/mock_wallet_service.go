@@ -0,0 +1,221 @@
+// Code generated for micro2-OK service
+// © nk 2025.
+
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// WalletService is an autogenerated mock type for the WalletService type
+type WalletService struct {
+	mock.Mock
+}
+
+type WalletService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WalletService) EXPECT() *WalletService_Expecter {
+	return &WalletService_Expecter{mock: &_m.Mock}
+}
+
+// GetBalance provides a mock function with given fields: ctx, userUUID, currency
+func (_m *WalletService) GetBalance(ctx context.Context, userUUID uuid.UUID, currency string) (model.Account, error) {
+	ret := _m.Called(ctx, userUUID, currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalance")
+	}
+
+	var r0 model.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (model.Account, error)); ok {
+		return rf(ctx, userUUID, currency)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) model.Account); ok {
+		r0 = rf(ctx, userUUID, currency)
+	} else {
+		r0 = ret.Get(0).(model.Account)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userUUID, currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WalletService_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type WalletService_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID uuid.UUID
+//   - currency string
+func (_e *WalletService_Expecter) GetBalance(ctx interface{}, userUUID interface{}, currency interface{}) *WalletService_GetBalance_Call {
+	return &WalletService_GetBalance_Call{Call: _e.mock.On("GetBalance", ctx, userUUID, currency)}
+}
+
+func (_c *WalletService_GetBalance_Call) Run(run func(ctx context.Context, userUUID uuid.UUID, currency string)) *WalletService_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *WalletService_GetBalance_Call) Return(_a0 model.Account, _a1 error) *WalletService_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WalletService_GetBalance_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (model.Account, error)) *WalletService_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatement provides a mock function with given fields: ctx, filter
+func (_m *WalletService) GetStatement(ctx context.Context, filter model.StatementFilter) (model.Statement, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatement")
+	}
+
+	var r0 model.Statement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.StatementFilter) (model.Statement, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.StatementFilter) model.Statement); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(model.Statement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.StatementFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WalletService_GetStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatement'
+type WalletService_GetStatement_Call struct {
+	*mock.Call
+}
+
+// GetStatement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.StatementFilter
+func (_e *WalletService_Expecter) GetStatement(ctx interface{}, filter interface{}) *WalletService_GetStatement_Call {
+	return &WalletService_GetStatement_Call{Call: _e.mock.On("GetStatement", ctx, filter)}
+}
+
+func (_c *WalletService_GetStatement_Call) Run(run func(ctx context.Context, filter model.StatementFilter)) *WalletService_GetStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.StatementFilter))
+	})
+	return _c
+}
+
+func (_c *WalletService_GetStatement_Call) Return(_a0 model.Statement, _a1 error) *WalletService_GetStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WalletService_GetStatement_Call) RunAndReturn(run func(context.Context, model.StatementFilter) (model.Statement, error)) *WalletService_GetStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TopUp provides a mock function with given fields: ctx, topUp
+func (_m *WalletService) TopUp(ctx context.Context, topUp model.TopUp) (model.JournalEntry, model.Account, error) {
+	ret := _m.Called(ctx, topUp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopUp")
+	}
+
+	var r0 model.JournalEntry
+	var r1 model.Account
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TopUp) (model.JournalEntry, model.Account, error)); ok {
+		return rf(ctx, topUp)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TopUp) model.JournalEntry); ok {
+		r0 = rf(ctx, topUp)
+	} else {
+		r0 = ret.Get(0).(model.JournalEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TopUp) model.Account); ok {
+		r1 = rf(ctx, topUp)
+	} else {
+		r1 = ret.Get(1).(model.Account)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.TopUp) error); ok {
+		r2 = rf(ctx, topUp)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// WalletService_TopUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopUp'
+type WalletService_TopUp_Call struct {
+	*mock.Call
+}
+
+// TopUp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topUp model.TopUp
+func (_e *WalletService_Expecter) TopUp(ctx interface{}, topUp interface{}) *WalletService_TopUp_Call {
+	return &WalletService_TopUp_Call{Call: _e.mock.On("TopUp", ctx, topUp)}
+}
+
+func (_c *WalletService_TopUp_Call) Run(run func(ctx context.Context, topUp model.TopUp)) *WalletService_TopUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TopUp))
+	})
+	return _c
+}
+
+func (_c *WalletService_TopUp_Call) Return(_a0 model.JournalEntry, _a1 model.Account, _a2 error) *WalletService_TopUp_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *WalletService_TopUp_Call) RunAndReturn(run func(context.Context, model.TopUp) (model.JournalEntry, model.Account, error)) *WalletService_TopUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWalletService creates a new instance of WalletService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWalletService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WalletService {
+	mock := &WalletService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
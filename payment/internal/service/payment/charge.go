@@ -0,0 +1,64 @@
+package payment
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
+)
+
+// charge списывает сумму транзакции у провайдера способа оплаты.
+// Реквизиты сохранённого инструмента передаются провайдеру вместе с суммой.
+// Ошибка возвращается только при сбое провайдера; отказ и ожидание — в статусе результата
+func (s *service) charge(ctx context.Context, transaction model.Transaction, instrument model.PaymentInstrument) (model.ChargeResult, error) {
+	ctx, span := tracing.StartSpan(ctx, "payment.provider_charge",
+		trace.WithAttributes(
+			attribute.String("payment.method", string(transaction.PaymentMethod)),
+			attribute.String("transaction.uuid", transaction.Uuid.String()),
+		),
+	)
+	defer span.End()
+
+	result, err := s.provider.Charge(ctx, model.Charge{
+		TransactionUuid: transaction.Uuid,
+		OrderUuid:       transaction.OrderUuid,
+		UserUuid:        transaction.UserUuid,
+		PaymentMethod:   transaction.PaymentMethod,
+		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+		CardToken:       instrument.CardToken,
+		SBPPhone:        instrument.SBPPhone,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "provider charge failed")
+		logger.Error(ctx,
+			"provider charge failed",
+			zap.String("orderUUID", transaction.OrderUuid.String()),
+			zap.String("paymentMethod", string(transaction.PaymentMethod)),
+			zap.Error(err),
+		)
+		return model.ChargeResult{}, err
+	}
+
+	span.SetAttributes(
+		attribute.String("provider.reference", result.ProviderReference),
+		attribute.String("charge.status", string(result.Status)),
+	)
+
+	if result.Status == model.ChargeStatusDeclined {
+		logger.Info(ctx,
+			"payment declined by provider",
+			zap.String("orderUUID", transaction.OrderUuid.String()),
+			zap.String("reason", result.DeclineReason),
+		)
+	}
+
+	return result, nil
+}
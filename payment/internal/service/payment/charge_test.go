@@ -0,0 +1,185 @@
+package payment
+
+import (
+	"fmt"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	providerMocks "github.com/nkolesnikov999/micro2-OK/payment/internal/provider/mocks"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+)
+
+func (s *ServiceSuite) strictProvider() {
+	s.provider = providerMocks.NewProvider(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+}
+
+func (s *ServiceSuite) TestPayOrderChargesProvider() {
+	s.strictProvider()
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	s.provider.On("Charge", mock.Anything, mock.MatchedBy(func(c model.Charge) bool {
+		return c.OrderUuid == orderUUID &&
+			c.UserUuid == userUUID &&
+			c.PaymentMethod == model.PaymentMethodSBP &&
+			c.Amount == testAmount &&
+			c.Currency == testCurrency &&
+			c.TransactionUuid != uuid.Nil
+	})).Return(model.ChargeResult{ProviderReference: "psp_1", Status: model.ChargeStatusSucceeded}, nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "SBP", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().NotEmpty(transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderDeclined() {
+	s.strictProvider()
+	s.provider.On("Charge", mock.Anything, mock.Anything).
+		Return(model.ChargeResult{Status: model.ChargeStatusDeclined, DeclineReason: "insufficient_funds"}, nil)
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, model.TransactionStatusFailed).Return(nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentFailed", mock.Anything, mock.MatchedBy(func(e model.PaymentFailedEvent) bool {
+		return e.Reason == "insufficient_funds" && e.PaymentMethod == "CARD"
+	})).Return(nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrPaymentDeclined)
+	s.Require().Empty(transactionUUID)
+
+	var declined *model.PaymentDeclinedError
+	s.Require().ErrorAs(err, &declined)
+	s.Require().Equal("insufficient_funds", declined.Reason)
+	s.producerService.AssertNotCalled(s.T(), "ProducePaymentCompleted", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderPending() {
+	s.strictProvider()
+	s.provider.On("Charge", mock.Anything, mock.Anything).
+		Return(model.ChargeResult{Status: model.ChargeStatusPending}, nil)
+
+	var saved model.Transaction
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(model.Transaction) }).
+		Return(nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(saved.Uuid.String(), transactionUUID)
+	s.Require().Equal(model.TransactionStatusPending, saved.Status)
+
+	// Итог придёт webhook'ом: статус не меняется, событий нет
+	s.transactionRepository.AssertNotCalled(s.T(), "UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything)
+	s.producerService.AssertNotCalled(s.T(), "ProducePaymentCompleted", mock.Anything, mock.Anything)
+	s.producerService.AssertNotCalled(s.T(), "ProducePaymentFailed", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderProviderUnavailable() {
+	s.strictProvider()
+	providerErr := fmt.Errorf("%w: timeout", model.ErrProviderUnavailable)
+	s.provider.On("Charge", mock.Anything, mock.Anything).Return(model.ChargeResult{}, providerErr)
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrProviderUnavailable)
+
+	// Деньги могли списаться: транзакция остаётся PENDING до webhook'а или повтора
+	s.transactionRepository.AssertNotCalled(s.T(), "UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything)
+	s.producerService.AssertNotCalled(s.T(), "ProducePaymentFailed", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderProviderErrorReportedUnavailable() {
+	s.strictProvider()
+	s.provider.On("Charge", mock.Anything, mock.Anything).Return(model.ChargeResult{}, gofakeit.Error())
+
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrProviderUnavailable)
+	s.transactionRepository.AssertNotCalled(s.T(), "UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderRepeatResumesPendingCharge() {
+	s.strictProvider()
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	existing := model.Transaction{
+		Uuid:           uuid.New(),
+		OrderUuid:      orderUUID,
+		UserUuid:       userUUID,
+		Amount:         testAmount,
+		Currency:       testCurrency,
+		PaymentMethod:  model.PaymentMethodCard,
+		Status:         model.TransactionStatusPending,
+		InstrumentUuid: uuid.New(),
+	}
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(model.ErrTransactionAlreadyExists)
+	s.transactionRepository.On("GetTransactionByOrder", mock.Anything, orderUUID).Return(existing, nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, existing.Uuid, model.TransactionStatusSucceeded).Return(nil)
+	s.instrumentRepository.On("GetInstrument", mock.Anything, userUUID, existing.InstrumentUuid).
+		Return(model.PaymentInstrument{Uuid: existing.InstrumentUuid, CardToken: "tok_1"}, nil)
+	// Повтор списывает с тем же ключом идемпотентности и реквизитами инструмента
+	s.provider.On("Charge", mock.Anything, mock.MatchedBy(func(c model.Charge) bool {
+		return c.TransactionUuid == existing.Uuid && c.CardToken == "tok_1"
+	})).Return(model.ChargeResult{Status: model.ChargeStatusSucceeded}, nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.MatchedBy(func(e model.PaymentCompletedEvent) bool {
+		return e.TransactionUUID == existing.Uuid.String()
+	})).Return(nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(existing.Uuid.String(), transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderSettledByWebhookDuringCharge() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, model.TransactionStatusSucceeded).
+		Return(model.ErrTransactionNotPending)
+	s.transactionRepository.On("GetTransaction", mock.Anything, mock.Anything).
+		Return(model.Transaction{Status: model.TransactionStatusSucceeded}, nil)
+	s.producerService.ExpectedCalls = nil
+
+	// Webhook закрыл транзакцию раньше ответа провайдера и сам опубликовал итог
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().NotEmpty(transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderSucceededPublishesCompleted() {
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, model.TransactionStatusSucceeded).Return(nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.MatchedBy(func(e model.PaymentCompletedEvent) bool {
+		return e.OrderUUID == orderUUID.String() &&
+			e.UserUUID == userUUID.String() &&
+			e.PaymentMethod == "SBP" &&
+			e.Amount == testAmount &&
+			e.Currency == testCurrency &&
+			e.EventUUID != ""
+	})).Return(nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "SBP", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().NotEmpty(transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderPublishError() {
+	publishErr := gofakeit.Error()
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.Anything).Return(publishErr)
+
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, publishErr)
+}
@@ -0,0 +1,165 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
+)
+
+func (s *service) ConfirmPayment(ctx context.Context, transactionUUID uuid.UUID, result model.ChargeResult) error {
+	ctx, span := tracing.StartSpan(ctx, "payment.confirm_payment",
+		trace.WithAttributes(
+			attribute.String("transaction.uuid", transactionUUID.String()),
+			attribute.String("charge.status", string(result.Status)),
+		),
+	)
+	defer span.End()
+
+	var status model.TransactionStatus
+	switch result.Status {
+	case model.ChargeStatusSucceeded:
+		status = model.TransactionStatusSucceeded
+	case model.ChargeStatusDeclined:
+		status = model.TransactionStatusFailed
+	default:
+		// Промежуточное уведомление: ждём итогового
+		return nil
+	}
+
+	transaction, err := s.transactionRepository.GetTransaction(ctx, transactionUUID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get transaction")
+		logger.Error(ctx,
+			"failed to get transaction for confirmation",
+			zap.String("transactionUUID", transactionUUID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if transaction.Status == model.TransactionStatusPending {
+		err = s.transactionRepository.UpdateTransactionStatus(ctx, transactionUUID, status)
+		switch {
+		case err == nil:
+			transaction.Status = status
+		case errors.Is(err, model.ErrTransactionNotPending):
+			// Параллельное подтверждение успело раньше — сверяемся с его итогом
+			transaction, err = s.transactionRepository.GetTransaction(ctx, transactionUUID)
+			if err != nil {
+				span.RecordError(err)
+				return err
+			}
+		default:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to update transaction")
+			logger.Error(ctx,
+				"failed to update transaction status",
+				zap.String("transactionUUID", transactionUUID.String()),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	if transaction.Status != status {
+		err = fmt.Errorf("%w: transaction is %s, provider reported %s",
+			model.ErrTransactionFinalized, transaction.Status, status)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "conflicting confirmation")
+		logger.Error(ctx,
+			"provider confirmation conflicts with transaction status",
+			zap.String("transactionUUID", transactionUUID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Повторный webhook публикует событие снова: сервис заказов обрабатывает его идемпотентно
+	if err := s.publish(ctx, transaction, result.DeclineReason); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to publish payment result")
+		return err
+	}
+
+	logger.Info(ctx,
+		"payment confirmed by provider",
+		zap.String("transactionUUID", transactionUUID.String()),
+		zap.String("status", string(status)),
+	)
+
+	return nil
+}
+
+// finalize переводит транзакцию из PENDING в итоговый статус и публикует итог оплаты.
+// Если webhook или параллельный повтор закрыл транзакцию раньше с тем же итогом,
+// событие уже опубликовано ими
+func (s *service) finalize(ctx context.Context, transaction model.Transaction, status model.TransactionStatus, reason string) error {
+	err := s.transactionRepository.UpdateTransactionStatus(ctx, transaction.Uuid, status)
+	if errors.Is(err, model.ErrTransactionNotPending) {
+		current, err := s.transactionRepository.GetTransaction(ctx, transaction.Uuid)
+		if err != nil {
+			return err
+		}
+		if current.Status != status {
+			return fmt.Errorf("%w: transaction is %s, provider reported %s",
+				model.ErrTransactionFinalized, current.Status, status)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	transaction.Status = status
+	return s.publish(ctx, transaction, reason)
+}
+
+// failTransaction помечает попытку неуспешной. Ошибка только логируется:
+// вызывающему важнее исходная причина отказа
+func (s *service) failTransaction(ctx context.Context, transaction model.Transaction, reason string) {
+	if err := s.finalize(ctx, transaction, model.TransactionStatusFailed, reason); err != nil {
+		logger.Error(ctx,
+			"failed to mark transaction as failed",
+			zap.String("transactionUUID", transaction.Uuid.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// publish отправляет событие об итоге транзакции; для PENDING событий нет
+func (s *service) publish(ctx context.Context, transaction model.Transaction, reason string) error {
+	switch transaction.Status {
+	case model.TransactionStatusSucceeded:
+		return s.producerService.ProducePaymentCompleted(ctx, model.PaymentCompletedEvent{
+			EventUUID:       uuid.NewString(),
+			OrderUUID:       transaction.OrderUuid.String(),
+			UserUUID:        transaction.UserUuid.String(),
+			TransactionUUID: transaction.Uuid.String(),
+			PaymentMethod:   string(transaction.PaymentMethod),
+			Amount:          transaction.Amount,
+			Currency:        transaction.Currency,
+		})
+	case model.TransactionStatusFailed:
+		return s.producerService.ProducePaymentFailed(ctx, model.PaymentFailedEvent{
+			EventUUID:       uuid.NewString(),
+			OrderUUID:       transaction.OrderUuid.String(),
+			UserUUID:        transaction.UserUuid.String(),
+			TransactionUUID: transaction.Uuid.String(),
+			PaymentMethod:   string(transaction.PaymentMethod),
+			Reason:          reason,
+		})
+	default:
+		return nil
+	}
+}
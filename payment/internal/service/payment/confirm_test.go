@@ -0,0 +1,123 @@
+package payment
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+)
+
+// strictRepository заменяет мок репозитория на мок без ожиданий по умолчанию
+func (s *ServiceSuite) strictRepository() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+}
+
+func (s *ServiceSuite) pendingTransaction() model.Transaction {
+	return model.Transaction{
+		Uuid:          uuid.New(),
+		OrderUuid:     uuid.New(),
+		UserUuid:      uuid.New(),
+		Amount:        testAmount,
+		Currency:      testCurrency,
+		PaymentMethod: model.PaymentMethodCard,
+		Status:        model.TransactionStatusPending,
+	}
+}
+
+func (s *ServiceSuite) TestConfirmPaymentSucceeded() {
+	s.strictRepository()
+	transaction := s.pendingTransaction()
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.Uuid).Return(transaction, nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, transaction.Uuid, model.TransactionStatusSucceeded).Return(nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.MatchedBy(func(e model.PaymentCompletedEvent) bool {
+		return e.TransactionUUID == transaction.Uuid.String() &&
+			e.OrderUUID == transaction.OrderUuid.String() &&
+			e.Amount == testAmount
+	})).Return(nil)
+
+	err := s.service.ConfirmPayment(s.ctx, transaction.Uuid, model.ChargeResult{Status: model.ChargeStatusSucceeded})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentDeclined() {
+	s.strictRepository()
+	transaction := s.pendingTransaction()
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.Uuid).Return(transaction, nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, transaction.Uuid, model.TransactionStatusFailed).Return(nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentFailed", mock.Anything, mock.MatchedBy(func(e model.PaymentFailedEvent) bool {
+		return e.TransactionUUID == transaction.Uuid.String() && e.Reason == "insufficient_funds"
+	})).Return(nil)
+
+	err := s.service.ConfirmPayment(s.ctx, transaction.Uuid, model.ChargeResult{
+		Status:        model.ChargeStatusDeclined,
+		DeclineReason: "insufficient_funds",
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentRepeatedWebhook() {
+	s.strictRepository()
+	transaction := s.pendingTransaction()
+	transaction.Status = model.TransactionStatusSucceeded
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.Uuid).Return(transaction, nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.Anything).Return(nil)
+
+	err := s.service.ConfirmPayment(s.ctx, transaction.Uuid, model.ChargeResult{Status: model.ChargeStatusSucceeded})
+	s.Require().NoError(err)
+	s.transactionRepository.AssertNotCalled(s.T(), "UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentConflictingStatus() {
+	transaction := s.pendingTransaction()
+	transaction.Status = model.TransactionStatusFailed
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.Uuid).Return(transaction, nil)
+
+	err := s.service.ConfirmPayment(s.ctx, transaction.Uuid, model.ChargeResult{Status: model.ChargeStatusSucceeded})
+	s.Require().ErrorIs(err, model.ErrTransactionFinalized)
+	s.producerService.AssertNotCalled(s.T(), "ProducePaymentCompleted", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentConcurrentConfirmation() {
+	s.strictRepository()
+	transaction := s.pendingTransaction()
+	confirmed := transaction
+	confirmed.Status = model.TransactionStatusSucceeded
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.Uuid).Return(transaction, nil).Once()
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.Uuid).Return(confirmed, nil).Once()
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, transaction.Uuid, model.TransactionStatusSucceeded).
+		Return(model.ErrTransactionNotPending)
+
+	err := s.service.ConfirmPayment(s.ctx, transaction.Uuid, model.ChargeResult{Status: model.ChargeStatusSucceeded})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentPendingNotification() {
+	err := s.service.ConfirmPayment(s.ctx, uuid.New(), model.ChargeResult{Status: model.ChargeStatusPending})
+	s.Require().NoError(err)
+	s.transactionRepository.AssertNotCalled(s.T(), "GetTransaction", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentNotFound() {
+	s.transactionRepository.On("GetTransaction", mock.Anything, mock.Anything).Return(model.Transaction{}, model.ErrTransactionNotFound)
+
+	err := s.service.ConfirmPayment(s.ctx, uuid.New(), model.ChargeResult{Status: model.ChargeStatusSucceeded})
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentPublishError() {
+	transaction := s.pendingTransaction()
+	publishErr := gofakeit.Error()
+	s.transactionRepository.On("GetTransaction", mock.Anything, transaction.Uuid).Return(transaction, nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.Anything).Return(publishErr)
+
+	// Ошибка публикации возвращается, чтобы PSP повторил webhook
+	err := s.service.ConfirmPayment(s.ctx, transaction.Uuid, model.ChargeResult{Status: model.ChargeStatusSucceeded})
+	s.Require().ErrorIs(err, publishErr)
+}
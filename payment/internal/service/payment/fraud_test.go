@@ -0,0 +1,92 @@
+package payment
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	providerMocks "github.com/nkolesnikov999/micro2-OK/payment/internal/provider/mocks"
+	serviceMocks "github.com/nkolesnikov999/micro2-OK/payment/internal/service/mocks"
+)
+
+func (s *ServiceSuite) withVerdict(verdict model.FraudVerdict) {
+	s.fraudService = serviceMocks.NewFraudService(s.T())
+	s.fraudService.On("Screen", mock.Anything, mock.Anything).Return(verdict)
+	s.provider = providerMocks.NewProvider(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+}
+
+func (s *ServiceSuite) TestPayOrderScreensPayment() {
+	s.withVerdict(model.FraudVerdict{Decision: model.FraudDecisionAllow})
+	s.fraudService.ExpectedCalls = nil
+	userUUID := uuid.New()
+	s.fraudService.On("Screen", mock.Anything, model.FraudCheck{
+		UserUuid:      userUUID,
+		PaymentMethod: model.PaymentMethodCreditCard,
+		Amount:        testAmount,
+		Currency:      testCurrency,
+	}).Return(model.FraudVerdict{Decision: model.FraudDecisionAllow})
+	s.provider.On("Charge", mock.Anything, mock.Anything).
+		Return(model.ChargeResult{Status: model.ChargeStatusSucceeded}, nil)
+
+	var saved model.Transaction
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(model.Transaction) }).
+		Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, model.TransactionStatusSucceeded).Return(nil)
+
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), userUUID, "CREDIT_CARD", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(model.FraudDecisionAllow, saved.FraudDecision)
+	s.Require().Empty(saved.FraudReason)
+}
+
+func (s *ServiceSuite) TestPayOrderFraudReviewCharges() {
+	verdict := model.FraudVerdict{}
+	verdict.Escalate(model.FraudDecisionReview, "new account 1h0m0s old")
+	s.withVerdict(verdict)
+	s.provider.On("Charge", mock.Anything, mock.Anything).
+		Return(model.ChargeResult{Status: model.ChargeStatusSucceeded}, nil)
+
+	var saved model.Transaction
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(model.Transaction) }).
+		Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, model.TransactionStatusSucceeded).Return(nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(saved.Uuid.String(), transactionUUID)
+	s.Require().Equal(model.FraudDecisionReview, saved.FraudDecision)
+	s.Require().Equal("new account 1h0m0s old", saved.FraudReason)
+}
+
+func (s *ServiceSuite) TestPayOrderFraudDenied() {
+	verdict := model.FraudVerdict{}
+	verdict.Escalate(model.FraudDecisionReview, "velocity 3 attempts in 1m0s")
+	verdict.Escalate(model.FraudDecisionDeny, "high-risk method CREDIT_CARD")
+	s.withVerdict(verdict)
+
+	var saved model.Transaction
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).(model.Transaction) }).
+		Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, model.TransactionStatusFailed).Return(nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentFailed", mock.Anything, mock.MatchedBy(func(e model.PaymentFailedEvent) bool {
+		return e.Reason == verdict.Reason()
+	})).Return(nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CREDIT_CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrFraudDenied)
+	s.Require().Empty(transactionUUID)
+	s.Require().Equal(model.FraudDecisionDeny, saved.FraudDecision)
+
+	var denied *model.FraudDeniedError
+	s.Require().ErrorAs(err, &denied)
+	s.Require().Equal("velocity 3 attempts in 1m0s; high-risk method CREDIT_CARD", denied.Reason)
+	// Провайдер не вызывается: мок без ожиданий упадёт на Charge
+}
@@ -0,0 +1,25 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (model.Transaction, error) {
+	transaction, err := s.transactionRepository.GetTransaction(ctx, transactionUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get transaction",
+			zap.String("transactionUUID", transactionUUID.String()),
+			zap.Error(err),
+		)
+		return model.Transaction{}, err
+	}
+
+	return transaction, nil
+}
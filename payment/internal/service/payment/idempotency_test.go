@@ -0,0 +1,232 @@
+package payment
+
+import (
+	"context"
+	"sync"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+)
+
+func (s *ServiceSuite) TestPayOrderRepeatReturnsOriginalTransaction() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	existing := model.Transaction{
+		Uuid:      uuid.New(),
+		OrderUuid: orderUUID,
+		UserUuid:  userUUID,
+		Amount:    testAmount,
+		Currency:  testCurrency,
+	}
+
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(model.ErrTransactionAlreadyExists)
+	s.transactionRepository.On("GetTransactionByOrder", mock.Anything, orderUUID).Return(existing, nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(existing.Uuid.String(), transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderConflictingUser() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	orderUUID := uuid.New()
+	existing := model.Transaction{
+		Uuid:      uuid.New(),
+		OrderUuid: orderUUID,
+		UserUuid:  uuid.New(),
+		Amount:    testAmount,
+		Currency:  testCurrency,
+	}
+
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(model.ErrTransactionAlreadyExists)
+	s.transactionRepository.On("GetTransactionByOrder", mock.Anything, orderUUID).Return(existing, nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrPaymentConflict)
+	s.Require().Empty(transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderConflictingAmount() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	existing := model.Transaction{
+		Uuid:      uuid.New(),
+		OrderUuid: orderUUID,
+		UserUuid:  userUUID,
+		Amount:    testAmount + 1,
+		Currency:  testCurrency,
+	}
+
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(model.ErrTransactionAlreadyExists)
+	s.transactionRepository.On("GetTransactionByOrder", mock.Anything, orderUUID).Return(existing, nil)
+
+	_, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrPaymentConflict)
+	s.provider.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderRepeatWithUnroundedAmount() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	// Сумма прочитана из DECIMAL(10,2), в запросе — неокруглённый float64
+	existing := model.Transaction{
+		Uuid:      uuid.New(),
+		OrderUuid: orderUUID,
+		UserUuid:  userUUID,
+		Amount:    0.3,
+		Currency:  testCurrency,
+	}
+	requestedAmount := 0.1
+	requestedAmount += 0.2
+
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(model.ErrTransactionAlreadyExists)
+	s.transactionRepository.On("GetTransactionByOrder", mock.Anything, orderUUID).Return(existing, nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", requestedAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(existing.Uuid.String(), transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderLookupExistingError() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	repoErr := gofakeit.Error()
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(model.ErrTransactionAlreadyExists)
+	s.transactionRepository.On("GetTransactionByOrder", mock.Anything, mock.Anything).Return(model.Transaction{}, repoErr)
+
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, repoErr)
+}
+
+func (s *ServiceSuite) TestPayOrderConcurrentDuplicates() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	// Хранилище с уникальностью по order_uuid, как в таблице transactions
+	var (
+		mu     sync.Mutex
+		stored = make(map[uuid.UUID]model.Transaction)
+	)
+	s.transactionRepository.EXPECT().CreateTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, t model.Transaction) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if _, ok := stored[t.OrderUuid]; ok {
+				return model.ErrTransactionAlreadyExists
+			}
+			stored[t.OrderUuid] = t
+			return nil
+		})
+	s.transactionRepository.EXPECT().GetTransactionByOrder(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, orderUUID uuid.UUID) (model.Transaction, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return stored[orderUUID], nil
+		}).Maybe()
+	s.transactionRepository.EXPECT().UpdateTransactionStatus(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, transactionUUID uuid.UUID, status model.TransactionStatus) error {
+			mu.Lock()
+			defer mu.Unlock()
+			for orderUUID, t := range stored {
+				if t.Uuid == transactionUUID {
+					if t.Status != model.TransactionStatusPending {
+						return model.ErrTransactionNotPending
+					}
+					t.Status = status
+					stored[orderUUID] = t
+				}
+			}
+			return nil
+		})
+	s.transactionRepository.EXPECT().GetTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, transactionUUID uuid.UUID) (model.Transaction, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, t := range stored {
+				if t.Uuid == transactionUUID {
+					return t, nil
+				}
+			}
+			return model.Transaction{}, model.ErrTransactionNotFound
+		}).Maybe()
+
+	const callers = 20
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	results := make([]string, callers)
+	errs := make([]error, callers)
+
+	var wg sync.WaitGroup
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", testAmount, "")
+		}()
+	}
+	wg.Wait()
+
+	for i := range callers {
+		s.Require().NoError(errs[i])
+		s.Require().Equal(stored[orderUUID].Uuid.String(), results[i])
+	}
+	s.Require().Len(stored, 1)
+	s.Require().Equal(model.TransactionStatusSucceeded, stored[orderUUID].Status)
+	// Транзакция сохраняется до списания: повторы, заставшие её в PENDING,
+	// списывают с тем же Idempotency-Key, и провайдер не спишет деньги дважды
+	s.provider.AssertCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+	for _, call := range s.provider.Calls {
+		s.Require().Equal(stored[orderUUID].Uuid, call.Arguments.Get(1).(model.Charge).TransactionUuid)
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderSaveErrorSkipsCharge() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	repoErr := gofakeit.Error()
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(repoErr)
+
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, repoErr)
+	s.provider.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderRepeatRepublishesCompleted() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	existing := model.Transaction{
+		Uuid:      uuid.New(),
+		OrderUuid: orderUUID,
+		UserUuid:  userUUID,
+		Amount:    testAmount,
+		Currency:  testCurrency,
+		Status:    model.TransactionStatusSucceeded,
+	}
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(model.ErrTransactionAlreadyExists)
+	s.transactionRepository.On("GetTransactionByOrder", mock.Anything, orderUUID).Return(existing, nil)
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.MatchedBy(func(e model.PaymentCompletedEvent) bool {
+		return e.TransactionUUID == existing.Uuid.String()
+	})).Return(nil)
+
+	// Повтор восстанавливает заказ, если прошлая публикация PaymentCompleted не дошла
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, "CARD", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(existing.Uuid.String(), transactionUUID)
+	s.provider.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,124 @@
+package payment
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestPayOrderWithInstrumentPassesCardToken() {
+	s.strictProvider()
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	instrument := model.PaymentInstrument{
+		Uuid:          uuid.New(),
+		UserUuid:      userUUID,
+		PaymentMethod: model.PaymentMethodCard,
+		CardToken:     "tok_visa",
+		CardLast4:     "4242",
+	}
+
+	s.instrumentRepository.On("GetInstrument", mock.Anything, userUUID, instrument.Uuid).Return(instrument, nil)
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(t model.Transaction) bool {
+		return t.InstrumentUuid == instrument.Uuid && t.PaymentMethod == model.PaymentMethodCard
+	})).Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.provider.On("Charge", mock.Anything, mock.MatchedBy(func(c model.Charge) bool {
+		return c.PaymentMethod == model.PaymentMethodCard && c.CardToken == "tok_visa"
+	})).Return(model.ChargeResult{Status: model.ChargeStatusSucceeded}, nil)
+
+	// Способ оплаты не указан — берётся из инструмента
+	transactionUUID, err := s.service.PayOrderWithInstrument(s.ctx, orderUUID, userUUID, instrument.Uuid, "", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().NotEmpty(transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInstrumentPassesSBPPhone() {
+	s.strictProvider()
+
+	userUUID := uuid.New()
+	instrument := model.PaymentInstrument{
+		Uuid:          uuid.New(),
+		UserUuid:      userUUID,
+		PaymentMethod: model.PaymentMethodSBP,
+		SBPPhone:      "+79991234567",
+	}
+
+	s.instrumentRepository.On("GetInstrument", mock.Anything, userUUID, instrument.Uuid).Return(instrument, nil)
+	s.provider.On("Charge", mock.Anything, mock.MatchedBy(func(c model.Charge) bool {
+		return c.SBPPhone == "+79991234567" && c.CardToken == ""
+	})).Return(model.ChargeResult{Status: model.ChargeStatusSucceeded}, nil)
+
+	_, err := s.service.PayOrderWithInstrument(s.ctx, uuid.New(), userUUID, instrument.Uuid, "sbp", testAmount, "")
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInstrumentMethodMismatch() {
+	s.strictProvider()
+
+	userUUID := uuid.New()
+	instrument := model.PaymentInstrument{
+		Uuid:          uuid.New(),
+		UserUuid:      userUUID,
+		PaymentMethod: model.PaymentMethodSBP,
+		SBPPhone:      "+79991234567",
+	}
+
+	s.instrumentRepository.On("GetInstrument", mock.Anything, userUUID, instrument.Uuid).Return(instrument, nil)
+
+	transactionUUID, err := s.service.PayOrderWithInstrument(s.ctx, uuid.New(), userUUID, instrument.Uuid, "CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrInstrumentMethodMismatch)
+	s.Require().Empty(transactionUUID)
+	s.transactionRepository.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInstrumentNotFound() {
+	s.strictProvider()
+
+	userUUID, instrumentUUID := uuid.New(), uuid.New()
+	s.instrumentRepository.On("GetInstrument", mock.Anything, userUUID, instrumentUUID).Return(model.PaymentInstrument{}, model.ErrInstrumentNotFound)
+
+	transactionUUID, err := s.service.PayOrderWithInstrument(s.ctx, uuid.New(), userUUID, instrumentUUID, "", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrInstrumentNotFound)
+	s.Require().Empty(transactionUUID)
+	s.transactionRepository.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderWithDefaultInstrument() {
+	s.strictProvider()
+
+	userUUID := uuid.New()
+	instrument := model.PaymentInstrument{
+		Uuid:          uuid.New(),
+		UserUuid:      userUUID,
+		PaymentMethod: model.PaymentMethodCard,
+		CardToken:     "tok_default",
+		IsDefault:     true,
+	}
+
+	s.instrumentRepository.On("GetDefaultInstrument", mock.Anything, userUUID).Return(instrument, nil)
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(t model.Transaction) bool {
+		return t.InstrumentUuid == instrument.Uuid && t.PaymentMethod == model.PaymentMethodCard
+	})).Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.provider.On("Charge", mock.Anything, mock.MatchedBy(func(c model.Charge) bool {
+		return c.CardToken == "tok_default"
+	})).Return(model.ChargeResult{Status: model.ChargeStatusSucceeded}, nil)
+
+	_, err := s.service.PayOrderWithInstrument(s.ctx, uuid.New(), userUUID, uuid.Nil, "", testAmount, "")
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestPayOrderWithoutDefaultInstrument() {
+	s.strictProvider()
+
+	userUUID := uuid.New()
+	s.instrumentRepository.On("GetDefaultInstrument", mock.Anything, userUUID).Return(model.PaymentInstrument{}, model.ErrInstrumentNotFound)
+
+	_, err := s.service.PayOrderWithInstrument(s.ctx, uuid.New(), userUUID, uuid.Nil, "", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrNoDefaultInstrument)
+	s.transactionRepository.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
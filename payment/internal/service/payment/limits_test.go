@@ -0,0 +1,93 @@
+package payment
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *ServiceSuite) limitedService() {
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, map[model.PaymentMethod]model.AmountLimit{
+		model.PaymentMethodCard:          {Min: 1, Max: 1_000_000},
+		model.PaymentMethodSBP:           {Min: 1, Max: 600_000},
+		model.PaymentMethodInvestorMoney: {Min: 100_000},
+	})
+}
+
+func (s *ServiceSuite) TestPayOrderWithinLimits() {
+	s.limitedService()
+
+	cases := []struct {
+		method string
+		amount float64
+	}{
+		{method: "CARD", amount: 1},
+		{method: "CARD", amount: 1_000_000},
+		{method: "SBP", amount: 600_000},
+		{method: "INVESTOR_MONEY", amount: 100_000},
+		{method: "INVESTOR_MONEY", amount: 50_000_000},
+		// Для CREDIT_CARD лимит не задан
+		{method: "CREDIT_CARD", amount: 0.01},
+	}
+
+	for _, tc := range cases {
+		transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), tc.method, tc.amount, "")
+		s.Require().NoError(err, "%s %.2f", tc.method, tc.amount)
+		s.Require().NotEmpty(transactionUUID)
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderOutOfLimits() {
+	s.limitedService()
+
+	cases := []struct {
+		method string
+		amount float64
+		limit  model.AmountLimit
+	}{
+		{method: "SBP", amount: 600_000.01, limit: model.AmountLimit{Min: 1, Max: 600_000}},
+		{method: "CARD", amount: 0.5, limit: model.AmountLimit{Min: 1, Max: 1_000_000}},
+		{method: "INVESTOR_MONEY", amount: 99_999, limit: model.AmountLimit{Min: 100_000}},
+	}
+
+	for _, tc := range cases {
+		transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), tc.method, tc.amount, "rub")
+		s.Require().ErrorIs(err, model.ErrAmountOutOfLimits)
+		s.Require().Empty(transactionUUID)
+
+		var limitErr *model.AmountLimitError
+		s.Require().ErrorAs(err, &limitErr)
+		s.Require().Equal(model.PaymentMethod(tc.method), limitErr.PaymentMethod)
+		s.Require().Equal(tc.amount, limitErr.Amount)
+		s.Require().Equal(testCurrency, limitErr.Currency)
+		s.Require().Equal(tc.limit, limitErr.Limit)
+	}
+
+	s.transactionRepository.AssertNotCalled(s.T(), "CreateTransaction", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderInvalidAmount() {
+	for _, amount := range []float64{0, -10, math.NaN(), math.Inf(1)} {
+		_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", amount, "")
+		s.Require().ErrorIs(err, model.ErrInvalidAmount)
+	}
+}
+
+func (s *ServiceSuite) TestPayOrderUnsupportedCurrency() {
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "USD")
+	s.Require().ErrorIs(err, model.ErrUnsupportedCurrency)
+}
+
+func (s *ServiceSuite) TestPayOrderStoresAmountAndCurrency() {
+	s.transactionRepository.ExpectedCalls = nil
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(t model.Transaction) bool {
+		return t.Amount == testAmount && t.Currency == testCurrency
+	})).Return(nil)
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, " rub ")
+	s.Require().NoError(err)
+}
@@ -0,0 +1,39 @@
+package payment
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const (
+	defaultTransactionsLimit = 50
+	maxTransactionsLimit     = 500
+)
+
+func (s *service) ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error) {
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultTransactionsLimit
+	case filter.Limit > maxTransactionsLimit:
+		filter.Limit = maxTransactionsLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	transactions, err := s.transactionRepository.ListTransactions(ctx, filter)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list transactions",
+			zap.Any("filter", filter),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return transactions, nil
+}
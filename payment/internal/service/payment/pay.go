@@ -2,7 +2,11 @@ package payment
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
@@ -15,10 +19,61 @@ import (
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
 )
 
-func (s *service) PayOrder(ctx context.Context, paymentMethod string) (transactionUUID string, err error) {
+func (s *service) PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod string, amount float64, currency string) (string, error) {
+	return s.payOrder(ctx, orderUUID, userUUID, paymentMethod, model.PaymentInstrument{}, amount, currency)
+}
+
+func (s *service) PayOrderWithInstrument(ctx context.Context, orderUUID, userUUID, instrumentUUID uuid.UUID, paymentMethod string, amount float64, currency string) (string, error) {
+	instrument, err := s.getInstrument(ctx, userUUID, instrumentUUID)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to get payment instrument",
+			zap.String("orderUUID", orderUUID.String()),
+			zap.String("instrumentUUID", instrumentUUID.String()),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	method := strings.ToUpper(strings.TrimSpace(paymentMethod))
+	if method == "" {
+		method = string(instrument.PaymentMethod)
+	}
+	if model.PaymentMethod(method) != instrument.PaymentMethod {
+		return "", fmt.Errorf("%w: %s instrument, %s requested",
+			model.ErrInstrumentMethodMismatch, instrument.PaymentMethod, method)
+	}
+
+	return s.payOrder(ctx, orderUUID, userUUID, method, instrument, amount, currency)
+}
+
+// getInstrument возвращает инструмент пользователя; нулевой UUID — инструмент по умолчанию
+func (s *service) getInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) (model.PaymentInstrument, error) {
+	if instrumentUUID != uuid.Nil {
+		return s.instrumentRepository.GetInstrument(ctx, userUUID, instrumentUUID)
+	}
+
+	instrument, err := s.instrumentRepository.GetDefaultInstrument(ctx, userUUID)
+	if errors.Is(err, model.ErrInstrumentNotFound) {
+		return model.PaymentInstrument{}, model.ErrNoDefaultInstrument
+	}
+	return instrument, err
+}
+
+// payOrder проводит оплату; instrument с нулевым Uuid означает оплату без сохранённого инструмента
+func (s *service) payOrder(
+	ctx context.Context,
+	orderUUID, userUUID uuid.UUID,
+	paymentMethod string,
+	instrument model.PaymentInstrument,
+	amount float64,
+	currency string,
+) (transactionUUID string, err error) {
 	ctx, span := tracing.StartSpan(ctx, "payment.call_pay_order",
 		trace.WithAttributes(
 			attribute.String("payment.method", paymentMethod),
+			attribute.String("order.uuid", orderUUID.String()),
+			attribute.Float64("payment.amount", amount),
 		),
 	)
 	defer span.End()
@@ -37,17 +92,224 @@ func (s *service) PayOrder(ctx context.Context, paymentMethod string) (transacti
 		return "", model.ErrInvalidPaymentMethod
 	}
 
-	transactionUUID = uuid.NewString()
+	currency, err = s.checkAmount(model.PaymentMethod(method), amount, currency)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "amount rejected")
+		logger.Error(ctx,
+			"amount rejected",
+			zap.String("orderUUID", orderUUID.String()),
+			zap.String("paymentMethod", method),
+			zap.Float64("amount", amount),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	// Антифрод до сохранения: решение записывается в транзакцию вместе с причиной
+	verdict := s.fraudService.Screen(ctx, model.FraudCheck{
+		UserUuid:      userUUID,
+		PaymentMethod: model.PaymentMethod(method),
+		Amount:        amount,
+		Currency:      currency,
+	})
+
+	now := time.Now()
+	transaction := model.Transaction{
+		Uuid:           uuid.New(),
+		OrderUuid:      orderUUID,
+		UserUuid:       userUUID,
+		Amount:         amount,
+		Currency:       currency,
+		PaymentMethod:  model.PaymentMethod(method),
+		Status:         model.TransactionStatusPending,
+		FraudDecision:  verdict.Decision,
+		FraudReason:    verdict.Reason(),
+		InstrumentUuid: instrument.Uuid,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	// Транзакция сохраняется до списания: уникальность order_uuid не даёт
+	// параллельным запросам списать деньги за один заказ дважды
+	err = s.transactionRepository.CreateTransaction(ctx, transaction)
+	if errors.Is(err, model.ErrTransactionAlreadyExists) {
+		// Повторный вызов для уже оплачиваемого заказа: отдаём исходную транзакцию
+		transaction, err = s.existingTransaction(ctx, transaction)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "existing transaction mismatch")
+			logger.Error(ctx,
+				"failed to reuse existing transaction",
+				zap.String("orderUUID", orderUUID.String()),
+				zap.Error(err),
+			)
+			return "", err
+		}
+		return transaction.Uuid.String(), nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to save transaction")
+		logger.Error(ctx,
+			"failed to save transaction",
+			zap.String("orderUUID", orderUUID.String()),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	transactionUUID = transaction.Uuid.String()
 	span.SetAttributes(
 		attribute.String("payment.method", method),
 		attribute.String("transaction.uuid", transactionUUID),
+		attribute.String("fraud.decision", string(verdict.Decision)),
 	)
 
+	if verdict.Decision == model.FraudDecisionDeny {
+		// Провайдер не вызывается; PaymentFailed вернёт заказ к ожиданию оплаты
+		s.failTransaction(ctx, transaction, verdict.Reason())
+		err := &model.FraudDeniedError{Reason: verdict.Reason()}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "payment denied by fraud screening")
+		return "", err
+	}
+
+	if err := s.chargeAndSettle(ctx, transaction, instrument); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "charge failed")
+		return "", err
+	}
+
 	logger.Debug(ctx,
-		"payment method validated successfully",
+		"payment processed",
 		zap.String("paymentMethod", paymentMethod),
 		zap.String("transactionUUID", transactionUUID),
 	)
 
 	return transactionUUID, nil
 }
+
+// checkAmount проверяет сумму и валюту оплаты и возвращает итоговый код валюты
+func (s *service) checkAmount(method model.PaymentMethod, amount float64, currency string) (string, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) || amount <= 0 {
+		return "", model.ErrInvalidAmount
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = s.currency
+	}
+	if currency != s.currency {
+		return "", fmt.Errorf("%w: %s", model.ErrUnsupportedCurrency, currency)
+	}
+
+	// Способ оплаты без настроенного лимита не ограничен
+	limit := s.limits[method]
+	if !limit.Allows(amount) {
+		return "", &model.AmountLimitError{
+			PaymentMethod: method,
+			Amount:        amount,
+			Currency:      currency,
+			Limit:         limit,
+		}
+	}
+
+	return currency, nil
+}
+
+// existingTransaction возвращает действующую транзакцию заказа,
+// если она совпадает с запросом по пользователю, сумме и валюте.
+// Транзакция в PENDING списывается повторно с тем же ключом идемпотентности.
+// Для успешной транзакции PaymentCompleted публикуется снова: повтор восстанавливает
+// заказ, если прошлая публикация не удалась
+func (s *service) existingTransaction(ctx context.Context, requested model.Transaction) (model.Transaction, error) {
+	existing, err := s.transactionRepository.GetTransactionByOrder(ctx, requested.OrderUuid)
+	if err != nil {
+		return model.Transaction{}, err
+	}
+
+	if existing.UserUuid != requested.UserUuid ||
+		model.ToCents(existing.Amount) != model.ToCents(requested.Amount) ||
+		existing.Currency != requested.Currency {
+		return model.Transaction{}, model.ErrPaymentConflict
+	}
+
+	if existing.Status == model.TransactionStatusPending {
+		// Итог прошлой попытки неизвестен или ещё не пришёл: повторяем списание
+		// с тем же UUID транзакции, по нему провайдер не спишет деньги дважды
+		if err := s.resumeCharge(ctx, existing); err != nil {
+			return model.Transaction{}, err
+		}
+		return existing, nil
+	}
+
+	if err := s.publish(ctx, existing, ""); err != nil {
+		return model.Transaction{}, err
+	}
+
+	logger.Debug(ctx,
+		"order already paid, returning existing transaction",
+		zap.String("orderUUID", requested.OrderUuid.String()),
+		zap.String("transactionUUID", existing.Uuid.String()),
+	)
+
+	return existing, nil
+}
+
+// resumeCharge повторяет списание по транзакции, оставшейся в PENDING
+func (s *service) resumeCharge(ctx context.Context, transaction model.Transaction) error {
+	var instrument model.PaymentInstrument
+	if transaction.InstrumentUuid != uuid.Nil {
+		var err error
+		instrument, err = s.instrumentRepository.GetInstrument(ctx, transaction.UserUuid, transaction.InstrumentUuid)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.chargeAndSettle(ctx, transaction, instrument)
+}
+
+// chargeAndSettle списывает сумму транзакции и сохраняет итог.
+// Сбой провайдера не означает отказ: деньги могли списаться, поэтому транзакция
+// остаётся в PENDING и держит заказ, пока итог не придёт webhook'ом или повторным PayOrder
+func (s *service) chargeAndSettle(ctx context.Context, transaction model.Transaction, instrument model.PaymentInstrument) error {
+	result, err := s.charge(ctx, transaction, instrument)
+	if err != nil {
+		logger.Warn(ctx,
+			"charge outcome unknown, transaction left pending",
+			zap.String("orderUUID", transaction.OrderUuid.String()),
+			zap.String("transactionUUID", transaction.Uuid.String()),
+			zap.Error(err),
+		)
+		if !errors.Is(err, model.ErrProviderUnavailable) {
+			err = fmt.Errorf("%w: %v", model.ErrProviderUnavailable, err)
+		}
+		return err
+	}
+
+	switch result.Status {
+	case model.ChargeStatusSucceeded:
+		if err := s.finalize(ctx, transaction, model.TransactionStatusSucceeded, ""); err != nil {
+			logger.Error(ctx,
+				"failed to complete transaction",
+				zap.String("transactionUUID", transaction.Uuid.String()),
+				zap.Error(err),
+			)
+			return err
+		}
+	case model.ChargeStatusDeclined:
+		s.failTransaction(ctx, transaction, result.DeclineReason)
+		return &model.PaymentDeclinedError{Reason: result.DeclineReason}
+	default:
+		// Итог придёт webhook'ом провайдера в ConfirmPayment
+		logger.Info(ctx,
+			"payment pending provider confirmation",
+			zap.String("orderUUID", transaction.OrderUuid.String()),
+			zap.String("transactionUUID", transaction.Uuid.String()),
+		)
+	}
+
+	return nil
+}
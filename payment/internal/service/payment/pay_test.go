@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
 
 	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
 )
@@ -11,7 +12,7 @@ import (
 func (s *ServiceSuite) TestPayOrderSuccess() {
 	paymentMethod := "CARD"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36) // UUID length
@@ -20,7 +21,7 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 func (s *ServiceSuite) TestPayOrderWithCard() {
 	paymentMethod := "CARD"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -29,7 +30,7 @@ func (s *ServiceSuite) TestPayOrderWithCard() {
 func (s *ServiceSuite) TestPayOrderWithSBP() {
 	paymentMethod := "SBP"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -38,7 +39,7 @@ func (s *ServiceSuite) TestPayOrderWithSBP() {
 func (s *ServiceSuite) TestPayOrderWithCreditCard() {
 	paymentMethod := "CREDIT_CARD"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -47,7 +48,7 @@ func (s *ServiceSuite) TestPayOrderWithCreditCard() {
 func (s *ServiceSuite) TestPayOrderWithInvestorMoney() {
 	paymentMethod := "INVESTOR_MONEY"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -56,7 +57,7 @@ func (s *ServiceSuite) TestPayOrderWithInvestorMoney() {
 func (s *ServiceSuite) TestPayOrderWithLowercaseMethod() {
 	paymentMethod := "card" // lowercase
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -65,7 +66,7 @@ func (s *ServiceSuite) TestPayOrderWithLowercaseMethod() {
 func (s *ServiceSuite) TestPayOrderWithMixedCaseMethod() {
 	paymentMethod := "Card" // mixed case
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -74,7 +75,7 @@ func (s *ServiceSuite) TestPayOrderWithMixedCaseMethod() {
 func (s *ServiceSuite) TestPayOrderWithWhitespace() {
 	paymentMethod := "  CARD  " // with whitespace
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -83,7 +84,7 @@ func (s *ServiceSuite) TestPayOrderWithWhitespace() {
 func (s *ServiceSuite) TestPayOrderWithTabWhitespace() {
 	paymentMethod := "\tCARD\t" // with tab whitespace
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -92,7 +93,7 @@ func (s *ServiceSuite) TestPayOrderWithTabWhitespace() {
 func (s *ServiceSuite) TestPayOrderWithNewlineWhitespace() {
 	paymentMethod := "\nCARD\n" // with newline whitespace
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.NoError(err)
 	s.NotEmpty(transactionUUID)
 	s.Len(transactionUUID, 36)
@@ -101,7 +102,7 @@ func (s *ServiceSuite) TestPayOrderWithNewlineWhitespace() {
 func (s *ServiceSuite) TestPayOrderWithInvalidMethod() {
 	paymentMethod := "INVALID_METHOD"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -110,7 +111,7 @@ func (s *ServiceSuite) TestPayOrderWithInvalidMethod() {
 func (s *ServiceSuite) TestPayOrderWithEmptyMethod() {
 	paymentMethod := ""
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -119,7 +120,7 @@ func (s *ServiceSuite) TestPayOrderWithEmptyMethod() {
 func (s *ServiceSuite) TestPayOrderWithWhitespaceOnly() {
 	paymentMethod := "   " // only whitespace
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -128,7 +129,7 @@ func (s *ServiceSuite) TestPayOrderWithWhitespaceOnly() {
 func (s *ServiceSuite) TestPayOrderWithRandomString() {
 	paymentMethod := gofakeit.Word()
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -137,7 +138,7 @@ func (s *ServiceSuite) TestPayOrderWithRandomString() {
 func (s *ServiceSuite) TestPayOrderWithNumberString() {
 	paymentMethod := "12345"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -146,7 +147,7 @@ func (s *ServiceSuite) TestPayOrderWithNumberString() {
 func (s *ServiceSuite) TestPayOrderWithSpecialCharacters() {
 	paymentMethod := "CARD@#$%"
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -155,7 +156,7 @@ func (s *ServiceSuite) TestPayOrderWithSpecialCharacters() {
 func (s *ServiceSuite) TestPayOrderWithPartialMatch() {
 	paymentMethod := "CAR" // partial match of CARD
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -164,7 +165,7 @@ func (s *ServiceSuite) TestPayOrderWithPartialMatch() {
 func (s *ServiceSuite) TestPayOrderWithMultipleWords() {
 	paymentMethod := "CREDIT CARD" // space in method name
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -173,7 +174,7 @@ func (s *ServiceSuite) TestPayOrderWithMultipleWords() {
 func (s *ServiceSuite) TestPayOrderWithVeryLongString() {
 	paymentMethod := strings.Repeat("CARD", 100) // very long string
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -182,7 +183,7 @@ func (s *ServiceSuite) TestPayOrderWithVeryLongString() {
 func (s *ServiceSuite) TestPayOrderWithUnicodeCharacters() {
 	paymentMethod := "КАРТА" // unicode characters
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -191,7 +192,7 @@ func (s *ServiceSuite) TestPayOrderWithUnicodeCharacters() {
 func (s *ServiceSuite) TestPayOrderWithNullCharacter() {
 	paymentMethod := "CARD\x00" // null character
 
-	transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 	s.Error(err)
 	s.ErrorIs(err, model.ErrInvalidPaymentMethod)
 	s.Empty(transactionUUID)
@@ -203,7 +204,7 @@ func (s *ServiceSuite) TestPayOrderGeneratesUniqueUUIDs() {
 	// Generate multiple UUIDs and ensure they're unique
 	uuids := make(map[string]bool)
 	for i := 0; i < 100; i++ {
-		transactionUUID, err := s.service.PayOrder(s.ctx, paymentMethod)
+		transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), paymentMethod, testAmount, "")
 		s.NoError(err)
 		s.NotEmpty(transactionUUID)
 
@@ -219,7 +220,7 @@ func (s *ServiceSuite) TestPayOrderWithAllValidMethods() {
 	validMethods := []string{"CARD", "SBP", "CREDIT_CARD", "INVESTOR_MONEY"}
 
 	for _, method := range validMethods {
-		transactionUUID, err := s.service.PayOrder(s.ctx, method)
+		transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), method, testAmount, "")
 		s.NoError(err, "Method %s should be valid", method)
 		s.NotEmpty(transactionUUID)
 		s.Len(transactionUUID, 36)
@@ -230,7 +231,7 @@ func (s *ServiceSuite) TestPayOrderWithAllValidMethodsLowercase() {
 	validMethods := []string{"card", "sbp", "credit_card", "investor_money"}
 
 	for _, method := range validMethods {
-		transactionUUID, err := s.service.PayOrder(s.ctx, method)
+		transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), method, testAmount, "")
 		s.NoError(err, "Method %s should be valid", method)
 		s.NotEmpty(transactionUUID)
 		s.Len(transactionUUID, 36)
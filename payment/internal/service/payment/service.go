@@ -1,13 +1,41 @@
 package payment
 
 import (
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/provider"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
 	def "github.com/nkolesnikov999/micro2-OK/payment/internal/service"
 )
 
 var _ def.PaymentService = (*service)(nil)
 
-type service struct{}
+type service struct {
+	transactionRepository repository.TransactionRepository
+	instrumentRepository  repository.InstrumentRepository
+	provider              provider.Provider
+	producerService       def.PaymentProducerService
+	fraudService          def.FraudService
 
-func NewService() *service {
-	return &service{}
+	currency string
+	limits   map[model.PaymentMethod]model.AmountLimit
+}
+
+func NewService(
+	transactionRepository repository.TransactionRepository,
+	instrumentRepository repository.InstrumentRepository,
+	provider provider.Provider,
+	producerService def.PaymentProducerService,
+	fraudService def.FraudService,
+	currency string,
+	limits map[model.PaymentMethod]model.AmountLimit,
+) *service {
+	return &service{
+		transactionRepository: transactionRepository,
+		instrumentRepository:  instrumentRepository,
+		provider:              provider,
+		producerService:       producerService,
+		fraudService:          fraudService,
+		currency:              currency,
+		limits:                limits,
+	}
 }
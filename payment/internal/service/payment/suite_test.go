@@ -4,16 +4,32 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	providerMocks "github.com/nkolesnikov999/micro2-OK/payment/internal/provider/mocks"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+	serviceMocks "github.com/nkolesnikov999/micro2-OK/payment/internal/service/mocks"
 	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
 )
 
+const (
+	testCurrency = "RUB"
+	testAmount   = 1500.0
+)
+
 type ServiceSuite struct {
 	suite.Suite
 
 	ctx context.Context
 
+	transactionRepository *mocks.TransactionRepository
+	instrumentRepository  *mocks.InstrumentRepository
+	provider              *providerMocks.Provider
+	producerService       *serviceMocks.PaymentProducerService
+	fraudService          *serviceMocks.FraudService
+
 	service *service
 }
 
@@ -22,7 +38,28 @@ func (s *ServiceSuite) SetupTest() {
 
 	s.ctx = context.Background()
 
-	s.service = NewService()
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	// По умолчанию сохранение транзакции успешно; тесты сохранения задают свои ожидания на новом моке
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	s.instrumentRepository = mocks.NewInstrumentRepository(s.T())
+
+	s.provider = providerMocks.NewProvider(s.T())
+	// По умолчанию провайдер подтверждает списание
+	s.provider.On("Charge", mock.Anything, mock.Anything).
+		Return(model.ChargeResult{Status: model.ChargeStatusSucceeded}, nil).Maybe()
+
+	s.producerService = serviceMocks.NewPaymentProducerService(s.T())
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.producerService.On("ProducePaymentFailed", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	s.fraudService = serviceMocks.NewFraudService(s.T())
+	// По умолчанию антифрод пропускает оплату
+	s.fraudService.On("Screen", mock.Anything, mock.Anything).
+		Return(model.FraudVerdict{Decision: model.FraudDecisionAllow}).Maybe()
+
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
 }
 
 func (s *ServiceSuite) TearDownTest() {
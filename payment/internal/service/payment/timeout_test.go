@@ -0,0 +1,71 @@
+package payment
+
+import (
+	"context"
+	"net/http/httptest"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/fakepsp"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/provider/psp"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+)
+
+// Таймаут PSP не означает отказ: итог приходит позже webhook'ом и закрывает ту же транзакцию
+func (s *ServiceSuite) TestPayOrderTimeoutThenLateWebhook() {
+	fakePSP := fakepsp.NewServer(fakepsp.Config{
+		Scenario:     fakepsp.ScenarioTimeout,
+		TimeoutDelay: time.Second,
+	})
+	server := httptest.NewServer(fakePSP.Handler())
+	defer server.Close()
+
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository,
+		psp.NewProvider(server.URL, 50*time.Millisecond),
+		s.producerService, s.fraudService, testCurrency, nil)
+
+	var stored model.Transaction
+	s.transactionRepository.EXPECT().CreateTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, t model.Transaction) error {
+			stored = t
+			return nil
+		})
+	s.transactionRepository.EXPECT().GetTransaction(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, transactionUUID uuid.UUID) (model.Transaction, error) {
+			if transactionUUID != stored.Uuid {
+				return model.Transaction{}, model.ErrTransactionNotFound
+			}
+			return stored, nil
+		})
+	s.transactionRepository.EXPECT().UpdateTransactionStatus(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ uuid.UUID, status model.TransactionStatus) error {
+			if stored.Status != model.TransactionStatusPending {
+				return model.ErrTransactionNotPending
+			}
+			stored.Status = status
+			return nil
+		})
+	s.producerService.ExpectedCalls = nil
+	s.producerService.On("ProducePaymentCompleted", mock.Anything, mock.MatchedBy(func(e model.PaymentCompletedEvent) bool {
+		return e.TransactionUUID == stored.Uuid.String()
+	})).Return(nil).Once()
+
+	orderUUID := uuid.New()
+	_, err := s.service.PayOrder(s.ctx, orderUUID, uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, model.ErrProviderUnavailable)
+	s.Require().Equal(orderUUID, stored.OrderUuid)
+	s.Require().Equal(model.TransactionStatusPending, stored.Status)
+	s.producerService.AssertNotCalled(s.T(), "ProducePaymentFailed", mock.Anything, mock.Anything)
+
+	// Поздний webhook об успехе
+	err = s.service.ConfirmPayment(s.ctx, stored.Uuid, model.ChargeResult{
+		ProviderReference: "psp_late",
+		Status:            model.ChargeStatusSucceeded,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(model.TransactionStatusSucceeded, stored.Status)
+}
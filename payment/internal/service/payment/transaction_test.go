@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+)
+
+func (s *ServiceSuite) TestPayOrderSavesTransaction() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	orderUUID, userUUID := uuid.New(), uuid.New()
+	var saved model.Transaction
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(t model.Transaction) bool {
+		return t.OrderUuid == orderUUID && t.UserUuid == userUUID &&
+			t.PaymentMethod == model.PaymentMethodSBP &&
+			t.Status == model.TransactionStatusPending &&
+			!t.CreatedAt.IsZero()
+	})).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(model.Transaction)
+	}).Return(nil)
+	// Списание подтверждено сразу — транзакция завершается
+	s.transactionRepository.On("UpdateTransactionStatus", mock.Anything, mock.Anything, model.TransactionStatusSucceeded).Return(nil)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, orderUUID, userUUID, " sbp ", testAmount, "")
+	s.Require().NoError(err)
+	s.Require().Equal(saved.Uuid.String(), transactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderSaveError() {
+	s.transactionRepository = mocks.NewTransactionRepository(s.T())
+	s.service = NewService(s.transactionRepository, s.instrumentRepository, s.provider, s.producerService, s.fraudService, testCurrency, nil)
+
+	repoErr := gofakeit.Error()
+	s.transactionRepository.On("CreateTransaction", mock.Anything, mock.Anything).Return(repoErr)
+
+	transactionUUID, err := s.service.PayOrder(s.ctx, uuid.New(), uuid.New(), "CARD", testAmount, "")
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Empty(transactionUUID)
+}
+
+func (s *ServiceSuite) TestGetTransaction() {
+	transaction := model.Transaction{Uuid: uuid.New(), OrderUuid: uuid.New(), CreatedAt: time.Now()}
+	s.transactionRepository.On("GetTransaction", s.ctx, transaction.Uuid).Return(transaction, nil)
+
+	result, err := s.service.GetTransaction(s.ctx, transaction.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(transaction, result)
+}
+
+func (s *ServiceSuite) TestGetTransactionNotFound() {
+	transactionUUID := uuid.New()
+	s.transactionRepository.On("GetTransaction", s.ctx, transactionUUID).Return(model.Transaction{}, model.ErrTransactionNotFound)
+
+	_, err := s.service.GetTransaction(s.ctx, transactionUUID)
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+}
+
+func (s *ServiceSuite) TestListTransactionsAppliesLimits() {
+	userUUID := uuid.New()
+	cases := []struct {
+		in, want model.TransactionsFilter
+	}{
+		{in: model.TransactionsFilter{UserUuid: userUUID}, want: model.TransactionsFilter{UserUuid: userUUID, Limit: defaultTransactionsLimit}},
+		{in: model.TransactionsFilter{Limit: 10000, Offset: -5}, want: model.TransactionsFilter{Limit: maxTransactionsLimit}},
+		{in: model.TransactionsFilter{Limit: 7, Offset: 3}, want: model.TransactionsFilter{Limit: 7, Offset: 3}},
+	}
+
+	for _, tc := range cases {
+		s.transactionRepository.On("ListTransactions", s.ctx, tc.want).Return([]model.Transaction{}, nil).Once()
+
+		result, err := s.service.ListTransactions(s.ctx, tc.in)
+		s.Require().NoError(err)
+		s.Require().Empty(result)
+	}
+}
+
+func (s *ServiceSuite) TestListTransactionsError() {
+	repoErr := gofakeit.Error()
+	s.transactionRepository.On("ListTransactions", s.ctx, mock.Anything).Return(nil, repoErr)
+
+	result, err := s.service.ListTransactions(s.ctx, model.TransactionsFilter{})
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Nil(result)
+}
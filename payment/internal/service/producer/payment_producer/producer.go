@@ -0,0 +1,81 @@
+package payment_producer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/service"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/kafka"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	eventsV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/events/v1"
+)
+
+var _ def.PaymentProducerService = (*service)(nil)
+
+// События одного заказа публикуются с ключом order_uuid,
+// чтобы попадать в одну партицию и читаться по порядку.
+type service struct {
+	paymentCompletedProducer kafka.Producer
+	paymentFailedProducer    kafka.Producer
+}
+
+func NewService(paymentCompletedProducer, paymentFailedProducer kafka.Producer) *service {
+	return &service{
+		paymentCompletedProducer: paymentCompletedProducer,
+		paymentFailedProducer:    paymentFailedProducer,
+	}
+}
+
+func (p *service) ProducePaymentCompleted(ctx context.Context, event model.PaymentCompletedEvent) error {
+	msg := &eventsV1.PaymentCompleted{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		TransactionUuid: event.TransactionUUID,
+		PaymentMethod:   event.PaymentMethod,
+		Amount:          event.Amount,
+		Currency:        event.Currency,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal PaymentCompleted", zap.Error(err))
+		return err
+	}
+
+	err = p.paymentCompletedProducer.Send(ctx, []byte(event.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish PaymentCompleted", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (p *service) ProducePaymentFailed(ctx context.Context, event model.PaymentFailedEvent) error {
+	msg := &eventsV1.PaymentFailed{
+		EventUuid:       event.EventUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		TransactionUuid: event.TransactionUUID,
+		PaymentMethod:   event.PaymentMethod,
+		Reason:          event.Reason,
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		logger.Error(ctx, "failed to marshal PaymentFailed", zap.Error(err))
+		return err
+	}
+
+	err = p.paymentFailedProducer.Send(ctx, []byte(event.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "failed to publish PaymentFailed", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -1,7 +1,62 @@
 package service
 
-import "context"
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
 
 type PaymentService interface {
-	PayOrder(ctx context.Context, paymentMethod string) (transactionUUID string, err error)
+	// PayOrder проводит оплату заказа и сохраняет транзакцию.
+	// Пустая валюта означает валюту сервиса по умолчанию.
+	// Если провайдер не подтвердил списание сразу, транзакция остаётся в PENDING
+	// до ConfirmPayment; итог оплаты публикуется событием PaymentCompleted или PaymentFailed.
+	// При сбое провайдера возвращается ErrProviderUnavailable, транзакция тоже остаётся в PENDING,
+	// а повторный вызов списывает по ней снова с тем же ключом идемпотентности.
+	PayOrder(ctx context.Context, orderUUID, userUUID uuid.UUID, paymentMethod string, amount float64, currency string) (transactionUUID string, err error)
+	// PayOrderWithInstrument оплачивает заказ сохранённым инструментом пользователя.
+	// Пустой paymentMethod означает способ оплаты инструмента; иначе он должен с ним совпадать.
+	// Нулевой instrumentUUID — инструмент пользователя по умолчанию, без него ErrNoDefaultInstrument.
+	PayOrderWithInstrument(ctx context.Context, orderUUID, userUUID, instrumentUUID uuid.UUID, paymentMethod string, amount float64, currency string) (transactionUUID string, err error)
+	GetTransaction(ctx context.Context, transactionUUID uuid.UUID) (model.Transaction, error)
+	// ListTransactions возвращает транзакции по фильтру, новые первыми.
+	ListTransactions(ctx context.Context, filter model.TransactionsFilter) ([]model.Transaction, error)
+	// ConfirmPayment применяет итог отложенного списания из webhook провайдера.
+	// Повторное подтверждение с тем же итогом не меняет транзакцию.
+	ConfirmPayment(ctx context.Context, transactionUUID uuid.UUID, result model.ChargeResult) error
+}
+
+// WalletService ведёт кошельки INVESTOR_MONEY. Пустая валюта означает валюту сервиса по умолчанию.
+type WalletService interface {
+	// GetBalance возвращает кошелёк пользователя; у кошелька без операций остаток 0.
+	GetBalance(ctx context.Context, userUUID uuid.UUID, currency string) (model.Account, error)
+	// TopUp зачисляет деньги на кошелёк и возвращает проводку и кошелёк после неё.
+	// Повтор с тем же ключом идемпотентности не пополняет кошелёк второй раз.
+	TopUp(ctx context.Context, topUp model.TopUp) (model.JournalEntry, model.Account, error)
+	// GetStatement возвращает остаток и движения по кошельку, новые первыми.
+	GetStatement(ctx context.Context, filter model.StatementFilter) (model.Statement, error)
+}
+
+// InstrumentService управляет сохранёнными платёжными инструментами пользователя.
+type InstrumentService interface {
+	// AddInstrument проверяет реквизиты под способ оплаты и сохраняет инструмент.
+	AddInstrument(ctx context.Context, instrument model.PaymentInstrument) (model.PaymentInstrument, error)
+	// ListInstruments возвращает инструменты пользователя, инструмент по умолчанию первым.
+	ListInstruments(ctx context.Context, userUUID uuid.UUID) ([]model.PaymentInstrument, error)
+	// DeleteInstrument возвращает ErrInstrumentNotFound, если у пользователя нет такого инструмента.
+	DeleteInstrument(ctx context.Context, userUUID, instrumentUUID uuid.UUID) error
+}
+
+// FraudService проверяет оплату по правилам антифрода до обращения к провайдеру.
+type FraudService interface {
+	// Screen не возвращает ошибку: при недоступности источников данных правило
+	// отправляет оплату на проверку (REVIEW), а не блокирует её.
+	Screen(ctx context.Context, check model.FraudCheck) model.FraudVerdict
+}
+
+type PaymentProducerService interface {
+	ProducePaymentCompleted(ctx context.Context, event model.PaymentCompletedEvent) error
+	ProducePaymentFailed(ctx context.Context, event model.PaymentFailedEvent) error
 }
@@ -0,0 +1,40 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+func (s *service) GetBalance(ctx context.Context, userUUID uuid.UUID, currency string) (model.Account, error) {
+	currency, err := s.checkCurrency(currency)
+	if err != nil {
+		return model.Account{}, err
+	}
+
+	return s.account(ctx, model.UserAccount(userUUID, currency))
+}
+
+// account возвращает счёт; счёт открывается первой операцией, до неё остаток нулевой
+func (s *service) account(ctx context.Context, key model.AccountKey) (model.Account, error) {
+	account, err := s.walletRepository.GetAccount(ctx, key)
+	if err != nil {
+		if errors.Is(err, model.ErrAccountNotFound) {
+			return model.Account{Key: key}, nil
+		}
+		logger.Error(ctx,
+			"failed to get wallet account",
+			zap.String("ownerUUID", key.OwnerUuid.String()),
+			zap.String("currency", key.Currency),
+			zap.Error(err),
+		)
+		return model.Account{}, err
+	}
+
+	return account, nil
+}
@@ -0,0 +1,45 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetBalance() {
+	userUUID := uuid.New()
+	key := model.UserAccount(userUUID, testCurrency)
+	account := model.Account{Uuid: uuid.New(), Key: key, Balance: 1200}
+	s.walletRepository.On("GetAccount", mock.Anything, key).Return(account, nil)
+
+	result, err := s.service.GetBalance(s.ctx, userUUID, " rub ")
+	s.Require().NoError(err)
+	s.Equal(account, result)
+}
+
+func (s *ServiceSuite) TestGetBalanceEmptyWallet() {
+	userUUID := uuid.New()
+	key := model.UserAccount(userUUID, testCurrency)
+	s.walletRepository.On("GetAccount", mock.Anything, key).Return(model.Account{}, model.ErrAccountNotFound)
+
+	result, err := s.service.GetBalance(s.ctx, userUUID, "")
+	s.Require().NoError(err)
+	s.Equal(key, result.Key)
+	s.Zero(result.Balance)
+}
+
+func (s *ServiceSuite) TestGetBalanceUnsupportedCurrency() {
+	_, err := s.service.GetBalance(s.ctx, uuid.New(), "USD")
+	s.Require().ErrorIs(err, model.ErrUnsupportedCurrency)
+}
+
+func (s *ServiceSuite) TestGetBalanceRepositoryError() {
+	repoErr := errors.New("connection reset")
+	s.walletRepository.On("GetAccount", mock.Anything, mock.Anything).Return(model.Account{}, repoErr)
+
+	_, err := s.service.GetBalance(s.ctx, uuid.New(), "")
+	s.Require().ErrorIs(err, repoErr)
+}
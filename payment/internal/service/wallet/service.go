@@ -0,0 +1,37 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository"
+	def "github.com/nkolesnikov999/micro2-OK/payment/internal/service"
+)
+
+var _ def.WalletService = (*service)(nil)
+
+type service struct {
+	walletRepository repository.WalletRepository
+
+	currency string
+}
+
+func NewService(walletRepository repository.WalletRepository, currency string) *service {
+	return &service{
+		walletRepository: walletRepository,
+		currency:         currency,
+	}
+}
+
+// checkCurrency подставляет валюту по умолчанию: кошельки ведутся в валюте сервиса
+func (s *service) checkCurrency(currency string) (string, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = s.currency
+	}
+	if currency != s.currency {
+		return "", fmt.Errorf("%w: %s", model.ErrUnsupportedCurrency, currency)
+	}
+	return currency, nil
+}
@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const (
+	defaultStatementLimit = 50
+	maxStatementLimit     = 500
+)
+
+func (s *service) GetStatement(ctx context.Context, filter model.StatementFilter) (model.Statement, error) {
+	currency, err := s.checkCurrency(filter.Currency)
+	if err != nil {
+		return model.Statement{}, err
+	}
+	filter.Currency = currency
+
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultStatementLimit
+	case filter.Limit > maxStatementLimit:
+		filter.Limit = maxStatementLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	account, err := s.account(ctx, model.UserAccount(filter.UserUuid, currency))
+	if err != nil {
+		return model.Statement{}, err
+	}
+
+	lines, err := s.walletRepository.ListStatement(ctx, filter)
+	if err != nil {
+		logger.Error(ctx,
+			"failed to list wallet statement",
+			zap.Any("filter", filter),
+			zap.Error(err),
+		)
+		return model.Statement{}, err
+	}
+
+	return model.Statement{
+		Balance:  account.Balance,
+		Currency: currency,
+		Lines:    lines,
+	}, nil
+}
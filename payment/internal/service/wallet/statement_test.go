@@ -0,0 +1,43 @@
+package wallet
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetStatement() {
+	userUUID := uuid.New()
+	key := model.UserAccount(userUUID, testCurrency)
+	lines := []model.StatementLine{
+		{EntryUuid: uuid.New(), Kind: model.EntryKindPayment, Amount: -300, BalanceAfter: 700},
+		{EntryUuid: uuid.New(), Kind: model.EntryKindTopUp, Amount: 1000, BalanceAfter: 1000},
+	}
+
+	s.walletRepository.On("GetAccount", mock.Anything, key).Return(model.Account{Key: key, Balance: 700}, nil)
+	s.walletRepository.On("ListStatement", mock.Anything, model.StatementFilter{
+		UserUuid: userUUID,
+		Currency: testCurrency,
+		Limit:    defaultStatementLimit,
+	}).Return(lines, nil)
+
+	statement, err := s.service.GetStatement(s.ctx, model.StatementFilter{UserUuid: userUUID, Offset: -1})
+	s.Require().NoError(err)
+	s.Equal(700.0, statement.Balance)
+	s.Equal(testCurrency, statement.Currency)
+	s.Equal(lines, statement.Lines)
+}
+
+func (s *ServiceSuite) TestGetStatementLimitCapped() {
+	userUUID := uuid.New()
+	s.walletRepository.On("GetAccount", mock.Anything, mock.Anything).Return(model.Account{}, model.ErrAccountNotFound)
+	s.walletRepository.On("ListStatement", mock.Anything, mock.MatchedBy(func(filter model.StatementFilter) bool {
+		return filter.Limit == maxStatementLimit
+	})).Return([]model.StatementLine{}, nil)
+
+	statement, err := s.service.GetStatement(s.ctx, model.StatementFilter{UserUuid: userUUID, Limit: 10_000})
+	s.Require().NoError(err)
+	s.Zero(statement.Balance)
+	s.Empty(statement.Lines)
+}
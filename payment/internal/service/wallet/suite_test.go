@@ -0,0 +1,40 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/repository/mocks"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+const testCurrency = "RUB"
+
+type ServiceSuite struct {
+	suite.Suite
+
+	ctx context.Context
+
+	walletRepository *mocks.WalletRepository
+
+	service *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	logger.InitForBenchmark()
+
+	s.ctx = context.Background()
+
+	s.walletRepository = mocks.NewWalletRepository(s.T())
+
+	s.service = NewService(s.walletRepository, testCurrency)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
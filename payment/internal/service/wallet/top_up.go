@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/tracing"
+)
+
+func (s *service) TopUp(ctx context.Context, topUp model.TopUp) (model.JournalEntry, model.Account, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallet.top_up",
+		trace.WithAttributes(
+			attribute.String("user.uuid", topUp.UserUuid.String()),
+			attribute.Float64("wallet.amount", topUp.Amount),
+		),
+	)
+	defer span.End()
+
+	// Доли копейки не принимаем: БД округлит сумму, и повтор с тем же ключом
+	// не совпадёт с проведённой операцией
+	if math.IsNaN(topUp.Amount) || math.IsInf(topUp.Amount, 0) || topUp.Amount <= 0 ||
+		float64(model.ToCents(topUp.Amount))/100 != topUp.Amount {
+		return model.JournalEntry{}, model.Account{}, model.ErrInvalidAmount
+	}
+
+	currency, err := s.checkCurrency(topUp.Currency)
+	if err != nil {
+		return model.JournalEntry{}, model.Account{}, err
+	}
+
+	// Без ключа каждое пополнение считается новым
+	reference := strings.TrimSpace(topUp.IdempotencyKey)
+	if reference == "" {
+		reference = uuid.NewString()
+	}
+
+	key := model.UserAccount(topUp.UserUuid, currency)
+	entry, err := s.walletRepository.Transfer(ctx, model.Transfer{
+		Kind:      model.EntryKindTopUp,
+		Reference: reference,
+		From:      model.SystemAccount(model.AccountKindFunding, currency),
+		To:        key,
+		Amount:    topUp.Amount,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to top up wallet")
+		logger.Error(ctx,
+			"failed to top up wallet",
+			zap.String("userUUID", topUp.UserUuid.String()),
+			zap.Float64("amount", topUp.Amount),
+			zap.String("reference", reference),
+			zap.Error(err),
+		)
+		return model.JournalEntry{}, model.Account{}, err
+	}
+
+	account, err := s.account(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		return model.JournalEntry{}, model.Account{}, err
+	}
+
+	logger.Info(ctx,
+		"wallet topped up",
+		zap.String("userUUID", topUp.UserUuid.String()),
+		zap.String("entryUUID", entry.Uuid.String()),
+		zap.Float64("amount", topUp.Amount),
+	)
+
+	return entry, account, nil
+}
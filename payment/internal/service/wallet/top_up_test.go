@@ -0,0 +1,69 @@
+package wallet
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nkolesnikov999/micro2-OK/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestTopUp() {
+	userUUID := uuid.New()
+	key := model.UserAccount(userUUID, testCurrency)
+	transfer := model.Transfer{
+		Kind:      model.EntryKindTopUp,
+		Reference: "top-up-1",
+		From:      model.SystemAccount(model.AccountKindFunding, testCurrency),
+		To:        key,
+		Amount:    500,
+	}
+	entry := model.JournalEntry{Uuid: uuid.New(), Kind: model.EntryKindTopUp, Reference: "top-up-1", Amount: 500}
+	account := model.Account{Uuid: uuid.New(), Key: key, Balance: 700}
+
+	s.walletRepository.On("Transfer", mock.Anything, transfer).Return(entry, nil)
+	s.walletRepository.On("GetAccount", mock.Anything, key).Return(account, nil)
+
+	resultEntry, resultAccount, err := s.service.TopUp(s.ctx, model.TopUp{
+		UserUuid:       userUUID,
+		Amount:         500,
+		IdempotencyKey: "top-up-1",
+	})
+	s.Require().NoError(err)
+	s.Equal(entry, resultEntry)
+	s.Equal(account, resultAccount)
+}
+
+func (s *ServiceSuite) TestTopUpWithoutIdempotencyKey() {
+	userUUID := uuid.New()
+	key := model.UserAccount(userUUID, testCurrency)
+	s.walletRepository.On("Transfer", mock.Anything, mock.MatchedBy(func(transfer model.Transfer) bool {
+		_, err := uuid.Parse(transfer.Reference)
+		return err == nil && transfer.To == key
+	})).Return(model.JournalEntry{Uuid: uuid.New()}, nil)
+	s.walletRepository.On("GetAccount", mock.Anything, key).Return(model.Account{Key: key, Balance: 100}, nil)
+
+	_, account, err := s.service.TopUp(s.ctx, model.TopUp{UserUuid: userUUID, Amount: 100})
+	s.Require().NoError(err)
+	s.Equal(100.0, account.Balance)
+}
+
+func (s *ServiceSuite) TestTopUpInvalidAmount() {
+	for _, amount := range []float64{0, -10, 10.005, math.NaN(), math.Inf(1)} {
+		_, _, err := s.service.TopUp(s.ctx, model.TopUp{UserUuid: uuid.New(), Amount: amount})
+		s.Require().ErrorIs(err, model.ErrInvalidAmount)
+	}
+}
+
+func (s *ServiceSuite) TestTopUpUnsupportedCurrency() {
+	_, _, err := s.service.TopUp(s.ctx, model.TopUp{UserUuid: uuid.New(), Amount: 100, Currency: "EUR"})
+	s.Require().ErrorIs(err, model.ErrUnsupportedCurrency)
+}
+
+func (s *ServiceSuite) TestTopUpConflict() {
+	s.walletRepository.On("Transfer", mock.Anything, mock.Anything).Return(model.JournalEntry{}, model.ErrEntryConflict)
+
+	_, _, err := s.service.TopUp(s.ctx, model.TopUp{UserUuid: uuid.New(), Amount: 100, IdempotencyKey: "top-up-1"})
+	s.Require().ErrorIs(err, model.ErrEntryConflict)
+}
@@ -0,0 +1,67 @@
+// Package webhook описывает подпись уведомлений платёжного провайдера.
+// Подпись — HMAC-SHA256 от строки "<timestamp>.<body>" на общем секрете,
+// timestamp (unix-секунды) передаётся отдельным заголовком и защищает от повторов.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-PSP-Signature"
+	TimestampHeader = "X-PSP-Timestamp"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrExpiredSignature = errors.New("webhook signature expired")
+)
+
+// Sign возвращает значение заголовка подписи для тела уведомления
+func Sign(secret []byte, timestamp time.Time, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(mac(secret, timestamp.Unix(), body))
+}
+
+// Verify проверяет подпись и свежесть уведомления относительно now
+func Verify(secret []byte, timestampHeader, signatureHeader string, body []byte, now time.Time, tolerance time.Duration) error {
+	unix, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	hexSignature, ok := strings.CutPrefix(signatureHeader, signaturePrefix)
+	if !ok {
+		return ErrInvalidSignature
+	}
+	signature, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal(signature, mac(secret, unix, body)) {
+		return ErrInvalidSignature
+	}
+
+	age := now.Sub(time.Unix(unix, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrExpiredSignature
+	}
+
+	return nil
+}
+
+func mac(secret []byte, unix int64, body []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(strconv.FormatInt(unix, 10)))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SignatureSuite struct {
+	suite.Suite
+
+	secret []byte
+	body   []byte
+	now    time.Time
+}
+
+func (s *SignatureSuite) SetupTest() {
+	s.secret = []byte("test-secret")
+	s.body = []byte(`{"reference":"r1","status":"succeeded"}`)
+	s.now = time.Unix(1_760_000_000, 0)
+}
+
+func (s *SignatureSuite) verify(timestamp time.Time, signature string, body []byte) error {
+	return Verify(s.secret, strconv.FormatInt(timestamp.Unix(), 10), signature, body, s.now, 5*time.Minute)
+}
+
+func (s *SignatureSuite) TestValidSignature() {
+	signature := Sign(s.secret, s.now, s.body)
+	s.Require().NoError(s.verify(s.now, signature, s.body))
+
+	// Небольшое расхождение часов допустимо
+	earlier := s.now.Add(-4 * time.Minute)
+	s.Require().NoError(s.verify(earlier, Sign(s.secret, earlier, s.body), s.body))
+}
+
+func (s *SignatureSuite) TestTamperedBody() {
+	signature := Sign(s.secret, s.now, s.body)
+	err := s.verify(s.now, signature, []byte(`{"reference":"r1","status":"declined"}`))
+	s.Require().ErrorIs(err, ErrInvalidSignature)
+}
+
+func (s *SignatureSuite) TestWrongSecret() {
+	signature := Sign([]byte("other-secret"), s.now, s.body)
+	s.Require().ErrorIs(s.verify(s.now, signature, s.body), ErrInvalidSignature)
+}
+
+func (s *SignatureSuite) TestTimestampIsSigned() {
+	signature := Sign(s.secret, s.now, s.body)
+	s.Require().ErrorIs(s.verify(s.now.Add(time.Second), signature, s.body), ErrInvalidSignature)
+}
+
+func (s *SignatureSuite) TestExpiredSignature() {
+	old := s.now.Add(-10 * time.Minute)
+	s.Require().ErrorIs(s.verify(old, Sign(s.secret, old, s.body), s.body), ErrExpiredSignature)
+}
+
+func (s *SignatureSuite) TestMalformedHeaders() {
+	signature := Sign(s.secret, s.now, s.body)
+
+	s.Require().ErrorIs(Verify(s.secret, "", signature, s.body, s.now, time.Minute), ErrInvalidSignature)
+	s.Require().ErrorIs(Verify(s.secret, "abc", signature, s.body, s.now, time.Minute), ErrInvalidSignature)
+	s.Require().ErrorIs(s.verify(s.now, signature[len("sha256="):], s.body), ErrInvalidSignature)
+	s.Require().ErrorIs(s.verify(s.now, "sha256=zz", s.body), ErrInvalidSignature)
+}
+
+func TestSignature(t *testing.T) {
+	suite.Run(t, new(SignatureSuite))
+}
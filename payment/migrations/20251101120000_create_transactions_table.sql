@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE transactions (
+    transaction_uuid UUID PRIMARY KEY,
+    order_uuid UUID NOT NULL,
+    user_uuid UUID NOT NULL,
+    amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    payment_method TEXT NOT NULL,
+    status TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX transactions_order_uuid_idx ON transactions (order_uuid);
+CREATE INDEX transactions_user_uuid_created_at_idx ON transactions (user_uuid, created_at DESC);
+
+-- +goose Down
+DROP TABLE transactions;
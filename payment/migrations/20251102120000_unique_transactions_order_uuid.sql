@@ -0,0 +1,8 @@
+-- +goose Up
+-- order_uuid служит ключом идемпотентности PayOrder: на заказ не больше одной транзакции
+DROP INDEX transactions_order_uuid_idx;
+CREATE UNIQUE INDEX transactions_order_uuid_key ON transactions (order_uuid);
+
+-- +goose Down
+DROP INDEX transactions_order_uuid_key;
+CREATE INDEX transactions_order_uuid_idx ON transactions (order_uuid);
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Существующие транзакции проводились в рублях
+ALTER TABLE transactions ADD COLUMN currency TEXT NOT NULL DEFAULT 'RUB';
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN currency;
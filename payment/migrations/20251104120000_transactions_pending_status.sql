@@ -0,0 +1,11 @@
+-- +goose Up
+-- Неуспешные попытки не блокируют повторную оплату заказа:
+-- уникален только order_uuid транзакций в статусах PENDING и SUCCEEDED
+DROP INDEX transactions_order_uuid_key;
+CREATE UNIQUE INDEX transactions_order_uuid_key ON transactions (order_uuid) WHERE status <> 'FAILED';
+CREATE INDEX transactions_order_uuid_idx ON transactions (order_uuid);
+
+-- +goose Down
+DROP INDEX transactions_order_uuid_idx;
+DROP INDEX transactions_order_uuid_key;
+CREATE UNIQUE INDEX transactions_order_uuid_key ON transactions (order_uuid);
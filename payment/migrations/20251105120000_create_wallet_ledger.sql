@@ -0,0 +1,47 @@
+-- +goose Up
+-- Журнал двойной записи для кошельков INVESTOR_MONEY. Каждая операция (journal_entries)
+-- состоит из двух проводок (journal_postings) с противоположными суммами,
+-- поэтому сумма остатков всех счетов всегда равна нулю
+CREATE TABLE wallet_accounts (
+    account_uuid UUID PRIMARY KEY,
+    kind TEXT NOT NULL,
+    -- Для системных счетов (FUNDING, REVENUE) — нулевой UUID
+    owner_uuid UUID NOT NULL,
+    currency TEXT NOT NULL,
+    balance DECIMAL(14,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- Кошелёк пользователя не уходит в минус: нарушение означает нехватку средств
+    CONSTRAINT wallet_accounts_user_balance_check CHECK (kind <> 'USER' OR balance >= 0)
+);
+
+CREATE UNIQUE INDEX wallet_accounts_key ON wallet_accounts (kind, owner_uuid, currency);
+
+CREATE TABLE journal_entries (
+    entry_uuid UUID PRIMARY KEY,
+    kind TEXT NOT NULL,
+    -- UUID транзакции для PAYMENT, ключ идемпотентности для TOP_UP
+    reference TEXT NOT NULL,
+    amount DECIMAL(14,2) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX journal_entries_reference_key ON journal_entries (kind, reference);
+
+CREATE TABLE journal_postings (
+    posting_id BIGSERIAL PRIMARY KEY,
+    entry_uuid UUID NOT NULL REFERENCES journal_entries (entry_uuid),
+    account_uuid UUID NOT NULL REFERENCES wallet_accounts (account_uuid),
+    -- Положительная сумма — зачисление на счёт, отрицательная — списание
+    amount DECIMAL(14,2) NOT NULL,
+    balance_after DECIMAL(14,2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX journal_postings_account_uuid_idx ON journal_postings (account_uuid, posting_id DESC);
+CREATE INDEX journal_postings_entry_uuid_idx ON journal_postings (entry_uuid);
+
+-- +goose Down
+DROP TABLE journal_postings;
+DROP TABLE journal_entries;
+DROP TABLE wallet_accounts;
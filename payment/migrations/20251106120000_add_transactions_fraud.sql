@@ -0,0 +1,8 @@
+-- +goose Up
+-- Пустое решение — транзакция создана до появления антифрода
+ALTER TABLE transactions ADD COLUMN fraud_decision TEXT NOT NULL DEFAULT '';
+ALTER TABLE transactions ADD COLUMN fraud_reason TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN fraud_reason;
+ALTER TABLE transactions DROP COLUMN fraud_decision;
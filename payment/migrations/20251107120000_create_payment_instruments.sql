@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE payment_instruments (
+    instrument_uuid UUID PRIMARY KEY,
+    user_uuid UUID NOT NULL,
+    payment_method TEXT NOT NULL,
+    -- Токен карты и телефон СБП хранятся зашифрованными (AES-256-GCM, ключ из конфига)
+    card_token_encrypted TEXT NOT NULL DEFAULT '',
+    card_last4 TEXT NOT NULL DEFAULT '',
+    sbp_phone_encrypted TEXT NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX payment_instruments_user_uuid_idx ON payment_instruments (user_uuid, created_at DESC);
+-- У пользователя не больше одного инструмента по умолчанию
+CREATE UNIQUE INDEX payment_instruments_user_default_idx ON payment_instruments (user_uuid) WHERE is_default;
+
+-- Инструмент может быть удалён после оплаты, поэтому без внешнего ключа
+ALTER TABLE transactions ADD COLUMN instrument_uuid UUID;
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN instrument_uuid;
+DROP TABLE payment_instruments;
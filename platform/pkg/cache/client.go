@@ -10,10 +10,18 @@ type RedisClient interface {
 	SetWithTTL(ctx context.Context, key string, value any, ttl time.Duration) error
 	Get(ctx context.Context, key string) ([]byte, error)
 	HashSet(ctx context.Context, key string, values any) error
+	// HashSetIfExists атомарно обновляет поля hash и выставляет срок жизни ключа,
+	// только если ключ существует. Возвращает false, если ключа нет
+	HashSetIfExists(ctx context.Context, key string, values any, expireAt time.Time) (bool, error)
 	HGetAll(ctx context.Context, key string) ([]any, error)
 	Del(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
 	Expire(ctx context.Context, key string, expiration time.Duration) error
+	// ExtendExpire выставляет TTL ключу без срока жизни, а существующий TTL только увеличивает
+	ExtendExpire(ctx context.Context, key string, expiration time.Duration) error
+	// IncrWithTTL увеличивает счётчик и возвращает новое значение.
+	// TTL выставляется только при создании ключа, поэтому окно не продлевается
+	IncrWithTTL(ctx context.Context, key string, ttl time.Duration) (int64, error)
 	Ping(ctx context.Context) error
 	SetOperator
 }
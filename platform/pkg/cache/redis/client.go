@@ -90,6 +90,32 @@ func (c *client) HashSet(ctx context.Context, key string, values any) error {
 	})
 }
 
+// hashSetIfExistsScript: EXISTS и HSET/PEXPIREAT выполняются одной командой,
+// поэтому удалённый параллельно ключ не воскресает неполным hash
+var hashSetIfExistsScript = redigo.NewScript(1, `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("HSET", KEYS[1], unpack(ARGV, 2))
+redis.call("PEXPIREAT", KEYS[1], ARGV[1])
+return 1
+`)
+
+func (c *client) HashSetIfExists(ctx context.Context, key string, values any, expireAt time.Time) (bool, error) {
+	var updated bool
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		args := redigo.Args{key, expireAt.UnixMilli()}.AddFlat(values)
+		val, err := redigo.Bool(hashSetIfExistsScript.Do(conn, args...))
+		if err != nil {
+			return err
+		}
+		updated = val
+		return nil
+	})
+
+	return updated, err
+}
+
 func (c *client) HGetAll(ctx context.Context, key string) ([]any, error) {
 	var values []any
 	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
@@ -132,6 +158,47 @@ func (c *client) Expire(ctx context.Context, key string, expiration time.Duratio
 	})
 }
 
+func (c *client) ExtendExpire(ctx context.Context, key string, expiration time.Duration) error {
+	return c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		// GT считает ключ без TTL бессрочным, поэтому сначала NX выставляет срок новому ключу
+		if err := conn.Send("MULTI"); err != nil {
+			return err
+		}
+		if err := conn.Send("EXPIRE", key, int(expiration.Seconds()), "NX"); err != nil {
+			return err
+		}
+		if err := conn.Send("EXPIRE", key, int(expiration.Seconds()), "GT"); err != nil {
+			return err
+		}
+		_, err := conn.Do("EXEC")
+		return err
+	})
+}
+
+func (c *client) IncrWithTTL(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var count int64
+	err := c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
+		// MULTI/EXEC: счётчик не останется без TTL между INCR и EXPIRE
+		if err := conn.Send("MULTI"); err != nil {
+			return err
+		}
+		if err := conn.Send("INCR", key); err != nil {
+			return err
+		}
+		if err := conn.Send("EXPIRE", key, int(ttl.Seconds()), "NX"); err != nil {
+			return err
+		}
+		values, err := redigo.Values(conn.Do("EXEC"))
+		if err != nil {
+			return err
+		}
+		count, err = redigo.Int64(values[0], nil)
+		return err
+	})
+
+	return count, err
+}
+
 func (c *client) Ping(ctx context.Context) error {
 	return c.withConn(ctx, func(ctx context.Context, conn redigo.Conn) error {
 		_, err := conn.Do("PING")
@@ -18,6 +18,8 @@ import (
 const (
 	// SessionUUIDMetadataKey ключ для передачи UUID сессии в gRPC metadata
 	SessionUUIDMetadataKey = "session-uuid"
+	// EnvoySessionUUIDMetadataKey заголовок с UUID сессии, который Envoy добавляет после ext_authz
+	EnvoySessionUUIDMetadataKey = "x-session-uuid"
 )
 
 type contextKey string
@@ -34,13 +36,16 @@ type IAMClient = authV1.AuthServiceClient
 
 // AuthInterceptor interceptor для аутентификации gRPC запросов
 type AuthInterceptor struct {
-	iamClient IAMClient
+	iamClient     IAMClient
+	publicMethods []string
 }
 
-// NewAuthInterceptor создает новый interceptor аутентификации
-func NewAuthInterceptor(iamClient IAMClient) *AuthInterceptor {
+// NewAuthInterceptor создает новый interceptor аутентификации.
+// publicMethods (полные имена или префиксы сервисов) не требуют сессии, например health check
+func NewAuthInterceptor(iamClient IAMClient, publicMethods ...string) *AuthInterceptor {
 	return &AuthInterceptor{
-		iamClient: iamClient,
+		iamClient:     iamClient,
+		publicMethods: publicMethods,
 	}
 }
 
@@ -52,6 +57,10 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
+		if isPublicMethod(i.publicMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
 		authCtx, err := i.authenticate(ctx)
 		if err != nil {
 			return nil, err
@@ -61,6 +70,37 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	}
 }
 
+// Stream возвращает stream server interceptor для аутентификации
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if isPublicMethod(i.publicMethods, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		authCtx, err := i.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: authCtx})
+	}
+}
+
+// authServerStream подменяет контекст потока на контекст с пользователем
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
 // authenticate выполняет аутентификацию и добавляет пользователя в контекст
 func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
 	// Извлекаем metadata из контекста
@@ -85,8 +125,11 @@ func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, er
 		)
 	}
 
-	// Получаем session UUID из metadata
+	// Получаем session UUID из metadata: от сервисов — session-uuid, через Envoy — x-session-uuid
 	sessionUUIDs := md.Get(SessionUUIDMetadataKey)
+	if len(sessionUUIDs) == 0 {
+		sessionUUIDs = md.Get(EnvoySessionUUIDMetadataKey)
+	}
 	if len(sessionUUIDs) == 0 {
 		logger.Warn(ctx, "[AuthInterceptor] missing session-uuid in metadata",
 			zap.String("expected_key", SessionUUIDMetadataKey),
@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/auth/v1"
+	commonV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/common/v1"
+)
+
+const testStreamMethod = "/inventory.v1.InventoryService/StreamParts"
+
+// fakeIAMClient отвечает на Whoami по известным сессиям
+type fakeIAMClient struct {
+	authV1.AuthServiceClient
+	users map[string]*commonV1.User
+}
+
+func (c *fakeIAMClient) Whoami(_ context.Context, req *authV1.WhoamiRequest, _ ...grpc.CallOption) (*authV1.WhoamiResponse, error) {
+	user, ok := c.users[req.GetSessionUuid()]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "session not found")
+	}
+	return &authV1.WhoamiResponse{User: user}, nil
+}
+
+// fakeServerStream — поток с заданным контекстом
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func newTestAuthInterceptor(publicMethods ...string) *AuthInterceptor {
+	return NewAuthInterceptor(&fakeIAMClient{users: map[string]*commonV1.User{
+		"session-1": {Uuid: "user-1", Permissions: []string{"catalog:read"}},
+	}}, publicMethods...)
+}
+
+func incomingContext(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func TestAuthInterceptorStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode codes.Code
+		wantUser string
+	}{
+		{
+			name:     "without metadata",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "without session",
+			ctx:      incomingContext("user-agent", "test"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "unknown session",
+			ctx:      incomingContext(SessionUUIDMetadataKey, "unknown"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "session from service",
+			ctx:      incomingContext(SessionUUIDMetadataKey, "session-1"),
+			wantCode: codes.OK,
+			wantUser: "user-1",
+		},
+		{
+			name:     "session from envoy",
+			ctx:      incomingContext(EnvoySessionUUIDMetadataKey, "session-1"),
+			wantCode: codes.OK,
+			wantUser: "user-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handlerCtx context.Context
+			handler := func(_ any, ss grpc.ServerStream) error {
+				handlerCtx = ss.Context()
+				return nil
+			}
+
+			err := newTestAuthInterceptor().Stream()(
+				nil,
+				&fakeServerStream{ctx: tt.ctx},
+				&grpc.StreamServerInfo{FullMethod: testStreamMethod, IsServerStream: true},
+				handler,
+			)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				require.Nil(t, handlerCtx, "handler must not run")
+				return
+			}
+
+			user, ok := GetUserFromContext(handlerCtx)
+			require.True(t, ok)
+			require.Equal(t, tt.wantUser, user.GetUuid())
+
+			sessionUUID, ok := GetSessionUUIDFromContext(handlerCtx)
+			require.True(t, ok)
+			require.Equal(t, "session-1", sessionUUID)
+		})
+	}
+}
+
+func TestAuthInterceptorUnaryPublicMethod(t *testing.T) {
+	interceptor := newTestAuthInterceptor("/grpc.health.v1.Health/")
+
+	called := false
+	_, err := interceptor.Unary()(
+		context.Background(),
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+		func(context.Context, any) (any, error) {
+			called = true
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, called)
+
+	_, err = interceptor.Unary()(
+		context.Background(),
+		nil,
+		&grpc.UnaryServerInfo{FullMethod: "/inventory.v1.InventoryService/GetPart"},
+		func(context.Context, any) (any, error) {
+			return nil, errors.New("handler must not run")
+		},
+	)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// Права проверяются по пользователю, которого AuthInterceptor положил в контекст потока
+func TestAuthAndPermissionStreamChain(t *testing.T) {
+	auth := newTestAuthInterceptor()
+
+	tests := []struct {
+		name     string
+		required []string
+		wantCode codes.Code
+	}{
+		{name: "granted", required: []string{"catalog:read"}, wantCode: codes.OK},
+		{name: "missing", required: []string{"catalog:write"}, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions := NewPermissionInterceptor(MethodPermissions{testStreamMethod: tt.required})
+			info := &grpc.StreamServerInfo{FullMethod: testStreamMethod, IsServerStream: true}
+
+			// Так grpc.ChainStreamInterceptor вызывает цепочку: auth, затем permissions
+			err := auth.Stream()(nil, &fakeServerStream{ctx: incomingContext(SessionUUIDMetadataKey, "session-1")}, info,
+				func(srv any, ss grpc.ServerStream) error {
+					return permissions.Stream()(srv, ss, info, func(any, grpc.ServerStream) error { return nil })
+				},
+			)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// PermissionsMetadataKey заголовок с правами пользователя через запятую,
+// который Envoy добавляет после ext_authz. Ему можно доверять, только если
+// сервис доступен клиентам исключительно через Envoy
+const PermissionsMetadataKey = "x-user-permissions"
+
+// MethodPermissions задаёт права, необходимые для вызова метода.
+// Ключ — полное имя метода или префикс сервиса, как в ServiceAllowList;
+// нужны все перечисленные права. Методы без правила доступны всем
+type MethodPermissions map[string][]string
+
+// PermissionInterceptor interceptor для проверки прав пользователя на вызов метода
+type PermissionInterceptor struct {
+	rules MethodPermissions
+}
+
+// NewPermissionInterceptor создает interceptor проверки прав.
+// Ставится после AuthInterceptor, если сервис проверяет сессию сам
+func NewPermissionInterceptor(rules MethodPermissions) *PermissionInterceptor {
+	return &PermissionInterceptor{
+		rules: rules,
+	}
+}
+
+// Unary возвращает unary server interceptor проверки прав
+func (i *PermissionInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := i.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream возвращает stream server interceptor проверки прав
+func (i *PermissionInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := i.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func (i *PermissionInterceptor) authorize(ctx context.Context, fullMethod string) error {
+	required := methodRule(i.rules, fullMethod)
+	if len(required) == 0 {
+		return nil
+	}
+
+	err := RequirePermissions(ctx, required...)
+	if err != nil {
+		logger.Warn(ctx, "[PermissionInterceptor] access denied",
+			zap.String("method", fullMethod),
+			zap.Strings("required", required),
+			zap.Error(err),
+		)
+	}
+
+	return err
+}
+
+// RequirePermissions проверяет права пользователя из контекста.
+// Подходит для проверок в обработчике, когда нужные права зависят от запроса
+func RequirePermissions(ctx context.Context, required ...string) error {
+	granted, ok := GetPermissionsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+
+	if missing := MissingPermissions(granted, required...); len(missing) > 0 {
+		return status.Errorf(codes.PermissionDenied, "missing permissions: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// GetPermissionsFromContext возвращает права пользователя: из контекста AuthInterceptor
+// или из заголовка Envoy. ok == false, если пользователь не аутентифицирован
+func GetPermissionsFromContext(ctx context.Context) ([]string, bool) {
+	if user, ok := GetUserFromContext(ctx); ok {
+		return user.GetPermissions(), true
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	values := md.Get(PermissionsMetadataKey)
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	return ParsePermissions(values...), true
+}
+
+// ParsePermissions разбирает значения заголовка с правами через запятую
+func ParsePermissions(values ...string) []string {
+	var permissions []string
+	for _, value := range values {
+		for _, permission := range strings.Split(value, ",") {
+			if permission = strings.TrimSpace(permission); permission != "" {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions
+}
+
+// MissingPermissions возвращает права из required, которых нет среди granted
+func MissingPermissions(granted []string, required ...string) []string {
+	has := make(map[string]struct{}, len(granted))
+	for _, permission := range granted {
+		has[permission] = struct{}{}
+	}
+
+	var missing []string
+	for _, permission := range required {
+		if _, ok := has[permission]; !ok {
+			missing = append(missing, permission)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	commonV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/common/v1"
+)
+
+func TestMethodRule(t *testing.T) {
+	rules := map[string][]string{
+		"/inventory.v1.InventoryService/":           {"catalog:read"},
+		"/inventory.v1.InventoryService/CreatePart": {"catalog:write"},
+		"/inventory.v1.InventoryService/ListParts":  nil,
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		want   []string
+	}{
+		{name: "exact", method: "/inventory.v1.InventoryService/CreatePart", want: []string{"catalog:write"}},
+		{name: "service prefix", method: "/inventory.v1.InventoryService/GetPart", want: []string{"catalog:read"}},
+		{name: "empty exact rule overrides prefix", method: "/inventory.v1.InventoryService/ListParts", want: nil},
+		{name: "no rule", method: "/payment.v1.PaymentService/PayOrder", want: nil},
+		{name: "malformed method", method: "PayOrder", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, methodRule(rules, tt.method))
+		})
+	}
+}
+
+func TestMissingPermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required []string
+		want     []string
+	}{
+		{name: "all granted", granted: []string{"orders:read", "orders:write"}, required: []string{"orders:write"}, want: nil},
+		{name: "nothing required", granted: nil, required: nil, want: nil},
+		{name: "some missing", granted: []string{"orders:read"}, required: []string{"orders:read", "orders:write", "users:admin"}, want: []string{"orders:write", "users:admin"}},
+		{name: "nothing granted", granted: nil, required: []string{"catalog:write"}, want: []string{"catalog:write"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, MissingPermissions(tt.granted, tt.required...))
+		})
+	}
+}
+
+func TestParsePermissions(t *testing.T) {
+	require.Equal(t,
+		[]string{"catalog:read", "orders:read", "orders:write"},
+		ParsePermissions(" catalog:read, orders:read,,", "orders:write"),
+	)
+	require.Nil(t, ParsePermissions(""))
+}
+
+func TestRequirePermissions(t *testing.T) {
+	user := &commonV1.User{Permissions: []string{"catalog:read"}}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		required []string
+		wantCode codes.Code
+	}{
+		{name: "user granted", ctx: context.WithValue(context.Background(), userContextKey, user), required: []string{"catalog:read"}, wantCode: codes.OK},
+		{name: "user missing", ctx: context.WithValue(context.Background(), userContextKey, user), required: []string{"catalog:write"}, wantCode: codes.PermissionDenied},
+		{
+			name:     "envoy header",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs(PermissionsMetadataKey, "catalog:read,catalog:write")),
+			required: []string{"catalog:write"},
+			wantCode: codes.OK,
+		},
+		{
+			// Пользователь из контекста важнее заголовка
+			name: "user overrides header",
+			ctx: metadata.NewIncomingContext(
+				context.WithValue(context.Background(), userContextKey, user),
+				metadata.Pairs(PermissionsMetadataKey, "catalog:write"),
+			),
+			required: []string{"catalog:write"},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "anonymous", ctx: context.Background(), required: []string{"catalog:read"}, wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantCode, status.Code(RequirePermissions(tt.ctx, tt.required...)))
+		})
+	}
+}
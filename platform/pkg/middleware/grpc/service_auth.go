@@ -0,0 +1,244 @@
+package grpc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+// Ключи gRPC metadata сервисного токена. Подпись — HMAC-SHA256 на ключе
+// сервиса-отправителя от строки "<service>.<full method>.<timestamp>.<digest>",
+// где digest — SHA-256 детерминированной proto-сериализации запроса unary-вызова
+// (для stream пустой). Токен одного RPC не подходит ни для другого метода, ни для другого тела.
+//
+// Ограничение: транспорт без TLS, и перехваченный токен можно повторить с тем же телом
+// в пределах tolerance. Поэтому методы под сервисной аутентификацией должны быть
+// идемпотентны (PayOrder по order_uuid, TopUp по idempotency_key), а в продакшене
+// соединение между сервисами нужно закрывать TLS (grpc.Creds вместо insecure)
+const (
+	ServiceNameMetadataKey      = "x-service-name"
+	ServiceTimestampMetadataKey = "x-service-timestamp"
+	ServiceSignatureMetadataKey = "x-service-signature"
+)
+
+// serviceContextKey ключ для хранения имени вызывающего сервиса в контексте
+const serviceContextKey contextKey = "service"
+
+// ServiceAllowList задаёт, каким сервисам разрешён вызов.
+// Ключ — полное имя метода ("/payment.v1.PaymentService/PayOrder")
+// или префикс сервиса ("/payment.v1.WalletService/"); полное имя приоритетнее
+type ServiceAllowList map[string][]string
+
+// allowed проверяет, разрешён ли сервису вызов метода
+func (l ServiceAllowList) allowed(fullMethod, service string) bool {
+	return slices.Contains(methodRule(l, fullMethod), service)
+}
+
+// methodRule возвращает правило метода: по полному имени, иначе по префиксу сервиса
+func methodRule(rules map[string][]string, fullMethod string) []string {
+	if rule, ok := rules[fullMethod]; ok {
+		return rule
+	}
+
+	prefix := fullMethod[:strings.LastIndex(fullMethod, "/")+1]
+	return rules[prefix]
+}
+
+// ServiceAuthInterceptor interceptor для аутентификации вызовов между сервисами
+type ServiceAuthInterceptor struct {
+	keys          map[string][]byte
+	tolerance     time.Duration
+	allowList     ServiceAllowList
+	publicMethods []string
+}
+
+// NewServiceAuthInterceptor создает interceptor сервисной аутентификации.
+// keys — ключи подписи по именам сервисов, tolerance — допустимый возраст токена.
+// publicMethods (полные имена или префиксы сервисов) не требуют токена, например health check
+func NewServiceAuthInterceptor(
+	keys map[string][]byte,
+	tolerance time.Duration,
+	allowList ServiceAllowList,
+	publicMethods ...string,
+) *ServiceAuthInterceptor {
+	return &ServiceAuthInterceptor{
+		keys:          keys,
+		tolerance:     tolerance,
+		allowList:     allowList,
+		publicMethods: publicMethods,
+	}
+}
+
+// Unary возвращает unary server interceptor сервисной аутентификации
+func (i *ServiceAuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		authCtx, err := i.authenticate(ctx, info.FullMethod, requestDigest(req))
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(authCtx, req)
+	}
+}
+
+// Stream возвращает stream server interceptor сервисной аутентификации
+func (i *ServiceAuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Сообщения потока ещё не прочитаны: подпись stream не покрывает тело
+		authCtx, err := i.authenticate(ss.Context(), info.FullMethod, "")
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: authCtx})
+	}
+}
+
+// authenticate проверяет подпись сервиса и его право на вызов метода
+func (i *ServiceAuthInterceptor) authenticate(ctx context.Context, fullMethod, digest string) (context.Context, error) {
+	if isPublicMethod(i.publicMethods, fullMethod) {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing service token")
+	}
+
+	service := firstValue(md, ServiceNameMetadataKey)
+	timestamp := firstValue(md, ServiceTimestampMetadataKey)
+	signature := firstValue(md, ServiceSignatureMetadataKey)
+	if service == "" || timestamp == "" || signature == "" {
+		logger.Warn(ctx, "[ServiceAuthInterceptor] missing service token",
+			zap.String("method", fullMethod),
+		)
+		return nil, status.Error(codes.Unauthenticated, "missing service token")
+	}
+
+	// Неизвестный сервис и неверная подпись неразличимы для вызывающего
+	key, ok := i.keys[service]
+	if !ok || !verifyServiceToken(key, service, fullMethod, digest, timestamp, signature, time.Now(), i.tolerance) {
+		logger.Warn(ctx, "[ServiceAuthInterceptor] invalid service token",
+			zap.String("service", service),
+			zap.String("method", fullMethod),
+		)
+		return nil, status.Error(codes.Unauthenticated, "invalid service token")
+	}
+
+	if !i.allowList.allowed(fullMethod, service) {
+		logger.Warn(ctx, "[ServiceAuthInterceptor] service is not allowed to call method",
+			zap.String("service", service),
+			zap.String("method", fullMethod),
+		)
+		return nil, status.Errorf(codes.PermissionDenied, "service %s is not allowed to call %s", service, fullMethod)
+	}
+
+	return context.WithValue(ctx, serviceContextKey, service), nil
+}
+
+// isPublicMethod проверяет, входит ли метод в публичные: по полному имени или префиксу сервиса
+func isPublicMethod(publicMethods []string, fullMethod string) bool {
+	for _, method := range publicMethods {
+		if fullMethod == method || (strings.HasSuffix(method, "/") && strings.HasPrefix(fullMethod, method)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceTokenUnaryClientInterceptor подписывает исходящие вызовы ключом сервиса
+func ServiceTokenUnaryClientInterceptor(service string, key []byte) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			ServiceNameMetadataKey, service,
+			ServiceTimestampMetadataKey, timestamp,
+			ServiceSignatureMetadataKey, signServiceToken(key, service, method, requestDigest(req), timestamp),
+		)
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// GetServiceFromContext возвращает имя сервиса, прошедшего сервисную аутентификацию
+func GetServiceFromContext(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(serviceContextKey).(string)
+	return service, ok
+}
+
+// requestDigest возвращает SHA-256 запроса в детерминированной proto-сериализации:
+// клиент и сервер получают одинаковый digest для одного и того же сообщения
+func requestDigest(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func signServiceToken(key []byte, service, fullMethod, digest, timestamp string) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(service + "." + fullMethod + "." + timestamp + "." + digest))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func verifyServiceToken(key []byte, service, fullMethod, digest, timestamp, signature string, now time.Time, tolerance time.Duration) bool {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	expected := signServiceToken(key, service, fullMethod, digest, timestamp)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return false
+	}
+
+	age := now.Sub(time.Unix(unix, 0))
+	return age <= tolerance && age >= -tolerance
+}
+
+func firstValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
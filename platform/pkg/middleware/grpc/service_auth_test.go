@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/auth/v1"
+)
+
+const (
+	testService       = "order-service"
+	testServiceMethod = "/payment.v1.PaymentService/PayOrder"
+)
+
+var testServiceKey = []byte("order-service-key")
+
+func TestVerifyServiceToken(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tolerance := time.Minute
+	digest := requestDigest(&authV1.WhoamiRequest{SessionUuid: "session-1"})
+	timestamp := func(at time.Time) string { return strconv.FormatInt(at.Unix(), 10) }
+
+	tests := []struct {
+		name      string
+		key       []byte
+		service   string
+		method    string
+		digest    string
+		timestamp string
+		want      bool
+	}{
+		{name: "valid", key: testServiceKey, service: testService, method: testServiceMethod, digest: digest, timestamp: timestamp(now), want: true},
+		{name: "at tolerance", key: testServiceKey, service: testService, method: testServiceMethod, digest: digest, timestamp: timestamp(now.Add(-tolerance)), want: true},
+		{name: "wrong key", key: []byte("other-key"), service: testService, method: testServiceMethod, digest: digest, timestamp: timestamp(now)},
+		{name: "wrong service", key: testServiceKey, service: "backoffice", method: testServiceMethod, digest: digest, timestamp: timestamp(now)},
+		{name: "wrong method", key: testServiceKey, service: testService, method: "/payment.v1.WalletService/TopUp", digest: digest, timestamp: timestamp(now)},
+		{name: "wrong body", key: testServiceKey, service: testService, method: testServiceMethod, digest: requestDigest(&authV1.WhoamiRequest{SessionUuid: "session-2"}), timestamp: timestamp(now)},
+		{name: "expired", key: testServiceKey, service: testService, method: testServiceMethod, digest: digest, timestamp: timestamp(now.Add(-tolerance - time.Second))},
+		{name: "from future", key: testServiceKey, service: testService, method: testServiceMethod, digest: digest, timestamp: timestamp(now.Add(tolerance + time.Second))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Подпись всегда от исходного вызова; проверка — с параметрами случая
+			signature := signServiceToken(testServiceKey, testService, testServiceMethod, digest, tt.timestamp)
+			got := verifyServiceToken(tt.key, tt.service, tt.method, tt.digest, tt.timestamp, signature, now, tolerance)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("malformed timestamp", func(t *testing.T) {
+		signature := signServiceToken(testServiceKey, testService, testServiceMethod, digest, "soon")
+		require.False(t, verifyServiceToken(testServiceKey, testService, testServiceMethod, digest, "soon", signature, now, tolerance))
+	})
+}
+
+func TestServiceAllowListPriority(t *testing.T) {
+	allowList := ServiceAllowList{
+		"/payment.v1.WalletService/":      {"backoffice", "order-service"},
+		"/payment.v1.WalletService/TopUp": {"backoffice"},
+		"/payment.v1.PaymentService/List": {},
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		service string
+		want    bool
+	}{
+		{name: "prefix allows", method: "/payment.v1.WalletService/GetBalance", service: "order-service", want: true},
+		{name: "exact overrides prefix", method: "/payment.v1.WalletService/TopUp", service: "order-service", want: false},
+		{name: "exact allows", method: "/payment.v1.WalletService/TopUp", service: "backoffice", want: true},
+		{name: "empty exact rule denies", method: "/payment.v1.PaymentService/List", service: "backoffice", want: false},
+		{name: "no rule denies", method: "/payment.v1.PaymentService/PayOrder", service: "order-service", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, allowList.allowed(tt.method, tt.service))
+		})
+	}
+}
+
+func TestIsPublicMethod(t *testing.T) {
+	publicMethods := []string{"/grpc.health.v1.Health/", "/inventory.v1.InventoryService/ListParts"}
+
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{method: "/grpc.health.v1.Health/Check", want: true},
+		{method: "/grpc.health.v1.Health/Watch", want: true},
+		{method: "/inventory.v1.InventoryService/ListParts", want: true},
+		{method: "/inventory.v1.InventoryService/ListPartsAdmin", want: false},
+		{method: "/inventory.v1.InventoryService/GetPart", want: false},
+		{method: "/grpc.health.v1.HealthX/Check", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			require.Equal(t, tt.want, isPublicMethod(publicMethods, tt.method))
+		})
+	}
+}
+
+// signedIncomingContext подписывает вызов клиентским interceptor'ом и отдаёт metadata как входящие
+func signedIncomingContext(t *testing.T, service string, key []byte, method string, req any) context.Context {
+	t.Helper()
+
+	var md metadata.MD
+	err := ServiceTokenUnaryClientInterceptor(service, key)(
+		context.Background(), method, req, nil, nil,
+		func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestServiceTokenRoundTrip(t *testing.T) {
+	interceptor := NewServiceAuthInterceptor(
+		map[string][]byte{testService: testServiceKey, "backoffice": []byte("backoffice-key")},
+		time.Minute,
+		ServiceAllowList{testServiceMethod: {testService}},
+	)
+	req := &authV1.WhoamiRequest{SessionUuid: "session-1"}
+
+	tests := []struct {
+		name      string
+		service   string
+		key       []byte
+		signedReq any
+		method    string
+		wantCode  codes.Code
+	}{
+		{name: "allowed", service: testService, key: testServiceKey, signedReq: req, method: testServiceMethod, wantCode: codes.OK},
+		{name: "not allowed", service: "backoffice", key: []byte("backoffice-key"), signedReq: req, method: testServiceMethod, wantCode: codes.PermissionDenied},
+		{name: "unknown service", service: "stranger", key: testServiceKey, signedReq: req, method: testServiceMethod, wantCode: codes.Unauthenticated},
+		{name: "wrong key", service: testService, key: []byte("other-key"), signedReq: req, method: testServiceMethod, wantCode: codes.Unauthenticated},
+		{name: "signed for another method", service: testService, key: testServiceKey, signedReq: req, method: "/payment.v1.PaymentService/GetTransaction", wantCode: codes.Unauthenticated},
+		{name: "body replaced", service: testService, key: testServiceKey, signedReq: &authV1.WhoamiRequest{SessionUuid: "session-2"}, method: testServiceMethod, wantCode: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handlerCtx context.Context
+			_, err := interceptor.Unary()(
+				signedIncomingContext(t, tt.service, tt.key, tt.method, tt.signedReq),
+				req,
+				&grpc.UnaryServerInfo{FullMethod: testServiceMethod},
+				func(ctx context.Context, _ any) (any, error) {
+					handlerCtx = ctx
+					return nil, nil
+				},
+			)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				require.Nil(t, handlerCtx, "handler must not run")
+				return
+			}
+
+			service, ok := GetServiceFromContext(handlerCtx)
+			require.True(t, ok)
+			require.Equal(t, tt.service, service)
+		})
+	}
+}
+
+func TestServiceAuthMissingToken(t *testing.T) {
+	interceptor := NewServiceAuthInterceptor(map[string][]byte{testService: testServiceKey}, time.Minute,
+		ServiceAllowList{testServiceMethod: {testService}}, "/grpc.health.v1.Health/")
+
+	_, err := interceptor.Unary()(context.Background(), nil,
+		&grpc.UnaryServerInfo{FullMethod: testServiceMethod},
+		func(context.Context, any) (any, error) { return nil, nil },
+	)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor.Unary()(context.Background(), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+		func(context.Context, any) (any, error) { return nil, nil },
+	)
+	require.NoError(t, err)
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+)
+
+// PermissionsHeader заголовок с правами пользователя, который Envoy добавляет после ext_authz
+const PermissionsHeader = "X-User-Permissions"
+
+// RoutePermissions задаёт права, необходимые для маршрута.
+// Ключ — шаблон http.ServeMux ("POST /api/v1/orders/{order_uuid}/refund");
+// нужны все перечисленные права. Маршруты без правила доступны всем
+type RoutePermissions map[string][]string
+
+// PermissionMiddleware middleware для проверки прав пользователя на маршрут
+type PermissionMiddleware struct {
+	mux   *http.ServeMux
+	rules RoutePermissions
+}
+
+// NewPermissionMiddleware создает middleware проверки прав.
+// Паникует на некорректном шаблоне, как http.ServeMux
+func NewPermissionMiddleware(rules RoutePermissions) *PermissionMiddleware {
+	// ServeMux используется только для сопоставления запроса с шаблоном
+	mux := http.NewServeMux()
+	for pattern := range rules {
+		mux.Handle(pattern, http.NotFoundHandler())
+	}
+
+	return &PermissionMiddleware{
+		mux:   mux,
+		rules: rules,
+	}
+}
+
+// Handle проверяет права на маршрут; ставится после AuthMiddleware
+func (m *PermissionMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, pattern := m.mux.Handler(r)
+		if pattern == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		RequirePermissions(m.rules[pattern]...)(next).ServeHTTP(w, r)
+	})
+}
+
+// RequirePermissions middleware для отдельного маршрута, например r.With(RequirePermissions("orders:write"))
+func RequirePermissions(required ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			granted, ok := permissionsFromRequest(r)
+			if !ok {
+				writeErrorResponse(w, http.StatusUnauthorized, "MISSING_SESSION", "Authentication required")
+				return
+			}
+
+			if missing := grpcAuth.MissingPermissions(granted, required...); len(missing) > 0 {
+				writeErrorResponse(w, http.StatusForbidden, "PERMISSION_DENIED",
+					"Missing permissions: "+strings.Join(missing, ", "))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// permissionsFromRequest возвращает права из контекста AuthMiddleware или из заголовка Envoy
+func permissionsFromRequest(r *http.Request) ([]string, bool) {
+	if user, ok := GetUserFromContext(r.Context()); ok {
+		return user.GetPermissions(), true
+	}
+
+	values := r.Header.Values(PermissionsHeader)
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	return grpcAuth.ParsePermissions(values...), true
+}
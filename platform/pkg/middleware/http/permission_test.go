@@ -0,0 +1,77 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	grpcAuth "github.com/nkolesnikov999/micro2-OK/platform/pkg/middleware/grpc"
+	commonV1 "github.com/nkolesnikov999/micro2-OK/shared/pkg/proto/common/v1"
+)
+
+func TestPermissionsFromRequest(t *testing.T) {
+	t.Run("user from context", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(PermissionsHeader, "orders:write")
+		r = r.WithContext(context.WithValue(r.Context(), grpcAuth.GetUserContextKey(),
+			&commonV1.User{Permissions: []string{"orders:read"}}))
+
+		permissions, ok := permissionsFromRequest(r)
+		require.True(t, ok)
+		require.Equal(t, []string{"orders:read"}, permissions)
+	})
+
+	t.Run("envoy header", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Add(PermissionsHeader, "orders:read, orders:write")
+		r.Header.Add(PermissionsHeader, "catalog:read")
+
+		permissions, ok := permissionsFromRequest(r)
+		require.True(t, ok)
+		require.Equal(t, []string{"orders:read", "orders:write", "catalog:read"}, permissions)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		_, ok := permissionsFromRequest(httptest.NewRequest(http.MethodGet, "/", nil))
+		require.False(t, ok)
+	})
+}
+
+func TestPermissionMiddleware(t *testing.T) {
+	handler := NewPermissionMiddleware(RoutePermissions{
+		"GET /api/v1/orders/{order_uuid}":      {"orders:read"},
+		"POST /api/v1/orders/{order_uuid}/pay": {"orders:write"},
+	}).Handle(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		permissions string
+		wantStatus  int
+	}{
+		{name: "granted", method: http.MethodGet, path: "/api/v1/orders/1", permissions: "orders:read", wantStatus: http.StatusNoContent},
+		{name: "missing", method: http.MethodPost, path: "/api/v1/orders/1/pay", permissions: "orders:read", wantStatus: http.StatusForbidden},
+		{name: "anonymous", method: http.MethodGet, path: "/api/v1/orders/1", wantStatus: http.StatusUnauthorized},
+		{name: "no rule", method: http.MethodGet, path: "/health", wantStatus: http.StatusNoContent},
+		{name: "method without rule", method: http.MethodDelete, path: "/api/v1/orders/1", wantStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.permissions != "" {
+				r.Header.Set(PermissionsHeader, tt.permissions)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+			require.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
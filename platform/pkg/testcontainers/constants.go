@@ -43,3 +43,13 @@ const (
 	RedisHostKey      = "REDIS_HOST"
 	RedisPortKey      = "REDIS_PORT"
 )
+
+// Kafka constants
+const (
+	// Kafka container constants
+	KafkaContainerName = "kafka"
+
+	// Kafka environment variables
+	KafkaImageNameKey = "KAFKA_IMAGE_NAME"
+	KafkaBrokersKey   = "KAFKA_BROKERS"
+)
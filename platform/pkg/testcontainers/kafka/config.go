@@ -0,0 +1,53 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types/container"
+	"go.uber.org/zap"
+
+	"github.com/nkolesnikov999/micro2-OK/platform/pkg/logger"
+)
+
+type Logger interface {
+	Info(ctx context.Context, msg string, fields ...zap.Field)
+	Error(ctx context.Context, msg string, fields ...zap.Field)
+}
+
+type Config struct {
+	NetworkName   string
+	ContainerName string
+	ImageName     string
+	Logger        Logger
+
+	Host string
+	Port string
+}
+
+func buildConfig(opts ...Option) *Config {
+	cfg := &Config{
+		NetworkName:   "test-network",
+		ContainerName: "kafka-container",
+		ImageName:     "confluentinc/cp-kafka:7.9.0",
+		Logger:        &logger.NoopLogger{},
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// Brokers возвращает адрес брокера внутри Docker-сети.
+// Брокер объявляет себя по имени контейнера, поэтому подключаться к нему
+// можно только из контейнеров той же сети.
+func (c *Config) Brokers() string {
+	return c.ContainerName + ":" + kafkaInternalPort
+}
+
+func defaultHostConfig() func(hc *container.HostConfig) {
+	return func(hc *container.HostConfig) {
+		hc.AutoRemove = true
+	}
+}
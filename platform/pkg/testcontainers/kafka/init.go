@@ -0,0 +1,58 @@
+package kafka
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func startKafkaContainer(ctx context.Context, cfg *Config) (testcontainers.Container, error) {
+	req := testcontainers.ContainerRequest{
+		Name:     cfg.ContainerName,
+		Image:    cfg.ImageName,
+		Networks: []string{cfg.NetworkName},
+		// Один узел в режиме KRaft: controller и broker в одном процессе
+		Env: map[string]string{
+			"CLUSTER_ID":                             kafkaClusterID,
+			"KAFKA_NODE_ID":                          "1",
+			"KAFKA_PROCESS_ROLES":                    "controller,broker",
+			"KAFKA_CONTROLLER_QUORUM_VOTERS":         "1@" + cfg.ContainerName + ":" + kafkaControllerPort,
+			"KAFKA_LISTENERS":                        "PLAINTEXT://0.0.0.0:" + kafkaInternalPort + ",CONTROLLER://0.0.0.0:" + kafkaControllerPort,
+			"KAFKA_ADVERTISED_LISTENERS":             "PLAINTEXT://" + cfg.ContainerName + ":" + kafkaInternalPort,
+			"KAFKA_LISTENER_SECURITY_PROTOCOL_MAP":   "PLAINTEXT:PLAINTEXT,CONTROLLER:PLAINTEXT",
+			"KAFKA_INTER_BROKER_LISTENER_NAME":       "PLAINTEXT",
+			"KAFKA_CONTROLLER_LISTENER_NAMES":        "CONTROLLER",
+			"KAFKA_AUTO_CREATE_TOPICS_ENABLE":        "true",
+			"KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR": "1",
+		},
+		WaitingFor:         wait.ForListeningPort(kafkaInternalPort + "/tcp").WithStartupTimeout(kafkaStartupTimeout),
+		HostConfigModifier: defaultHostConfig(),
+		ExposedPorts:       []string{kafkaInternalPort + "/tcp"},
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to start kafka container: %v", err)
+	}
+
+	return container, nil
+}
+
+func getContainerHostPort(ctx context.Context, container testcontainers.Container) (string, string, error) {
+	host, err := container.Host(ctx)
+	if err != nil {
+		return "", "", errors.Errorf("failed to get container host: %v", err)
+	}
+
+	port, err := container.MappedPort(ctx, kafkaInternalPort+"/tcp")
+	if err != nil {
+		return "", "", errors.Errorf("failed to get mapped port: %v", err)
+	}
+
+	return host, port.Port(), nil
+}
@@ -0,0 +1,69 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/testcontainers/testcontainers-go"
+	"go.uber.org/zap"
+)
+
+const (
+	kafkaInternalPort   = "29092"
+	kafkaControllerPort = "29093"
+	kafkaStartupTimeout = 2 * time.Minute
+
+	// Произвольный идентификатор кластера для форматирования хранилища KRaft
+	kafkaClusterID = "MkU3OEVBNTcwNTJENDM2Qk"
+)
+
+type Container struct {
+	container testcontainers.Container
+	cfg       *Config
+}
+
+func NewContainer(ctx context.Context, opts ...Option) (*Container, error) {
+	cfg := buildConfig(opts...)
+
+	container, err := startKafkaContainer(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	success := false
+	defer func() {
+		if !success {
+			if err = container.Terminate(ctx); err != nil {
+				cfg.Logger.Error(ctx, "failed to terminate kafka container", zap.Error(err))
+			}
+		}
+	}()
+
+	cfg.Host, cfg.Port, err = getContainerHostPort(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Logger.Info(ctx, "Kafka container started", zap.String("host", cfg.Host), zap.String("port", cfg.Port))
+	success = true
+
+	return &Container{
+		container: container,
+		cfg:       cfg,
+	}, nil
+}
+
+func (c *Container) Config() *Config {
+	return c.cfg
+}
+
+func (c *Container) Terminate(ctx context.Context) error {
+	if err := c.container.Terminate(ctx); err != nil {
+		c.cfg.Logger.Error(ctx, "failed to terminate kafka container", zap.Error(err))
+		return err
+	}
+
+	c.cfg.Logger.Info(ctx, "Kafka container terminated")
+
+	return nil
+}
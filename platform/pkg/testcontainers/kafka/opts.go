@@ -0,0 +1,27 @@
+package kafka
+
+type Option func(*Config)
+
+func WithNetworkName(network string) Option {
+	return func(c *Config) {
+		c.NetworkName = network
+	}
+}
+
+func WithContainerName(containerName string) Option {
+	return func(c *Config) {
+		c.ContainerName = containerName
+	}
+}
+
+func WithImageName(image string) Option {
+	return func(c *Config) {
+		c.ImageName = image
+	}
+}
+
+func WithLogger(logger Logger) Option {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
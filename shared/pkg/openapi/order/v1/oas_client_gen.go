@@ -28,6 +28,12 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// AddPaymentInstrument invokes addPaymentInstrument operation.
+	//
+	// Saves a payment instrument for the authenticated user.
+	//
+	// POST /payment-instruments
+	AddPaymentInstrument(ctx context.Context, request *AddPaymentInstrumentRequest) (AddPaymentInstrumentRes, error)
 	// CancelOrder invokes cancelOrder operation.
 	//
 	// Cancels an existing order.
@@ -40,12 +46,24 @@ type Invoker interface {
 	//
 	// POST /orders
 	CreateOrder(ctx context.Context, request *CreateOrderRequest) (CreateOrderRes, error)
+	// DeletePaymentInstrument invokes deletePaymentInstrument operation.
+	//
+	// Deletes a saved payment instrument of the authenticated user.
+	//
+	// DELETE /payment-instruments/{instrument_uuid}
+	DeletePaymentInstrument(ctx context.Context, params DeletePaymentInstrumentParams) (DeletePaymentInstrumentRes, error)
 	// GetOrderByUuid invokes getOrderByUuid operation.
 	//
 	// Retrieves order details by UUID.
 	//
 	// GET /orders/{order_uuid}
 	GetOrderByUuid(ctx context.Context, params GetOrderByUuidParams) (GetOrderByUuidRes, error)
+	// ListPaymentInstruments invokes listPaymentInstruments operation.
+	//
+	// Returns saved payment instruments of the authenticated user.
+	//
+	// GET /payment-instruments
+	ListPaymentInstruments(ctx context.Context) (ListPaymentInstrumentsRes, error)
 	// PayOrder invokes payOrder operation.
 	//
 	// Processes payment for an existing order.
@@ -101,6 +119,81 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
+// AddPaymentInstrument invokes addPaymentInstrument operation.
+//
+// Saves a payment instrument for the authenticated user.
+//
+// POST /payment-instruments
+func (c *Client) AddPaymentInstrument(ctx context.Context, request *AddPaymentInstrumentRequest) (AddPaymentInstrumentRes, error) {
+	res, err := c.sendAddPaymentInstrument(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendAddPaymentInstrument(ctx context.Context, request *AddPaymentInstrumentRequest) (res AddPaymentInstrumentRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("addPaymentInstrument"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/payment-instruments"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AddPaymentInstrumentOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/payment-instruments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAddPaymentInstrumentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAddPaymentInstrumentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // CancelOrder invokes cancelOrder operation.
 //
 // Cancels an existing order.
@@ -267,6 +360,96 @@ func (c *Client) sendCreateOrder(ctx context.Context, request *CreateOrderReques
 	return result, nil
 }
 
+// DeletePaymentInstrument invokes deletePaymentInstrument operation.
+//
+// Deletes a saved payment instrument of the authenticated user.
+//
+// DELETE /payment-instruments/{instrument_uuid}
+func (c *Client) DeletePaymentInstrument(ctx context.Context, params DeletePaymentInstrumentParams) (DeletePaymentInstrumentRes, error) {
+	res, err := c.sendDeletePaymentInstrument(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeletePaymentInstrument(ctx context.Context, params DeletePaymentInstrumentParams) (res DeletePaymentInstrumentRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePaymentInstrument"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/payment-instruments/{instrument_uuid}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePaymentInstrumentOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/payment-instruments/"
+	{
+		// Encode "instrument_uuid" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "instrument_uuid",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.InstrumentUUID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeletePaymentInstrumentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // GetOrderByUuid invokes getOrderByUuid operation.
 //
 // Retrieves order details by UUID.
@@ -357,6 +540,78 @@ func (c *Client) sendGetOrderByUuid(ctx context.Context, params GetOrderByUuidPa
 	return result, nil
 }
 
+// ListPaymentInstruments invokes listPaymentInstruments operation.
+//
+// Returns saved payment instruments of the authenticated user.
+//
+// GET /payment-instruments
+func (c *Client) ListPaymentInstruments(ctx context.Context) (ListPaymentInstrumentsRes, error) {
+	res, err := c.sendListPaymentInstruments(ctx)
+	return res, err
+}
+
+func (c *Client) sendListPaymentInstruments(ctx context.Context) (res ListPaymentInstrumentsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listPaymentInstruments"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/payment-instruments"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPaymentInstrumentsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/payment-instruments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListPaymentInstrumentsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // PayOrder invokes payOrder operation.
 //
 // Processes payment for an existing order.
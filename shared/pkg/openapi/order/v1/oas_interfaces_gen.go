@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package order_v1
 
+type AddPaymentInstrumentRes interface {
+	addPaymentInstrumentRes()
+}
+
 type CancelOrderRes interface {
 	cancelOrderRes()
 }
@@ -9,10 +13,18 @@ type CreateOrderRes interface {
 	createOrderRes()
 }
 
+type DeletePaymentInstrumentRes interface {
+	deletePaymentInstrumentRes()
+}
+
 type GetOrderByUuidRes interface {
 	getOrderByUuidRes()
 }
 
+type ListPaymentInstrumentsRes interface {
+	listPaymentInstrumentsRes()
+}
+
 type PayOrderRes interface {
 	payOrderRes()
 }
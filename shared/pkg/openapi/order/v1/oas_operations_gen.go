@@ -6,8 +6,11 @@ package order_v1
 type OperationName = string
 
 const (
-	CancelOrderOperation    OperationName = "CancelOrder"
-	CreateOrderOperation    OperationName = "CreateOrder"
-	GetOrderByUuidOperation OperationName = "GetOrderByUuid"
-	PayOrderOperation       OperationName = "PayOrder"
+	AddPaymentInstrumentOperation    OperationName = "AddPaymentInstrument"
+	CancelOrderOperation             OperationName = "CancelOrder"
+	CreateOrderOperation             OperationName = "CreateOrder"
+	DeletePaymentInstrumentOperation OperationName = "DeletePaymentInstrument"
+	GetOrderByUuidOperation          OperationName = "GetOrderByUuid"
+	ListPaymentInstrumentsOperation  OperationName = "ListPaymentInstruments"
+	PayOrderOperation                OperationName = "PayOrder"
 )
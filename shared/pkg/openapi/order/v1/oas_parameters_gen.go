@@ -82,6 +82,72 @@ func decodeCancelOrderParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
+// DeletePaymentInstrumentParams is parameters of deletePaymentInstrument operation.
+type DeletePaymentInstrumentParams struct {
+	// Уникальный идентификатор платёжного инструмента.
+	InstrumentUUID uuid.UUID
+}
+
+func unpackDeletePaymentInstrumentParams(packed middleware.Parameters) (params DeletePaymentInstrumentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "instrument_uuid",
+			In:   "path",
+		}
+		params.InstrumentUUID = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeDeletePaymentInstrumentParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePaymentInstrumentParams, _ error) {
+	// Decode path: instrument_uuid.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "instrument_uuid",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.InstrumentUUID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "instrument_uuid",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // GetOrderByUuidParams is parameters of getOrderByUuid operation.
 type GetOrderByUuidParams struct {
 	// Уникальный идентификатор заказа.
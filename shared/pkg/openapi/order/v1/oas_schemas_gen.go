@@ -4,6 +4,7 @@ package order_v1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/google/uuid"
@@ -13,6 +14,71 @@ func (s *GenericErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Ref: #/components/schemas/add_payment_instrument_request
+type AddPaymentInstrumentRequest struct {
+	// Способ оплаты.
+	PaymentMethod PaymentMethod `json:"payment_method"`
+	// Токен карты от платёжного провайдера (CARD, CREDIT_CARD).
+	CardToken OptString `json:"card_token"`
+	// Последние 4 цифры карты (CARD, CREDIT_CARD).
+	CardLast4 OptString `json:"card_last4"`
+	// Телефон СБП в формате +79991234567 (SBP).
+	SbpPhone OptString `json:"sbp_phone"`
+	// Сделать инструментом по умолчанию. Прежний
+	// инструмент по умолчанию перестаёт им быть.
+	IsDefault OptBool `json:"is_default"`
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *AddPaymentInstrumentRequest) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetCardToken returns the value of CardToken.
+func (s *AddPaymentInstrumentRequest) GetCardToken() OptString {
+	return s.CardToken
+}
+
+// GetCardLast4 returns the value of CardLast4.
+func (s *AddPaymentInstrumentRequest) GetCardLast4() OptString {
+	return s.CardLast4
+}
+
+// GetSbpPhone returns the value of SbpPhone.
+func (s *AddPaymentInstrumentRequest) GetSbpPhone() OptString {
+	return s.SbpPhone
+}
+
+// GetIsDefault returns the value of IsDefault.
+func (s *AddPaymentInstrumentRequest) GetIsDefault() OptBool {
+	return s.IsDefault
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *AddPaymentInstrumentRequest) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetCardToken sets the value of CardToken.
+func (s *AddPaymentInstrumentRequest) SetCardToken(val OptString) {
+	s.CardToken = val
+}
+
+// SetCardLast4 sets the value of CardLast4.
+func (s *AddPaymentInstrumentRequest) SetCardLast4(val OptString) {
+	s.CardLast4 = val
+}
+
+// SetSbpPhone sets the value of SbpPhone.
+func (s *AddPaymentInstrumentRequest) SetSbpPhone(val OptString) {
+	s.SbpPhone = val
+}
+
+// SetIsDefault sets the value of IsDefault.
+func (s *AddPaymentInstrumentRequest) SetIsDefault(val OptBool) {
+	s.IsDefault = val
+}
+
 // Ref: #/components/schemas/bad_gateway_error
 type BadGatewayError struct {
 	// HTTP-код ошибки.
@@ -79,6 +145,70 @@ func (*BadRequestError) createOrderRes()    {}
 func (*BadRequestError) getOrderByUuidRes() {}
 func (*BadRequestError) payOrderRes()       {}
 
+// Тип правила совместимости деталей.
+// Ref: #/components/schemas/compatibility_rule_type
+type CompatibilityRuleType string
+
+const (
+	CompatibilityRuleTypeCOMPATIBILITYRULETYPEUNSPECIFIED    CompatibilityRuleType = "COMPATIBILITY_RULE_TYPE_UNSPECIFIED"
+	CompatibilityRuleTypeCOMPATIBILITYRULETYPEREQUIRES       CompatibilityRuleType = "COMPATIBILITY_RULE_TYPE_REQUIRES"
+	CompatibilityRuleTypeCOMPATIBILITYRULETYPEEXCLUDES       CompatibilityRuleType = "COMPATIBILITY_RULE_TYPE_EXCLUDES"
+	CompatibilityRuleTypeCOMPATIBILITYRULETYPEMAXPERORDER    CompatibilityRuleType = "COMPATIBILITY_RULE_TYPE_MAX_PER_ORDER"
+	CompatibilityRuleTypeCOMPATIBILITYRULETYPECOMPATIBLEWITH CompatibilityRuleType = "COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH"
+)
+
+// AllValues returns all CompatibilityRuleType values.
+func (CompatibilityRuleType) AllValues() []CompatibilityRuleType {
+	return []CompatibilityRuleType{
+		CompatibilityRuleTypeCOMPATIBILITYRULETYPEUNSPECIFIED,
+		CompatibilityRuleTypeCOMPATIBILITYRULETYPEREQUIRES,
+		CompatibilityRuleTypeCOMPATIBILITYRULETYPEEXCLUDES,
+		CompatibilityRuleTypeCOMPATIBILITYRULETYPEMAXPERORDER,
+		CompatibilityRuleTypeCOMPATIBILITYRULETYPECOMPATIBLEWITH,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CompatibilityRuleType) MarshalText() ([]byte, error) {
+	switch s {
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEUNSPECIFIED:
+		return []byte(s), nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEREQUIRES:
+		return []byte(s), nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEEXCLUDES:
+		return []byte(s), nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEMAXPERORDER:
+		return []byte(s), nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPECOMPATIBLEWITH:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CompatibilityRuleType) UnmarshalText(data []byte) error {
+	switch CompatibilityRuleType(data) {
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEUNSPECIFIED:
+		*s = CompatibilityRuleTypeCOMPATIBILITYRULETYPEUNSPECIFIED
+		return nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEREQUIRES:
+		*s = CompatibilityRuleTypeCOMPATIBILITYRULETYPEREQUIRES
+		return nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEEXCLUDES:
+		*s = CompatibilityRuleTypeCOMPATIBILITYRULETYPEEXCLUDES
+		return nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPEMAXPERORDER:
+		*s = CompatibilityRuleTypeCOMPATIBILITYRULETYPEMAXPERORDER
+		return nil
+	case CompatibilityRuleTypeCOMPATIBILITYRULETYPECOMPATIBLEWITH:
+		*s = CompatibilityRuleTypeCOMPATIBILITYRULETYPECOMPATIBLEWITH
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/conflict_error
 type ConflictError struct {
 	// HTTP-код ошибки.
@@ -110,16 +240,25 @@ func (s *ConflictError) SetMessage(val string) {
 func (*ConflictError) cancelOrderRes() {}
 func (*ConflictError) payOrderRes()    {}
 
+// Нужно указать хотя бы одну деталь или комплект.
+// Владелец заказа — пользователь сессии.
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
-	// UUID пользователя.
-	UserUUID uuid.UUID `json:"user_uuid"`
-	// Список UUID деталей.
+	// Устарело. Если указан, должен совпадать с
+	// пользователем сессии, иначе 403.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	UserUUID OptUUID `json:"user_uuid"`
+	// Список UUID деталей. Повтор UUID означает несколько
+	// единиц детали.
 	PartUuids []uuid.UUID `json:"part_uuids"`
+	// Список UUID комплектов. Каждый комплект
+	// разворачивается в детали своего состава.
+	KitUuids []uuid.UUID `json:"kit_uuids"`
 }
 
 // GetUserUUID returns the value of UserUUID.
-func (s *CreateOrderRequest) GetUserUUID() uuid.UUID {
+func (s *CreateOrderRequest) GetUserUUID() OptUUID {
 	return s.UserUUID
 }
 
@@ -128,8 +267,13 @@ func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetKitUuids returns the value of KitUuids.
+func (s *CreateOrderRequest) GetKitUuids() []uuid.UUID {
+	return s.KitUuids
+}
+
 // SetUserUUID sets the value of UserUUID.
-func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
+func (s *CreateOrderRequest) SetUserUUID(val OptUUID) {
 	s.UserUUID = val
 }
 
@@ -138,6 +282,11 @@ func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetKitUuids sets the value of KitUuids.
+func (s *CreateOrderRequest) SetKitUuids(val []uuid.UUID) {
+	s.KitUuids = val
+}
+
 // Ref: #/components/schemas/create_order_response
 type CreateOrderResponse struct {
 	// Уникальный идентификатор заказа.
@@ -168,6 +317,11 @@ func (s *CreateOrderResponse) SetTotalPrice(val float32) {
 
 func (*CreateOrderResponse) createOrderRes() {}
 
+// DeletePaymentInstrumentNoContent is response for DeletePaymentInstrument operation.
+type DeletePaymentInstrumentNoContent struct{}
+
+func (*DeletePaymentInstrumentNoContent) deletePaymentInstrumentRes() {}
+
 // Ref: #/components/schemas/forbidden_error
 type ForbiddenError struct {
 	// HTTP-код ошибки.
@@ -283,10 +437,32 @@ func (s *InternalServerError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*InternalServerError) cancelOrderRes()    {}
-func (*InternalServerError) createOrderRes()    {}
-func (*InternalServerError) getOrderByUuidRes() {}
-func (*InternalServerError) payOrderRes()       {}
+func (*InternalServerError) addPaymentInstrumentRes()    {}
+func (*InternalServerError) cancelOrderRes()             {}
+func (*InternalServerError) createOrderRes()             {}
+func (*InternalServerError) deletePaymentInstrumentRes() {}
+func (*InternalServerError) getOrderByUuidRes()          {}
+func (*InternalServerError) listPaymentInstrumentsRes()  {}
+func (*InternalServerError) payOrderRes()                {}
+
+// Ref: #/components/schemas/list_payment_instruments_response
+type ListPaymentInstrumentsResponse struct {
+	// Инструменты пользователя, инструмент по умолчанию
+	// первым.
+	Instruments []PaymentInstrumentDto `json:"instruments"`
+}
+
+// GetInstruments returns the value of Instruments.
+func (s *ListPaymentInstrumentsResponse) GetInstruments() []PaymentInstrumentDto {
+	return s.Instruments
+}
+
+// SetInstruments sets the value of Instruments.
+func (s *ListPaymentInstrumentsResponse) SetInstruments(val []PaymentInstrumentDto) {
+	s.Instruments = val
+}
+
+func (*ListPaymentInstrumentsResponse) listPaymentInstrumentsRes() {}
 
 // Ref: #/components/schemas/not_found_error
 type NotFoundError struct {
@@ -316,10 +492,57 @@ func (s *NotFoundError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*NotFoundError) cancelOrderRes()    {}
-func (*NotFoundError) createOrderRes()    {}
-func (*NotFoundError) getOrderByUuidRes() {}
-func (*NotFoundError) payOrderRes()       {}
+func (*NotFoundError) cancelOrderRes()             {}
+func (*NotFoundError) createOrderRes()             {}
+func (*NotFoundError) deletePaymentInstrumentRes() {}
+func (*NotFoundError) getOrderByUuidRes()          {}
+func (*NotFoundError) payOrderRes()                {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
@@ -522,6 +745,52 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #/components/schemas/order_dto
 type OrderDto struct {
 	// Уникальный идентификатор заказа.
@@ -618,16 +887,18 @@ func (*OrderDto) getOrderByUuidRes() {}
 type OrderStatus string
 
 const (
-	OrderStatusPENDINGPAYMENT OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPAID           OrderStatus = "PAID"
-	OrderStatusCANCELLED      OrderStatus = "CANCELLED"
-	OrderStatusASSEMBLED      OrderStatus = "ASSEMBLED"
+	OrderStatusPENDINGPAYMENT    OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPAYMENTPROCESSING OrderStatus = "PAYMENT_PROCESSING"
+	OrderStatusPAID              OrderStatus = "PAID"
+	OrderStatusCANCELLED         OrderStatus = "CANCELLED"
+	OrderStatusASSEMBLED         OrderStatus = "ASSEMBLED"
 )
 
 // AllValues returns all OrderStatus values.
 func (OrderStatus) AllValues() []OrderStatus {
 	return []OrderStatus{
 		OrderStatusPENDINGPAYMENT,
+		OrderStatusPAYMENTPROCESSING,
 		OrderStatusPAID,
 		OrderStatusCANCELLED,
 		OrderStatusASSEMBLED,
@@ -639,6 +910,8 @@ func (s OrderStatus) MarshalText() ([]byte, error) {
 	switch s {
 	case OrderStatusPENDINGPAYMENT:
 		return []byte(s), nil
+	case OrderStatusPAYMENTPROCESSING:
+		return []byte(s), nil
 	case OrderStatusPAID:
 		return []byte(s), nil
 	case OrderStatusCANCELLED:
@@ -656,6 +929,9 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	case OrderStatusPENDINGPAYMENT:
 		*s = OrderStatusPENDINGPAYMENT
 		return nil
+	case OrderStatusPAYMENTPROCESSING:
+		*s = OrderStatusPAYMENTPROCESSING
+		return nil
 	case OrderStatusPAID:
 		*s = OrderStatusPAID
 		return nil
@@ -670,21 +946,39 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 	}
 }
 
+// Способ оплаты можно указать явно, выбрать
+// сохранённый инструмент или не указывать ничего —
+// тогда оплата идёт инструментом пользователя по
+// умолчанию.
 // Ref: #/components/schemas/pay_order_request
 type PayOrderRequest struct {
-	PaymentMethod PaymentMethod `json:"payment_method"`
+	// Способ оплаты. С instrument_uuid должен совпадать со
+	// способом инструмента.
+	PaymentMethod OptPaymentMethod `json:"payment_method"`
+	// UUID сохранённого платёжного инструмента.
+	InstrumentUUID OptUUID `json:"instrument_uuid"`
 }
 
 // GetPaymentMethod returns the value of PaymentMethod.
-func (s *PayOrderRequest) GetPaymentMethod() PaymentMethod {
+func (s *PayOrderRequest) GetPaymentMethod() OptPaymentMethod {
 	return s.PaymentMethod
 }
 
+// GetInstrumentUUID returns the value of InstrumentUUID.
+func (s *PayOrderRequest) GetInstrumentUUID() OptUUID {
+	return s.InstrumentUUID
+}
+
 // SetPaymentMethod sets the value of PaymentMethod.
-func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
+func (s *PayOrderRequest) SetPaymentMethod(val OptPaymentMethod) {
 	s.PaymentMethod = val
 }
 
+// SetInstrumentUUID sets the value of InstrumentUUID.
+func (s *PayOrderRequest) SetInstrumentUUID(val OptUUID) {
+	s.InstrumentUUID = val
+}
+
 // Ref: #/components/schemas/pay_order_response
 type PayOrderResponse struct {
 	// UUID транзакции.
@@ -703,6 +997,86 @@ func (s *PayOrderResponse) SetTransactionUUID(val string) {
 
 func (*PayOrderResponse) payOrderRes() {}
 
+// Сохранённый платёжный инструмент. Токен карты и
+// телефон СБП не возвращаются.
+// Ref: #/components/schemas/payment_instrument_dto
+type PaymentInstrumentDto struct {
+	// Уникальный идентификатор инструмента.
+	InstrumentUUID uuid.UUID `json:"instrument_uuid"`
+	// Способ оплаты.
+	PaymentMethod PaymentMethod `json:"payment_method"`
+	// Последние 4 цифры карты (CARD, CREDIT_CARD).
+	CardLast4 OptString `json:"card_last4"`
+	// Маскированный телефон СБП.
+	SbpPhoneMasked OptString `json:"sbp_phone_masked"`
+	// Инструмент по умолчанию.
+	IsDefault bool `json:"is_default"`
+	// Дата сохранения.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetInstrumentUUID returns the value of InstrumentUUID.
+func (s *PaymentInstrumentDto) GetInstrumentUUID() uuid.UUID {
+	return s.InstrumentUUID
+}
+
+// GetPaymentMethod returns the value of PaymentMethod.
+func (s *PaymentInstrumentDto) GetPaymentMethod() PaymentMethod {
+	return s.PaymentMethod
+}
+
+// GetCardLast4 returns the value of CardLast4.
+func (s *PaymentInstrumentDto) GetCardLast4() OptString {
+	return s.CardLast4
+}
+
+// GetSbpPhoneMasked returns the value of SbpPhoneMasked.
+func (s *PaymentInstrumentDto) GetSbpPhoneMasked() OptString {
+	return s.SbpPhoneMasked
+}
+
+// GetIsDefault returns the value of IsDefault.
+func (s *PaymentInstrumentDto) GetIsDefault() bool {
+	return s.IsDefault
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PaymentInstrumentDto) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetInstrumentUUID sets the value of InstrumentUUID.
+func (s *PaymentInstrumentDto) SetInstrumentUUID(val uuid.UUID) {
+	s.InstrumentUUID = val
+}
+
+// SetPaymentMethod sets the value of PaymentMethod.
+func (s *PaymentInstrumentDto) SetPaymentMethod(val PaymentMethod) {
+	s.PaymentMethod = val
+}
+
+// SetCardLast4 sets the value of CardLast4.
+func (s *PaymentInstrumentDto) SetCardLast4(val OptString) {
+	s.CardLast4 = val
+}
+
+// SetSbpPhoneMasked sets the value of SbpPhoneMasked.
+func (s *PaymentInstrumentDto) SetSbpPhoneMasked(val OptString) {
+	s.SbpPhoneMasked = val
+}
+
+// SetIsDefault sets the value of IsDefault.
+func (s *PaymentInstrumentDto) SetIsDefault(val bool) {
+	s.IsDefault = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PaymentInstrumentDto) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*PaymentInstrumentDto) addPaymentInstrumentRes() {}
+
 // Метод оплаты заказа.
 // Ref: #/components/schemas/payment_method
 type PaymentMethod string
@@ -800,6 +1174,57 @@ func (*RateLimitError) createOrderRes()    {}
 func (*RateLimitError) getOrderByUuidRes() {}
 func (*RateLimitError) payOrderRes()       {}
 
+// Ref: #/components/schemas/rule_violation
+type RuleViolation struct {
+	// UUID нарушенного правила.
+	RuleUUID uuid.UUID             `json:"rule_uuid"`
+	Type     CompatibilityRuleType `json:"type"`
+	// Описание нарушения.
+	Message string `json:"message"`
+	// Детали, нарушающие правило.
+	PartUuids []uuid.UUID `json:"part_uuids"`
+}
+
+// GetRuleUUID returns the value of RuleUUID.
+func (s *RuleViolation) GetRuleUUID() uuid.UUID {
+	return s.RuleUUID
+}
+
+// GetType returns the value of Type.
+func (s *RuleViolation) GetType() CompatibilityRuleType {
+	return s.Type
+}
+
+// GetMessage returns the value of Message.
+func (s *RuleViolation) GetMessage() string {
+	return s.Message
+}
+
+// GetPartUuids returns the value of PartUuids.
+func (s *RuleViolation) GetPartUuids() []uuid.UUID {
+	return s.PartUuids
+}
+
+// SetRuleUUID sets the value of RuleUUID.
+func (s *RuleViolation) SetRuleUUID(val uuid.UUID) {
+	s.RuleUUID = val
+}
+
+// SetType sets the value of Type.
+func (s *RuleViolation) SetType(val CompatibilityRuleType) {
+	s.Type = val
+}
+
+// SetMessage sets the value of Message.
+func (s *RuleViolation) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetPartUuids sets the value of PartUuids.
+func (s *RuleViolation) SetPartUuids(val []uuid.UUID) {
+	s.PartUuids = val
+}
+
 // Ref: #/components/schemas/service_unavailable_error
 type ServiceUnavailableError struct {
 	// HTTP-код ошибки.
@@ -828,10 +1253,13 @@ func (s *ServiceUnavailableError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ServiceUnavailableError) cancelOrderRes()    {}
-func (*ServiceUnavailableError) createOrderRes()    {}
-func (*ServiceUnavailableError) getOrderByUuidRes() {}
-func (*ServiceUnavailableError) payOrderRes()       {}
+func (*ServiceUnavailableError) addPaymentInstrumentRes()    {}
+func (*ServiceUnavailableError) cancelOrderRes()             {}
+func (*ServiceUnavailableError) createOrderRes()             {}
+func (*ServiceUnavailableError) deletePaymentInstrumentRes() {}
+func (*ServiceUnavailableError) getOrderByUuidRes()          {}
+func (*ServiceUnavailableError) listPaymentInstrumentsRes()  {}
+func (*ServiceUnavailableError) payOrderRes()                {}
 
 // Ref: #/components/schemas/unauthorized_error
 type UnauthorizedError struct {
@@ -861,10 +1289,13 @@ func (s *UnauthorizedError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*UnauthorizedError) cancelOrderRes()    {}
-func (*UnauthorizedError) createOrderRes()    {}
-func (*UnauthorizedError) getOrderByUuidRes() {}
-func (*UnauthorizedError) payOrderRes()       {}
+func (*UnauthorizedError) addPaymentInstrumentRes()    {}
+func (*UnauthorizedError) cancelOrderRes()             {}
+func (*UnauthorizedError) createOrderRes()             {}
+func (*UnauthorizedError) deletePaymentInstrumentRes() {}
+func (*UnauthorizedError) getOrderByUuidRes()          {}
+func (*UnauthorizedError) listPaymentInstrumentsRes()  {}
+func (*UnauthorizedError) payOrderRes()                {}
 
 // Ref: #/components/schemas/validation_error
 type ValidationError struct {
@@ -872,6 +1303,8 @@ type ValidationError struct {
 	Code int `json:"code"`
 	// Описание ошибки.
 	Message string `json:"message"`
+	// Нарушения правил совместимости деталей.
+	Violations []RuleViolation `json:"violations"`
 }
 
 // GetCode returns the value of Code.
@@ -884,6 +1317,11 @@ func (s *ValidationError) GetMessage() string {
 	return s.Message
 }
 
+// GetViolations returns the value of Violations.
+func (s *ValidationError) GetViolations() []RuleViolation {
+	return s.Violations
+}
+
 // SetCode sets the value of Code.
 func (s *ValidationError) SetCode(val int) {
 	s.Code = val
@@ -894,7 +1332,13 @@ func (s *ValidationError) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*ValidationError) cancelOrderRes()    {}
-func (*ValidationError) createOrderRes()    {}
-func (*ValidationError) getOrderByUuidRes() {}
-func (*ValidationError) payOrderRes()       {}
+// SetViolations sets the value of Violations.
+func (s *ValidationError) SetViolations(val []RuleViolation) {
+	s.Violations = val
+}
+
+func (*ValidationError) addPaymentInstrumentRes() {}
+func (*ValidationError) cancelOrderRes()          {}
+func (*ValidationError) createOrderRes()          {}
+func (*ValidationError) getOrderByUuidRes()       {}
+func (*ValidationError) payOrderRes()             {}
@@ -8,6 +8,12 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AddPaymentInstrument implements addPaymentInstrument operation.
+	//
+	// Saves a payment instrument for the authenticated user.
+	//
+	// POST /payment-instruments
+	AddPaymentInstrument(ctx context.Context, req *AddPaymentInstrumentRequest) (AddPaymentInstrumentRes, error)
 	// CancelOrder implements cancelOrder operation.
 	//
 	// Cancels an existing order.
@@ -20,12 +26,24 @@ type Handler interface {
 	//
 	// POST /orders
 	CreateOrder(ctx context.Context, req *CreateOrderRequest) (CreateOrderRes, error)
+	// DeletePaymentInstrument implements deletePaymentInstrument operation.
+	//
+	// Deletes a saved payment instrument of the authenticated user.
+	//
+	// DELETE /payment-instruments/{instrument_uuid}
+	DeletePaymentInstrument(ctx context.Context, params DeletePaymentInstrumentParams) (DeletePaymentInstrumentRes, error)
 	// GetOrderByUuid implements getOrderByUuid operation.
 	//
 	// Retrieves order details by UUID.
 	//
 	// GET /orders/{order_uuid}
 	GetOrderByUuid(ctx context.Context, params GetOrderByUuidParams) (GetOrderByUuidRes, error)
+	// ListPaymentInstruments implements listPaymentInstruments operation.
+	//
+	// Returns saved payment instruments of the authenticated user.
+	//
+	// GET /payment-instruments
+	ListPaymentInstruments(ctx context.Context) (ListPaymentInstrumentsRes, error)
 	// PayOrder implements payOrder operation.
 	//
 	// Processes payment for an existing order.
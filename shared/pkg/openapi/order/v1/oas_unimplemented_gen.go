@@ -13,6 +13,15 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddPaymentInstrument implements addPaymentInstrument operation.
+//
+// Saves a payment instrument for the authenticated user.
+//
+// POST /payment-instruments
+func (UnimplementedHandler) AddPaymentInstrument(ctx context.Context, req *AddPaymentInstrumentRequest) (r AddPaymentInstrumentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CancelOrder implements cancelOrder operation.
 //
 // Cancels an existing order.
@@ -31,6 +40,15 @@ func (UnimplementedHandler) CreateOrder(ctx context.Context, req *CreateOrderReq
 	return r, ht.ErrNotImplemented
 }
 
+// DeletePaymentInstrument implements deletePaymentInstrument operation.
+//
+// Deletes a saved payment instrument of the authenticated user.
+//
+// DELETE /payment-instruments/{instrument_uuid}
+func (UnimplementedHandler) DeletePaymentInstrument(ctx context.Context, params DeletePaymentInstrumentParams) (r DeletePaymentInstrumentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrderByUuid implements getOrderByUuid operation.
 //
 // Retrieves order details by UUID.
@@ -40,6 +58,15 @@ func (UnimplementedHandler) GetOrderByUuid(ctx context.Context, params GetOrderB
 	return r, ht.ErrNotImplemented
 }
 
+// ListPaymentInstruments implements listPaymentInstruments operation.
+//
+// Returns saved payment instruments of the authenticated user.
+//
+// GET /payment-instruments
+func (UnimplementedHandler) ListPaymentInstruments(ctx context.Context) (r ListPaymentInstrumentsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PayOrder implements payOrder operation.
 //
 // Processes payment for an existing order.
@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/part.proto
+
+package events_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Деталь добавлена в каталог
+type PartCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	PartUuid      string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`    // Идентификатор детали
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // Название детали
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`                    // Категория (строкой, значение из inventory.v1.Category)
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`                        // Цена за единицу
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartCreated) Reset() {
+	*x = PartCreated{}
+	mi := &file_events_v1_part_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartCreated) ProtoMessage() {}
+
+func (x *PartCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_part_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartCreated.ProtoReflect.Descriptor instead.
+func (*PartCreated) Descriptor() ([]byte, []int) {
+	return file_events_v1_part_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PartCreated) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartCreated) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartCreated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartCreated) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PartCreated) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Деталь в каталоге изменена
+type PartUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`               // Уникальный идентификатор события (для идемпотентности)
+	PartUuid      string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`                  // Идентификатор детали
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                          // Название детали
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`                                  // Категория (строкой, значение из inventory.v1.Category)
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`                                      // Цена за единицу после изменения
+	PreviousPrice float64                `protobuf:"fixed64,6,opt,name=previous_price,json=previousPrice,proto3" json:"previous_price,omitempty"` // Цена за единицу до изменения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartUpdated) Reset() {
+	*x = PartUpdated{}
+	mi := &file_events_v1_part_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartUpdated) ProtoMessage() {}
+
+func (x *PartUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_part_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartUpdated.ProtoReflect.Descriptor instead.
+func (*PartUpdated) Descriptor() ([]byte, []int) {
+	return file_events_v1_part_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PartUpdated) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartUpdated) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartUpdated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartUpdated) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PartUpdated) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PartUpdated) GetPreviousPrice() float64 {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return 0
+}
+
+// Деталь удалена из каталога
+type PartDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"` // Уникальный идентификатор события (для идемпотентности)
+	PartUuid      string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`    // Идентификатор детали
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartDeleted) Reset() {
+	*x = PartDeleted{}
+	mi := &file_events_v1_part_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartDeleted) ProtoMessage() {}
+
+func (x *PartDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_part_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartDeleted.ProtoReflect.Descriptor instead.
+func (*PartDeleted) Descriptor() ([]byte, []int) {
+	return file_events_v1_part_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PartDeleted) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartDeleted) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+var File_events_v1_part_proto protoreflect.FileDescriptor
+
+const file_events_v1_part_proto_rawDesc = "" +
+	"\n" +
+	"\x14events/v1/part.proto\x12\tevents.v1\"\x8f\x01\n" +
+	"\vPartCreated\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\"\xb6\x01\n" +
+	"\vPartUpdated\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12%\n" +
+	"\x0eprevious_price\x18\x06 \x01(\x01R\rpreviousPrice\"I\n" +
+	"\vPartDeleted\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuidBJZHgithub.com/nkolesnikov999/micro2-OK/shared/pkg/proto/events/v1;events_v1b\x06proto3"
+
+var (
+	file_events_v1_part_proto_rawDescOnce sync.Once
+	file_events_v1_part_proto_rawDescData []byte
+)
+
+func file_events_v1_part_proto_rawDescGZIP() []byte {
+	file_events_v1_part_proto_rawDescOnce.Do(func() {
+		file_events_v1_part_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_part_proto_rawDesc), len(file_events_v1_part_proto_rawDesc)))
+	})
+	return file_events_v1_part_proto_rawDescData
+}
+
+var file_events_v1_part_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_v1_part_proto_goTypes = []any{
+	(*PartCreated)(nil), // 0: events.v1.PartCreated
+	(*PartUpdated)(nil), // 1: events.v1.PartUpdated
+	(*PartDeleted)(nil), // 2: events.v1.PartDeleted
+}
+var file_events_v1_part_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_part_proto_init() }
+func file_events_v1_part_proto_init() {
+	if File_events_v1_part_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_part_proto_rawDesc), len(file_events_v1_part_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_part_proto_goTypes,
+		DependencyIndexes: file_events_v1_part_proto_depIdxs,
+		MessageInfos:      file_events_v1_part_proto_msgTypes,
+	}.Build()
+	File_events_v1_part_proto = out.File
+	file_events_v1_part_proto_goTypes = nil
+	file_events_v1_part_proto_depIdxs = nil
+}
@@ -0,0 +1,272 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: events/v1/stock.proto
+
+package events_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Остаток детали на складе изменился
+type StockLevelChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid      string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`                 // Уникальный идентификатор события (для идемпотентности)
+	PartUuid       string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`                    // Идентификатор детали
+	MovementUuid   string                 `protobuf:"bytes,3,opt,name=movement_uuid,json=movementUuid,proto3" json:"movement_uuid,omitempty"`        // Идентификатор движения в журнале остатков
+	Delta          int64                  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`                                         // Изменение остатка
+	StockQuantity  int64                  `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`    // Остаток после изменения
+	Reason         string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                        // Причина (строкой, значение из inventory.v1.StockMovementReason)
+	ReferenceId    string                 `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`           // Идентификатор связанного документа, например заказа
+	WarehouseCode  string                 `protobuf:"bytes,8,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`     // Код склада
+	WarehouseStock int64                  `protobuf:"varint,9,opt,name=warehouse_stock,json=warehouseStock,proto3" json:"warehouse_stock,omitempty"` // Остаток на складе после изменения
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockLevelChanged) Reset() {
+	*x = StockLevelChanged{}
+	mi := &file_events_v1_stock_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLevelChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLevelChanged) ProtoMessage() {}
+
+func (x *StockLevelChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_stock_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLevelChanged.ProtoReflect.Descriptor instead.
+func (*StockLevelChanged) Descriptor() ([]byte, []int) {
+	return file_events_v1_stock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StockLevelChanged) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *StockLevelChanged) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockLevelChanged) GetMovementUuid() string {
+	if x != nil {
+		return x.MovementUuid
+	}
+	return ""
+}
+
+func (x *StockLevelChanged) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockLevelChanged) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *StockLevelChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockLevelChanged) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *StockLevelChanged) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *StockLevelChanged) GetWarehouseStock() int64 {
+	if x != nil {
+		return x.WarehouseStock
+	}
+	return 0
+}
+
+// Остаток детали опустился до порога
+type StockLow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventUuid     string                 `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`              // Уникальный идентификатор события (для идемпотентности)
+	PartUuid      string                 `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`                 // Идентификатор детали
+	StockQuantity int64                  `protobuf:"varint,3,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"` // Остаток после изменения
+	Threshold     int64                  `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`                              // Порог низкого остатка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLow) Reset() {
+	*x = StockLow{}
+	mi := &file_events_v1_stock_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLow) ProtoMessage() {}
+
+func (x *StockLow) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_stock_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLow.ProtoReflect.Descriptor instead.
+func (*StockLow) Descriptor() ([]byte, []int) {
+	return file_events_v1_stock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StockLow) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *StockLow) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockLow) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *StockLow) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+var File_events_v1_stock_proto protoreflect.FileDescriptor
+
+const file_events_v1_stock_proto_rawDesc = "" +
+	"\n" +
+	"\x15events/v1/stock.proto\x12\tevents.v1\"\xbc\x02\n" +
+	"\x11StockLevelChanged\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12#\n" +
+	"\rmovement_uuid\x18\x03 \x01(\tR\fmovementUuid\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x03R\x05delta\x12%\n" +
+	"\x0estock_quantity\x18\x05 \x01(\x03R\rstockQuantity\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12!\n" +
+	"\freference_id\x18\a \x01(\tR\vreferenceId\x12%\n" +
+	"\x0ewarehouse_code\x18\b \x01(\tR\rwarehouseCode\x12'\n" +
+	"\x0fwarehouse_stock\x18\t \x01(\x03R\x0ewarehouseStock\"\x8b\x01\n" +
+	"\bStockLow\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12%\n" +
+	"\x0estock_quantity\x18\x03 \x01(\x03R\rstockQuantity\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x03R\tthresholdBJZHgithub.com/nkolesnikov999/micro2-OK/shared/pkg/proto/events/v1;events_v1b\x06proto3"
+
+var (
+	file_events_v1_stock_proto_rawDescOnce sync.Once
+	file_events_v1_stock_proto_rawDescData []byte
+)
+
+func file_events_v1_stock_proto_rawDescGZIP() []byte {
+	file_events_v1_stock_proto_rawDescOnce.Do(func() {
+		file_events_v1_stock_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_stock_proto_rawDesc), len(file_events_v1_stock_proto_rawDesc)))
+	})
+	return file_events_v1_stock_proto_rawDescData
+}
+
+var file_events_v1_stock_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_stock_proto_goTypes = []any{
+	(*StockLevelChanged)(nil), // 0: events.v1.StockLevelChanged
+	(*StockLow)(nil),          // 1: events.v1.StockLow
+}
+var file_events_v1_stock_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_stock_proto_init() }
+func file_events_v1_stock_proto_init() {
+	if File_events_v1_stock_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_stock_proto_rawDesc), len(file_events_v1_stock_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_stock_proto_goTypes,
+		DependencyIndexes: file_events_v1_stock_proto_depIdxs,
+		MessageInfos:      file_events_v1_stock_proto_msgTypes,
+	}.Build()
+	File_events_v1_stock_proto = out.File
+	file_events_v1_stock_proto_goTypes = nil
+	file_events_v1_stock_proto_depIdxs = nil
+}
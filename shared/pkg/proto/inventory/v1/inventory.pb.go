@@ -25,6 +25,132 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// StockMovementReason перечисляет причины изменения остатка.
+type StockMovementReason int32
+
+const (
+	// Неизвестная причина
+	StockMovementReason_STOCK_MOVEMENT_REASON_UNSPECIFIED StockMovementReason = 0
+	// Поступление на склад
+	StockMovementReason_STOCK_MOVEMENT_REASON_RESTOCK StockMovementReason = 1
+	// Резервирование под заказ
+	StockMovementReason_STOCK_MOVEMENT_REASON_RESERVATION StockMovementReason = 2
+	// Снятие резерва
+	StockMovementReason_STOCK_MOVEMENT_REASON_RELEASE StockMovementReason = 3
+	// Продажа
+	StockMovementReason_STOCK_MOVEMENT_REASON_SALE StockMovementReason = 4
+	// Ручная корректировка
+	StockMovementReason_STOCK_MOVEMENT_REASON_MANUAL_ADJUSTMENT StockMovementReason = 5
+)
+
+// Enum value maps for StockMovementReason.
+var (
+	StockMovementReason_name = map[int32]string{
+		0: "STOCK_MOVEMENT_REASON_UNSPECIFIED",
+		1: "STOCK_MOVEMENT_REASON_RESTOCK",
+		2: "STOCK_MOVEMENT_REASON_RESERVATION",
+		3: "STOCK_MOVEMENT_REASON_RELEASE",
+		4: "STOCK_MOVEMENT_REASON_SALE",
+		5: "STOCK_MOVEMENT_REASON_MANUAL_ADJUSTMENT",
+	}
+	StockMovementReason_value = map[string]int32{
+		"STOCK_MOVEMENT_REASON_UNSPECIFIED":       0,
+		"STOCK_MOVEMENT_REASON_RESTOCK":           1,
+		"STOCK_MOVEMENT_REASON_RESERVATION":       2,
+		"STOCK_MOVEMENT_REASON_RELEASE":           3,
+		"STOCK_MOVEMENT_REASON_SALE":              4,
+		"STOCK_MOVEMENT_REASON_MANUAL_ADJUSTMENT": 5,
+	}
+)
+
+func (x StockMovementReason) Enum() *StockMovementReason {
+	p := new(StockMovementReason)
+	*p = x
+	return p
+}
+
+func (x StockMovementReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockMovementReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (StockMovementReason) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x StockMovementReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockMovementReason.Descriptor instead.
+func (StockMovementReason) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// CompatibilityRuleType перечисляет типы правил совместимости.
+type CompatibilityRuleType int32
+
+const (
+	// Неизвестный тип
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_UNSPECIFIED CompatibilityRuleType = 0
+	// Если есть деталь subject, должна быть и деталь target
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_REQUIRES CompatibilityRuleType = 1
+	// Детали subject и target не могут быть в одном наборе
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_EXCLUDES CompatibilityRuleType = 2
+	// Деталей subject не больше max_quantity единиц
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_MAX_PER_ORDER CompatibilityRuleType = 3
+	// Каждая деталь subject совместима с каждой деталью target по тегу или метаданным
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH CompatibilityRuleType = 4
+)
+
+// Enum value maps for CompatibilityRuleType.
+var (
+	CompatibilityRuleType_name = map[int32]string{
+		0: "COMPATIBILITY_RULE_TYPE_UNSPECIFIED",
+		1: "COMPATIBILITY_RULE_TYPE_REQUIRES",
+		2: "COMPATIBILITY_RULE_TYPE_EXCLUDES",
+		3: "COMPATIBILITY_RULE_TYPE_MAX_PER_ORDER",
+		4: "COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH",
+	}
+	CompatibilityRuleType_value = map[string]int32{
+		"COMPATIBILITY_RULE_TYPE_UNSPECIFIED":     0,
+		"COMPATIBILITY_RULE_TYPE_REQUIRES":        1,
+		"COMPATIBILITY_RULE_TYPE_EXCLUDES":        2,
+		"COMPATIBILITY_RULE_TYPE_MAX_PER_ORDER":   3,
+		"COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH": 4,
+	}
+)
+
+func (x CompatibilityRuleType) Enum() *CompatibilityRuleType {
+	p := new(CompatibilityRuleType)
+	*p = x
+	return p
+}
+
+func (x CompatibilityRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompatibilityRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (CompatibilityRuleType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x CompatibilityRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompatibilityRuleType.Descriptor instead.
+func (CompatibilityRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
 // Category перечисляет категории деталей инвентаря.
 type Category int32
 
@@ -41,75 +167,3190 @@ const (
 	Category_CATEGORY_WING Category = 4
 )
 
-// Enum value maps for Category.
-var (
-	Category_name = map[int32]string{
-		0: "CATEGORY_UNSPECIFIED",
-		1: "CATEGORY_ENGINE",
-		2: "CATEGORY_FUEL",
-		3: "CATEGORY_PORTHOLE",
-		4: "CATEGORY_WING",
+// Enum value maps for Category.
+var (
+	Category_name = map[int32]string{
+		0: "CATEGORY_UNSPECIFIED",
+		1: "CATEGORY_ENGINE",
+		2: "CATEGORY_FUEL",
+		3: "CATEGORY_PORTHOLE",
+		4: "CATEGORY_WING",
+	}
+	Category_value = map[string]int32{
+		"CATEGORY_UNSPECIFIED": 0,
+		"CATEGORY_ENGINE":      1,
+		"CATEGORY_FUEL":        2,
+		"CATEGORY_PORTHOLE":    3,
+		"CATEGORY_WING":        4,
+	}
+)
+
+func (x Category) Enum() *Category {
+	p := new(Category)
+	*p = x
+	return p
+}
+
+func (x Category) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Category) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (Category) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x Category) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Category.Descriptor instead.
+func (Category) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// GetPartRequest содержит параметры запроса для получения детали по UUID.
+type GetPartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор детали
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartRequest) Reset() {
+	*x = GetPartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartRequest) ProtoMessage() {}
+
+func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
+func (*GetPartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// GetPartResponse содержит найденную деталь.
+type GetPartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartResponse) Reset() {
+	*x = GetPartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartResponse) ProtoMessage() {}
+
+func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
+func (*GetPartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// ListPartsRequest описывает фильтр для получения списка деталей.
+type ListPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр
+	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsRequest) Reset() {
+	*x = ListPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsRequest) ProtoMessage() {}
+
+func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ListPartsResponse содержит список найденных деталей.
+type ListPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Список деталей
+	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartsResponse) Reset() {
+	*x = ListPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartsResponse) ProtoMessage() {}
+
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// StreamPartsRequest описывает фильтр и размер порции потоковой выгрузки.
+type StreamPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр, как в ListParts
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Количество деталей в одном сообщении. Если не задано, используется 100, максимум — 1000
+	ChunkSize     int32 `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPartsRequest) Reset() {
+	*x = StreamPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPartsRequest) ProtoMessage() {}
+
+func (x *StreamPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPartsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamPartsRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+// StreamPartsResponse содержит очередную порцию деталей, упорядоченных по uuid.
+type StreamPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Детали
+	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPartsResponse) Reset() {
+	*x = StreamPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPartsResponse) ProtoMessage() {}
+
+func (x *StreamPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPartsResponse.ProtoReflect.Descriptor instead.
+func (*StreamPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// GetPartFacetsRequest описывает фильтр для подсчёта фасетов каталога.
+type GetPartFacetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Сколько самых популярных тегов вернуть. 0 — значение по умолчанию
+	TopTagsLimit  int32 `protobuf:"varint,2,opt,name=top_tags_limit,json=topTagsLimit,proto3" json:"top_tags_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartFacetsRequest) Reset() {
+	*x = GetPartFacetsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartFacetsRequest) ProtoMessage() {}
+
+func (x *GetPartFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetPartFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPartFacetsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetPartFacetsRequest) GetTopTagsLimit() int32 {
+	if x != nil {
+		return x.TopTagsLimit
+	}
+	return 0
+}
+
+// GetPartFacetsResponse содержит количество деталей по каждому фасету.
+type GetPartFacetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Количество деталей по категориям
+	Categories []*CategoryFacet `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Количество деталей по странам производителей
+	ManufacturerCountries []*FacetCount `protobuf:"bytes,2,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	// Количество деталей по названиям производителей
+	ManufacturerNames []*FacetCount `protobuf:"bytes,3,rep,name=manufacturer_names,json=manufacturerNames,proto3" json:"manufacturer_names,omitempty"`
+	// Самые популярные теги
+	Tags []*FacetCount `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Диапазон действующих цен (с учётом истории цен, как в ListParts)
+	PriceRange    *PriceRange `protobuf:"bytes,5,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartFacetsResponse) Reset() {
+	*x = GetPartFacetsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartFacetsResponse) ProtoMessage() {}
+
+func (x *GetPartFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GetPartFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPartFacetsResponse) GetCategories() []*CategoryFacet {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetPartFacetsResponse) GetManufacturerCountries() []*FacetCount {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *GetPartFacetsResponse) GetManufacturerNames() []*FacetCount {
+	if x != nil {
+		return x.ManufacturerNames
+	}
+	return nil
+}
+
+func (x *GetPartFacetsResponse) GetTags() []*FacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetPartFacetsResponse) GetPriceRange() *PriceRange {
+	if x != nil {
+		return x.PriceRange
+	}
+	return nil
+}
+
+// FacetCount содержит значение фасета и количество деталей с этим значением.
+type FacetCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Значение фасета
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Количество деталей
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// CategoryFacet содержит категорию и количество деталей в ней.
+type CategoryFacet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категория
+	Category Category `protobuf:"varint,1,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	// Количество деталей
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacet) ProtoMessage() {}
+
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CategoryFacet) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *CategoryFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PriceRange описывает минимальную и максимальную цену среди деталей.
+type PriceRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Минимальная цена
+	Min float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Максимальная цена
+	Max           float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceRange) Reset() {
+	*x = PriceRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRange) ProtoMessage() {}
+
+func (x *PriceRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRange.ProtoReflect.Descriptor instead.
+func (*PriceRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PriceRange) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PriceRange) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// CreatePartRequest содержит данные новой детали.
+type CreatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Данные детали
+	Info          *PartInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreatePartRequest) GetInfo() *PartInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// CreatePartResponse содержит созданную деталь.
+type CreatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// UpdatePartRequest содержит новые данные существующей детали.
+type UpdatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор детали
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Данные детали
+	Info          *PartInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdatePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdatePartRequest) GetInfo() *PartInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// UpdatePartResponse содержит обновлённую деталь.
+type UpdatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// DeletePartRequest содержит UUID удаляемой детали.
+type DeletePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор детали
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartRequest) Reset() {
+	*x = DeletePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartRequest) ProtoMessage() {}
+
+func (x *DeletePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeletePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// DeletePartResponse пустой ответ на удаление детали.
+type DeletePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartResponse) Reset() {
+	*x = DeletePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartResponse) ProtoMessage() {}
+
+func (x *DeletePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+// PartInfo содержит редактируемые поля детали.
+type PartInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Название детали
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Описание детали
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Цена за единицу
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Категория
+	Category Category `protobuf:"varint,4,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	// Размеры детали
+	Dimensions *Dimensions `protobuf:"bytes,5,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Информация о производителе
+	Manufacturer *Manufacturer `protobuf:"bytes,6,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	// Теги для быстрого поиска
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Гибкие метаданные
+	Metadata      map[string]*Value `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartInfo) Reset() {
+	*x = PartInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartInfo) ProtoMessage() {}
+
+func (x *PartInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartInfo.ProtoReflect.Descriptor instead.
+func (*PartInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PartInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PartInfo) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PartInfo) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *PartInfo) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PartInfo) GetManufacturer() *Manufacturer {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return nil
+}
+
+func (x *PartInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartInfo) GetMetadata() map[string]*Value {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// AdjustStockRequest описывает изменение остатка детали.
+type AdjustStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Изменение остатка: положительное — приход, отрицательное — расход
+	Delta int64 `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Причина изменения
+	Reason StockMovementReason `protobuf:"varint,3,opt,name=reason,proto3,enum=inventory.v1.StockMovementReason" json:"reason,omitempty"`
+	// Идентификатор связанной сущности (например, UUID заказа)
+	ReferenceId string `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// Код склада. Пусто — склад выбирается по списку приоритетов:
+	// для расхода первый склад с достаточным остатком, для прихода — первый в списке
+	WarehouseCode string `protobuf:"bytes,5,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AdjustStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() StockMovementReason {
+	if x != nil {
+		return x.Reason
+	}
+	return StockMovementReason_STOCK_MOVEMENT_REASON_UNSPECIFIED
+}
+
+func (x *AdjustStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+// AdjustStockResponse содержит записанное движение остатка.
+type AdjustStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движение остатка
+	Movement      *StockMovement `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AdjustStockResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+// ListStockMovementsRequest описывает фильтр журнала движений остатков.
+type ListStockMovementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Идентификатор связанной сущности. Пусто — не фильтруем
+	ReferenceId string `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// Максимальное количество записей. 0 — значение по умолчанию
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Сколько записей пропустить
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Код склада. Пусто — не фильтруем
+	WarehouseCode string `protobuf:"bytes,5,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListStockMovementsRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+// ListStockMovementsResponse содержит движения остатков.
+type ListStockMovementsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движения остатков
+	Movements     []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+// StockMovement описывает одну запись журнала движений остатков.
+type StockMovement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID движения
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Изменение остатка
+	Delta int64 `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Причина изменения
+	Reason StockMovementReason `protobuf:"varint,4,opt,name=reason,proto3,enum=inventory.v1.StockMovementReason" json:"reason,omitempty"`
+	// Идентификатор связанной сущности (например, UUID заказа)
+	ReferenceId string `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// Кто изменил остаток
+	Actor string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Остаток после изменения
+	StockAfter int64 `protobuf:"varint,7,opt,name=stock_after,json=stockAfter,proto3" json:"stock_after,omitempty"`
+	// Дата движения
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Код склада
+	WarehouseCode string `protobuf:"bytes,9,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	// Остаток на складе после изменения
+	WarehouseStockAfter int64 `protobuf:"varint,10,opt,name=warehouse_stock_after,json=warehouseStockAfter,proto3" json:"warehouse_stock_after,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StockMovement) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() StockMovementReason {
+	if x != nil {
+		return x.Reason
+	}
+	return StockMovementReason_STOCK_MOVEMENT_REASON_UNSPECIFIED
+}
+
+func (x *StockMovement) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovement) GetStockAfter() int64 {
+	if x != nil {
+		return x.StockAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StockMovement) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *StockMovement) GetWarehouseStockAfter() int64 {
+	if x != nil {
+		return x.WarehouseStockAfter
+	}
+	return 0
+}
+
+// CreateWarehouseRequest содержит новый склад.
+type CreateWarehouseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Склад
+	Info          *WarehouseInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateWarehouseRequest) GetInfo() *WarehouseInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// CreateWarehouseResponse содержит созданный склад.
+type CreateWarehouseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Склад
+	Warehouse     *Warehouse `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// ListWarehousesRequest пустой: возвращаются все склады.
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+// ListWarehousesResponse содержит склады.
+type ListWarehousesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Склады
+	Warehouses    []*Warehouse `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// WarehouseInfo описывает данные склада, задаваемые при создании.
+type WarehouseInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Код склада: строчные латинские буквы, цифры, '-' и '_'
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Название
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Расположение
+	Location      string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseInfo) Reset() {
+	*x = WarehouseInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseInfo) ProtoMessage() {}
+
+func (x *WarehouseInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseInfo.ProtoReflect.Descriptor instead.
+func (*WarehouseInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WarehouseInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *WarehouseInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WarehouseInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// Warehouse описывает склад (сборочную площадку).
+type Warehouse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Код склада
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Название
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Расположение
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Дата создания
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Warehouse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Warehouse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// SchedulePartPriceRequest содержит будущую цену детали.
+type SchedulePartPriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Цена за единицу
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Момент, с которого действует цена. Должен быть в будущем
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePartPriceRequest) Reset() {
+	*x = SchedulePartPriceRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePartPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePartPriceRequest) ProtoMessage() {}
+
+func (x *SchedulePartPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePartPriceRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePartPriceRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SchedulePartPriceRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *SchedulePartPriceRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePartPriceRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+// SchedulePartPriceResponse содержит запланированную цену.
+type SchedulePartPriceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Цена
+	Price         *PartPrice `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePartPriceResponse) Reset() {
+	*x = SchedulePartPriceResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePartPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePartPriceResponse) ProtoMessage() {}
+
+func (x *SchedulePartPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePartPriceResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePartPriceResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SchedulePartPriceResponse) GetPrice() *PartPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// ListPartPricesRequest содержит деталь, историю цен которой нужно вернуть.
+type ListPartPricesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid      string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartPricesRequest) Reset() {
+	*x = ListPartPricesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartPricesRequest) ProtoMessage() {}
+
+func (x *ListPartPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartPricesRequest.ProtoReflect.Descriptor instead.
+func (*ListPartPricesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListPartPricesRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+// ListPartPricesResponse содержит историю цен детали.
+type ListPartPricesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Цены, начиная с самой поздней
+	Prices        []*PartPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPartPricesResponse) Reset() {
+	*x = ListPartPricesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPartPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPartPricesResponse) ProtoMessage() {}
+
+func (x *ListPartPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartPricesResponse.ProtoReflect.Descriptor instead.
+func (*ListPartPricesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPartPricesResponse) GetPrices() []*PartPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// GetPartPriceAtRequest содержит деталь и момент времени.
+type GetPartPriceAtRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Момент времени. Если не задан, используется текущий
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartPriceAtRequest) Reset() {
+	*x = GetPartPriceAtRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartPriceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartPriceAtRequest) ProtoMessage() {}
+
+func (x *GetPartPriceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartPriceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetPartPriceAtRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPartPriceAtRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *GetPartPriceAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// GetPartPriceAtResponse содержит цену, действовавшую в запрошенный момент.
+type GetPartPriceAtResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Цена
+	Price         *PartPrice `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartPriceAtResponse) Reset() {
+	*x = GetPartPriceAtResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartPriceAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartPriceAtResponse) ProtoMessage() {}
+
+func (x *GetPartPriceAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartPriceAtResponse.ProtoReflect.Descriptor instead.
+func (*GetPartPriceAtResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPartPriceAtResponse) GetPrice() *PartPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// PartPrice описывает запись истории цен детали.
+type PartPrice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Цена за единицу
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Момент, с которого действует цена
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// Дата создания записи
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartPrice) Reset() {
+	*x = PartPrice{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartPrice) ProtoMessage() {}
+
+func (x *PartPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartPrice.ProtoReflect.Descriptor instead.
+func (*PartPrice) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PartPrice) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PartPrice) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PartPrice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateKitRequest содержит новый комплект.
+type CreateKitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Комплект
+	Info          *KitInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateKitRequest) Reset() {
+	*x = CreateKitRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKitRequest) ProtoMessage() {}
+
+func (x *CreateKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKitRequest.ProtoReflect.Descriptor instead.
+func (*CreateKitRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateKitRequest) GetInfo() *KitInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// CreateKitResponse содержит созданный комплект.
+type CreateKitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Комплект
+	Kit           *Kit `protobuf:"bytes,1,opt,name=kit,proto3" json:"kit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateKitResponse) Reset() {
+	*x = CreateKitResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKitResponse) ProtoMessage() {}
+
+func (x *CreateKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKitResponse.ProtoReflect.Descriptor instead.
+func (*CreateKitResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateKitResponse) GetKit() *Kit {
+	if x != nil {
+		return x.Kit
+	}
+	return nil
+}
+
+// GetKitRequest содержит UUID комплекта.
+type GetKitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID комплекта
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKitRequest) Reset() {
+	*x = GetKitRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKitRequest) ProtoMessage() {}
+
+func (x *GetKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKitRequest.ProtoReflect.Descriptor instead.
+func (*GetKitRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetKitRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// GetKitResponse содержит найденный комплект.
+type GetKitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Комплект
+	Kit           *Kit `protobuf:"bytes,1,opt,name=kit,proto3" json:"kit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKitResponse) Reset() {
+	*x = GetKitResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKitResponse) ProtoMessage() {}
+
+func (x *GetKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKitResponse.ProtoReflect.Descriptor instead.
+func (*GetKitResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetKitResponse) GetKit() *Kit {
+	if x != nil {
+		return x.Kit
+	}
+	return nil
+}
+
+// ListKitsRequest описывает, какие комплекты вернуть.
+type ListKitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID комплектов. Если пусто, возвращаются все комплекты
+	Uuids         []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitsRequest) Reset() {
+	*x = ListKitsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitsRequest) ProtoMessage() {}
+
+func (x *ListKitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitsRequest.ProtoReflect.Descriptor instead.
+func (*ListKitsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListKitsRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+// ListKitsResponse содержит найденные комплекты.
+type ListKitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Комплекты
+	Kits          []*Kit `protobuf:"bytes,1,rep,name=kits,proto3" json:"kits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKitsResponse) Reset() {
+	*x = ListKitsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKitsResponse) ProtoMessage() {}
+
+func (x *ListKitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKitsResponse.ProtoReflect.Descriptor instead.
+func (*ListKitsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListKitsResponse) GetKits() []*Kit {
+	if x != nil {
+		return x.Kits
+	}
+	return nil
+}
+
+// UpdateKitRequest содержит новые данные комплекта.
+type UpdateKitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID комплекта
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Комплект
+	Info          *KitInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKitRequest) Reset() {
+	*x = UpdateKitRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitRequest) ProtoMessage() {}
+
+func (x *UpdateKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitRequest.ProtoReflect.Descriptor instead.
+func (*UpdateKitRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateKitRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateKitRequest) GetInfo() *KitInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// UpdateKitResponse содержит обновлённый комплект.
+type UpdateKitResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Комплект
+	Kit           *Kit `protobuf:"bytes,1,opt,name=kit,proto3" json:"kit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateKitResponse) Reset() {
+	*x = UpdateKitResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateKitResponse) ProtoMessage() {}
+
+func (x *UpdateKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateKitResponse.ProtoReflect.Descriptor instead.
+func (*UpdateKitResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateKitResponse) GetKit() *Kit {
+	if x != nil {
+		return x.Kit
+	}
+	return nil
+}
+
+// DeleteKitRequest содержит UUID удаляемого комплекта.
+type DeleteKitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID комплекта
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKitRequest) Reset() {
+	*x = DeleteKitRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKitRequest) ProtoMessage() {}
+
+func (x *DeleteKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKitRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteKitRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// DeleteKitResponse пустой ответ на удаление комплекта.
+type DeleteKitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteKitResponse) Reset() {
+	*x = DeleteKitResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKitResponse) ProtoMessage() {}
+
+func (x *DeleteKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKitResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKitResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+// KitComponent описывает деталь в составе комплекта.
+type KitComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Количество деталей в одном комплекте
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitComponent) Reset() {
+	*x = KitComponent{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitComponent) ProtoMessage() {}
+
+func (x *KitComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitComponent.ProtoReflect.Descriptor instead.
+func (*KitComponent) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *KitComponent) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *KitComponent) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// KitInfo описывает редактируемые поля комплекта.
+type KitInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Название
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Описание
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Состав комплекта. Каждая деталь указывается один раз
+	Components []*KitComponent `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	// Цена комплекта. Если не задана, считается как сумма цен деталей с учётом количества
+	PriceOverride *float64 `protobuf:"fixed64,4,opt,name=price_override,json=priceOverride,proto3,oneof" json:"price_override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitInfo) Reset() {
+	*x = KitInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitInfo) ProtoMessage() {}
+
+func (x *KitInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitInfo.ProtoReflect.Descriptor instead.
+func (*KitInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *KitInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KitInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *KitInfo) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *KitInfo) GetPriceOverride() float64 {
+	if x != nil && x.PriceOverride != nil {
+		return *x.PriceOverride
+	}
+	return 0
+}
+
+// Kit описывает комплект — товар, состоящий из нескольких деталей.
+type Kit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор комплекта
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Название
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Описание
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Состав комплекта
+	Components []*KitComponent `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	// Цена, заданная вручную
+	PriceOverride *float64 `protobuf:"fixed64,5,opt,name=price_override,json=priceOverride,proto3,oneof" json:"price_override,omitempty"`
+	// Действующая цена комплекта: price_override или сумма цен деталей
+	Price float64 `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	// Сколько комплектов можно собрать из остатков деталей
+	AvailableQuantity int64 `protobuf:"varint,7,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	// Дата создания
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Дата обновления
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Kit) Reset() {
+	*x = Kit{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Kit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kit) ProtoMessage() {}
+
+func (x *Kit) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kit.ProtoReflect.Descriptor instead.
+func (*Kit) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Kit) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Kit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Kit) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Kit) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Kit) GetPriceOverride() float64 {
+	if x != nil && x.PriceOverride != nil {
+		return *x.PriceOverride
+	}
+	return 0
+}
+
+func (x *Kit) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Kit) GetAvailableQuantity() int64 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *Kit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Kit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateCompatibilityRuleRequest содержит новое правило совместимости.
+type CreateCompatibilityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правило
+	Info          *CompatibilityRuleInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompatibilityRuleRequest) Reset() {
+	*x = CreateCompatibilityRuleRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompatibilityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompatibilityRuleRequest) ProtoMessage() {}
+
+func (x *CreateCompatibilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompatibilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompatibilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateCompatibilityRuleRequest) GetInfo() *CompatibilityRuleInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// CreateCompatibilityRuleResponse содержит созданное правило.
+type CreateCompatibilityRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правило
+	Rule          *CompatibilityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompatibilityRuleResponse) Reset() {
+	*x = CreateCompatibilityRuleResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompatibilityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompatibilityRuleResponse) ProtoMessage() {}
+
+func (x *CreateCompatibilityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompatibilityRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompatibilityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateCompatibilityRuleResponse) GetRule() *CompatibilityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ListCompatibilityRulesRequest пустой запрос списка правил.
+type ListCompatibilityRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompatibilityRulesRequest) Reset() {
+	*x = ListCompatibilityRulesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompatibilityRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompatibilityRulesRequest) ProtoMessage() {}
+
+func (x *ListCompatibilityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompatibilityRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompatibilityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+// ListCompatibilityRulesResponse содержит все правила совместимости.
+type ListCompatibilityRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правила
+	Rules         []*CompatibilityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompatibilityRulesResponse) Reset() {
+	*x = ListCompatibilityRulesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompatibilityRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompatibilityRulesResponse) ProtoMessage() {}
+
+func (x *ListCompatibilityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompatibilityRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompatibilityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListCompatibilityRulesResponse) GetRules() []*CompatibilityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// DeleteCompatibilityRuleRequest содержит UUID удаляемого правила.
+type DeleteCompatibilityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid идентификатор правила
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompatibilityRuleRequest) Reset() {
+	*x = DeleteCompatibilityRuleRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompatibilityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompatibilityRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCompatibilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompatibilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompatibilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteCompatibilityRuleRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// DeleteCompatibilityRuleResponse пустой ответ на удаление правила.
+type DeleteCompatibilityRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompatibilityRuleResponse) Reset() {
+	*x = DeleteCompatibilityRuleResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompatibilityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompatibilityRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCompatibilityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompatibilityRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCompatibilityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+// ValidateConfigurationRequest содержит набор деталей корабля.
+type ValidateConfigurationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID деталей. Повтор UUID означает несколько единиц одной детали
+	PartUuids     []string `protobuf:"bytes,1,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationRequest) Reset() {
+	*x = ValidateConfigurationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationRequest) ProtoMessage() {}
+
+func (x *ValidateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	Category_value = map[string]int32{
-		"CATEGORY_UNSPECIFIED": 0,
-		"CATEGORY_ENGINE":      1,
-		"CATEGORY_FUEL":        2,
-		"CATEGORY_PORTHOLE":    3,
-		"CATEGORY_WING":        4,
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ValidateConfigurationRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
 	}
-)
+	return nil
+}
 
-func (x Category) Enum() *Category {
-	p := new(Category)
-	*p = x
-	return p
+// ValidateConfigurationResponse содержит результат проверки набора деталей.
+type ValidateConfigurationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Набор деталей не нарушает ни одного правила
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Нарушенные правила
+	Violations    []*RuleViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x Category) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *ValidateConfigurationResponse) Reset() {
+	*x = ValidateConfigurationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+func (x *ValidateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+func (*ValidateConfigurationResponse) ProtoMessage() {}
+
+func (x *ValidateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x Category) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use ValidateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{56}
 }
 
-// Deprecated: Use Category.Descriptor instead.
-func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+func (x *ValidateConfigurationResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
 }
 
-// GetPartRequest содержит параметры запроса для получения детали по UUID.
-type GetPartRequest struct {
+func (x *ValidateConfigurationResponse) GetViolations() []*RuleViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// RuleViolation описывает нарушение одного правила совместимости.
+type RuleViolation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// uuid идентификатор детали
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// UUID нарушенного правила
+	RuleUuid string `protobuf:"bytes,1,opt,name=rule_uuid,json=ruleUuid,proto3" json:"rule_uuid,omitempty"`
+	// Тип правила
+	Type CompatibilityRuleType `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.CompatibilityRuleType" json:"type,omitempty"`
+	// Описание нарушения
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// UUID деталей, из-за которых правило нарушено
+	PartUuids     []string `protobuf:"bytes,4,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartRequest) Reset() {
-	*x = GetPartRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+func (x *RuleViolation) Reset() {
+	*x = RuleViolation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartRequest) String() string {
+func (x *RuleViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartRequest) ProtoMessage() {}
+func (*RuleViolation) ProtoMessage() {}
 
-func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[0]
+func (x *RuleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -120,42 +3361,69 @@ func (x *GetPartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartRequest.ProtoReflect.Descriptor instead.
-func (*GetPartRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RuleViolation.ProtoReflect.Descriptor instead.
+func (*RuleViolation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetPartRequest) GetUuid() string {
+func (x *RuleViolation) GetRuleUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.RuleUuid
 	}
 	return ""
 }
 
-// GetPartResponse содержит найденную деталь.
-type GetPartResponse struct {
+func (x *RuleViolation) GetType() CompatibilityRuleType {
+	if x != nil {
+		return x.Type
+	}
+	return CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *RuleViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RuleViolation) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+// PartSelector выбирает детали по атрибутам. Заданные поля проверяются все вместе.
+type PartSelector struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Деталь
-	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// Категория. UNSPECIFIED — любая
+	Category Category `protobuf:"varint,1,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	// Тег. Пусто — не проверяем
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Ключ метаданных. Пусто — не проверяем
+	MetadataKey string `protobuf:"bytes,3,opt,name=metadata_key,json=metadataKey,proto3" json:"metadata_key,omitempty"`
+	// Значение метаданных в строковом виде. Пусто — достаточно наличия ключа
+	MetadataValue string `protobuf:"bytes,4,opt,name=metadata_value,json=metadataValue,proto3" json:"metadata_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartResponse) Reset() {
-	*x = GetPartResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *PartSelector) Reset() {
+	*x = PartSelector{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartResponse) String() string {
+func (x *PartSelector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartResponse) ProtoMessage() {}
+func (*PartSelector) ProtoMessage() {}
 
-func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[1]
+func (x *PartSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,42 +3434,74 @@ func (x *GetPartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartResponse.ProtoReflect.Descriptor instead.
-func (*GetPartResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PartSelector.ProtoReflect.Descriptor instead.
+func (*PartSelector) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetPartResponse) GetPart() *Part {
+func (x *PartSelector) GetCategory() Category {
 	if x != nil {
-		return x.Part
+		return x.Category
 	}
-	return nil
+	return Category_CATEGORY_UNSPECIFIED
 }
 
-// ListPartsRequest описывает фильтр для получения списка деталей.
-type ListPartsRequest struct {
+func (x *PartSelector) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PartSelector) GetMetadataKey() string {
+	if x != nil {
+		return x.MetadataKey
+	}
+	return ""
+}
+
+func (x *PartSelector) GetMetadataValue() string {
+	if x != nil {
+		return x.MetadataValue
+	}
+	return ""
+}
+
+// CompatibilityRuleInfo содержит редактируемые поля правила совместимости.
+type CompatibilityRuleInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Фильтр
-	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Тип правила
+	Type CompatibilityRuleType `protobuf:"varint,1,opt,name=type,proto3,enum=inventory.v1.CompatibilityRuleType" json:"type,omitempty"`
+	// Детали, к которым применяется правило
+	Subject *PartSelector `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Связанные детали. Не используется для MAX_PER_ORDER
+	Target *PartSelector `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// Максимальное количество единиц для MAX_PER_ORDER
+	MaxQuantity int64 `protobuf:"varint,4,opt,name=max_quantity,json=maxQuantity,proto3" json:"max_quantity,omitempty"`
+	// Для COMPATIBLE_WITH: ключ метаданных, значения которого должны совпадать.
+	// Пусто — у деталей должен быть хотя бы один общий тег
+	MatchMetadataKey string `protobuf:"bytes,5,opt,name=match_metadata_key,json=matchMetadataKey,proto3" json:"match_metadata_key,omitempty"`
+	// Описание правила, возвращается в нарушениях
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsRequest) Reset() {
-	*x = ListPartsRequest{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *CompatibilityRuleInfo) Reset() {
+	*x = CompatibilityRuleInfo{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsRequest) String() string {
+func (x *CompatibilityRuleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsRequest) ProtoMessage() {}
+func (*CompatibilityRuleInfo) ProtoMessage() {}
 
-func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[2]
+func (x *CompatibilityRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,42 +3512,81 @@ func (x *ListPartsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsRequest.ProtoReflect.Descriptor instead.
-func (*ListPartsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CompatibilityRuleInfo.ProtoReflect.Descriptor instead.
+func (*CompatibilityRuleInfo) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListPartsRequest) GetFilter() *PartsFilter {
+func (x *CompatibilityRuleInfo) GetType() CompatibilityRuleType {
 	if x != nil {
-		return x.Filter
+		return x.Type
+	}
+	return CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *CompatibilityRuleInfo) GetSubject() *PartSelector {
+	if x != nil {
+		return x.Subject
 	}
 	return nil
 }
 
-// ListPartsResponse содержит список найденных деталей.
-type ListPartsResponse struct {
+func (x *CompatibilityRuleInfo) GetTarget() *PartSelector {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *CompatibilityRuleInfo) GetMaxQuantity() int64 {
+	if x != nil {
+		return x.MaxQuantity
+	}
+	return 0
+}
+
+func (x *CompatibilityRuleInfo) GetMatchMetadataKey() string {
+	if x != nil {
+		return x.MatchMetadataKey
+	}
+	return ""
+}
+
+func (x *CompatibilityRuleInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CompatibilityRule описывает правило совместимости деталей.
+type CompatibilityRule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Список деталей
-	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Уникальный идентификатор правила
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Правило
+	Info *CompatibilityRuleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// Дата создания
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPartsResponse) Reset() {
-	*x = ListPartsResponse{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *CompatibilityRule) Reset() {
+	*x = CompatibilityRule{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPartsResponse) String() string {
+func (x *CompatibilityRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPartsResponse) ProtoMessage() {}
+func (*CompatibilityRule) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *CompatibilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,14 +3597,28 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CompatibilityRule.ProtoReflect.Descriptor instead.
+func (*CompatibilityRule) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
+func (x *CompatibilityRule) GetUuid() string {
 	if x != nil {
-		return x.Parts
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CompatibilityRule) GetInfo() *CompatibilityRuleInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *CompatibilityRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
@@ -287,7 +3640,7 @@ type Dimensions struct {
 
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +3652,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +3665,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -358,7 +3711,7 @@ type Manufacturer struct {
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +3723,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +3736,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -425,7 +3778,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +3790,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +3803,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *Value) GetValue() isValue_Value {
@@ -537,9 +3890,9 @@ type Part struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Описание детали
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Цена за единицу
+	// Действующая цена за единицу
 	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	// Количество на складе
+	// Количество на всех складах
 	StockQuantity int64 `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
 	// Категория
 	Category Category `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
@@ -554,14 +3907,16 @@ type Part struct {
 	// Дата создания
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Дата обновления
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Остатки по складам: код склада -> количество
+	WarehouseStock map[string]int64 `protobuf:"bytes,13,rep,name=warehouse_stock,json=warehouseStock,proto3" json:"warehouse_stock,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +3928,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +3941,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *Part) GetUuid() string {
@@ -673,6 +4028,13 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetWarehouseStock() map[string]int64 {
+	if x != nil {
+		return x.WarehouseStock
+	}
+	return nil
+}
+
 // PartsFilter определяет критерии фильтрации при поиске деталей.
 type PartsFilter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -692,7 +4054,7 @@ type PartsFilter struct {
 
 func (x *PartsFilter) Reset() {
 	*x = PartsFilter{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +4066,7 @@ func (x *PartsFilter) String() string {
 func (*PartsFilter) ProtoMessage() {}
 
 func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +4079,7 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
 func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *PartsFilter) GetUuids() []string {
@@ -767,7 +4129,219 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\x10ListPartsRequest\x121\n" +
 	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"j\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"f\n" +
+	"\x12StreamPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x02 \x01(\x05R\tchunkSize\"?\n" +
+	"\x13StreamPartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"o\n" +
+	"\x14GetPartFacetsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12$\n" +
+	"\x0etop_tags_limit\x18\x02 \x01(\x05R\ftopTagsLimit\"\xd7\x02\n" +
+	"\x15GetPartFacetsResponse\x12;\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1b.inventory.v1.CategoryFacetR\n" +
+	"categories\x12O\n" +
+	"\x16manufacturer_countries\x18\x02 \x03(\v2\x18.inventory.v1.FacetCountR\x15manufacturerCountries\x12G\n" +
+	"\x12manufacturer_names\x18\x03 \x03(\v2\x18.inventory.v1.FacetCountR\x11manufacturerNames\x12,\n" +
+	"\x04tags\x18\x04 \x03(\v2\x18.inventory.v1.FacetCountR\x04tags\x129\n" +
+	"\vprice_range\x18\x05 \x01(\v2\x18.inventory.v1.PriceRangeR\n" +
+	"priceRange\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"Y\n" +
+	"\rCategoryFacet\x122\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"0\n" +
+	"\n" +
+	"PriceRange\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\"?\n" +
+	"\x11CreatePartRequest\x12*\n" +
+	"\x04info\x18\x01 \x01(\v2\x16.inventory.v1.PartInfoR\x04info\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"S\n" +
+	"\x11UpdatePartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12*\n" +
+	"\x04info\x18\x02 \x01(\v2\x16.inventory.v1.PartInfoR\x04info\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"'\n" +
+	"\x11DeletePartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x14\n" +
+	"\x12DeletePartResponse\"\xac\x03\n" +
+	"\bPartInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x122\n" +
+	"\bcategory\x18\x04 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x128\n" +
+	"\n" +
+	"dimensions\x18\x05 \x01(\v2\x18.inventory.v1.DimensionsR\n" +
+	"dimensions\x12>\n" +
+	"\fmanufacturer\x18\x06 \x01(\v2\x1a.inventory.v1.ManufacturerR\fmanufacturer\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12@\n" +
+	"\bmetadata\x18\b \x03(\v2$.inventory.v1.PartInfo.MetadataEntryR\bmetadata\x1aP\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xcc\x01\n" +
+	"\x12AdjustStockRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\x129\n" +
+	"\x06reason\x18\x03 \x01(\x0e2!.inventory.v1.StockMovementReasonR\x06reason\x12!\n" +
+	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12%\n" +
+	"\x0ewarehouse_code\x18\x05 \x01(\tR\rwarehouseCode\"N\n" +
+	"\x13AdjustStockResponse\x127\n" +
+	"\bmovement\x18\x01 \x01(\v2\x1b.inventory.v1.StockMovementR\bmovement\"\xb0\x01\n" +
+	"\x19ListStockMovementsRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12!\n" +
+	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12%\n" +
+	"\x0ewarehouse_code\x18\x05 \x01(\tR\rwarehouseCode\"W\n" +
+	"\x1aListStockMovementsResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\"\x81\x03\n" +
+	"\rStockMovement\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\x129\n" +
+	"\x06reason\x18\x04 \x01(\x0e2!.inventory.v1.StockMovementReasonR\x06reason\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12\x1f\n" +
+	"\vstock_after\x18\a \x01(\x03R\n" +
+	"stockAfter\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0ewarehouse_code\x18\t \x01(\tR\rwarehouseCode\x122\n" +
+	"\x15warehouse_stock_after\x18\n" +
+	" \x01(\x03R\x13warehouseStockAfter\"I\n" +
+	"\x16CreateWarehouseRequest\x12/\n" +
+	"\x04info\x18\x01 \x01(\v2\x1b.inventory.v1.WarehouseInfoR\x04info\"P\n" +
+	"\x17CreateWarehouseResponse\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\"\x17\n" +
+	"\x15ListWarehousesRequest\"Q\n" +
+	"\x16ListWarehousesResponse\x127\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x17.inventory.v1.WarehouseR\n" +
+	"warehouses\"S\n" +
+	"\rWarehouseInfo\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\"\x8a\x01\n" +
+	"\tWarehouse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x01\n" +
+	"\x18SchedulePartPriceRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"J\n" +
+	"\x19SchedulePartPriceResponse\x12-\n" +
+	"\x05price\x18\x01 \x01(\v2\x17.inventory.v1.PartPriceR\x05price\"4\n" +
+	"\x15ListPartPricesRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\"I\n" +
+	"\x16ListPartPricesResponse\x12/\n" +
+	"\x06prices\x18\x01 \x03(\v2\x17.inventory.v1.PartPriceR\x06prices\"`\n" +
+	"\x15GetPartPriceAtRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"G\n" +
+	"\x16GetPartPriceAtResponse\x12-\n" +
+	"\x05price\x18\x01 \x01(\v2\x17.inventory.v1.PartPriceR\x05price\"\xbc\x01\n" +
+	"\tPartPrice\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"=\n" +
+	"\x10CreateKitRequest\x12)\n" +
+	"\x04info\x18\x01 \x01(\v2\x15.inventory.v1.KitInfoR\x04info\"8\n" +
+	"\x11CreateKitResponse\x12#\n" +
+	"\x03kit\x18\x01 \x01(\v2\x11.inventory.v1.KitR\x03kit\"#\n" +
+	"\rGetKitRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"5\n" +
+	"\x0eGetKitResponse\x12#\n" +
+	"\x03kit\x18\x01 \x01(\v2\x11.inventory.v1.KitR\x03kit\"'\n" +
+	"\x0fListKitsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\"9\n" +
+	"\x10ListKitsResponse\x12%\n" +
+	"\x04kits\x18\x01 \x03(\v2\x11.inventory.v1.KitR\x04kits\"Q\n" +
+	"\x10UpdateKitRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12)\n" +
+	"\x04info\x18\x02 \x01(\v2\x15.inventory.v1.KitInfoR\x04info\"8\n" +
+	"\x11UpdateKitResponse\x12#\n" +
+	"\x03kit\x18\x01 \x01(\v2\x11.inventory.v1.KitR\x03kit\"&\n" +
+	"\x10DeleteKitRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x13\n" +
+	"\x11DeleteKitResponse\"G\n" +
+	"\fKitComponent\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xba\x01\n" +
+	"\aKitInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v2\x1a.inventory.v1.KitComponentR\n" +
+	"components\x12*\n" +
+	"\x0eprice_override\x18\x04 \x01(\x01H\x00R\rpriceOverride\x88\x01\x01B\x11\n" +
+	"\x0f_price_override\"\x85\x03\n" +
+	"\x03Kit\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2\x1a.inventory.v1.KitComponentR\n" +
+	"components\x12*\n" +
+	"\x0eprice_override\x18\x05 \x01(\x01H\x00R\rpriceOverride\x88\x01\x01\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12-\n" +
+	"\x12available_quantity\x18\a \x01(\x03R\x11availableQuantity\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_price_override\"Y\n" +
+	"\x1eCreateCompatibilityRuleRequest\x127\n" +
+	"\x04info\x18\x01 \x01(\v2#.inventory.v1.CompatibilityRuleInfoR\x04info\"V\n" +
+	"\x1fCreateCompatibilityRuleResponse\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.inventory.v1.CompatibilityRuleR\x04rule\"\x1f\n" +
+	"\x1dListCompatibilityRulesRequest\"W\n" +
+	"\x1eListCompatibilityRulesResponse\x125\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1f.inventory.v1.CompatibilityRuleR\x05rules\"4\n" +
+	"\x1eDeleteCompatibilityRuleRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"!\n" +
+	"\x1fDeleteCompatibilityRuleResponse\"=\n" +
+	"\x1cValidateConfigurationRequest\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x01 \x03(\tR\tpartUuids\"r\n" +
+	"\x1dValidateConfigurationResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12;\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2\x1b.inventory.v1.RuleViolationR\n" +
+	"violations\"\x9e\x01\n" +
+	"\rRuleViolation\x12\x1b\n" +
+	"\trule_uuid\x18\x01 \x01(\tR\bruleUuid\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.inventory.v1.CompatibilityRuleTypeR\x04type\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x04 \x03(\tR\tpartUuids\"\x9e\x01\n" +
+	"\fPartSelector\x122\n" +
+	"\bcategory\x18\x01 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12!\n" +
+	"\fmetadata_key\x18\x03 \x01(\tR\vmetadataKey\x12%\n" +
+	"\x0emetadata_value\x18\x04 \x01(\tR\rmetadataValue\"\xad\x02\n" +
+	"\x15CompatibilityRuleInfo\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.inventory.v1.CompatibilityRuleTypeR\x04type\x124\n" +
+	"\asubject\x18\x02 \x01(\v2\x1a.inventory.v1.PartSelectorR\asubject\x122\n" +
+	"\x06target\x18\x03 \x01(\v2\x1a.inventory.v1.PartSelectorR\x06target\x12!\n" +
+	"\fmax_quantity\x18\x04 \x01(\x03R\vmaxQuantity\x12,\n" +
+	"\x12match_metadata_key\x18\x05 \x01(\tR\x10matchMetadataKey\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\x9b\x01\n" +
+	"\x11CompatibilityRule\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x127\n" +
+	"\x04info\x18\x02 \x01(\v2#.inventory.v1.CompatibilityRuleInfoR\x04info\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"j\n" +
 	"\n" +
 	"Dimensions\x12\x16\n" +
 	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
@@ -785,7 +4359,7 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\a\n" +
-	"\x05value\"\xd5\x04\n" +
+	"\x05value\"\xe9\x05\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -803,10 +4377,14 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12O\n" +
+	"\x0fwarehouse_stock\x18\r \x03(\v2&.inventory.v1.Part.WarehouseStockEntryR\x0ewarehouseStock\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xbc\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\x1aA\n" +
+	"\x13WarehouseStockEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xbc\x01\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -814,16 +4392,53 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags*v\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags*\xf6\x01\n" +
+	"\x13StockMovementReason\x12%\n" +
+	"!STOCK_MOVEMENT_REASON_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSTOCK_MOVEMENT_REASON_RESTOCK\x10\x01\x12%\n" +
+	"!STOCK_MOVEMENT_REASON_RESERVATION\x10\x02\x12!\n" +
+	"\x1dSTOCK_MOVEMENT_REASON_RELEASE\x10\x03\x12\x1e\n" +
+	"\x1aSTOCK_MOVEMENT_REASON_SALE\x10\x04\x12+\n" +
+	"'STOCK_MOVEMENT_REASON_MANUAL_ADJUSTMENT\x10\x05*\xe4\x01\n" +
+	"\x15CompatibilityRuleType\x12'\n" +
+	"#COMPATIBILITY_RULE_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" COMPATIBILITY_RULE_TYPE_REQUIRES\x10\x01\x12$\n" +
+	" COMPATIBILITY_RULE_TYPE_EXCLUDES\x10\x02\x12)\n" +
+	"%COMPATIBILITY_RULE_TYPE_MAX_PER_ORDER\x10\x03\x12+\n" +
+	"'COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH\x10\x04*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xf0\x01\n" +
+	"\rCATEGORY_WING\x10\x042\xae\x18\n" +
 	"\x10InventoryService\x12m\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/inventory/part/{uuid}\x12m\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/inventory/partsBPZNgithub.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/inventory/parts\x12|\n" +
+	"\vStreamParts\x12 .inventory.v1.StreamPartsRequest\x1a!.inventory.v1.StreamPartsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/inventory/parts/stream0\x01\x12\x80\x01\n" +
+	"\rGetPartFacets\x12\".inventory.v1.GetPartFacetsRequest\x1a#.inventory.v1.GetPartFacetsResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/inventory/parts/facets\x12s\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/inventory/parts\x12y\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/inventory/part/{uuid}\x12v\n" +
+	"\n" +
+	"DeletePart\x12\x1f.inventory.v1.DeletePartRequest\x1a .inventory.v1.DeletePartResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/inventory/part/{uuid}\x12\x87\x01\n" +
+	"\vAdjustStock\x12 .inventory.v1.AdjustStockRequest\x1a!.inventory.v1.AdjustStockResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/inventory/part/{part_uuid}/stock\x12\xa3\x01\n" +
+	"\x12ListStockMovements\x12'.inventory.v1.ListStockMovementsRequest\x1a(.inventory.v1.ListStockMovementsResponse\":\x82\xd3\xe4\x93\x024\x122/api/v1/inventory/part/{part_uuid}/stock/movements\x12o\n" +
+	"\tCreateKit\x12\x1e.inventory.v1.CreateKitRequest\x1a\x1f.inventory.v1.CreateKitResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/inventory/kits\x12i\n" +
+	"\x06GetKit\x12\x1b.inventory.v1.GetKitRequest\x1a\x1c.inventory.v1.GetKitResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/inventory/kit/{uuid}\x12i\n" +
+	"\bListKits\x12\x1d.inventory.v1.ListKitsRequest\x1a\x1e.inventory.v1.ListKitsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/inventory/kits\x12u\n" +
+	"\tUpdateKit\x12\x1e.inventory.v1.UpdateKitRequest\x1a\x1f.inventory.v1.UpdateKitResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/inventory/kit/{uuid}\x12r\n" +
+	"\tDeleteKit\x12\x1e.inventory.v1.DeleteKitRequest\x1a\x1f.inventory.v1.DeleteKitResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/inventory/kit/{uuid}\x12\xa8\x01\n" +
+	"\x17CreateCompatibilityRule\x12,.inventory.v1.CreateCompatibilityRuleRequest\x1a-.inventory.v1.CreateCompatibilityRuleResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/inventory/compatibility/rules\x12\xa2\x01\n" +
+	"\x16ListCompatibilityRules\x12+.inventory.v1.ListCompatibilityRulesRequest\x1a,.inventory.v1.ListCompatibilityRulesResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/inventory/compatibility/rules\x12\xac\x01\n" +
+	"\x17DeleteCompatibilityRule\x12,.inventory.v1.DeleteCompatibilityRuleRequest\x1a-.inventory.v1.DeleteCompatibilityRuleResponse\"4\x82\xd3\xe4\x93\x02.*,/api/v1/inventory/compatibility/rules/{uuid}\x12\xa5\x01\n" +
+	"\x15ValidateConfiguration\x12*.inventory.v1.ValidateConfigurationRequest\x1a+.inventory.v1.ValidateConfigurationResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/inventory/configuration/validate\x12\x87\x01\n" +
+	"\x0fCreateWarehouse\x12$.inventory.v1.CreateWarehouseRequest\x1a%.inventory.v1.CreateWarehouseResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/inventory/warehouses\x12\x81\x01\n" +
+	"\x0eListWarehouses\x12#.inventory.v1.ListWarehousesRequest\x1a$.inventory.v1.ListWarehousesResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/inventory/warehouses\x12\x9a\x01\n" +
+	"\x11SchedulePartPrice\x12&.inventory.v1.SchedulePartPriceRequest\x1a'.inventory.v1.SchedulePartPriceResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/inventory/part/{part_uuid}/prices\x12\x8e\x01\n" +
+	"\x0eListPartPrices\x12#.inventory.v1.ListPartPricesRequest\x1a$.inventory.v1.ListPartPricesResponse\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/inventory/part/{part_uuid}/prices\x12\x8d\x01\n" +
+	"\x0eGetPartPriceAt\x12#.inventory.v1.GetPartPriceAtRequest\x1a$.inventory.v1.GetPartPriceAtResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/inventory/part/{part_uuid}/priceBPZNgithub.com/nkolesnikov999/micro2-OK/shared/pkg/proto/inventory/v1;inventory_v1b\x06proto3"
 
 var (
 	file_inventory_v1_inventory_proto_rawDescOnce sync.Once
@@ -837,43 +4452,202 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*Dimensions)(nil),            // 5: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 6: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 7: inventory.v1.Value
-	(*Part)(nil),                  // 8: inventory.v1.Part
-	(*PartsFilter)(nil),           // 9: inventory.v1.PartsFilter
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(StockMovementReason)(0),                // 0: inventory.v1.StockMovementReason
+	(CompatibilityRuleType)(0),              // 1: inventory.v1.CompatibilityRuleType
+	(Category)(0),                           // 2: inventory.v1.Category
+	(*GetPartRequest)(nil),                  // 3: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),                 // 4: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),                // 5: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),               // 6: inventory.v1.ListPartsResponse
+	(*StreamPartsRequest)(nil),              // 7: inventory.v1.StreamPartsRequest
+	(*StreamPartsResponse)(nil),             // 8: inventory.v1.StreamPartsResponse
+	(*GetPartFacetsRequest)(nil),            // 9: inventory.v1.GetPartFacetsRequest
+	(*GetPartFacetsResponse)(nil),           // 10: inventory.v1.GetPartFacetsResponse
+	(*FacetCount)(nil),                      // 11: inventory.v1.FacetCount
+	(*CategoryFacet)(nil),                   // 12: inventory.v1.CategoryFacet
+	(*PriceRange)(nil),                      // 13: inventory.v1.PriceRange
+	(*CreatePartRequest)(nil),               // 14: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),              // 15: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),               // 16: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),              // 17: inventory.v1.UpdatePartResponse
+	(*DeletePartRequest)(nil),               // 18: inventory.v1.DeletePartRequest
+	(*DeletePartResponse)(nil),              // 19: inventory.v1.DeletePartResponse
+	(*PartInfo)(nil),                        // 20: inventory.v1.PartInfo
+	(*AdjustStockRequest)(nil),              // 21: inventory.v1.AdjustStockRequest
+	(*AdjustStockResponse)(nil),             // 22: inventory.v1.AdjustStockResponse
+	(*ListStockMovementsRequest)(nil),       // 23: inventory.v1.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),      // 24: inventory.v1.ListStockMovementsResponse
+	(*StockMovement)(nil),                   // 25: inventory.v1.StockMovement
+	(*CreateWarehouseRequest)(nil),          // 26: inventory.v1.CreateWarehouseRequest
+	(*CreateWarehouseResponse)(nil),         // 27: inventory.v1.CreateWarehouseResponse
+	(*ListWarehousesRequest)(nil),           // 28: inventory.v1.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),          // 29: inventory.v1.ListWarehousesResponse
+	(*WarehouseInfo)(nil),                   // 30: inventory.v1.WarehouseInfo
+	(*Warehouse)(nil),                       // 31: inventory.v1.Warehouse
+	(*SchedulePartPriceRequest)(nil),        // 32: inventory.v1.SchedulePartPriceRequest
+	(*SchedulePartPriceResponse)(nil),       // 33: inventory.v1.SchedulePartPriceResponse
+	(*ListPartPricesRequest)(nil),           // 34: inventory.v1.ListPartPricesRequest
+	(*ListPartPricesResponse)(nil),          // 35: inventory.v1.ListPartPricesResponse
+	(*GetPartPriceAtRequest)(nil),           // 36: inventory.v1.GetPartPriceAtRequest
+	(*GetPartPriceAtResponse)(nil),          // 37: inventory.v1.GetPartPriceAtResponse
+	(*PartPrice)(nil),                       // 38: inventory.v1.PartPrice
+	(*CreateKitRequest)(nil),                // 39: inventory.v1.CreateKitRequest
+	(*CreateKitResponse)(nil),               // 40: inventory.v1.CreateKitResponse
+	(*GetKitRequest)(nil),                   // 41: inventory.v1.GetKitRequest
+	(*GetKitResponse)(nil),                  // 42: inventory.v1.GetKitResponse
+	(*ListKitsRequest)(nil),                 // 43: inventory.v1.ListKitsRequest
+	(*ListKitsResponse)(nil),                // 44: inventory.v1.ListKitsResponse
+	(*UpdateKitRequest)(nil),                // 45: inventory.v1.UpdateKitRequest
+	(*UpdateKitResponse)(nil),               // 46: inventory.v1.UpdateKitResponse
+	(*DeleteKitRequest)(nil),                // 47: inventory.v1.DeleteKitRequest
+	(*DeleteKitResponse)(nil),               // 48: inventory.v1.DeleteKitResponse
+	(*KitComponent)(nil),                    // 49: inventory.v1.KitComponent
+	(*KitInfo)(nil),                         // 50: inventory.v1.KitInfo
+	(*Kit)(nil),                             // 51: inventory.v1.Kit
+	(*CreateCompatibilityRuleRequest)(nil),  // 52: inventory.v1.CreateCompatibilityRuleRequest
+	(*CreateCompatibilityRuleResponse)(nil), // 53: inventory.v1.CreateCompatibilityRuleResponse
+	(*ListCompatibilityRulesRequest)(nil),   // 54: inventory.v1.ListCompatibilityRulesRequest
+	(*ListCompatibilityRulesResponse)(nil),  // 55: inventory.v1.ListCompatibilityRulesResponse
+	(*DeleteCompatibilityRuleRequest)(nil),  // 56: inventory.v1.DeleteCompatibilityRuleRequest
+	(*DeleteCompatibilityRuleResponse)(nil), // 57: inventory.v1.DeleteCompatibilityRuleResponse
+	(*ValidateConfigurationRequest)(nil),    // 58: inventory.v1.ValidateConfigurationRequest
+	(*ValidateConfigurationResponse)(nil),   // 59: inventory.v1.ValidateConfigurationResponse
+	(*RuleViolation)(nil),                   // 60: inventory.v1.RuleViolation
+	(*PartSelector)(nil),                    // 61: inventory.v1.PartSelector
+	(*CompatibilityRuleInfo)(nil),           // 62: inventory.v1.CompatibilityRuleInfo
+	(*CompatibilityRule)(nil),               // 63: inventory.v1.CompatibilityRule
+	(*Dimensions)(nil),                      // 64: inventory.v1.Dimensions
+	(*Manufacturer)(nil),                    // 65: inventory.v1.Manufacturer
+	(*Value)(nil),                           // 66: inventory.v1.Value
+	(*Part)(nil),                            // 67: inventory.v1.Part
+	(*PartsFilter)(nil),                     // 68: inventory.v1.PartsFilter
+	nil,                                     // 69: inventory.v1.PartInfo.MetadataEntry
+	nil,                                     // 70: inventory.v1.Part.MetadataEntry
+	nil,                                     // 71: inventory.v1.Part.WarehouseStockEntry
+	(*timestamppb.Timestamp)(nil),           // 72: google.protobuf.Timestamp
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	8,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	9,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	8,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	5,  // 4: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	6,  // 5: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 6: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 7: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 8: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	7,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	67, // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	68, // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	67, // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	68, // 3: inventory.v1.StreamPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	67, // 4: inventory.v1.StreamPartsResponse.parts:type_name -> inventory.v1.Part
+	68, // 5: inventory.v1.GetPartFacetsRequest.filter:type_name -> inventory.v1.PartsFilter
+	12, // 6: inventory.v1.GetPartFacetsResponse.categories:type_name -> inventory.v1.CategoryFacet
+	11, // 7: inventory.v1.GetPartFacetsResponse.manufacturer_countries:type_name -> inventory.v1.FacetCount
+	11, // 8: inventory.v1.GetPartFacetsResponse.manufacturer_names:type_name -> inventory.v1.FacetCount
+	11, // 9: inventory.v1.GetPartFacetsResponse.tags:type_name -> inventory.v1.FacetCount
+	13, // 10: inventory.v1.GetPartFacetsResponse.price_range:type_name -> inventory.v1.PriceRange
+	2,  // 11: inventory.v1.CategoryFacet.category:type_name -> inventory.v1.Category
+	20, // 12: inventory.v1.CreatePartRequest.info:type_name -> inventory.v1.PartInfo
+	67, // 13: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	20, // 14: inventory.v1.UpdatePartRequest.info:type_name -> inventory.v1.PartInfo
+	67, // 15: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	2,  // 16: inventory.v1.PartInfo.category:type_name -> inventory.v1.Category
+	64, // 17: inventory.v1.PartInfo.dimensions:type_name -> inventory.v1.Dimensions
+	65, // 18: inventory.v1.PartInfo.manufacturer:type_name -> inventory.v1.Manufacturer
+	69, // 19: inventory.v1.PartInfo.metadata:type_name -> inventory.v1.PartInfo.MetadataEntry
+	0,  // 20: inventory.v1.AdjustStockRequest.reason:type_name -> inventory.v1.StockMovementReason
+	25, // 21: inventory.v1.AdjustStockResponse.movement:type_name -> inventory.v1.StockMovement
+	25, // 22: inventory.v1.ListStockMovementsResponse.movements:type_name -> inventory.v1.StockMovement
+	0,  // 23: inventory.v1.StockMovement.reason:type_name -> inventory.v1.StockMovementReason
+	72, // 24: inventory.v1.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	30, // 25: inventory.v1.CreateWarehouseRequest.info:type_name -> inventory.v1.WarehouseInfo
+	31, // 26: inventory.v1.CreateWarehouseResponse.warehouse:type_name -> inventory.v1.Warehouse
+	31, // 27: inventory.v1.ListWarehousesResponse.warehouses:type_name -> inventory.v1.Warehouse
+	72, // 28: inventory.v1.Warehouse.created_at:type_name -> google.protobuf.Timestamp
+	72, // 29: inventory.v1.SchedulePartPriceRequest.effective_from:type_name -> google.protobuf.Timestamp
+	38, // 30: inventory.v1.SchedulePartPriceResponse.price:type_name -> inventory.v1.PartPrice
+	38, // 31: inventory.v1.ListPartPricesResponse.prices:type_name -> inventory.v1.PartPrice
+	72, // 32: inventory.v1.GetPartPriceAtRequest.at:type_name -> google.protobuf.Timestamp
+	38, // 33: inventory.v1.GetPartPriceAtResponse.price:type_name -> inventory.v1.PartPrice
+	72, // 34: inventory.v1.PartPrice.effective_from:type_name -> google.protobuf.Timestamp
+	72, // 35: inventory.v1.PartPrice.created_at:type_name -> google.protobuf.Timestamp
+	50, // 36: inventory.v1.CreateKitRequest.info:type_name -> inventory.v1.KitInfo
+	51, // 37: inventory.v1.CreateKitResponse.kit:type_name -> inventory.v1.Kit
+	51, // 38: inventory.v1.GetKitResponse.kit:type_name -> inventory.v1.Kit
+	51, // 39: inventory.v1.ListKitsResponse.kits:type_name -> inventory.v1.Kit
+	50, // 40: inventory.v1.UpdateKitRequest.info:type_name -> inventory.v1.KitInfo
+	51, // 41: inventory.v1.UpdateKitResponse.kit:type_name -> inventory.v1.Kit
+	49, // 42: inventory.v1.KitInfo.components:type_name -> inventory.v1.KitComponent
+	49, // 43: inventory.v1.Kit.components:type_name -> inventory.v1.KitComponent
+	72, // 44: inventory.v1.Kit.created_at:type_name -> google.protobuf.Timestamp
+	72, // 45: inventory.v1.Kit.updated_at:type_name -> google.protobuf.Timestamp
+	62, // 46: inventory.v1.CreateCompatibilityRuleRequest.info:type_name -> inventory.v1.CompatibilityRuleInfo
+	63, // 47: inventory.v1.CreateCompatibilityRuleResponse.rule:type_name -> inventory.v1.CompatibilityRule
+	63, // 48: inventory.v1.ListCompatibilityRulesResponse.rules:type_name -> inventory.v1.CompatibilityRule
+	60, // 49: inventory.v1.ValidateConfigurationResponse.violations:type_name -> inventory.v1.RuleViolation
+	1,  // 50: inventory.v1.RuleViolation.type:type_name -> inventory.v1.CompatibilityRuleType
+	2,  // 51: inventory.v1.PartSelector.category:type_name -> inventory.v1.Category
+	1,  // 52: inventory.v1.CompatibilityRuleInfo.type:type_name -> inventory.v1.CompatibilityRuleType
+	61, // 53: inventory.v1.CompatibilityRuleInfo.subject:type_name -> inventory.v1.PartSelector
+	61, // 54: inventory.v1.CompatibilityRuleInfo.target:type_name -> inventory.v1.PartSelector
+	62, // 55: inventory.v1.CompatibilityRule.info:type_name -> inventory.v1.CompatibilityRuleInfo
+	72, // 56: inventory.v1.CompatibilityRule.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 57: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	64, // 58: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	65, // 59: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	70, // 60: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	72, // 61: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	72, // 62: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	71, // 63: inventory.v1.Part.warehouse_stock:type_name -> inventory.v1.Part.WarehouseStockEntry
+	2,  // 64: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	66, // 65: inventory.v1.PartInfo.MetadataEntry.value:type_name -> inventory.v1.Value
+	66, // 66: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	3,  // 67: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	5,  // 68: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	7,  // 69: inventory.v1.InventoryService.StreamParts:input_type -> inventory.v1.StreamPartsRequest
+	9,  // 70: inventory.v1.InventoryService.GetPartFacets:input_type -> inventory.v1.GetPartFacetsRequest
+	14, // 71: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	16, // 72: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	18, // 73: inventory.v1.InventoryService.DeletePart:input_type -> inventory.v1.DeletePartRequest
+	21, // 74: inventory.v1.InventoryService.AdjustStock:input_type -> inventory.v1.AdjustStockRequest
+	23, // 75: inventory.v1.InventoryService.ListStockMovements:input_type -> inventory.v1.ListStockMovementsRequest
+	39, // 76: inventory.v1.InventoryService.CreateKit:input_type -> inventory.v1.CreateKitRequest
+	41, // 77: inventory.v1.InventoryService.GetKit:input_type -> inventory.v1.GetKitRequest
+	43, // 78: inventory.v1.InventoryService.ListKits:input_type -> inventory.v1.ListKitsRequest
+	45, // 79: inventory.v1.InventoryService.UpdateKit:input_type -> inventory.v1.UpdateKitRequest
+	47, // 80: inventory.v1.InventoryService.DeleteKit:input_type -> inventory.v1.DeleteKitRequest
+	52, // 81: inventory.v1.InventoryService.CreateCompatibilityRule:input_type -> inventory.v1.CreateCompatibilityRuleRequest
+	54, // 82: inventory.v1.InventoryService.ListCompatibilityRules:input_type -> inventory.v1.ListCompatibilityRulesRequest
+	56, // 83: inventory.v1.InventoryService.DeleteCompatibilityRule:input_type -> inventory.v1.DeleteCompatibilityRuleRequest
+	58, // 84: inventory.v1.InventoryService.ValidateConfiguration:input_type -> inventory.v1.ValidateConfigurationRequest
+	26, // 85: inventory.v1.InventoryService.CreateWarehouse:input_type -> inventory.v1.CreateWarehouseRequest
+	28, // 86: inventory.v1.InventoryService.ListWarehouses:input_type -> inventory.v1.ListWarehousesRequest
+	32, // 87: inventory.v1.InventoryService.SchedulePartPrice:input_type -> inventory.v1.SchedulePartPriceRequest
+	34, // 88: inventory.v1.InventoryService.ListPartPrices:input_type -> inventory.v1.ListPartPricesRequest
+	36, // 89: inventory.v1.InventoryService.GetPartPriceAt:input_type -> inventory.v1.GetPartPriceAtRequest
+	4,  // 90: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	6,  // 91: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	8,  // 92: inventory.v1.InventoryService.StreamParts:output_type -> inventory.v1.StreamPartsResponse
+	10, // 93: inventory.v1.InventoryService.GetPartFacets:output_type -> inventory.v1.GetPartFacetsResponse
+	15, // 94: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	17, // 95: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	19, // 96: inventory.v1.InventoryService.DeletePart:output_type -> inventory.v1.DeletePartResponse
+	22, // 97: inventory.v1.InventoryService.AdjustStock:output_type -> inventory.v1.AdjustStockResponse
+	24, // 98: inventory.v1.InventoryService.ListStockMovements:output_type -> inventory.v1.ListStockMovementsResponse
+	40, // 99: inventory.v1.InventoryService.CreateKit:output_type -> inventory.v1.CreateKitResponse
+	42, // 100: inventory.v1.InventoryService.GetKit:output_type -> inventory.v1.GetKitResponse
+	44, // 101: inventory.v1.InventoryService.ListKits:output_type -> inventory.v1.ListKitsResponse
+	46, // 102: inventory.v1.InventoryService.UpdateKit:output_type -> inventory.v1.UpdateKitResponse
+	48, // 103: inventory.v1.InventoryService.DeleteKit:output_type -> inventory.v1.DeleteKitResponse
+	53, // 104: inventory.v1.InventoryService.CreateCompatibilityRule:output_type -> inventory.v1.CreateCompatibilityRuleResponse
+	55, // 105: inventory.v1.InventoryService.ListCompatibilityRules:output_type -> inventory.v1.ListCompatibilityRulesResponse
+	57, // 106: inventory.v1.InventoryService.DeleteCompatibilityRule:output_type -> inventory.v1.DeleteCompatibilityRuleResponse
+	59, // 107: inventory.v1.InventoryService.ValidateConfiguration:output_type -> inventory.v1.ValidateConfigurationResponse
+	27, // 108: inventory.v1.InventoryService.CreateWarehouse:output_type -> inventory.v1.CreateWarehouseResponse
+	29, // 109: inventory.v1.InventoryService.ListWarehouses:output_type -> inventory.v1.ListWarehousesResponse
+	33, // 110: inventory.v1.InventoryService.SchedulePartPrice:output_type -> inventory.v1.SchedulePartPriceResponse
+	35, // 111: inventory.v1.InventoryService.ListPartPrices:output_type -> inventory.v1.ListPartPricesResponse
+	37, // 112: inventory.v1.InventoryService.GetPartPriceAt:output_type -> inventory.v1.GetPartPriceAtResponse
+	90, // [90:113] is the sub-list for method output_type
+	67, // [67:90] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -881,7 +4655,9 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[6].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[47].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[48].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[63].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -892,8 +4668,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,8 +21,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName                 = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName               = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_StreamParts_FullMethodName             = "/inventory.v1.InventoryService/StreamParts"
+	InventoryService_GetPartFacets_FullMethodName           = "/inventory.v1.InventoryService/GetPartFacets"
+	InventoryService_CreatePart_FullMethodName              = "/inventory.v1.InventoryService/CreatePart"
+	InventoryService_UpdatePart_FullMethodName              = "/inventory.v1.InventoryService/UpdatePart"
+	InventoryService_DeletePart_FullMethodName              = "/inventory.v1.InventoryService/DeletePart"
+	InventoryService_AdjustStock_FullMethodName             = "/inventory.v1.InventoryService/AdjustStock"
+	InventoryService_ListStockMovements_FullMethodName      = "/inventory.v1.InventoryService/ListStockMovements"
+	InventoryService_CreateKit_FullMethodName               = "/inventory.v1.InventoryService/CreateKit"
+	InventoryService_GetKit_FullMethodName                  = "/inventory.v1.InventoryService/GetKit"
+	InventoryService_ListKits_FullMethodName                = "/inventory.v1.InventoryService/ListKits"
+	InventoryService_UpdateKit_FullMethodName               = "/inventory.v1.InventoryService/UpdateKit"
+	InventoryService_DeleteKit_FullMethodName               = "/inventory.v1.InventoryService/DeleteKit"
+	InventoryService_CreateCompatibilityRule_FullMethodName = "/inventory.v1.InventoryService/CreateCompatibilityRule"
+	InventoryService_ListCompatibilityRules_FullMethodName  = "/inventory.v1.InventoryService/ListCompatibilityRules"
+	InventoryService_DeleteCompatibilityRule_FullMethodName = "/inventory.v1.InventoryService/DeleteCompatibilityRule"
+	InventoryService_ValidateConfiguration_FullMethodName   = "/inventory.v1.InventoryService/ValidateConfiguration"
+	InventoryService_CreateWarehouse_FullMethodName         = "/inventory.v1.InventoryService/CreateWarehouse"
+	InventoryService_ListWarehouses_FullMethodName          = "/inventory.v1.InventoryService/ListWarehouses"
+	InventoryService_SchedulePartPrice_FullMethodName       = "/inventory.v1.InventoryService/SchedulePartPrice"
+	InventoryService_ListPartPrices_FullMethodName          = "/inventory.v1.InventoryService/ListPartPrices"
+	InventoryService_GetPartPriceAt_FullMethodName          = "/inventory.v1.InventoryService/GetPartPriceAt"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -35,6 +56,49 @@ type InventoryServiceClient interface {
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
 	// Возвращает список деталей по фильтру.
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// Потоково возвращает детали по фильтру порциями. Подходит для выгрузки большого каталога,
+	// которая не помещается в один ответ ListParts.
+	StreamParts(ctx context.Context, in *StreamPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamPartsResponse], error)
+	// Возвращает количество деталей по фасетам каталога для того же фильтра, что и ListParts.
+	GetPartFacets(ctx context.Context, in *GetPartFacetsRequest, opts ...grpc.CallOption) (*GetPartFacetsResponse, error)
+	// Добавляет деталь в каталог. Остаток новой детали равен нулю и меняется только через AdjustStock.
+	CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error)
+	// Обновляет описание, цену и атрибуты детали. Остаток не меняется.
+	UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error)
+	// Удаляет деталь из каталога. Журнал движений остатков сохраняется.
+	DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error)
+	// Изменяет остаток детали на складе и записывает движение в журнал.
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	// Возвращает журнал движений остатков, начиная с самых новых.
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	// Добавляет комплект из нескольких деталей.
+	CreateKit(ctx context.Context, in *CreateKitRequest, opts ...grpc.CallOption) (*CreateKitResponse, error)
+	// Возвращает комплект с ценой и доступным количеством.
+	GetKit(ctx context.Context, in *GetKitRequest, opts ...grpc.CallOption) (*GetKitResponse, error)
+	// Возвращает комплекты: все или с указанными UUID.
+	ListKits(ctx context.Context, in *ListKitsRequest, opts ...grpc.CallOption) (*ListKitsResponse, error)
+	// Заменяет название, состав и цену комплекта.
+	UpdateKit(ctx context.Context, in *UpdateKitRequest, opts ...grpc.CallOption) (*UpdateKitResponse, error)
+	// Удаляет комплект. Детали из его состава не меняются.
+	DeleteKit(ctx context.Context, in *DeleteKitRequest, opts ...grpc.CallOption) (*DeleteKitResponse, error)
+	// Добавляет правило совместимости деталей.
+	CreateCompatibilityRule(ctx context.Context, in *CreateCompatibilityRuleRequest, opts ...grpc.CallOption) (*CreateCompatibilityRuleResponse, error)
+	// Возвращает все правила совместимости в порядке создания.
+	ListCompatibilityRules(ctx context.Context, in *ListCompatibilityRulesRequest, opts ...grpc.CallOption) (*ListCompatibilityRulesResponse, error)
+	// Удаляет правило совместимости.
+	DeleteCompatibilityRule(ctx context.Context, in *DeleteCompatibilityRuleRequest, opts ...grpc.CallOption) (*DeleteCompatibilityRuleResponse, error)
+	// Проверяет набор деталей по правилам совместимости и возвращает все нарушения.
+	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
+	// Добавляет склад.
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error)
+	// Возвращает все склады, упорядоченные по коду.
+	ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error)
+	// Планирует изменение цены детали с указанного момента в будущем.
+	SchedulePartPrice(ctx context.Context, in *SchedulePartPriceRequest, opts ...grpc.CallOption) (*SchedulePartPriceResponse, error)
+	// Возвращает историю цен детали, включая запланированные, начиная с самых поздних.
+	ListPartPrices(ctx context.Context, in *ListPartPricesRequest, opts ...grpc.CallOption) (*ListPartPricesResponse, error)
+	// Возвращает цену детали, действовавшую в указанный момент.
+	GetPartPriceAt(ctx context.Context, in *GetPartPriceAtRequest, opts ...grpc.CallOption) (*GetPartPriceAtResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -65,6 +129,225 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) StreamParts(ctx context.Context, in *StreamPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_StreamParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPartsRequest, StreamPartsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_StreamPartsClient = grpc.ServerStreamingClient[StreamPartsResponse]
+
+func (c *inventoryServiceClient) GetPartFacets(ctx context.Context, in *GetPartFacetsRequest, opts ...grpc.CallOption) (*GetPartFacetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPartFacetsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPartFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeletePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateKit(ctx context.Context, in *CreateKitRequest, opts ...grpc.CallOption) (*CreateKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateKitResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetKit(ctx context.Context, in *GetKitRequest, opts ...grpc.CallOption) (*GetKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKitResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListKits(ctx context.Context, in *ListKitsRequest, opts ...grpc.CallOption) (*ListKitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKitsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListKits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateKit(ctx context.Context, in *UpdateKitRequest, opts ...grpc.CallOption) (*UpdateKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateKitResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteKit(ctx context.Context, in *DeleteKitRequest, opts ...grpc.CallOption) (*DeleteKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteKitResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateCompatibilityRule(ctx context.Context, in *CreateCompatibilityRuleRequest, opts ...grpc.CallOption) (*CreateCompatibilityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCompatibilityRuleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateCompatibilityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListCompatibilityRules(ctx context.Context, in *ListCompatibilityRulesRequest, opts ...grpc.CallOption) (*ListCompatibilityRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompatibilityRulesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListCompatibilityRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteCompatibilityRule(ctx context.Context, in *DeleteCompatibilityRuleRequest, opts ...grpc.CallOption) (*DeleteCompatibilityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCompatibilityRuleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteCompatibilityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigurationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ValidateConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWarehouseResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWarehousesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListWarehouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SchedulePartPrice(ctx context.Context, in *SchedulePartPriceRequest, opts ...grpc.CallOption) (*SchedulePartPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePartPriceResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SchedulePartPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListPartPrices(ctx context.Context, in *ListPartPricesRequest, opts ...grpc.CallOption) (*ListPartPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPartPricesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListPartPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPartPriceAt(ctx context.Context, in *GetPartPriceAtRequest, opts ...grpc.CallOption) (*GetPartPriceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPartPriceAtResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPartPriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -75,6 +358,49 @@ type InventoryServiceServer interface {
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
 	// Возвращает список деталей по фильтру.
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// Потоково возвращает детали по фильтру порциями. Подходит для выгрузки большого каталога,
+	// которая не помещается в один ответ ListParts.
+	StreamParts(*StreamPartsRequest, grpc.ServerStreamingServer[StreamPartsResponse]) error
+	// Возвращает количество деталей по фасетам каталога для того же фильтра, что и ListParts.
+	GetPartFacets(context.Context, *GetPartFacetsRequest) (*GetPartFacetsResponse, error)
+	// Добавляет деталь в каталог. Остаток новой детали равен нулю и меняется только через AdjustStock.
+	CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error)
+	// Обновляет описание, цену и атрибуты детали. Остаток не меняется.
+	UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error)
+	// Удаляет деталь из каталога. Журнал движений остатков сохраняется.
+	DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error)
+	// Изменяет остаток детали на складе и записывает движение в журнал.
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	// Возвращает журнал движений остатков, начиная с самых новых.
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	// Добавляет комплект из нескольких деталей.
+	CreateKit(context.Context, *CreateKitRequest) (*CreateKitResponse, error)
+	// Возвращает комплект с ценой и доступным количеством.
+	GetKit(context.Context, *GetKitRequest) (*GetKitResponse, error)
+	// Возвращает комплекты: все или с указанными UUID.
+	ListKits(context.Context, *ListKitsRequest) (*ListKitsResponse, error)
+	// Заменяет название, состав и цену комплекта.
+	UpdateKit(context.Context, *UpdateKitRequest) (*UpdateKitResponse, error)
+	// Удаляет комплект. Детали из его состава не меняются.
+	DeleteKit(context.Context, *DeleteKitRequest) (*DeleteKitResponse, error)
+	// Добавляет правило совместимости деталей.
+	CreateCompatibilityRule(context.Context, *CreateCompatibilityRuleRequest) (*CreateCompatibilityRuleResponse, error)
+	// Возвращает все правила совместимости в порядке создания.
+	ListCompatibilityRules(context.Context, *ListCompatibilityRulesRequest) (*ListCompatibilityRulesResponse, error)
+	// Удаляет правило совместимости.
+	DeleteCompatibilityRule(context.Context, *DeleteCompatibilityRuleRequest) (*DeleteCompatibilityRuleResponse, error)
+	// Проверяет набор деталей по правилам совместимости и возвращает все нарушения.
+	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
+	// Добавляет склад.
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error)
+	// Возвращает все склады, упорядоченные по коду.
+	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
+	// Планирует изменение цены детали с указанного момента в будущем.
+	SchedulePartPrice(context.Context, *SchedulePartPriceRequest) (*SchedulePartPriceResponse, error)
+	// Возвращает историю цен детали, включая запланированные, начиная с самых поздних.
+	ListPartPrices(context.Context, *ListPartPricesRequest) (*ListPartPricesResponse, error)
+	// Возвращает цену детали, действовавшую в указанный момент.
+	GetPartPriceAt(context.Context, *GetPartPriceAtRequest) (*GetPartPriceAtResponse, error)
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
@@ -91,6 +417,69 @@ func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequ
 func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
 }
+func (UnimplementedInventoryServiceServer) StreamParts(*StreamPartsRequest, grpc.ServerStreamingServer[StreamPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPartFacets(context.Context, *GetPartFacetsRequest) (*GetPartFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartFacets not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateKit(context.Context, *CreateKitRequest) (*CreateKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKit not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetKit(context.Context, *GetKitRequest) (*GetKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKit not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListKits(context.Context, *ListKitsRequest) (*ListKitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKits not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateKit(context.Context, *UpdateKitRequest) (*UpdateKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKit not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteKit(context.Context, *DeleteKitRequest) (*DeleteKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKit not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateCompatibilityRule(context.Context, *CreateCompatibilityRuleRequest) (*CreateCompatibilityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompatibilityRule not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListCompatibilityRules(context.Context, *ListCompatibilityRulesRequest) (*ListCompatibilityRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompatibilityRules not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteCompatibilityRule(context.Context, *DeleteCompatibilityRuleRequest) (*DeleteCompatibilityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompatibilityRule not implemented")
+}
+func (UnimplementedInventoryServiceServer) ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfiguration not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarehouses not implemented")
+}
+func (UnimplementedInventoryServiceServer) SchedulePartPrice(context.Context, *SchedulePartPriceRequest) (*SchedulePartPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePartPrice not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListPartPrices(context.Context, *ListPartPricesRequest) (*ListPartPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPartPrices not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPartPriceAt(context.Context, *GetPartPriceAtRequest) (*GetPartPriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartPriceAt not implemented")
+}
 func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
 func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
@@ -148,6 +537,377 @@ func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_StreamParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).StreamParts(m, &grpc.GenericServerStream[StreamPartsRequest, StreamPartsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_StreamPartsServer = grpc.ServerStreamingServer[StreamPartsResponse]
+
+func _InventoryService_GetPartFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPartFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPartFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPartFacets(ctx, req.(*GetPartFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePart(ctx, req.(*CreatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, req.(*UpdatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeletePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeletePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeletePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeletePart(ctx, req.(*DeletePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateKit(ctx, req.(*CreateKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetKit(ctx, req.(*GetKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListKits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListKits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListKits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListKits(ctx, req.(*ListKitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateKit(ctx, req.(*UpdateKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteKit(ctx, req.(*DeleteKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateCompatibilityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompatibilityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateCompatibilityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateCompatibilityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateCompatibilityRule(ctx, req.(*CreateCompatibilityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListCompatibilityRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompatibilityRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListCompatibilityRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListCompatibilityRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListCompatibilityRules(ctx, req.(*ListCompatibilityRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteCompatibilityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompatibilityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteCompatibilityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteCompatibilityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteCompatibilityRule(ctx, req.(*DeleteCompatibilityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ValidateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ValidateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, req.(*ValidateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, req.(*ListWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SchedulePartPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePartPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SchedulePartPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SchedulePartPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SchedulePartPrice(ctx, req.(*SchedulePartPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListPartPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListPartPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListPartPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListPartPrices(ctx, req.(*ListPartPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPartPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPartPriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPartPriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPartPriceAt(ctx, req.(*GetPartPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,7 +923,93 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "GetPartFacets",
+			Handler:    _InventoryService_GetPartFacets_Handler,
+		},
+		{
+			MethodName: "CreatePart",
+			Handler:    _InventoryService_CreatePart_Handler,
+		},
+		{
+			MethodName: "UpdatePart",
+			Handler:    _InventoryService_UpdatePart_Handler,
+		},
+		{
+			MethodName: "DeletePart",
+			Handler:    _InventoryService_DeletePart_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _InventoryService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _InventoryService_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "CreateKit",
+			Handler:    _InventoryService_CreateKit_Handler,
+		},
+		{
+			MethodName: "GetKit",
+			Handler:    _InventoryService_GetKit_Handler,
+		},
+		{
+			MethodName: "ListKits",
+			Handler:    _InventoryService_ListKits_Handler,
+		},
+		{
+			MethodName: "UpdateKit",
+			Handler:    _InventoryService_UpdateKit_Handler,
+		},
+		{
+			MethodName: "DeleteKit",
+			Handler:    _InventoryService_DeleteKit_Handler,
+		},
+		{
+			MethodName: "CreateCompatibilityRule",
+			Handler:    _InventoryService_CreateCompatibilityRule_Handler,
+		},
+		{
+			MethodName: "ListCompatibilityRules",
+			Handler:    _InventoryService_ListCompatibilityRules_Handler,
+		},
+		{
+			MethodName: "DeleteCompatibilityRule",
+			Handler:    _InventoryService_DeleteCompatibilityRule_Handler,
+		},
+		{
+			MethodName: "ValidateConfiguration",
+			Handler:    _InventoryService_ValidateConfiguration_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _InventoryService_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "ListWarehouses",
+			Handler:    _InventoryService_ListWarehouses_Handler,
+		},
+		{
+			MethodName: "SchedulePartPrice",
+			Handler:    _InventoryService_SchedulePartPrice_Handler,
+		},
+		{
+			MethodName: "ListPartPrices",
+			Handler:    _InventoryService_ListPartPrices_Handler,
+		},
+		{
+			MethodName: "GetPartPriceAt",
+			Handler:    _InventoryService_GetPartPriceAt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamParts",
+			Handler:       _InventoryService_StreamParts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
 }
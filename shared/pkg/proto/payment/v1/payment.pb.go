@@ -11,6 +11,7 @@ package payment_v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -84,6 +85,120 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
 }
 
+// TransactionStatus перечисляет состояния транзакции.
+type TransactionStatus int32
+
+const (
+	// Неизвестное состояние
+	TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED TransactionStatus = 0
+	// Оплата прошла успешно
+	TransactionStatus_TRANSACTION_STATUS_SUCCEEDED TransactionStatus = 1
+	// Провайдер ещё не подтвердил списание
+	TransactionStatus_TRANSACTION_STATUS_PENDING TransactionStatus = 2
+	// Провайдер отказал в списании или был недоступен
+	TransactionStatus_TRANSACTION_STATUS_FAILED TransactionStatus = 3
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0: "TRANSACTION_STATUS_UNSPECIFIED",
+		1: "TRANSACTION_STATUS_SUCCEEDED",
+		2: "TRANSACTION_STATUS_PENDING",
+		3: "TRANSACTION_STATUS_FAILED",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNSPECIFIED": 0,
+		"TRANSACTION_STATUS_SUCCEEDED":   1,
+		"TRANSACTION_STATUS_PENDING":     2,
+		"TRANSACTION_STATUS_FAILED":      3,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[1]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+// FraudDecision перечисляет решения антифрод-проверки.
+type FraudDecision int32
+
+const (
+	// Транзакция создана до появления антифрода
+	FraudDecision_FRAUD_DECISION_UNSPECIFIED FraudDecision = 0
+	// Оплата разрешена
+	FraudDecision_FRAUD_DECISION_ALLOW FraudDecision = 1
+	// Оплата проведена, но помечена для ручной проверки
+	FraudDecision_FRAUD_DECISION_REVIEW FraudDecision = 2
+	// Оплата отклонена антифродом
+	FraudDecision_FRAUD_DECISION_DENY FraudDecision = 3
+)
+
+// Enum value maps for FraudDecision.
+var (
+	FraudDecision_name = map[int32]string{
+		0: "FRAUD_DECISION_UNSPECIFIED",
+		1: "FRAUD_DECISION_ALLOW",
+		2: "FRAUD_DECISION_REVIEW",
+		3: "FRAUD_DECISION_DENY",
+	}
+	FraudDecision_value = map[string]int32{
+		"FRAUD_DECISION_UNSPECIFIED": 0,
+		"FRAUD_DECISION_ALLOW":       1,
+		"FRAUD_DECISION_REVIEW":      2,
+		"FRAUD_DECISION_DENY":        3,
+	}
+)
+
+func (x FraudDecision) Enum() *FraudDecision {
+	p := new(FraudDecision)
+	*p = x
+	return p
+}
+
+func (x FraudDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FraudDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[2].Descriptor()
+}
+
+func (FraudDecision) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[2]
+}
+
+func (x FraudDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FraudDecision.Descriptor instead.
+func (FraudDecision) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
 // PayOrderRequest содержит данные для инициации оплаты заказа.
 type PayOrderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -91,10 +206,18 @@ type PayOrderRequest struct {
 	OrderUuid string `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
 	// UUID пользователя, который инициирует оплату
 	UserUuid string `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
-	// Выбранный способ оплаты
+	// Выбранный способ оплаты. Без способа и instrument_uuid оплата идёт
+	// инструментом пользователя по умолчанию
 	PaymentMethod PaymentMethod `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Сумма к оплате
+	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Код валюты (ISO 4217). Пусто — валюта сервиса по умолчанию
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// UUID сохранённого инструмента пользователя. Если задан, способ оплаты берётся
+	// из инструмента; payment_method можно не указывать или указать тот же
+	InstrumentUuid string `protobuf:"bytes,6,opt,name=instrument_uuid,json=instrumentUuid,proto3" json:"instrument_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PayOrderRequest) Reset() {
@@ -148,6 +271,27 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 }
 
+func (x *PayOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetInstrumentUuid() string {
+	if x != nil {
+		return x.InstrumentUuid
+	}
+	return ""
+}
+
 // PayOrderResponse содержит результат инициации оплаты.
 type PayOrderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -194,27 +338,974 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
-var File_payment_v1_payment_proto protoreflect.FileDescriptor
+// AmountLimitViolation передаётся в деталях ошибки FailedPrecondition,
+// когда сумма не укладывается в лимиты способа оплаты.
+type AmountLimitViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Способ оплаты
+	PaymentMethod PaymentMethod `protobuf:"varint,1,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Запрошенная сумма
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Минимальная сумма для способа оплаты
+	MinAmount float64 `protobuf:"fixed64,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// Максимальная сумма для способа оплаты. 0 — без ограничения
+	MaxAmount float64 `protobuf:"fixed64,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// Код валюты
+	Currency      string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_payment_v1_payment_proto_rawDesc = "" +
-	"\n" +
-	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\"\x8f\x01\n" +
-	"\x0fPayOrderRequest\x12\x1d\n" +
-	"\n" +
-	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
-	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
-	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\xa3\x01\n" +
+func (x *AmountLimitViolation) Reset() {
+	*x = AmountLimitViolation{}
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmountLimitViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmountLimitViolation) ProtoMessage() {}
+
+func (x *AmountLimitViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmountLimitViolation.ProtoReflect.Descriptor instead.
+func (*AmountLimitViolation) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AmountLimitViolation) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *AmountLimitViolation) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AmountLimitViolation) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *AmountLimitViolation) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *AmountLimitViolation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Transaction описывает сохранённую транзакцию оплаты.
+type Transaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// UUID заказа
+	OrderUuid string `protobuf:"bytes,2,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// UUID пользователя
+	UserUuid string `protobuf:"bytes,3,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Сумма оплаты
+	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Способ оплаты
+	PaymentMethod PaymentMethod `protobuf:"varint,5,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Состояние транзакции
+	Status TransactionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=payment.v1.TransactionStatus" json:"status,omitempty"`
+	// Дата создания
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Дата последнего изменения
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Код валюты
+	Currency string `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Решение антифрод-проверки
+	FraudDecision FraudDecision `protobuf:"varint,10,opt,name=fraud_decision,json=fraudDecision,proto3,enum=payment.v1.FraudDecision" json:"fraud_decision,omitempty"`
+	// Причины решения антифрода, через "; "
+	FraudReason string `protobuf:"bytes,11,opt,name=fraud_reason,json=fraudReason,proto3" json:"fraud_reason,omitempty"`
+	// UUID сохранённого инструмента, которым оплачен заказ. Пусто — оплата без инструмента
+	InstrumentUuid string `protobuf:"bytes,12,opt,name=instrument_uuid,json=instrumentUuid,proto3" json:"instrument_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Transaction) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetFraudDecision() FraudDecision {
+	if x != nil {
+		return x.FraudDecision
+	}
+	return FraudDecision_FRAUD_DECISION_UNSPECIFIED
+}
+
+func (x *Transaction) GetFraudReason() string {
+	if x != nil {
+		return x.FraudReason
+	}
+	return ""
+}
+
+func (x *Transaction) GetInstrumentUuid() string {
+	if x != nil {
+		return x.InstrumentUuid
+	}
+	return ""
+}
+
+// GetTransactionRequest содержит UUID транзакции.
+type GetTransactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTransactionRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// GetTransactionResponse содержит найденную транзакцию.
+type GetTransactionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Транзакция
+	Transaction   *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// ListTransactionsRequest содержит фильтр транзакций.
+type ListTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID заказа. Пусто — не фильтруем
+	OrderUuid string `protobuf:"bytes,1,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// UUID пользователя. Пусто — не фильтруем
+	UserUuid string `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Максимальное количество записей. 0 — значение по умолчанию
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Сколько записей пропустить
+	Offset        int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTransactionsRequest) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListTransactionsResponse содержит найденные транзакции.
+type ListTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Транзакции, новые первыми
+	Transactions  []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// PaymentInstrument описывает сохранённый платёжный инструмент.
+// Токен карты и телефон СБП хранятся зашифрованными и в ответах не возвращаются.
+type PaymentInstrument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID инструмента
+	InstrumentUuid string `protobuf:"bytes,1,opt,name=instrument_uuid,json=instrumentUuid,proto3" json:"instrument_uuid,omitempty"`
+	// UUID владельца
+	UserUuid string `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Способ оплаты
+	PaymentMethod PaymentMethod `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Последние 4 цифры карты (CARD, CREDIT_CARD)
+	CardLast4 string `protobuf:"bytes,4,opt,name=card_last4,json=cardLast4,proto3" json:"card_last4,omitempty"`
+	// Маскированный телефон СБП, например +7******4567
+	SbpPhoneMasked string `protobuf:"bytes,5,opt,name=sbp_phone_masked,json=sbpPhoneMasked,proto3" json:"sbp_phone_masked,omitempty"`
+	// Инструмент по умолчанию
+	IsDefault bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// Дата сохранения
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentInstrument) Reset() {
+	*x = PaymentInstrument{}
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentInstrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentInstrument) ProtoMessage() {}
+
+func (x *PaymentInstrument) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentInstrument.ProtoReflect.Descriptor instead.
+func (*PaymentInstrument) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PaymentInstrument) GetInstrumentUuid() string {
+	if x != nil {
+		return x.InstrumentUuid
+	}
+	return ""
+}
+
+func (x *PaymentInstrument) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PaymentInstrument) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *PaymentInstrument) GetCardLast4() string {
+	if x != nil {
+		return x.CardLast4
+	}
+	return ""
+}
+
+func (x *PaymentInstrument) GetSbpPhoneMasked() string {
+	if x != nil {
+		return x.SbpPhoneMasked
+	}
+	return ""
+}
+
+func (x *PaymentInstrument) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *PaymentInstrument) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AddPaymentInstrumentRequest содержит данные нового инструмента.
+type AddPaymentInstrumentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID владельца
+	UserUuid string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Способ оплаты
+	PaymentMethod PaymentMethod `protobuf:"varint,2,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Токен карты от платёжного провайдера (CARD, CREDIT_CARD)
+	CardToken string `protobuf:"bytes,3,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	// Последние 4 цифры карты (CARD, CREDIT_CARD)
+	CardLast4 string `protobuf:"bytes,4,opt,name=card_last4,json=cardLast4,proto3" json:"card_last4,omitempty"`
+	// Телефон СБП в формате +79991234567 (SBP)
+	SbpPhone string `protobuf:"bytes,5,opt,name=sbp_phone,json=sbpPhone,proto3" json:"sbp_phone,omitempty"`
+	// Сделать инструментом по умолчанию. Прежний инструмент по умолчанию перестаёт им быть
+	IsDefault     bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPaymentInstrumentRequest) Reset() {
+	*x = AddPaymentInstrumentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentInstrumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentInstrumentRequest) ProtoMessage() {}
+
+func (x *AddPaymentInstrumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentInstrumentRequest.ProtoReflect.Descriptor instead.
+func (*AddPaymentInstrumentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddPaymentInstrumentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *AddPaymentInstrumentRequest) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *AddPaymentInstrumentRequest) GetCardToken() string {
+	if x != nil {
+		return x.CardToken
+	}
+	return ""
+}
+
+func (x *AddPaymentInstrumentRequest) GetCardLast4() string {
+	if x != nil {
+		return x.CardLast4
+	}
+	return ""
+}
+
+func (x *AddPaymentInstrumentRequest) GetSbpPhone() string {
+	if x != nil {
+		return x.SbpPhone
+	}
+	return ""
+}
+
+func (x *AddPaymentInstrumentRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// AddPaymentInstrumentResponse содержит сохранённый инструмент.
+type AddPaymentInstrumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instrument    *PaymentInstrument     `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPaymentInstrumentResponse) Reset() {
+	*x = AddPaymentInstrumentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentInstrumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentInstrumentResponse) ProtoMessage() {}
+
+func (x *AddPaymentInstrumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentInstrumentResponse.ProtoReflect.Descriptor instead.
+func (*AddPaymentInstrumentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddPaymentInstrumentResponse) GetInstrument() *PaymentInstrument {
+	if x != nil {
+		return x.Instrument
+	}
+	return nil
+}
+
+// ListPaymentInstrumentsRequest содержит владельца инструментов.
+type ListPaymentInstrumentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID владельца
+	UserUuid      string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentInstrumentsRequest) Reset() {
+	*x = ListPaymentInstrumentsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentInstrumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentInstrumentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentInstrumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentInstrumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentInstrumentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPaymentInstrumentsRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// ListPaymentInstrumentsResponse содержит инструменты пользователя.
+type ListPaymentInstrumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instruments   []*PaymentInstrument   `protobuf:"bytes,1,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentInstrumentsResponse) Reset() {
+	*x = ListPaymentInstrumentsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentInstrumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentInstrumentsResponse) ProtoMessage() {}
+
+func (x *ListPaymentInstrumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentInstrumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentInstrumentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPaymentInstrumentsResponse) GetInstruments() []*PaymentInstrument {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+// DeletePaymentInstrumentRequest содержит удаляемый инструмент.
+type DeletePaymentInstrumentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID владельца
+	UserUuid string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// UUID инструмента
+	InstrumentUuid string `protobuf:"bytes,2,opt,name=instrument_uuid,json=instrumentUuid,proto3" json:"instrument_uuid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeletePaymentInstrumentRequest) Reset() {
+	*x = DeletePaymentInstrumentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePaymentInstrumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentInstrumentRequest) ProtoMessage() {}
+
+func (x *DeletePaymentInstrumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentInstrumentRequest.ProtoReflect.Descriptor instead.
+func (*DeletePaymentInstrumentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeletePaymentInstrumentRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *DeletePaymentInstrumentRequest) GetInstrumentUuid() string {
+	if x != nil {
+		return x.InstrumentUuid
+	}
+	return ""
+}
+
+// DeletePaymentInstrumentResponse — пустой ответ на удаление.
+type DeletePaymentInstrumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePaymentInstrumentResponse) Reset() {
+	*x = DeletePaymentInstrumentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePaymentInstrumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentInstrumentResponse) ProtoMessage() {}
+
+func (x *DeletePaymentInstrumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentInstrumentResponse.ProtoReflect.Descriptor instead.
+func (*DeletePaymentInstrumentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+}
+
+var File_payment_v1_payment_proto protoreflect.FileDescriptor
+
+const file_payment_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x18payment/v1/payment.proto\x12\n" +
+	"payment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xec\x01\n" +
+	"\x0fPayOrderRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12'\n" +
+	"\x0finstrument_uuid\x18\x06 \x01(\tR\x0einstrumentUuid\"=\n" +
+	"\x10PayOrderResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"\xca\x01\n" +
+	"\x14AmountLimitViolation\x12@\n" +
+	"\x0epayment_method\x18\x01 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x03 \x01(\x01R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x04 \x01(\x01R\tmaxAmount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xa5\x04\n" +
+	"\vTransaction\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12@\n" +
+	"\x0epayment_method\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x125\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1d.payment.v1.TransactionStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12@\n" +
+	"\x0efraud_decision\x18\n" +
+	" \x01(\x0e2\x19.payment.v1.FraudDecisionR\rfraudDecision\x12!\n" +
+	"\ffraud_reason\x18\v \x01(\tR\vfraudReason\x12'\n" +
+	"\x0finstrument_uuid\x18\f \x01(\tR\x0einstrumentUuid\"B\n" +
+	"\x15GetTransactionRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"S\n" +
+	"\x16GetTransactionResponse\x129\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x17.payment.v1.TransactionR\vtransaction\"\x83\x01\n" +
+	"\x17ListTransactionsRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"W\n" +
+	"\x18ListTransactionsResponse\x12;\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x17.payment.v1.TransactionR\ftransactions\"\xbe\x02\n" +
+	"\x11PaymentInstrument\x12'\n" +
+	"\x0finstrument_uuid\x18\x01 \x01(\tR\x0einstrumentUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x1d\n" +
+	"\n" +
+	"card_last4\x18\x04 \x01(\tR\tcardLast4\x12(\n" +
+	"\x10sbp_phone_masked\x18\x05 \x01(\tR\x0esbpPhoneMasked\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf6\x01\n" +
+	"\x1bAddPaymentInstrumentRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x1d\n" +
+	"\n" +
+	"card_token\x18\x03 \x01(\tR\tcardToken\x12\x1d\n" +
+	"\n" +
+	"card_last4\x18\x04 \x01(\tR\tcardLast4\x12\x1b\n" +
+	"\tsbp_phone\x18\x05 \x01(\tR\bsbpPhone\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\"]\n" +
+	"\x1cAddPaymentInstrumentResponse\x12=\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\v2\x1d.payment.v1.PaymentInstrumentR\n" +
+	"instrument\"<\n" +
+	"\x1dListPaymentInstrumentsRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\"a\n" +
+	"\x1eListPaymentInstrumentsResponse\x12?\n" +
+	"\vinstruments\x18\x01 \x03(\v2\x1d.payment.v1.PaymentInstrumentR\vinstruments\"f\n" +
+	"\x1eDeletePaymentInstrumentRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12'\n" +
+	"\x0finstrument_uuid\x18\x02 \x01(\tR\x0einstrumentUuid\"!\n" +
+	"\x1fDeletePaymentInstrumentResponse*\xa3\x01\n" +
 	"\rPaymentMethod\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
 	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
 	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042W\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x04*\x98\x01\n" +
+	"\x11TransactionStatus\x12\"\n" +
+	"\x1eTRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cTRANSACTION_STATUS_SUCCEEDED\x10\x01\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_PENDING\x10\x02\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_FAILED\x10\x03*}\n" +
+	"\rFraudDecision\x12\x1e\n" +
+	"\x1aFRAUD_DECISION_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14FRAUD_DECISION_ALLOW\x10\x01\x12\x19\n" +
+	"\x15FRAUD_DECISION_REVIEW\x10\x02\x12\x17\n" +
+	"\x13FRAUD_DECISION_DENY\x10\x032\xdf\x04\n" +
 	"\x0ePaymentService\x12E\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponseBLZJgithub.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\x12W\n" +
+	"\x0eGetTransaction\x12!.payment.v1.GetTransactionRequest\x1a\".payment.v1.GetTransactionResponse\x12]\n" +
+	"\x10ListTransactions\x12#.payment.v1.ListTransactionsRequest\x1a$.payment.v1.ListTransactionsResponse\x12i\n" +
+	"\x14AddPaymentInstrument\x12'.payment.v1.AddPaymentInstrumentRequest\x1a(.payment.v1.AddPaymentInstrumentResponse\x12o\n" +
+	"\x16ListPaymentInstruments\x12).payment.v1.ListPaymentInstrumentsRequest\x1a*.payment.v1.ListPaymentInstrumentsResponse\x12r\n" +
+	"\x17DeletePaymentInstrument\x12*.payment.v1.DeletePaymentInstrumentRequest\x1a+.payment.v1.DeletePaymentInstrumentResponseBLZJgithub.com/nkolesnikov999/micro2-OK/shared/pkg/proto/payment/v1;payment_v1b\x06proto3"
 
 var (
 	file_payment_v1_payment_proto_rawDescOnce sync.Once
@@ -228,22 +1319,61 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 	return file_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(PaymentMethod)(0),                      // 0: payment.v1.PaymentMethod
+	(TransactionStatus)(0),                  // 1: payment.v1.TransactionStatus
+	(FraudDecision)(0),                      // 2: payment.v1.FraudDecision
+	(*PayOrderRequest)(nil),                 // 3: payment.v1.PayOrderRequest
+	(*PayOrderResponse)(nil),                // 4: payment.v1.PayOrderResponse
+	(*AmountLimitViolation)(nil),            // 5: payment.v1.AmountLimitViolation
+	(*Transaction)(nil),                     // 6: payment.v1.Transaction
+	(*GetTransactionRequest)(nil),           // 7: payment.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),          // 8: payment.v1.GetTransactionResponse
+	(*ListTransactionsRequest)(nil),         // 9: payment.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),        // 10: payment.v1.ListTransactionsResponse
+	(*PaymentInstrument)(nil),               // 11: payment.v1.PaymentInstrument
+	(*AddPaymentInstrumentRequest)(nil),     // 12: payment.v1.AddPaymentInstrumentRequest
+	(*AddPaymentInstrumentResponse)(nil),    // 13: payment.v1.AddPaymentInstrumentResponse
+	(*ListPaymentInstrumentsRequest)(nil),   // 14: payment.v1.ListPaymentInstrumentsRequest
+	(*ListPaymentInstrumentsResponse)(nil),  // 15: payment.v1.ListPaymentInstrumentsResponse
+	(*DeletePaymentInstrumentRequest)(nil),  // 16: payment.v1.DeletePaymentInstrumentRequest
+	(*DeletePaymentInstrumentResponse)(nil), // 17: payment.v1.DeletePaymentInstrumentResponse
+	(*timestamppb.Timestamp)(nil),           // 18: google.protobuf.Timestamp
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
-	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	0,  // 1: payment.v1.AmountLimitViolation.payment_method:type_name -> payment.v1.PaymentMethod
+	0,  // 2: payment.v1.Transaction.payment_method:type_name -> payment.v1.PaymentMethod
+	1,  // 3: payment.v1.Transaction.status:type_name -> payment.v1.TransactionStatus
+	18, // 4: payment.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	18, // 5: payment.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: payment.v1.Transaction.fraud_decision:type_name -> payment.v1.FraudDecision
+	6,  // 7: payment.v1.GetTransactionResponse.transaction:type_name -> payment.v1.Transaction
+	6,  // 8: payment.v1.ListTransactionsResponse.transactions:type_name -> payment.v1.Transaction
+	0,  // 9: payment.v1.PaymentInstrument.payment_method:type_name -> payment.v1.PaymentMethod
+	18, // 10: payment.v1.PaymentInstrument.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: payment.v1.AddPaymentInstrumentRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	11, // 12: payment.v1.AddPaymentInstrumentResponse.instrument:type_name -> payment.v1.PaymentInstrument
+	11, // 13: payment.v1.ListPaymentInstrumentsResponse.instruments:type_name -> payment.v1.PaymentInstrument
+	3,  // 14: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	7,  // 15: payment.v1.PaymentService.GetTransaction:input_type -> payment.v1.GetTransactionRequest
+	9,  // 16: payment.v1.PaymentService.ListTransactions:input_type -> payment.v1.ListTransactionsRequest
+	12, // 17: payment.v1.PaymentService.AddPaymentInstrument:input_type -> payment.v1.AddPaymentInstrumentRequest
+	14, // 18: payment.v1.PaymentService.ListPaymentInstruments:input_type -> payment.v1.ListPaymentInstrumentsRequest
+	16, // 19: payment.v1.PaymentService.DeletePaymentInstrument:input_type -> payment.v1.DeletePaymentInstrumentRequest
+	4,  // 20: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	8,  // 21: payment.v1.PaymentService.GetTransaction:output_type -> payment.v1.GetTransactionResponse
+	10, // 22: payment.v1.PaymentService.ListTransactions:output_type -> payment.v1.ListTransactionsResponse
+	13, // 23: payment.v1.PaymentService.AddPaymentInstrument:output_type -> payment.v1.AddPaymentInstrumentResponse
+	15, // 24: payment.v1.PaymentService.ListPaymentInstruments:output_type -> payment.v1.ListPaymentInstrumentsResponse
+	17, // 25: payment.v1.PaymentService.DeletePaymentInstrument:output_type -> payment.v1.DeletePaymentInstrumentResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -256,8 +1386,8 @@ func file_payment_v1_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,7 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_PayOrder_FullMethodName = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_PayOrder_FullMethodName                = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_GetTransaction_FullMethodName          = "/payment.v1.PaymentService/GetTransaction"
+	PaymentService_ListTransactions_FullMethodName        = "/payment.v1.PaymentService/ListTransactions"
+	PaymentService_AddPaymentInstrument_FullMethodName    = "/payment.v1.PaymentService/AddPaymentInstrument"
+	PaymentService_ListPaymentInstruments_FullMethodName  = "/payment.v1.PaymentService/ListPaymentInstruments"
+	PaymentService_DeletePaymentInstrument_FullMethodName = "/payment.v1.PaymentService/DeletePaymentInstrument"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -32,6 +37,16 @@ const (
 type PaymentServiceClient interface {
 	// Инициирует оплату заказа.
 	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error)
+	// Возвращает транзакцию по UUID.
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	// Возвращает транзакции по фильтру, новые первыми.
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// Сохраняет платёжный инструмент пользователя.
+	AddPaymentInstrument(ctx context.Context, in *AddPaymentInstrumentRequest, opts ...grpc.CallOption) (*AddPaymentInstrumentResponse, error)
+	// Возвращает сохранённые инструменты пользователя, инструмент по умолчанию первым.
+	ListPaymentInstruments(ctx context.Context, in *ListPaymentInstrumentsRequest, opts ...grpc.CallOption) (*ListPaymentInstrumentsResponse, error)
+	// Удаляет сохранённый инструмент пользователя.
+	DeletePaymentInstrument(ctx context.Context, in *DeletePaymentInstrumentRequest, opts ...grpc.CallOption) (*DeletePaymentInstrumentResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -52,6 +67,56 @@ func (c *paymentServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest
 	return out, nil
 }
 
+func (c *paymentServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) AddPaymentInstrument(ctx context.Context, in *AddPaymentInstrumentRequest, opts ...grpc.CallOption) (*AddPaymentInstrumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPaymentInstrumentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_AddPaymentInstrument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListPaymentInstruments(ctx context.Context, in *ListPaymentInstrumentsRequest, opts ...grpc.CallOption) (*ListPaymentInstrumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentInstrumentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPaymentInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) DeletePaymentInstrument(ctx context.Context, in *DeletePaymentInstrumentRequest, opts ...grpc.CallOption) (*DeletePaymentInstrumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePaymentInstrumentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_DeletePaymentInstrument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -60,6 +125,16 @@ func (c *paymentServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest
 type PaymentServiceServer interface {
 	// Инициирует оплату заказа.
 	PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error)
+	// Возвращает транзакцию по UUID.
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	// Возвращает транзакции по фильтру, новые первыми.
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// Сохраняет платёжный инструмент пользователя.
+	AddPaymentInstrument(context.Context, *AddPaymentInstrumentRequest) (*AddPaymentInstrumentResponse, error)
+	// Возвращает сохранённые инструменты пользователя, инструмент по умолчанию первым.
+	ListPaymentInstruments(context.Context, *ListPaymentInstrumentsRequest) (*ListPaymentInstrumentsResponse, error)
+	// Удаляет сохранённый инструмент пользователя.
+	DeletePaymentInstrument(context.Context, *DeletePaymentInstrumentRequest) (*DeletePaymentInstrumentResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -73,6 +148,21 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
 }
+func (UnimplementedPaymentServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedPaymentServiceServer) AddPaymentInstrument(context.Context, *AddPaymentInstrumentRequest) (*AddPaymentInstrumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPaymentInstrument not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListPaymentInstruments(context.Context, *ListPaymentInstrumentsRequest) (*ListPaymentInstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentInstruments not implemented")
+}
+func (UnimplementedPaymentServiceServer) DeletePaymentInstrument(context.Context, *DeletePaymentInstrumentRequest) (*DeletePaymentInstrumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentInstrument not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -112,6 +202,96 @@ func _PaymentService_PayOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_AddPaymentInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPaymentInstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).AddPaymentInstrument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_AddPaymentInstrument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).AddPaymentInstrument(ctx, req.(*AddPaymentInstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPaymentInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPaymentInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPaymentInstruments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPaymentInstruments(ctx, req.(*ListPaymentInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_DeletePaymentInstrument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentInstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).DeletePaymentInstrument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_DeletePaymentInstrument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).DeletePaymentInstrument(ctx, req.(*DeletePaymentInstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +303,26 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PayOrder",
 			Handler:    _PaymentService_PayOrder_Handler,
 		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _PaymentService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _PaymentService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "AddPaymentInstrument",
+			Handler:    _PaymentService_AddPaymentInstrument_Handler,
+		},
+		{
+			MethodName: "ListPaymentInstruments",
+			Handler:    _PaymentService_ListPaymentInstruments_Handler,
+		},
+		{
+			MethodName: "DeletePaymentInstrument",
+			Handler:    _PaymentService_DeletePaymentInstrument_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/v1/payment.proto",